@@ -142,3 +142,106 @@ func Convert_v1beta1_OCIManagedClusterStatus_To_v1beta2_OCIManagedClusterStatus(
 func Convert_v1beta2_OCIManagedClusterSpec_To_v1beta1_OCIManagedClusterSpec(in *v1beta2.OCIManagedClusterSpec, out *OCIManagedClusterSpec, s conversion.Scope) error {
 	return autoConvert_v1beta2_OCIManagedClusterSpec_To_v1beta1_OCIManagedClusterSpec(in, out, s)
 }
+
+// Convert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec converts v1beta2 NetworkSpec to v1beta1 NetworkSpec
+func Convert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in *v1beta2.NetworkSpec, out *NetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in, out, s)
+}
+
+// Convert_v1beta2_Subnet_To_v1beta1_Subnet converts v1beta2 Subnet to v1beta1 Subnet
+func Convert_v1beta2_Subnet_To_v1beta1_Subnet(in *v1beta2.Subnet, out *Subnet, s conversion.Scope) error {
+	return autoConvert_v1beta2_Subnet_To_v1beta1_Subnet(in, out, s)
+}
+
+// Convert_v1beta2_NSG_To_v1beta1_NSG converts v1beta2 NSG to v1beta1 NSG
+func Convert_v1beta2_NSG_To_v1beta1_NSG(in *v1beta2.NSG, out *NSG, s conversion.Scope) error {
+	return autoConvert_v1beta2_NSG_To_v1beta1_NSG(in, out, s)
+}
+
+// Convert_v1beta2_DRG_To_v1beta1_DRG converts v1beta2 DRG to v1beta1 DRG
+func Convert_v1beta2_DRG_To_v1beta1_DRG(in *v1beta2.DRG, out *DRG, s conversion.Scope) error {
+	return autoConvert_v1beta2_DRG_To_v1beta1_DRG(in, out, s)
+}
+
+// Convert_v1beta2_VCNPeering_To_v1beta1_VCNPeering converts v1beta2 VCNPeering to v1beta1 VCNPeering
+func Convert_v1beta2_VCNPeering_To_v1beta1_VCNPeering(in *v1beta2.VCNPeering, out *VCNPeering, s conversion.Scope) error {
+	return autoConvert_v1beta2_VCNPeering_To_v1beta1_VCNPeering(in, out, s)
+}
+
+// Convert_v1beta2_OCIClusterStatus_To_v1beta1_OCIClusterStatus converts v1beta2 OCIClusterStatus to v1beta1 OCIClusterStatus
+func Convert_v1beta2_OCIClusterStatus_To_v1beta1_OCIClusterStatus(in *v1beta2.OCIClusterStatus, out *OCIClusterStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_OCIClusterStatus_To_v1beta1_OCIClusterStatus(in, out, s)
+}
+
+// Convert_v1beta2_OCIMachineStatus_To_v1beta1_OCIMachineStatus converts v1beta2 OCIMachineStatus to v1beta1 OCIMachineStatus
+func Convert_v1beta2_OCIMachineStatus_To_v1beta1_OCIMachineStatus(in *v1beta2.OCIMachineStatus, out *OCIMachineStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_OCIMachineStatus_To_v1beta1_OCIMachineStatus(in, out, s)
+}
+
+// Convert_v1beta2_OCIMachineSpec_To_v1beta1_OCIMachineSpec converts v1beta2 OCIMachineSpec to v1beta1 OCIMachineSpec
+func Convert_v1beta2_OCIMachineSpec_To_v1beta1_OCIMachineSpec(in *v1beta2.OCIMachineSpec, out *OCIMachineSpec, s conversion.Scope) error {
+	return autoConvert_v1beta2_OCIMachineSpec_To_v1beta1_OCIMachineSpec(in, out, s)
+}
+
+// Convert_v1beta2_OCIManagedClusterStatus_To_v1beta1_OCIManagedClusterStatus converts v1beta2 OCIManagedClusterStatus to v1beta1 OCIManagedClusterStatus
+func Convert_v1beta2_OCIManagedClusterStatus_To_v1beta1_OCIManagedClusterStatus(in *v1beta2.OCIManagedClusterStatus, out *OCIManagedClusterStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_OCIManagedClusterStatus_To_v1beta1_OCIManagedClusterStatus(in, out, s)
+}
+
+// Convert_v1beta2_RemotePeeringConnection_To_v1beta1_RemotePeeringConnection converts v1beta2 RemotePeeringConnection to v1beta1 RemotePeeringConnection
+func Convert_v1beta2_RemotePeeringConnection_To_v1beta1_RemotePeeringConnection(in *v1beta2.RemotePeeringConnection, out *RemotePeeringConnection, s conversion.Scope) error {
+	return autoConvert_v1beta2_RemotePeeringConnection_To_v1beta1_RemotePeeringConnection(in, out, s)
+}
+
+// restoreNetworkSpec restores the fields of the network resources which are not available in v1beta1.
+func restoreNetworkSpec(dst *v1beta2.NetworkSpec, restored *v1beta2.NetworkSpec) {
+	dst.CompartmentId = restored.CompartmentId
+	dst.APIServerLB.CompartmentId = restored.APIServerLB.CompartmentId
+	dst.APIServerLB.DeletionPolicy = restored.APIServerLB.DeletionPolicy
+	dst.Vcn.DeletionPolicy = restored.Vcn.DeletionPolicy
+	for i, subnet := range dst.Vcn.Subnets {
+		if subnet != nil && i < len(restored.Vcn.Subnets) && restored.Vcn.Subnets[i] != nil {
+			subnet.CompartmentId = restored.Vcn.Subnets[i].CompartmentId
+			subnet.FlowLog = restored.Vcn.Subnets[i].FlowLog
+			subnet.DeletionPolicy = restored.Vcn.Subnets[i].DeletionPolicy
+		}
+	}
+	for i, nsg := range dst.Vcn.NetworkSecurityGroup.List {
+		if nsg != nil && i < len(restored.Vcn.NetworkSecurityGroup.List) && restored.Vcn.NetworkSecurityGroup.List[i] != nil {
+			nsg.CompartmentId = restored.Vcn.NetworkSecurityGroup.List[i].CompartmentId
+			nsg.DeletionPolicy = restored.Vcn.NetworkSecurityGroup.List[i].DeletionPolicy
+		}
+	}
+	if dst.VCNPeering != nil && restored.VCNPeering != nil {
+		dst.VCNPeering.VPN = restored.VCNPeering.VPN
+		if dst.VCNPeering.DRG != nil && restored.VCNPeering.DRG != nil {
+			dst.VCNPeering.DRG.DeletionPolicy = restored.VCNPeering.DRG.DeletionPolicy
+		}
+		for i := range dst.VCNPeering.RemotePeeringConnections {
+			if i < len(restored.VCNPeering.RemotePeeringConnections) {
+				dst.VCNPeering.RemotePeeringConnections[i].PeerIdentityRef = restored.VCNPeering.RemotePeeringConnections[i].PeerIdentityRef
+				dst.VCNPeering.RemotePeeringConnections[i].PeerCompartmentId = restored.VCNPeering.RemotePeeringConnections[i].PeerCompartmentId
+			}
+		}
+	}
+}
+
+// Convert_Pointer_v1beta1_Subnet_To_Pointer_v1beta2_Subnet converts a v1beta1 Subnet pointer to a v1beta2 Subnet pointer
+func Convert_Pointer_v1beta1_Subnet_To_Pointer_v1beta2_Subnet(in **Subnet, out **v1beta2.Subnet, s conversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	*out = &v1beta2.Subnet{}
+	return Convert_v1beta1_Subnet_To_v1beta2_Subnet(*in, *out, s)
+}
+
+// Convert_Pointer_v1beta2_Subnet_To_Pointer_v1beta1_Subnet converts a v1beta2 Subnet pointer to a v1beta1 Subnet pointer
+func Convert_Pointer_v1beta2_Subnet_To_Pointer_v1beta1_Subnet(in **v1beta2.Subnet, out **Subnet, s conversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	*out = &Subnet{}
+	return Convert_v1beta2_Subnet_To_v1beta1_Subnet(*in, *out, s)
+}
@@ -47,8 +47,18 @@ func (src *OCICluster) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.NetworkSpec.Vcn.ServiceGateway.Skip = restored.Spec.NetworkSpec.Vcn.ServiceGateway.Skip
 	dst.Spec.NetworkSpec.Vcn.InternetGateway.Skip = restored.Spec.NetworkSpec.Vcn.InternetGateway.Skip
 	dst.Spec.NetworkSpec.Vcn.RouteTable.Skip = restored.Spec.NetworkSpec.Vcn.RouteTable.Skip
+	dst.Spec.NetworkSpec.Vcn.Shared = restored.Spec.NetworkSpec.Vcn.Shared
+	restoreNetworkSpec(&dst.Spec.NetworkSpec, &restored.Spec.NetworkSpec)
 	dst.Spec.NetworkSpec.APIServerLB.LoadBalancerType = restored.Spec.NetworkSpec.APIServerLB.LoadBalancerType
 	dst.Spec.ClientOverrides = restored.Spec.ClientOverrides
+	dst.Spec.WorkloadResourceCleanup = restored.Spec.WorkloadResourceCleanup
+	dst.Spec.DriftPolicy = restored.Spec.DriftPolicy
+	dst.Status.RemotePeeringConnections = restored.Status.RemotePeeringConnections
+	dst.Status.Drift = restored.Status.Drift
+	dst.Status.Network = restored.Status.Network
+	dst.Status.PlannedOperations = restored.Status.PlannedOperations
+	dst.Status.Hibernation = restored.Status.Hibernation
+	dst.Status.AppliedTags = restored.Status.AppliedTags
 
 	return nil
 }
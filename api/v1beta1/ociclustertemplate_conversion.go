@@ -40,9 +40,13 @@ func (src *OCIClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Template.Spec.NetworkSpec.Vcn.ServiceGateway.Skip = restored.Spec.Template.Spec.NetworkSpec.Vcn.ServiceGateway.Skip
 	dst.Spec.Template.Spec.NetworkSpec.Vcn.InternetGateway.Skip = restored.Spec.Template.Spec.NetworkSpec.Vcn.InternetGateway.Skip
 	dst.Spec.Template.Spec.NetworkSpec.Vcn.RouteTable.Skip = restored.Spec.Template.Spec.NetworkSpec.Vcn.RouteTable.Skip
+	dst.Spec.Template.Spec.NetworkSpec.Vcn.Shared = restored.Spec.Template.Spec.NetworkSpec.Vcn.Shared
+	restoreNetworkSpec(&dst.Spec.Template.Spec.NetworkSpec, &restored.Spec.Template.Spec.NetworkSpec)
 	dst.Spec.Template.Spec.AvailabilityDomains = restored.Spec.Template.Spec.AvailabilityDomains
 	dst.Spec.Template.Spec.NetworkSpec.APIServerLB.LoadBalancerType = restored.Spec.Template.Spec.NetworkSpec.APIServerLB.LoadBalancerType
 	dst.Spec.Template.Spec.ClientOverrides = restored.Spec.Template.Spec.ClientOverrides
+	dst.Spec.Template.Spec.WorkloadResourceCleanup = restored.Spec.Template.Spec.WorkloadResourceCleanup
+	dst.Spec.Template.Spec.DriftPolicy = restored.Spec.Template.Spec.DriftPolicy
 	return nil
 }
 
@@ -34,6 +34,28 @@ func (src *OCIMachine) ConvertTo(dstRaw conversion.Hub) error {
 	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
 		return err
 	}
+	dst.Spec.UpdatePolicy = restored.Spec.UpdatePolicy
+	dst.Spec.ImageSelector = restored.Spec.ImageSelector
+	dst.Status.Image = restored.Status.Image
+	dst.Spec.LaunchFallbackPolicy = restored.Spec.LaunchFallbackPolicy
+	dst.Spec.FailureDomain = restored.Spec.FailureDomain
+	dst.Spec.InstanceSourceViaBootVolumeDetails = restored.Spec.InstanceSourceViaBootVolumeDetails
+	dst.Spec.BootVolumeBackupPolicyId = restored.Spec.BootVolumeBackupPolicyId
+	dst.Spec.PersistentVolumes = restored.Spec.PersistentVolumes
+	dst.Spec.ConsoleHistoryCapture = restored.Spec.ConsoleHistoryCapture
+	dst.Spec.BootstrapFormat = restored.Spec.BootstrapFormat
+	dst.Spec.IgnitionStorage = restored.Spec.IgnitionStorage
+	dst.Spec.StoreWindowsCredentials = restored.Spec.StoreWindowsCredentials
+	dst.Spec.MaintenanceEventHandling = restored.Spec.MaintenanceEventHandling
+	dst.Status.LaunchAttempts = restored.Status.LaunchAttempts
+	dst.Status.Placement = restored.Status.Placement
+	dst.Status.Hibernated = restored.Status.Hibernated
+	dst.Status.BootVolumeId = restored.Status.BootVolumeId
+	dst.Status.PersistentVolumes = restored.Status.PersistentVolumes
+	dst.Status.ConsoleHistory = restored.Status.ConsoleHistory
+	dst.Status.Ignition = restored.Status.Ignition
+	dst.Status.WindowsCredentials = restored.Status.WindowsCredentials
+	dst.Status.AppliedTags = restored.Status.AppliedTags
 
 	return nil
 }
@@ -74,6 +96,18 @@ func (r *OCIMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	if ok, err := utilconversion.UnmarshalData(r, restored); err != nil || !ok {
 		return err
 	}
+	dst.Spec.Template.Spec.UpdatePolicy = restored.Spec.Template.Spec.UpdatePolicy
+	dst.Spec.Template.Spec.ImageSelector = restored.Spec.Template.Spec.ImageSelector
+	dst.Spec.Template.Spec.LaunchFallbackPolicy = restored.Spec.Template.Spec.LaunchFallbackPolicy
+	dst.Spec.Template.Spec.FailureDomain = restored.Spec.Template.Spec.FailureDomain
+	dst.Spec.Template.Spec.InstanceSourceViaBootVolumeDetails = restored.Spec.Template.Spec.InstanceSourceViaBootVolumeDetails
+	dst.Spec.Template.Spec.BootVolumeBackupPolicyId = restored.Spec.Template.Spec.BootVolumeBackupPolicyId
+	dst.Spec.Template.Spec.PersistentVolumes = restored.Spec.Template.Spec.PersistentVolumes
+	dst.Spec.Template.Spec.ConsoleHistoryCapture = restored.Spec.Template.Spec.ConsoleHistoryCapture
+	dst.Spec.Template.Spec.BootstrapFormat = restored.Spec.Template.Spec.BootstrapFormat
+	dst.Spec.Template.Spec.IgnitionStorage = restored.Spec.Template.Spec.IgnitionStorage
+	dst.Spec.Template.Spec.StoreWindowsCredentials = restored.Spec.Template.Spec.StoreWindowsCredentials
+	dst.Spec.Template.Spec.MaintenanceEventHandling = restored.Spec.Template.Spec.MaintenanceEventHandling
 
 	return nil
 }
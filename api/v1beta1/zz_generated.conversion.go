@@ -145,11 +145,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.DRG)(nil), (*DRG)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_DRG_To_v1beta1_DRG(a.(*v1beta2.DRG), b.(*DRG), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*EgressSecurityRule)(nil), (*v1beta2.EgressSecurityRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_EgressSecurityRule_To_v1beta2_EgressSecurityRule(a.(*EgressSecurityRule), b.(*v1beta2.EgressSecurityRule), scope)
 	}); err != nil {
@@ -380,11 +375,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.NSG)(nil), (*NSG)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_NSG_To_v1beta1_NSG(a.(*v1beta2.NSG), b.(*NSG), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*v1beta2.NetworkDetails)(nil), (*NetworkDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_NetworkDetails_To_v1beta1_NetworkDetails(a.(*v1beta2.NetworkDetails), b.(*NetworkDetails), scope)
 	}); err != nil {
@@ -395,11 +385,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.NetworkSpec)(nil), (*NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(a.(*v1beta2.NetworkSpec), b.(*NetworkSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*OCIAvailabilityDomain)(nil), (*v1beta2.OCIAvailabilityDomain)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OCIAvailabilityDomain_To_v1beta2_OCIAvailabilityDomain(a.(*OCIAvailabilityDomain), b.(*v1beta2.OCIAvailabilityDomain), scope)
 	}); err != nil {
@@ -475,11 +460,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.OCIClusterStatus)(nil), (*OCIClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_OCIClusterStatus_To_v1beta1_OCIClusterStatus(a.(*v1beta2.OCIClusterStatus), b.(*OCIClusterStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*OCIClusterTemplate)(nil), (*v1beta2.OCIClusterTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OCIClusterTemplate_To_v1beta2_OCIClusterTemplate(a.(*OCIClusterTemplate), b.(*v1beta2.OCIClusterTemplate), scope)
 	}); err != nil {
@@ -540,21 +520,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.OCIMachineSpec)(nil), (*OCIMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_OCIMachineSpec_To_v1beta1_OCIMachineSpec(a.(*v1beta2.OCIMachineSpec), b.(*OCIMachineSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*OCIMachineStatus)(nil), (*v1beta2.OCIMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OCIMachineStatus_To_v1beta2_OCIMachineStatus(a.(*OCIMachineStatus), b.(*v1beta2.OCIMachineStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.OCIMachineStatus)(nil), (*OCIMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_OCIMachineStatus_To_v1beta1_OCIMachineStatus(a.(*v1beta2.OCIMachineStatus), b.(*OCIMachineStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*OCIMachineTemplate)(nil), (*v1beta2.OCIMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OCIMachineTemplate_To_v1beta2_OCIMachineTemplate(a.(*OCIMachineTemplate), b.(*v1beta2.OCIMachineTemplate), scope)
 	}); err != nil {
@@ -620,11 +590,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.OCIManagedClusterStatus)(nil), (*OCIManagedClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_OCIManagedClusterStatus_To_v1beta1_OCIManagedClusterStatus(a.(*v1beta2.OCIManagedClusterStatus), b.(*OCIManagedClusterStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*OCIManagedClusterTemplate)(nil), (*v1beta2.OCIManagedClusterTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OCIManagedClusterTemplate_To_v1beta2_OCIManagedClusterTemplate(a.(*OCIManagedClusterTemplate), b.(*v1beta2.OCIManagedClusterTemplate), scope)
 	}); err != nil {
@@ -780,11 +745,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.RemotePeeringConnection)(nil), (*RemotePeeringConnection)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_RemotePeeringConnection_To_v1beta1_RemotePeeringConnection(a.(*v1beta2.RemotePeeringConnection), b.(*RemotePeeringConnection), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*SecurityList)(nil), (*v1beta2.SecurityList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SecurityList_To_v1beta2_SecurityList(a.(*SecurityList), b.(*v1beta2.SecurityList), scope)
 	}); err != nil {
@@ -810,11 +770,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Subnet)(nil), (*Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Subnet_To_v1beta1_Subnet(a.(*v1beta2.Subnet), b.(*Subnet), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*TcpOptions)(nil), (*v1beta2.TcpOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_TcpOptions_To_v1beta2_TcpOptions(a.(*TcpOptions), b.(*v1beta2.TcpOptions), scope)
 	}); err != nil {
@@ -850,11 +805,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.VCNPeering)(nil), (*VCNPeering)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_VCNPeering_To_v1beta1_VCNPeering(a.(*v1beta2.VCNPeering), b.(*VCNPeering), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VnicAttachment)(nil), (*v1beta2.VnicAttachment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VnicAttachment_To_v1beta2_VnicAttachment(a.(*VnicAttachment), b.(*v1beta2.VnicAttachment), scope)
 	}); err != nil {
@@ -865,6 +815,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((**Subnet)(nil), (**v1beta2.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_v1beta1_Subnet_To_Pointer_v1beta2_Subnet(a.(**Subnet), b.(**v1beta2.Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((**v1beta2.Subnet)(nil), (**Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_v1beta2_Subnet_To_Pointer_v1beta1_Subnet(a.(**v1beta2.Subnet), b.(**Subnet), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*EgressSecurityRuleForNSG)(nil), (*v1beta2.EgressSecurityRuleForNSG)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_EgressSecurityRuleForNSG_To_v1beta2_EgressSecurityRuleForNSG(a.(*EgressSecurityRuleForNSG), b.(*v1beta2.EgressSecurityRuleForNSG), scope)
 	}); err != nil {
@@ -900,21 +860,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.DRG)(nil), (*DRG)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DRG_To_v1beta1_DRG(a.(*v1beta2.DRG), b.(*DRG), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.LoadBalancer)(nil), (*LoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer(a.(*v1beta2.LoadBalancer), b.(*LoadBalancer), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.NSG)(nil), (*NSG)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NSG_To_v1beta1_NSG(a.(*v1beta2.NSG), b.(*NSG), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.NetworkSpec)(nil), (*NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(a.(*v1beta2.NetworkSpec), b.(*NetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.OCIClusterSpec)(nil), (*OCIClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_OCIClusterSpec_To_v1beta1_OCIClusterSpec(a.(*v1beta2.OCIClusterSpec), b.(*OCIClusterSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.OCIClusterStatus)(nil), (*OCIClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OCIClusterStatus_To_v1beta1_OCIClusterStatus(a.(*v1beta2.OCIClusterStatus), b.(*OCIClusterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.OCIMachineSpec)(nil), (*OCIMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OCIMachineSpec_To_v1beta1_OCIMachineSpec(a.(*v1beta2.OCIMachineSpec), b.(*OCIMachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.OCIMachineStatus)(nil), (*OCIMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OCIMachineStatus_To_v1beta1_OCIMachineStatus(a.(*v1beta2.OCIMachineStatus), b.(*OCIMachineStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.OCIManagedClusterSpec)(nil), (*OCIManagedClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_OCIManagedClusterSpec_To_v1beta1_OCIManagedClusterSpec(a.(*v1beta2.OCIManagedClusterSpec), b.(*OCIManagedClusterSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.OCIManagedClusterStatus)(nil), (*OCIManagedClusterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OCIManagedClusterStatus_To_v1beta1_OCIManagedClusterStatus(a.(*v1beta2.OCIManagedClusterStatus), b.(*OCIManagedClusterStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.OCIManagedControlPlaneSpec)(nil), (*OCIManagedControlPlaneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_OCIManagedControlPlaneSpec_To_v1beta1_OCIManagedControlPlaneSpec(a.(*v1beta2.OCIManagedControlPlaneSpec), b.(*OCIManagedControlPlaneSpec), scope)
 	}); err != nil {
@@ -925,6 +920,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.RemotePeeringConnection)(nil), (*RemotePeeringConnection)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_RemotePeeringConnection_To_v1beta1_RemotePeeringConnection(a.(*v1beta2.RemotePeeringConnection), b.(*RemotePeeringConnection), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.Subnet)(nil), (*Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Subnet_To_v1beta1_Subnet(a.(*v1beta2.Subnet), b.(*Subnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.VCNPeering)(nil), (*VCNPeering)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_VCNPeering_To_v1beta1_VCNPeering(a.(*v1beta2.VCNPeering), b.(*VCNPeering), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.VCN)(nil), (*VCN)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_VCN_To_v1beta1_VCN(a.(*v1beta2.VCN), b.(*VCN), scope)
 	}); err != nil {
@@ -1225,14 +1235,10 @@ func autoConvert_v1beta2_DRG_To_v1beta1_DRG(in *v1beta2.DRG, out *DRG, s convers
 	out.Name = in.Name
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.VcnAttachmentId = (*string)(unsafe.Pointer(in.VcnAttachmentId))
+	// WARNING: in.DeletionPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_DRG_To_v1beta1_DRG is an autogenerated conversion function.
-func Convert_v1beta2_DRG_To_v1beta1_DRG(in *v1beta2.DRG, out *DRG, s conversion.Scope) error {
-	return autoConvert_v1beta2_DRG_To_v1beta1_DRG(in, out, s)
-}
-
 func autoConvert_v1beta1_EgressSecurityRule_To_v1beta2_EgressSecurityRule(in *EgressSecurityRule, out *v1beta2.EgressSecurityRule, s conversion.Scope) error {
 	out.Destination = (*string)(unsafe.Pointer(in.Destination))
 	out.Protocol = (*string)(unsafe.Pointer(in.Protocol))
@@ -1808,6 +1814,8 @@ func autoConvert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer(in *v1beta2.LoadBa
 	if err := Convert_v1beta2_NLBSpec_To_v1beta1_NLBSpec(&in.NLBSpec, &out.NLBSpec, s); err != nil {
 		return err
 	}
+	// WARNING: in.CompartmentId requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1895,14 +1903,11 @@ func autoConvert_v1beta2_NSG_To_v1beta1_NSG(in *v1beta2.NSG, out *NSG, s convers
 	} else {
 		out.IngressRules = nil
 	}
+	// WARNING: in.CompartmentId requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_NSG_To_v1beta1_NSG is an autogenerated conversion function.
-func Convert_v1beta2_NSG_To_v1beta1_NSG(in *v1beta2.NSG, out *NSG, s conversion.Scope) error {
-	return autoConvert_v1beta2_NSG_To_v1beta1_NSG(in, out, s)
-}
-
 func autoConvert_v1beta1_NetworkDetails_To_v1beta2_NetworkDetails(in *NetworkDetails, out *v1beta2.NetworkDetails, s conversion.Scope) error {
 	out.SubnetId = (*string)(unsafe.Pointer(in.SubnetId))
 	out.AssignPublicIp = in.AssignPublicIp
@@ -1944,7 +1949,15 @@ func autoConvert_v1beta1_NetworkSpec_To_v1beta2_NetworkSpec(in *NetworkSpec, out
 	if err := Convert_v1beta1_LoadBalancer_To_v1beta2_LoadBalancer(&in.APIServerLB, &out.APIServerLB, s); err != nil {
 		return err
 	}
-	out.VCNPeering = (*v1beta2.VCNPeering)(unsafe.Pointer(in.VCNPeering))
+	if in.VCNPeering != nil {
+		in, out := &in.VCNPeering, &out.VCNPeering
+		*out = new(v1beta2.VCNPeering)
+		if err := Convert_v1beta1_VCNPeering_To_v1beta2_VCNPeering(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VCNPeering = nil
+	}
 	return nil
 }
 
@@ -1955,21 +1968,25 @@ func Convert_v1beta1_NetworkSpec_To_v1beta2_NetworkSpec(in *NetworkSpec, out *v1
 
 func autoConvert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in *v1beta2.NetworkSpec, out *NetworkSpec, s conversion.Scope) error {
 	out.SkipNetworkManagement = in.SkipNetworkManagement
+	// WARNING: in.CompartmentId requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta2_VCN_To_v1beta1_VCN(&in.Vcn, &out.Vcn, s); err != nil {
 		return err
 	}
 	if err := Convert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer(&in.APIServerLB, &out.APIServerLB, s); err != nil {
 		return err
 	}
-	out.VCNPeering = (*VCNPeering)(unsafe.Pointer(in.VCNPeering))
+	if in.VCNPeering != nil {
+		in, out := &in.VCNPeering, &out.VCNPeering
+		*out = new(VCNPeering)
+		if err := Convert_v1beta2_VCNPeering_To_v1beta1_VCNPeering(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VCNPeering = nil
+	}
 	return nil
 }
 
-// Convert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec is an autogenerated conversion function.
-func Convert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in *v1beta2.NetworkSpec, out *NetworkSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_OCIAvailabilityDomain_To_v1beta2_OCIAvailabilityDomain(in *OCIAvailabilityDomain, out *v1beta2.OCIAvailabilityDomain, s conversion.Scope) error {
 	out.Name = in.Name
 	out.FaultDomains = *(*[]string)(unsafe.Pointer(&in.FaultDomains))
@@ -2196,6 +2213,8 @@ func autoConvert_v1beta2_OCIClusterSpec_To_v1beta1_OCIClusterSpec(in *v1beta2.OC
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	// WARNING: in.AvailabilityDomains requires manual conversion: does not exist in peer-type
 	// WARNING: in.ClientOverrides requires manual conversion: does not exist in peer-type
+	// WARNING: in.WorkloadResourceCleanup requires manual conversion: does not exist in peer-type
+	// WARNING: in.DriftPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2209,16 +2228,17 @@ func autoConvert_v1beta1_OCIClusterStatus_To_v1beta2_OCIClusterStatus(in *OCIClu
 
 func autoConvert_v1beta2_OCIClusterStatus_To_v1beta1_OCIClusterStatus(in *v1beta2.OCIClusterStatus, out *OCIClusterStatus, s conversion.Scope) error {
 	out.FailureDomains = *(*apiv1beta1.FailureDomains)(unsafe.Pointer(&in.FailureDomains))
+	// WARNING: in.RemotePeeringConnections requires manual conversion: does not exist in peer-type
+	// WARNING: in.Drift requires manual conversion: does not exist in peer-type
+	// WARNING: in.Network requires manual conversion: does not exist in peer-type
+	// WARNING: in.PlannedOperations requires manual conversion: does not exist in peer-type
+	// WARNING: in.Hibernation requires manual conversion: does not exist in peer-type
+	// WARNING: in.AppliedTags requires manual conversion: does not exist in peer-type
 	out.Ready = in.Ready
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1beta2_OCIClusterStatus_To_v1beta1_OCIClusterStatus is an autogenerated conversion function.
-func Convert_v1beta2_OCIClusterStatus_To_v1beta1_OCIClusterStatus(in *v1beta2.OCIClusterStatus, out *OCIClusterStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_OCIClusterStatus_To_v1beta1_OCIClusterStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_OCIClusterTemplate_To_v1beta2_OCIClusterTemplate(in *OCIClusterTemplate, out *v1beta2.OCIClusterTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_OCIClusterTemplateSpec_To_v1beta2_OCIClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2449,6 +2469,7 @@ func autoConvert_v1beta1_OCIMachineSpec_To_v1beta2_OCIMachineSpec(in *OCIMachine
 func autoConvert_v1beta2_OCIMachineSpec_To_v1beta1_OCIMachineSpec(in *v1beta2.OCIMachineSpec, out *OCIMachineSpec, s conversion.Scope) error {
 	out.InstanceId = (*string)(unsafe.Pointer(in.InstanceId))
 	out.ImageId = in.ImageId
+	// WARNING: in.ImageSelector requires manual conversion: does not exist in peer-type
 	out.CompartmentId = in.CompartmentId
 	out.Shape = in.Shape
 	out.ComputeClusterId = (*string)(unsafe.Pointer(in.ComputeClusterId))
@@ -2470,6 +2491,7 @@ func autoConvert_v1beta2_OCIMachineSpec_To_v1beta1_OCIMachineSpec(in *v1beta2.OC
 	out.PlatformConfig = (*PlatformConfig)(unsafe.Pointer(in.PlatformConfig))
 	out.DedicatedVmHostId = (*string)(unsafe.Pointer(in.DedicatedVmHostId))
 	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
+	// WARNING: in.FailureDomain requires manual conversion: does not exist in peer-type
 	out.IsPvEncryptionInTransitEnabled = in.IsPvEncryptionInTransitEnabled
 	out.BootVolumeSizeInGBs = in.BootVolumeSizeInGBs
 	out.Metadata = *(*map[string]string)(unsafe.Pointer(&in.Metadata))
@@ -2479,14 +2501,19 @@ func autoConvert_v1beta2_OCIMachineSpec_To_v1beta1_OCIMachineSpec(in *v1beta2.OC
 	out.SubnetName = in.SubnetName
 	out.PreserveBootVolume = in.PreserveBootVolume
 	out.PreserveDataVolumesCreatedAtLaunch = in.PreserveDataVolumesCreatedAtLaunch
+	// WARNING: in.UpdatePolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.LaunchFallbackPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.InstanceSourceViaBootVolumeDetails requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootVolumeBackupPolicyId requires manual conversion: does not exist in peer-type
+	// WARNING: in.PersistentVolumes requires manual conversion: does not exist in peer-type
+	// WARNING: in.ConsoleHistoryCapture requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootstrapFormat requires manual conversion: does not exist in peer-type
+	// WARNING: in.IgnitionStorage requires manual conversion: does not exist in peer-type
+	// WARNING: in.StoreWindowsCredentials requires manual conversion: does not exist in peer-type
+	// WARNING: in.MaintenanceEventHandling requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_OCIMachineSpec_To_v1beta1_OCIMachineSpec is an autogenerated conversion function.
-func Convert_v1beta2_OCIMachineSpec_To_v1beta1_OCIMachineSpec(in *v1beta2.OCIMachineSpec, out *OCIMachineSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_OCIMachineSpec_To_v1beta1_OCIMachineSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_OCIMachineStatus_To_v1beta2_OCIMachineStatus(in *OCIMachineStatus, out *v1beta2.OCIMachineStatus, s conversion.Scope) error {
 	out.Ready = in.Ready
 	out.Addresses = *(*[]apiv1beta1.MachineAddress)(unsafe.Pointer(&in.Addresses))
@@ -2512,15 +2539,20 @@ func autoConvert_v1beta2_OCIMachineStatus_To_v1beta1_OCIMachineStatus(in *v1beta
 	out.LaunchInstanceWorkRequestId = in.LaunchInstanceWorkRequestId
 	out.CreateBackendWorkRequestId = in.CreateBackendWorkRequestId
 	out.DeleteBackendWorkRequestId = in.DeleteBackendWorkRequestId
+	// WARNING: in.Image requires manual conversion: does not exist in peer-type
+	// WARNING: in.Hibernated requires manual conversion: does not exist in peer-type
+	// WARNING: in.LaunchAttempts requires manual conversion: does not exist in peer-type
+	// WARNING: in.Placement requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootVolumeId requires manual conversion: does not exist in peer-type
+	// WARNING: in.PersistentVolumes requires manual conversion: does not exist in peer-type
+	// WARNING: in.ConsoleHistory requires manual conversion: does not exist in peer-type
+	// WARNING: in.Ignition requires manual conversion: does not exist in peer-type
+	// WARNING: in.WindowsCredentials requires manual conversion: does not exist in peer-type
+	// WARNING: in.AppliedTags requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1beta2_OCIMachineStatus_To_v1beta1_OCIMachineStatus is an autogenerated conversion function.
-func Convert_v1beta2_OCIMachineStatus_To_v1beta1_OCIMachineStatus(in *v1beta2.OCIMachineStatus, out *OCIMachineStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_OCIMachineStatus_To_v1beta1_OCIMachineStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_OCIMachineTemplate_To_v1beta2_OCIMachineTemplate(in *OCIMachineTemplate, out *v1beta2.OCIMachineTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_OCIMachineTemplateSpec_To_v1beta2_OCIMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2743,6 +2775,8 @@ func autoConvert_v1beta2_OCIManagedClusterSpec_To_v1beta1_OCIManagedClusterSpec(
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	// WARNING: in.AvailabilityDomains requires manual conversion: does not exist in peer-type
 	// WARNING: in.ClientOverrides requires manual conversion: does not exist in peer-type
+	// WARNING: in.WorkloadResourceCleanup requires manual conversion: does not exist in peer-type
+	// WARNING: in.DriftPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2756,16 +2790,15 @@ func autoConvert_v1beta1_OCIManagedClusterStatus_To_v1beta2_OCIManagedClusterSta
 
 func autoConvert_v1beta2_OCIManagedClusterStatus_To_v1beta1_OCIManagedClusterStatus(in *v1beta2.OCIManagedClusterStatus, out *OCIManagedClusterStatus, s conversion.Scope) error {
 	out.FailureDomains = *(*apiv1beta1.FailureDomains)(unsafe.Pointer(&in.FailureDomains))
+	// WARNING: in.RemotePeeringConnections requires manual conversion: does not exist in peer-type
+	// WARNING: in.Drift requires manual conversion: does not exist in peer-type
+	// WARNING: in.Network requires manual conversion: does not exist in peer-type
+	// WARNING: in.AppliedTags requires manual conversion: does not exist in peer-type
 	out.Ready = in.Ready
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1beta2_OCIManagedClusterStatus_To_v1beta1_OCIManagedClusterStatus is an autogenerated conversion function.
-func Convert_v1beta2_OCIManagedClusterStatus_To_v1beta1_OCIManagedClusterStatus(in *v1beta2.OCIManagedClusterStatus, out *OCIManagedClusterStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_OCIManagedClusterStatus_To_v1beta1_OCIManagedClusterStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_OCIManagedClusterTemplate_To_v1beta2_OCIManagedClusterTemplate(in *OCIManagedClusterTemplate, out *v1beta2.OCIManagedClusterTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_OCIManagedClusterTemplateSpec_To_v1beta2_OCIManagedClusterTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -3008,6 +3041,7 @@ func autoConvert_v1beta2_OCIManagedControlPlaneStatus_To_v1beta1_OCIManagedContr
 	out.Version = (*string)(unsafe.Pointer(in.Version))
 	// WARNING: in.AddonStatus requires manual conversion: does not exist in peer-type
 	out.Initialized = in.Initialized
+	// WARNING: in.PlannedOperations requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -3273,14 +3307,11 @@ func autoConvert_v1beta2_RemotePeeringConnection_To_v1beta1_RemotePeeringConnect
 	out.PeerDRGId = (*string)(unsafe.Pointer(in.PeerDRGId))
 	out.PeerRPCConnectionId = (*string)(unsafe.Pointer(in.PeerRPCConnectionId))
 	out.RPCConnectionId = (*string)(unsafe.Pointer(in.RPCConnectionId))
+	// WARNING: in.PeerIdentityRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.PeerCompartmentId requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_RemotePeeringConnection_To_v1beta1_RemotePeeringConnection is an autogenerated conversion function.
-func Convert_v1beta2_RemotePeeringConnection_To_v1beta1_RemotePeeringConnection(in *v1beta2.RemotePeeringConnection, out *RemotePeeringConnection, s conversion.Scope) error {
-	return autoConvert_v1beta2_RemotePeeringConnection_To_v1beta1_RemotePeeringConnection(in, out, s)
-}
-
 func autoConvert_v1beta1_SecurityList_To_v1beta2_SecurityList(in *SecurityList, out *v1beta2.SecurityList, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.Name = in.Name
@@ -3357,14 +3388,12 @@ func autoConvert_v1beta2_Subnet_To_v1beta1_Subnet(in *v1beta2.Subnet, out *Subne
 	out.Type = SubnetType(in.Type)
 	out.SecurityList = (*SecurityList)(unsafe.Pointer(in.SecurityList))
 	out.DnsLabel = (*string)(unsafe.Pointer(in.DnsLabel))
+	// WARNING: in.CompartmentId requires manual conversion: does not exist in peer-type
+	// WARNING: in.FlowLog requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_Subnet_To_v1beta1_Subnet is an autogenerated conversion function.
-func Convert_v1beta2_Subnet_To_v1beta1_Subnet(in *v1beta2.Subnet, out *Subnet, s conversion.Scope) error {
-	return autoConvert_v1beta2_Subnet_To_v1beta1_Subnet(in, out, s)
-}
-
 func autoConvert_v1beta1_TcpOptions_To_v1beta2_TcpOptions(in *TcpOptions, out *v1beta2.TcpOptions, s conversion.Scope) error {
 	out.DestinationPortRange = (*v1beta2.PortRange)(unsafe.Pointer(in.DestinationPortRange))
 	out.SourcePortRange = (*v1beta2.PortRange)(unsafe.Pointer(in.SourcePortRange))
@@ -3439,7 +3468,17 @@ func autoConvert_v1beta1_VCN_To_v1beta2_VCN(in *VCN, out *v1beta2.VCN, s convers
 	// WARNING: in.ServiceGatewayId requires manual conversion: does not exist in peer-type
 	// WARNING: in.PrivateRouteTableId requires manual conversion: does not exist in peer-type
 	// WARNING: in.PublicRouteTableId requires manual conversion: does not exist in peer-type
-	out.Subnets = *(*[]*v1beta2.Subnet)(unsafe.Pointer(&in.Subnets))
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]*v1beta2.Subnet, len(*in))
+		for i := range *in {
+			if err := Convert_Pointer_v1beta1_Subnet_To_Pointer_v1beta2_Subnet(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Subnets = nil
+	}
 	// WARNING: in.NetworkSecurityGroups requires manual conversion: does not exist in peer-type
 	out.DnsLabel = (*string)(unsafe.Pointer(in.DnsLabel))
 	return nil
@@ -3450,20 +3489,50 @@ func autoConvert_v1beta2_VCN_To_v1beta1_VCN(in *v1beta2.VCN, out *VCN, s convers
 	out.Name = in.Name
 	out.CIDR = in.CIDR
 	out.CIDRS = *(*[]string)(unsafe.Pointer(&in.CIDRS))
-	out.Subnets = *(*[]*Subnet)(unsafe.Pointer(&in.Subnets))
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]*Subnet, len(*in))
+		for i := range *in {
+			if err := Convert_Pointer_v1beta2_Subnet_To_Pointer_v1beta1_Subnet(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Subnets = nil
+	}
 	// WARNING: in.InternetGateway requires manual conversion: does not exist in peer-type
 	// WARNING: in.NATGateway requires manual conversion: does not exist in peer-type
 	// WARNING: in.ServiceGateway requires manual conversion: does not exist in peer-type
 	// WARNING: in.RouteTable requires manual conversion: does not exist in peer-type
 	// WARNING: in.NetworkSecurityGroup requires manual conversion: does not exist in peer-type
 	out.DnsLabel = (*string)(unsafe.Pointer(in.DnsLabel))
+	// WARNING: in.Shared requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeletionPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
 func autoConvert_v1beta1_VCNPeering_To_v1beta2_VCNPeering(in *VCNPeering, out *v1beta2.VCNPeering, s conversion.Scope) error {
-	out.DRG = (*v1beta2.DRG)(unsafe.Pointer(in.DRG))
+	if in.DRG != nil {
+		in, out := &in.DRG, &out.DRG
+		*out = new(v1beta2.DRG)
+		if err := Convert_v1beta1_DRG_To_v1beta2_DRG(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DRG = nil
+	}
 	out.PeerRouteRules = *(*[]v1beta2.PeerRouteRule)(unsafe.Pointer(&in.PeerRouteRules))
-	out.RemotePeeringConnections = *(*[]v1beta2.RemotePeeringConnection)(unsafe.Pointer(&in.RemotePeeringConnections))
+	if in.RemotePeeringConnections != nil {
+		in, out := &in.RemotePeeringConnections, &out.RemotePeeringConnections
+		*out = make([]v1beta2.RemotePeeringConnection, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_RemotePeeringConnection_To_v1beta2_RemotePeeringConnection(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RemotePeeringConnections = nil
+	}
 	return nil
 }
 
@@ -3473,17 +3542,31 @@ func Convert_v1beta1_VCNPeering_To_v1beta2_VCNPeering(in *VCNPeering, out *v1bet
 }
 
 func autoConvert_v1beta2_VCNPeering_To_v1beta1_VCNPeering(in *v1beta2.VCNPeering, out *VCNPeering, s conversion.Scope) error {
-	out.DRG = (*DRG)(unsafe.Pointer(in.DRG))
+	if in.DRG != nil {
+		in, out := &in.DRG, &out.DRG
+		*out = new(DRG)
+		if err := Convert_v1beta2_DRG_To_v1beta1_DRG(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DRG = nil
+	}
 	out.PeerRouteRules = *(*[]PeerRouteRule)(unsafe.Pointer(&in.PeerRouteRules))
-	out.RemotePeeringConnections = *(*[]RemotePeeringConnection)(unsafe.Pointer(&in.RemotePeeringConnections))
+	if in.RemotePeeringConnections != nil {
+		in, out := &in.RemotePeeringConnections, &out.RemotePeeringConnections
+		*out = make([]RemotePeeringConnection, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_RemotePeeringConnection_To_v1beta1_RemotePeeringConnection(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RemotePeeringConnections = nil
+	}
+	// WARNING: in.VPN requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_VCNPeering_To_v1beta1_VCNPeering is an autogenerated conversion function.
-func Convert_v1beta2_VCNPeering_To_v1beta1_VCNPeering(in *v1beta2.VCNPeering, out *VCNPeering, s conversion.Scope) error {
-	return autoConvert_v1beta2_VCNPeering_To_v1beta1_VCNPeering(in, out, s)
-}
-
 func autoConvert_v1beta1_VnicAttachment_To_v1beta2_VnicAttachment(in *VnicAttachment, out *v1beta2.VnicAttachment, s conversion.Scope) error {
 	out.VnicAttachmentId = (*string)(unsafe.Pointer(in.VnicAttachmentId))
 	out.AssignPublicIp = in.AssignPublicIp
@@ -49,6 +49,8 @@ const (
 	DRGVCNAttachmentReconciliationFailedReason = "DRGVCNAttachmentReconciliationFailed"
 	// DRGRPCAttachmentReconciliationFailedReason used when the DRG RPC Attachment reconciliation fails.
 	DRGRPCAttachmentReconciliationFailedReason = "DRGRPCAttachmentReconciliationFailed"
+	// VPNReconciliationFailedReason used when the Site-to-Site VPN reconciliation fails.
+	VPNReconciliationFailedReason = "VPNReconciliationFailed"
 	// InternetGatewayReconciliationFailedReason used when the InternetGateway reconciliation is failed.
 	InternetGatewayReconciliationFailedReason = "InternetGatewayReconciliationFailed"
 	// NatGatewayReconciliationFailedReason used when the NatGateway reconciliation is failed.
@@ -61,6 +63,8 @@ const (
 	RouteTableReconciliationFailedReason = "RouteTableReconciliationFailed"
 	// SubnetReconciliationFailedReason used when the Subnet reconciliation is failed.
 	SubnetReconciliationFailedReason = "SubnetReconciliationFailed"
+	// FlowLogReconciliationFailedReason used when the FlowLog reconciliation is failed.
+	FlowLogReconciliationFailedReason = "FlowLogReconciliationFailed"
 	// SecurityListReconciliationFailedReason used when the SecurityList reconciliation is failed.
 	SecurityListReconciliationFailedReason = "SecurityListReconciliationFailed"
 	// APIServerLoadBalancerFailedReason used when the Subnet reconciliation is failed.
@@ -71,6 +75,42 @@ const (
 	InstanceLBBackendAdditionFailedReason = "BackendAdditionFailed"
 	// InstanceVnicAttachmentFailedReason used when attaching vnics to machine
 	InstanceVnicAttachmentFailedReason = "VnicAttachmentFailed"
+	// InstanceTagsUpdateFailedReason used when the tags of the instance, its VNICs or its boot volume could not be updated
+	InstanceTagsUpdateFailedReason = "InstanceTagsUpdateFailed"
+	// InstanceHibernatedReason used when the instance is stopped by the hibernation of the cluster
+	InstanceHibernatedReason = "InstanceHibernated"
+	// WaitingForControlPlaneResumeReason used when the instance of a worker machine waits for the control plane of
+	// the cluster to resume from the hibernation
+	WaitingForControlPlaneResumeReason = "WaitingForControlPlaneResume"
+	// InstanceUpdatedCondition Ready indicates the running instance matches the spec of an OCIMachine with the
+	// InPlace update policy.
+	InstanceUpdatedCondition clusterv1.ConditionType = "InstanceUpdated"
+	// InstanceUpdatingReason used when the instance is being updated in place
+	InstanceUpdatingReason = "InstanceUpdating"
+	// InstanceRebootingReason used when the instance reboots to apply the new shape config
+	InstanceRebootingReason = "InstanceRebooting"
+	// WaitingForControlPlaneRebootReason used when the instance of a control plane machine waits for the reboot of
+	// another control plane machine to complete before rebooting
+	WaitingForControlPlaneRebootReason = "WaitingForControlPlaneReboot"
+	// InstanceUpdateFailedReason used when the instance could not be updated in place
+	InstanceUpdateFailedReason = "InstanceUpdateFailed"
+	// WaitingForBootVolumeReason used when the boot volume the instance is booted from is not available yet
+	WaitingForBootVolumeReason = "WaitingForBootVolume"
+	// InstanceBootVolumeBackupPolicyFailedReason used when the backup policy could not be assigned to the boot volume
+	InstanceBootVolumeBackupPolicyFailedReason = "BootVolumeBackupPolicyFailed"
+	// WaitingForPersistentVolumesReason used when the persistent volumes are not attached to the instance yet
+	WaitingForPersistentVolumesReason = "WaitingForPersistentVolumes"
+	// PersistentVolumeAttachmentFailedReason used when the persistent volumes could not be attached to the instance
+	PersistentVolumeAttachmentFailedReason = "PersistentVolumeAttachmentFailed"
+	// InstanceMaintenanceScheduledCondition indicates OCI scheduled maintenance for the instance, the message has the
+	// time the maintenance is due. The condition is removed once no maintenance is scheduled.
+	InstanceMaintenanceScheduledCondition clusterv1.ConditionType = "InstanceMaintenanceScheduled"
+	// InstanceMaintenanceDueReason used when the instance is due for maintenance
+	InstanceMaintenanceDueReason = "InstanceMaintenanceDue"
+	// InstanceRebootMigratingReason used when the instance is reboot migrated with the RebootMigrateAnnotation
+	InstanceRebootMigratingReason = "InstanceRebootMigrating"
+	// MachineRemediationRequestedReason used when the Machine is marked for remediation ahead of the maintenance
+	MachineRemediationRequestedReason = "MachineRemediationRequested"
 	// InstanceIPAddressNotFound used when IP address of the instance count not be found
 	InstanceIPAddressNotFound = "InstanceIPAddressNotFound"
 	// VcnEventReady used after reconciliation has completed successfully
@@ -81,6 +121,8 @@ const (
 	DRGVCNAttachmentEventReady = "DRGVCNAttachmentEventReady"
 	// DRGRPCAttachmentEventReady used after reconciliation has completed successfully
 	DRGRPCAttachmentEventReady = "DRGRPCAttachmentEventReady"
+	// VPNEventReady used after reconciliation has completed successfully
+	VPNEventReady = "VPNReady"
 	// InternetGatewayEventReady used after reconciliation has completed successfully
 	InternetGatewayEventReady = "InternetGatewayReady"
 	// NatEventReady used after reconciliation has completed successfully
@@ -93,6 +135,8 @@ const (
 	RouteTableEventReady = "RouteTableReady"
 	// SubnetEventReady used after reconciliation has completed successfully
 	SubnetEventReady = "SubnetReady"
+	// FlowLogEventReady used after reconciliation has completed successfully
+	FlowLogEventReady = "FlowLogReady"
 	// InstanceVnicAttachmentReady used after reconciliation has been completed successfully
 	InstanceVnicAttachmentReady = "VnicAttachmentReady"
 	// ApiServerLoadBalancerEventReady used after reconciliation has completed successfully
@@ -102,6 +146,54 @@ const (
 	// NamespaceNotAllowedByIdentity used to indicate cluster in a namespace not allowed by identity.
 	NamespaceNotAllowedByIdentity = "NamespaceNotAllowedByIdentity"
 
+	// VPNTunnelsReadyCondition Ready indicates the IPSec tunnels of the Site-to-Site VPN are up.
+	VPNTunnelsReadyCondition clusterv1.ConditionType = "VPNTunnelsReady"
+	// VPNTunnelNotReadyReason used when an IPSec tunnel of the Site-to-Site VPN is not up.
+	VPNTunnelNotReadyReason = "VPNTunnelNotReady"
+
+	// WorkloadResourcesDeletedCondition indicates the cloud resources created by the workload cluster, which block
+	// the deletion of the network of the cluster, have been deleted.
+	WorkloadResourcesDeletedCondition clusterv1.ConditionType = "WorkloadResourcesDeleted"
+	// WorkloadResourcesBlockingReason used when load balancers created by the workload cluster block the deletion
+	// of the subnets of the cluster.
+	WorkloadResourcesBlockingReason = "WorkloadResourcesBlocking"
+	// WorkloadResourceDeletionFailedReason used when the cloud resources created by the workload cluster could
+	// not be deleted.
+	WorkloadResourceDeletionFailedReason = "WorkloadResourceDeletionFailed"
+
+	// DRGReadyCondition Ready indicates the DRG of the cluster has been reconciled.
+	DRGReadyCondition clusterv1.ConditionType = "DRGReady"
+	// VCNReadyCondition Ready indicates the VCN of the cluster has been reconciled.
+	VCNReadyCondition clusterv1.ConditionType = "VCNReady"
+	// InternetGatewayReadyCondition Ready indicates the Internet Gateway of the cluster has been reconciled.
+	InternetGatewayReadyCondition clusterv1.ConditionType = "InternetGatewayReady"
+	// NATGatewayReadyCondition Ready indicates the NAT Gateway of the cluster has been reconciled.
+	NATGatewayReadyCondition clusterv1.ConditionType = "NATGatewayReady"
+	// ServiceGatewayReadyCondition Ready indicates the Service Gateway of the cluster has been reconciled.
+	ServiceGatewayReadyCondition clusterv1.ConditionType = "ServiceGatewayReady"
+	// NSGsReadyCondition Ready indicates the Network Security Groups of the cluster have been reconciled.
+	NSGsReadyCondition clusterv1.ConditionType = "NSGsReady"
+	// RouteTablesReadyCondition Ready indicates the Route Tables of the cluster have been reconciled.
+	RouteTablesReadyCondition clusterv1.ConditionType = "RouteTablesReady"
+	// SubnetsReadyCondition Ready indicates the Subnets of the cluster have been reconciled.
+	SubnetsReadyCondition clusterv1.ConditionType = "SubnetsReady"
+	// FlowLogsReadyCondition Ready indicates the flow logs of the Subnets of the cluster have been reconciled.
+	FlowLogsReadyCondition clusterv1.ConditionType = "FlowLogsReady"
+	// DRGVCNAttachmentReadyCondition Ready indicates the DRG VCN Attachment of the cluster has been reconciled.
+	DRGVCNAttachmentReadyCondition clusterv1.ConditionType = "DRGVCNAttachmentReady"
+	// DRGRPCAttachmentReadyCondition Ready indicates the DRG RPC Attachments of the cluster have been reconciled.
+	DRGRPCAttachmentReadyCondition clusterv1.ConditionType = "DRGRPCAttachmentReady"
+	// VPNReadyCondition Ready indicates the Site-to-Site VPN of the cluster has been reconciled.
+	VPNReadyCondition clusterv1.ConditionType = "VPNReady"
+	// FailureDomainsReadyCondition Ready indicates the failure domains of the cluster have been reconciled.
+	FailureDomainsReadyCondition clusterv1.ConditionType = "FailureDomainsReady"
+	// APIServerLBReadyCondition Ready indicates the API server load balancer of the cluster has been reconciled.
+	APIServerLBReadyCondition clusterv1.ConditionType = "APIServerLBReady"
+	// GatewaysReadyCondition Ready summarizes the conditions of the Internet, NAT and Service Gateways of the cluster.
+	GatewaysReadyCondition clusterv1.ConditionType = "GatewaysReady"
+	// PeeringReadyCondition Ready summarizes the conditions of the DRG VCN and RPC Attachments of the cluster.
+	PeeringReadyCondition clusterv1.ConditionType = "PeeringReady"
+
 	// ControlPlaneReadyCondition Ready indicates the control plane is in a Running state.
 	ControlPlaneReadyCondition clusterv1.ConditionType = "ControlPlaneReady"
 	// ControlPlaneProvisionFailedReason used for failures during control plane provisioning.
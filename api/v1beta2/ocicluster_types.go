@@ -80,6 +80,16 @@ type OCIClusterSpec struct {
 	// +optional
 	// +nullable
 	ClientOverrides *ClientOverrides `json:"clientOverrides,omitempty"`
+
+	// WorkloadResourceCleanup defines the cleanup of the cloud resources created by the workload cluster
+	// when the cluster is deleted.
+	// +optional
+	WorkloadResourceCleanup *WorkloadResourceCleanup `json:"workloadResourceCleanup,omitempty"`
+
+	// DriftPolicy defines whether the changes made to the network resources outside of Cluster API are reverted
+	// (Enforce, the default) or only reported in the status (Report).
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // OCIClusterStatus defines the observed state of OCICluster
@@ -87,6 +97,33 @@ type OCIClusterStatus struct {
 	// +optional
 	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
 
+	// RemotePeeringConnections is the observed state of the Remote Peering Connections of the VCN peering.
+	// +optional
+	RemotePeeringConnections []RemotePeeringConnectionStatus `json:"remotePeeringConnections,omitempty"`
+
+	// Drift lists the network resources which differed from the spec in the last reconciliation.
+	// +optional
+	Drift []ResourceDrift `json:"drift,omitempty"`
+
+	// Network is the inventory of the network resources of the cluster.
+	// +optional
+	Network *NetworkStatus `json:"network,omitempty"`
+
+	// PlannedOperations are the operations on the OCI resources which the last reconciliation in plan mode
+	// would have performed. See PlanAnnotation.
+	// +optional
+	PlannedOperations []PlannedOperation `json:"plannedOperations,omitempty"`
+
+	// Hibernation is the hibernation state of the cluster, it is empty while the cluster is not hibernated.
+	// See HibernateAnnotation.
+	// +optional
+	Hibernation HibernationState `json:"hibernation,omitempty"`
+
+	// AppliedTags are the tags of the spec which were last applied to the network resources and the API server
+	// load balancer.
+	// +optional
+	AppliedTags *AppliedTags `json:"appliedTags,omitempty"`
+
 	// +optional
 	Ready bool `json:"ready"`
 	// NetworkSpec encapsulates all things related to OCI network.
@@ -178,6 +215,53 @@ type ClientOverrides struct {
 	// +optional
 	// +nullable
 	ContainerEngineClientUrl *string `json:"containerEngineClientUrl,omitempty"`
+
+	// LoggingClientUrl allows the default logging SDK client URL to be changed.
+	//
+	// +optional
+	// +nullable
+	LoggingClientUrl *string `json:"loggingClientUrl,omitempty"`
+
+	// BlockStorageClientUrl allows the default block storage SDK client URL to be changed.
+	//
+	// +optional
+	// +nullable
+	BlockStorageClientUrl *string `json:"blockStorageClientUrl,omitempty"`
+}
+
+// WorkloadResourceCleanupPolicy defines what happens to the cloud resources created by the workload cluster
+// when the cluster is deleted.
+type WorkloadResourceCleanupPolicy string
+
+const (
+	// WorkloadResourceCleanupPolicyDelete deletes the load balancers and network load balancers in the subnets of
+	// the cluster and the block volumes which are not attached, created by the workload cluster, before the
+	// network of the cluster is deleted.
+	WorkloadResourceCleanupPolicyDelete WorkloadResourceCleanupPolicy = "Delete"
+
+	// WorkloadResourceCleanupPolicyRetain retains the cloud resources created by the workload cluster. The
+	// deletion of the subnets of the cluster is retried until the load balancers in them are deleted.
+	WorkloadResourceCleanupPolicyRetain WorkloadResourceCleanupPolicy = "Retain"
+)
+
+// WorkloadResourceCleanup defines the cleanup of the cloud resources created by the cloud controller manager
+// and the CSI driver of the workload cluster, such as Service load balancers and block volumes.
+type WorkloadResourceCleanup struct {
+	// Policy defines what happens to the cloud resources created by the workload cluster when the cluster
+	// is deleted. Defaults to Retain.
+	// +kubebuilder:validation:Enum=Delete;Retain
+	// +optional
+	Policy WorkloadResourceCleanupPolicy `json:"policy,omitempty"`
+
+	// FreeformTags identify the cloud resources created by the workload cluster, as configured in the `tags`
+	// section of the cloud provider configuration of the cloud controller manager and the CSI driver.
+	// A resource is created by the workload cluster if it carries all the freeform and defined tags.
+	// +optional
+	FreeformTags map[string]string `json:"freeformTags,omitempty"`
+
+	// DefinedTags identify the cloud resources created by the workload cluster, along with FreeformTags.
+	// +optional
+	DefinedTags map[string]map[string]string `json:"definedTags,omitempty"`
 }
 
 // GetConditions returns the list of conditions for an OCICluster API object.
@@ -21,6 +21,7 @@ package v1beta2
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -103,6 +104,12 @@ func (c *OCICluster) ValidateUpdate(old runtime.Object) (admission.Warnings, err
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "compartmentId"), c.Spec.CompartmentId, "field is immutable"))
 	}
 
+	// the shared network identifier is used to identify the network resources, setting, removing or changing it
+	// would orphan them
+	if !reflect.DeepEqual(c.Spec.NetworkSpec.Vcn.Shared, oldCluster.Spec.NetworkSpec.Vcn.Shared) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "networkSpec", "vcn", "shared"), c.Spec.NetworkSpec.Vcn.Shared, "field is immutable"))
+	}
+
 	allErrs = append(allErrs, c.validate(oldCluster)...)
 
 	if len(allErrs) == 0 {
@@ -122,6 +129,7 @@ func (c *OCICluster) validate(old *OCICluster) field.ErrorList {
 
 	allErrs = append(allErrs, ValidateNetworkSpec(OCIClusterSubnetRoles, c.Spec.NetworkSpec, oldNetworkSpec, field.NewPath("spec").Child("networkSpec"))...)
 	allErrs = append(allErrs, ValidateClusterName(c.Name)...)
+	allErrs = append(allErrs, ValidateWorkloadResourceCleanup(c.Spec.WorkloadResourceCleanup, field.NewPath("spec").Child("workloadResourceCleanup"))...)
 
 	if len(c.Spec.CompartmentId) <= 0 {
 		allErrs = append(
@@ -26,6 +26,7 @@ import (
 	"github.com/onsi/gomega"
 	. "github.com/onsi/gomega"
 	"github.com/oracle/oci-go-sdk/v65/common"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -244,6 +245,203 @@ func TestOCICluster_ValidateCreate(t *testing.T) {
 			errorMgsShouldContain: "subnet role invalid",
 			expectErr:             true,
 		},
+		{
+			name: "shouldn't allow vpn without static routes",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: goodClusterName,
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						VCNPeering: &VCNPeering{
+							DRG: &DRG{Manage: true},
+							VPN: &VPN{
+								CPE: CPE{IPAddress: "203.0.113.10"},
+							},
+						},
+					},
+				},
+			},
+			errorMgsShouldContain: "at least one static route is required",
+			expectErr:             true,
+		},
+		{
+			name: "should allow vpn",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: goodClusterName,
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						VCNPeering: &VCNPeering{
+							DRG: &DRG{Manage: true},
+							VPN: &VPN{
+								CPE:          CPE{IPAddress: "203.0.113.10"},
+								StaticRoutes: []string{"192.168.0.0/16"},
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "shouldn't allow cross-tenancy rpc without peer compartment",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: goodClusterName,
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						VCNPeering: &VCNPeering{
+							RemotePeeringConnections: []RemotePeeringConnection{
+								{
+									Name:            "peer",
+									ManagePeerRPC:   true,
+									PeerDRGId:       common.String("peer-drg-id"),
+									PeerIdentityRef: &corev1.ObjectReference{Name: "peer-identity"},
+								},
+							},
+						},
+					},
+				},
+			},
+			errorMgsShouldContain: "peerCompartmentId is required if peerIdentityRef is set",
+			expectErr:             true,
+		},
+		{
+			name: "shouldn't allow workload resource cleanup without tags",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: goodClusterName,
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					OCIResourceIdentifier: "uuid",
+					WorkloadResourceCleanup: &WorkloadResourceCleanup{
+						Policy: WorkloadResourceCleanupPolicyDelete,
+					},
+				},
+			},
+			errorMgsShouldContain: "freeformTags or definedTags are required for the Delete policy",
+			expectErr:             true,
+		},
+		{
+			name: "shouldn't allow retained subnet in a deleted vcn",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: goodClusterName,
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						Vcn: VCN{
+							CIDR: "10.0.0.0/16",
+							Subnets: []*Subnet{
+								{
+									Role:           WorkerRole,
+									DeletionPolicy: DeletionPolicyRetain,
+								},
+							},
+						},
+					},
+				},
+			},
+			errorMgsShouldContain: "a subnet can only be retained if the VCN is retained",
+			expectErr:             true,
+		},
+		{
+			name: "shouldn't allow deleted control plane endpoint subnet with a retained load balancer",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: goodClusterName,
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						Vcn: VCN{
+							CIDR:           "10.0.0.0/16",
+							DeletionPolicy: DeletionPolicyRetain,
+							Subnets: []*Subnet{
+								{
+									Role:           ControlPlaneEndpointRole,
+									DeletionPolicy: DeletionPolicyDelete,
+								},
+							},
+						},
+						APIServerLB: LoadBalancer{
+							DeletionPolicy: DeletionPolicyRetain,
+						},
+					},
+				},
+			},
+			errorMgsShouldContain: "the control plane endpoint subnet can not be deleted if the API server load balancer is retained",
+			expectErr:             true,
+		},
+		{
+			name: "should allow retained network",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: goodClusterName,
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						Vcn: VCN{
+							CIDR:           "10.0.0.0/16",
+							DeletionPolicy: DeletionPolicyRetain,
+							Subnets: []*Subnet{
+								{
+									Role:           WorkerRole,
+									DeletionPolicy: DeletionPolicyDelete,
+								},
+							},
+						},
+						APIServerLB: LoadBalancer{
+							DeletionPolicy: DeletionPolicyRetain,
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "shouldn't allow invalid subnet flow log",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: goodClusterName,
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						Vcn: VCN{
+							CIDR: "10.0.0.0/16",
+							Subnets: []*Subnet{
+								&Subnet{
+									Role: Custom,
+									FlowLog: &FlowLog{
+										LogGroupId:        "ocid",
+										RetentionDuration: common.Int(45),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			errorMgsShouldContain: "retention duration must be a multiple of 30 between 30 and 180",
+			expectErr:             true,
+		},
 		{
 			name: "allow subnet custom role",
 			c: &OCICluster{
@@ -436,6 +634,47 @@ func TestOCICluster_ValidateCreate(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "shouldn't allow shared network without identifier",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: goodClusterName,
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						Vcn: VCN{
+							Shared: &SharedNetwork{},
+						},
+					},
+				},
+			},
+			errorMgsShouldContain: "identifier",
+			expectErr:             true,
+		},
+		{
+			name: "shouldn't allow routes to the DRG of the cluster in a shared network",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: goodClusterName,
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						Vcn: VCN{
+							Shared: &SharedNetwork{Identifier: "shared"},
+						},
+						VCNPeering: &VCNPeering{
+							PeerRouteRules: []PeerRouteRule{{VCNCIDRRange: "10.1.0.0/16"}},
+						},
+					},
+				},
+			},
+			errorMgsShouldContain: "peerRouteRules",
+			expectErr:             true,
+		},
 		{
 			name: "should allow blank region",
 			c: &OCICluster{
@@ -563,6 +802,71 @@ func TestOCICluster_ValidateUpdate(t *testing.T) {
 			errorMgsShouldContain: "ociResourceIdentifier",
 			expectErr:             true,
 		},
+		{
+			name: "shouldn't allow shared network identifier change",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					Region:                "old-region",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						Vcn: VCN{
+							Shared: &SharedNetwork{Identifier: "shared-new"},
+						},
+					},
+				},
+			},
+			old: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					Region:                "old-region",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						Vcn: VCN{
+							Shared: &SharedNetwork{Identifier: "shared-old"},
+						},
+					},
+				},
+			},
+			errorMgsShouldContain: "shared",
+			expectErr:             true,
+		},
+		{
+			name: "shouldn't allow sharing the network of an existing cluster",
+			c: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					Region:                "old-region",
+					OCIResourceIdentifier: "uuid",
+					NetworkSpec: NetworkSpec{
+						Vcn: VCN{
+							Shared: &SharedNetwork{Identifier: "shared-new"},
+						},
+					},
+				},
+			},
+			old: &OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: OCIClusterSpec{
+					CompartmentId:         "ocid",
+					Region:                "old-region",
+					OCIResourceIdentifier: "uuid",
+				},
+			},
+			errorMgsShouldContain: "shared",
+			expectErr:             true,
+		},
 		{
 			name: "should succeed",
 			c: &OCICluster{
@@ -43,6 +43,11 @@ type OCIMachineSpec struct {
 	// OCID of the image to be used to launch the instance.
 	ImageId string `json:"imageId,omitempty"`
 
+	// ImageSelector selects the image to be used to launch the instance by its attributes, it can not be used
+	// together with ImageId.
+	// +optional
+	ImageSelector *ImageSelector `json:"imageSelector,omitempty"`
+
 	// Compartment to launch the instance in.
 	CompartmentId string `json:"compartmentId,omitempty"`
 
@@ -101,6 +106,11 @@ type OCIMachineSpec struct {
 	// +optional
 	ProviderID *string `json:"providerID,omitempty"`
 
+	// FailureDomain is the failure domain the instance was launched in, this will be set by Cluster API provider
+	// itself and is copied to the Machine by Cluster API, users should not set this parameter.
+	// +optional
+	FailureDomain *string `json:"failureDomain,omitempty"`
+
 	// Is in transit encryption of volumes required.
 	// +optional
 	IsPvEncryptionInTransitEnabled bool `json:"isPvEncryptionInTransitEnabled,omitempty"`
@@ -133,12 +143,65 @@ type OCIMachineSpec struct {
 	SubnetName string `json:"subnetName,omitempty"`
 
 	// Specifies whether to delete or preserve the boot volume when terminating an instance.
-	// When set to true, the boot volume is preserved. The default value is false.
+	// When set to true, the boot volume is preserved. The default value is false. An existing boot volume the
+	// instance is booted from is always preserved.
 	PreserveBootVolume bool `json:"preserveBootVolume,omitempty"`
 
 	// Specifies whether to delete or preserve the data volumes created during launch when
 	//terminating an instance. When set to true, the data volumes are preserved. The default value is true.
 	PreserveDataVolumesCreatedAtLaunch bool `json:"preserveDataVolumesCreatedAtLaunch,omitempty"`
+
+	// UpdatePolicy defines whether the changes to the shape config, the agent config and the availability config
+	// are applied to the running instance (InPlace) or only to the instances launched later (None, the default).
+	// +optional
+	UpdatePolicy MachineUpdatePolicy `json:"updatePolicy,omitempty"`
+
+	// LaunchFallbackPolicy defines where else, with which other shapes and with which other capacity type the
+	// instance is launched when there is no capacity for the instance as specified.
+	// +optional
+	LaunchFallbackPolicy *LaunchFallbackPolicy `json:"launchFallbackPolicy,omitempty"`
+
+	// InstanceSourceViaBootVolumeConfig boots the instance from an existing boot volume, or from a new boot volume
+	// restored from a boot volume backup, instead of an image.
+	// +optional
+	InstanceSourceViaBootVolumeDetails *InstanceSourceViaBootVolumeConfig `json:"instanceSourceViaBootVolumeConfig,omitempty"`
+
+	// BootVolumeBackupPolicyId defines the OCID of the backup policy assigned to the boot volume of the instance.
+	// +optional
+	BootVolumeBackupPolicyId *string `json:"bootVolumeBackupPolicyId,omitempty"`
+
+	// PersistentVolumes are the block volumes which follow the machine across the replacements of the machine.
+	// The volumes are attached once the instance is running, and detached when they are removed from the spec.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	PersistentVolumes []PersistentVolume `json:"persistentVolumes,omitempty"`
+
+	// ConsoleHistoryCapture captures the serial console history of the instance into a Secret when the machine
+	// fails to bootstrap. The console history can also be captured with the CaptureConsoleHistoryAnnotation.
+	// +optional
+	ConsoleHistoryCapture *ConsoleHistoryCapture `json:"consoleHistoryCapture,omitempty"`
+
+	// BootstrapFormat declares the format of the bootstrap data, cloud-config or ignition. The format of the
+	// bootstrap data secret is used when it is not declared.
+	// +kubebuilder:validation:Enum=cloud-config;ignition
+	// +optional
+	BootstrapFormat BootstrapFormat `json:"bootstrapFormat,omitempty"`
+
+	// IgnitionStorage stores an Ignition config which exceeds the size limit of the instance metadata in Object
+	// Storage.
+	// +optional
+	IgnitionStorage *IgnitionStorage `json:"ignitionStorage,omitempty"`
+
+	// StoreWindowsCredentials stores the initial credentials of a Windows instance in a Secret owned by the
+	// OCIMachine. The password can then be rotated with the RotateWindowsPasswordAnnotation.
+	// +optional
+	StoreWindowsCredentials bool `json:"storeWindowsCredentials,omitempty"`
+
+	// MaintenanceEventHandling polls the maintenance events OCI schedules for the instance, and optionally marks the
+	// Machine for remediation ahead of the maintenance.
+	// +optional
+	MaintenanceEventHandling *MaintenanceEventHandling `json:"maintenanceEventHandling,omitempty"`
 }
 
 // OCIMachineStatus defines the observed state of OCIMachine.
@@ -172,6 +235,47 @@ type OCIMachineStatus struct {
 	// +optional
 	DeleteBackendWorkRequestId string `json:"deleteBackendWorkRequestId,omitempty"`
 
+	// Image is the image selected by the image selector of the spec.
+	// +optional
+	Image *SelectedImage `json:"image,omitempty"`
+
+	// Hibernated is true when the instance was stopped by the hibernation of the cluster, the instance is started
+	// again when the cluster resumes.
+	// +optional
+	Hibernated bool `json:"hibernated,omitempty"`
+
+	// LaunchAttempts are the most recent attempts to launch the instance which failed for lack of capacity.
+	// +optional
+	LaunchAttempts []LaunchAttempt `json:"launchAttempts,omitempty"`
+
+	// Placement is where and how the instance was launched by the launch fallback policy.
+	// +optional
+	Placement *InstancePlacement `json:"placement,omitempty"`
+
+	// BootVolumeId is the OCID of the boot volume restored from the boot volume backup of the spec.
+	// +optional
+	BootVolumeId *string `json:"bootVolumeId,omitempty"`
+
+	// PersistentVolumes are the volumes claimed by the machine for the persistent volumes of the spec.
+	// +optional
+	PersistentVolumes []PersistentVolumeStatus `json:"persistentVolumes,omitempty"`
+
+	// ConsoleHistory is the last capture of the serial console history of the instance.
+	// +optional
+	ConsoleHistory *ConsoleHistoryStatus `json:"consoleHistory,omitempty"`
+
+	// Ignition is the Ignition config of the instance uploaded to Object Storage, it is deleted with the machine.
+	// +optional
+	Ignition *IgnitionStatus `json:"ignition,omitempty"`
+
+	// WindowsCredentials is the Secret the credentials of the Windows instance are stored in.
+	// +optional
+	WindowsCredentials *WindowsCredentialsStatus `json:"windowsCredentials,omitempty"`
+
+	// AppliedTags are the tags of the spec which were last applied to the instance, its VNICs and its boot volume.
+	// +optional
+	AppliedTags *AppliedTags `json:"appliedTags,omitempty"`
+
 	// Conditions defines current service state of the OCIMachine.
 	// +optional
 	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
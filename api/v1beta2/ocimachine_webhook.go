@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2021, 2022 Oracle and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var (
+	_ webhook.Validator = &OCIMachine{}
+)
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-ocimachine,mutating=false,failurePolicy=fail,matchPolicy=Equivalent,groups=infrastructure.cluster.x-k8s.io,resources=ocimachines,versions=v1beta2,name=validation.ocimachine.infrastructure.cluster.x-k8s.io,sideEffects=None,admissionReviewVersions=v1beta1
+
+func (m *OCIMachine) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(m).
+		Complete()
+}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (m *OCIMachine) ValidateCreate() (admission.Warnings, error) {
+	clusterlogger.Info("validate create machine", "name", m.Name)
+
+	allErrs := validateImageSelector(m.Spec.ImageId, m.Spec.ImageSelector, field.NewPath("spec"))
+	allErrs = append(allErrs, validateBootVolumeSource(m.Spec, field.NewPath("spec"))...)
+
+	if len(allErrs) == 0 {
+		return nil, nil
+	}
+
+	return nil, apierrors.NewInvalid(m.GroupVersionKind().GroupKind(), m.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (m *OCIMachine) ValidateDelete() (admission.Warnings, error) {
+	clusterlogger.Info("validate delete machine", "name", m.Name)
+
+	return nil, nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (m *OCIMachine) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	clusterlogger.Info("validate update machine", "name", m.Name)
+
+	oldMachine, ok := old.(*OCIMachine)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an OCIMachine but got a %T", old))
+	}
+
+	allErrs := m.validateImmutableFields(oldMachine)
+
+	if len(allErrs) == 0 {
+		return nil, nil
+	}
+
+	return nil, apierrors.NewInvalid(m.GroupVersionKind().GroupKind(), m.Name, allErrs)
+}
+
+// validateImmutableFields rejects the changes which can not be applied to the instance of the machine. The shape
+// config, the agent config and the availability config are applied to the running instance by the InPlace update
+// policy, the tags, the boot volume backup policy, the persistent volumes, the console history capture, the
+// storage of the Windows credentials and the maintenance event handling are always applied, the launch fallback
+// policy and the Ignition storage only apply to a launch and the other fields can only change by replacing the
+// machine.
+func (m *OCIMachine) validateImmutableFields(old *OCIMachine) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	newSpec, oldSpec := m.Spec, old.Spec
+
+	// the instance id, the provider id and the failure domain are set once the instance is launched
+	if oldSpec.InstanceId != nil && !reflect.DeepEqual(newSpec.InstanceId, oldSpec.InstanceId) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("instanceId"), "field is immutable"))
+	}
+	if oldSpec.ProviderID != nil && !reflect.DeepEqual(newSpec.ProviderID, oldSpec.ProviderID) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("providerID"), "field is immutable"))
+	}
+	if oldSpec.FailureDomain != nil && !reflect.DeepEqual(newSpec.FailureDomain, oldSpec.FailureDomain) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("failureDomain"), "field is immutable"))
+	}
+	if !reflect.DeepEqual(newSpec.ShapeConfig.Nvmes, oldSpec.ShapeConfig.Nvmes) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("shapeConfig", "nvmes"), "field is immutable"))
+	}
+
+	for _, spec := range []*OCIMachineSpec{&newSpec, &oldSpec} {
+		spec.InstanceId = nil
+		spec.ProviderID = nil
+		spec.FailureDomain = nil
+		spec.ShapeConfig = ShapeConfig{}
+		spec.AgentConfig = nil
+		spec.AvailabilityConfig = nil
+		spec.FreeformTags = nil
+		spec.DefinedTags = nil
+		spec.PreserveBootVolume = false
+		spec.PreserveDataVolumesCreatedAtLaunch = false
+		spec.UpdatePolicy = ""
+		spec.LaunchFallbackPolicy = nil
+		spec.BootVolumeBackupPolicyId = nil
+		spec.PersistentVolumes = nil
+		spec.ConsoleHistoryCapture = nil
+		spec.IgnitionStorage = nil
+		spec.StoreWindowsCredentials = false
+		spec.MaintenanceEventHandling = nil
+	}
+	newValue, oldValue := reflect.ValueOf(newSpec), reflect.ValueOf(oldSpec)
+	for i := 0; i < newValue.NumField(); i++ {
+		if reflect.DeepEqual(newValue.Field(i).Interface(), oldValue.Field(i).Interface()) {
+			continue
+		}
+		name := strings.Split(newValue.Type().Field(i).Tag.Get("json"), ",")[0]
+		allErrs = append(allErrs, field.Forbidden(specPath.Child(name), "field is immutable"))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return allErrs
+}
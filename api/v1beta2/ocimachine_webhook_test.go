@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2021, 2022 Oracle and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOCIMachine_ValidateUpdate(t *testing.T) {
+	oldMachine := &OCIMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: OCIMachineSpec{
+			InstanceId:    common.String("ocid1.instance.oc1..xxx"),
+			FailureDomain: common.String("2"),
+			ImageId:       "ocid1.image.oc1..xxx",
+			Shape:         "VM.Standard.E4.Flex",
+			ShapeConfig: ShapeConfig{
+				Ocpus:       "2",
+				MemoryInGBs: "16",
+			},
+			Metadata: map[string]string{
+				"key": "value",
+			},
+		},
+	}
+	tests := []struct {
+		name       string
+		update     func(m *OCIMachine)
+		errorField string
+		expectErr  bool
+	}{
+		{
+			name: "should allow the instance id to be set",
+			update: func(m *OCIMachine) {
+				m.Spec.ProviderID = common.String("oci://ocid1.instance.oc1..xxx")
+			},
+			expectErr: false,
+		},
+		{
+			name: "should allow the mutable fields to change",
+			update: func(m *OCIMachine) {
+				m.Spec.ShapeConfig.Ocpus = "4"
+				m.Spec.ShapeConfig.MemoryInGBs = "32"
+				m.Spec.AgentConfig = &LaunchInstanceAgentConfig{
+					IsMonitoringDisabled: common.Bool(true),
+				}
+				m.Spec.AvailabilityConfig = &LaunchInstanceAvailabilityConfig{
+					IsLiveMigrationPreferred: common.Bool(true),
+				}
+				m.Spec.FreeformTags = map[string]string{"key": "value"}
+				m.Spec.PreserveBootVolume = true
+				m.Spec.UpdatePolicy = MachineUpdatePolicyInPlace
+				m.Spec.BootVolumeBackupPolicyId = common.String("ocid1.volumebackuppolicy.oc1..xxx")
+				m.Spec.PersistentVolumes = []PersistentVolume{{Name: "data", KeyBy: PersistentVolumeKeyMachineIndex}}
+				m.Spec.ConsoleHistoryCapture = &ConsoleHistoryCapture{OnFailure: true}
+				m.Spec.IgnitionStorage = &IgnitionStorage{BucketName: "bucket"}
+				m.Spec.StoreWindowsCredentials = true
+				m.Spec.MaintenanceEventHandling = &MaintenanceEventHandling{RemediateBefore: &metav1.Duration{Duration: time.Hour}}
+			},
+			expectErr: false,
+		},
+		{
+			name: "shouldn't allow the instance id to change",
+			update: func(m *OCIMachine) {
+				m.Spec.InstanceId = common.String("ocid1.instance.oc1..yyy")
+			},
+			errorField: "spec.instanceId",
+			expectErr:  true,
+		},
+		{
+			name: "shouldn't allow the failure domain to change",
+			update: func(m *OCIMachine) {
+				m.Spec.FailureDomain = common.String("3")
+			},
+			errorField: "spec.failureDomain",
+			expectErr:  true,
+		},
+		{
+			name: "shouldn't allow the image to change",
+			update: func(m *OCIMachine) {
+				m.Spec.ImageId = "ocid1.image.oc1..yyy"
+			},
+			errorField: "spec.imageId",
+			expectErr:  true,
+		},
+		{
+			name: "shouldn't allow the shape to change",
+			update: func(m *OCIMachine) {
+				m.Spec.Shape = "VM.Standard.E5.Flex"
+			},
+			errorField: "spec.shape",
+			expectErr:  true,
+		},
+		{
+			name: "shouldn't allow the nvmes to change",
+			update: func(m *OCIMachine) {
+				m.Spec.ShapeConfig.Nvmes = common.Int(2)
+			},
+			errorField: "spec.shapeConfig.nvmes",
+			expectErr:  true,
+		},
+		{
+			name: "shouldn't allow the metadata to change",
+			update: func(m *OCIMachine) {
+				m.Spec.Metadata = map[string]string{"key": "other"}
+			},
+			errorField: "spec.metadata",
+			expectErr:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			machine := oldMachine.DeepCopy()
+			test.update(machine)
+			_, err := machine.ValidateUpdate(oldMachine)
+			if test.expectErr {
+				g.Expect(err).NotTo(gomega.Succeed())
+				g.Expect(strings.Contains(err.Error(), test.errorField)).To(gomega.BeTrue())
+			} else {
+				g.Expect(err).To(gomega.Succeed())
+			}
+		})
+	}
+}
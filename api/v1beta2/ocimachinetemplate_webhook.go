@@ -89,6 +89,10 @@ func (m *OCIMachineTemplate) validate() field.ErrorList {
 		)
 	}
 
+	allErrs = append(allErrs, validateImageSelector(m.Spec.Template.Spec.ImageId, m.Spec.Template.Spec.ImageSelector,
+		field.NewPath("spec", "template", "spec"))...)
+	allErrs = append(allErrs, validateBootVolumeSource(m.Spec.Template.Spec, field.NewPath("spec", "template", "spec"))...)
+
 	// simple validity test for compartment
 	if len(m.Spec.Template.Spec.CompartmentId) > 0 && !ValidOcid(m.Spec.Template.Spec.CompartmentId) {
 		allErrs = append(
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/onsi/gomega"
+	"github.com/oracle/oci-go-sdk/v65/common"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -75,6 +76,118 @@ var tests = []struct {
 		errorField: "shape",
 		expectErr:  true,
 	},
+	{
+		name: "shouldn't allow ImageId together with ImageSelector",
+		inputTemplate: &OCIMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: OCIMachineTemplateSpec{
+				Template: OCIMachineTemplateResource{
+					Spec: OCIMachineSpec{
+						ImageId: "ocid",
+						ImageSelector: &ImageSelector{
+							OperatingSystem: "Oracle Linux",
+						},
+						Shape: "DenseIO.E4.Flex",
+					},
+				},
+			},
+		},
+		errorField: "imageSelector",
+		expectErr:  true,
+	},
+	{
+		name: "shouldn't allow bad DisplayNamePattern",
+		inputTemplate: &OCIMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: OCIMachineTemplateSpec{
+				Template: OCIMachineTemplateResource{
+					Spec: OCIMachineSpec{
+						ImageSelector: &ImageSelector{
+							DisplayNamePattern: "Oracle-Linux-(8",
+						},
+						Shape: "DenseIO.E4.Flex",
+					},
+				},
+			},
+		},
+		errorField: "displayNamePattern",
+		expectErr:  true,
+	},
+	{
+		name: "shouldn't allow ImageId together with a boot volume source",
+		inputTemplate: &OCIMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: OCIMachineTemplateSpec{
+				Template: OCIMachineTemplateResource{
+					Spec: OCIMachineSpec{
+						ImageId: "ocid",
+						InstanceSourceViaBootVolumeDetails: &InstanceSourceViaBootVolumeConfig{
+							BootVolumeBackupId: common.String("ocid"),
+						},
+						Shape: "DenseIO.E4.Flex",
+					},
+				},
+			},
+		},
+		errorField: "instanceSourceViaBootVolumeConfig",
+		expectErr:  true,
+	},
+	{
+		name: "shouldn't allow both a boot volume and a boot volume backup",
+		inputTemplate: &OCIMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: OCIMachineTemplateSpec{
+				Template: OCIMachineTemplateResource{
+					Spec: OCIMachineSpec{
+						InstanceSourceViaBootVolumeDetails: &InstanceSourceViaBootVolumeConfig{
+							BootVolumeId:       common.String("ocid"),
+							BootVolumeBackupId: common.String("ocid"),
+						},
+						Shape: "DenseIO.E4.Flex",
+					},
+				},
+			},
+		},
+		errorField: "instanceSourceViaBootVolumeConfig",
+		expectErr:  true,
+	},
+	{
+		name: "shouldn't allow bad BootVolumeBackupPolicyId",
+		inputTemplate: &OCIMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: OCIMachineTemplateSpec{
+				Template: OCIMachineTemplateResource{
+					Spec: OCIMachineSpec{
+						ImageId:                  "ocid",
+						BootVolumeBackupPolicyId: common.String("policy"),
+						Shape:                    "DenseIO.E4.Flex",
+					},
+				},
+			},
+		},
+		errorField: "bootVolumeBackupPolicyId",
+		expectErr:  true,
+	},
+	{
+		name: "should succeed with a boot volume backup",
+		inputTemplate: &OCIMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: OCIMachineTemplateSpec{
+				Template: OCIMachineTemplateResource{
+					Spec: OCIMachineSpec{
+						InstanceSourceViaBootVolumeDetails: &InstanceSourceViaBootVolumeConfig{
+							BootVolumeBackupId:  common.String("ocid"),
+							BootVolumeVpusPerGB: common.Int64(20),
+						},
+						BootVolumeBackupPolicyId: common.String("ocid"),
+						CompartmentId:            "ocid",
+						Shape:                    "DVH.DenseIO2.52",
+					},
+				},
+			},
+		},
+		expectErr: false,
+	},
 	{
 		name: "should succeed",
 		inputTemplate: &OCIMachineTemplate{
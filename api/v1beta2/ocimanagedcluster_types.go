@@ -76,6 +76,16 @@ type OCIManagedClusterSpec struct {
 	// +optional
 	// +nullable
 	ClientOverrides *ClientOverrides `json:"hostUrl,omitempty"`
+
+	// WorkloadResourceCleanup defines the cleanup of the cloud resources created by the workload cluster
+	// when the cluster is deleted.
+	// +optional
+	WorkloadResourceCleanup *WorkloadResourceCleanup `json:"workloadResourceCleanup,omitempty"`
+
+	// DriftPolicy defines whether the changes made to the network resources outside of Cluster API are reverted
+	// (Enforce, the default) or only reported in the status (Report).
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // OCIManagedClusterStatus defines the observed state of OCICluster
@@ -83,6 +93,22 @@ type OCIManagedClusterStatus struct {
 	// +optional
 	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
 
+	// RemotePeeringConnections is the observed state of the Remote Peering Connections of the VCN peering.
+	// +optional
+	RemotePeeringConnections []RemotePeeringConnectionStatus `json:"remotePeeringConnections,omitempty"`
+
+	// Drift lists the network resources which differed from the spec in the last reconciliation.
+	// +optional
+	Drift []ResourceDrift `json:"drift,omitempty"`
+
+	// Network is the inventory of the network resources of the cluster.
+	// +optional
+	Network *NetworkStatus `json:"network,omitempty"`
+
+	// AppliedTags are the tags of the spec which were last applied to the network resources.
+	// +optional
+	AppliedTags *AppliedTags `json:"appliedTags,omitempty"`
+
 	// +optional
 	Ready bool `json:"ready"`
 	// NetworkSpec encapsulates all things related to OCI network.
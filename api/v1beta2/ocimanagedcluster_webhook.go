@@ -159,6 +159,12 @@ func (c *OCIManagedCluster) ValidateUpdate(old runtime.Object) (admission.Warnin
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "compartmentId"), c.Spec.CompartmentId, "field is immutable"))
 	}
 
+	// the shared network identifier is used to identify the network resources, setting, removing or changing it
+	// would orphan them
+	if !reflect.DeepEqual(c.Spec.NetworkSpec.Vcn.Shared, oldCluster.Spec.NetworkSpec.Vcn.Shared) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "networkSpec", "vcn", "shared"), c.Spec.NetworkSpec.Vcn.Shared, "field is immutable"))
+	}
+
 	allErrs = append(allErrs, c.validate(oldCluster)...)
 
 	if len(allErrs) == 0 {
@@ -178,6 +184,7 @@ func (c *OCIManagedCluster) validate(old *OCIManagedCluster) field.ErrorList {
 
 	allErrs = append(allErrs, ValidateNetworkSpec(OCIManagedClusterSubnetRoles, c.Spec.NetworkSpec, oldNetworkSpec, field.NewPath("spec").Child("networkSpec"))...)
 	allErrs = append(allErrs, ValidateClusterName(c.Name)...)
+	allErrs = append(allErrs, ValidateWorkloadResourceCleanup(c.Spec.WorkloadResourceCleanup, field.NewPath("spec").Child("workloadResourceCleanup"))...)
 
 	if len(c.Spec.CompartmentId) <= 0 {
 		allErrs = append(
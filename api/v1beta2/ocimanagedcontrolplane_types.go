@@ -175,6 +175,11 @@ type OCIManagedControlPlaneStatus struct {
 	// uploaded kubernetes config-map.
 	// +optional
 	Initialized bool `json:"initialized"`
+
+	// PlannedOperations are the operations on the OCI resources which the last reconciliation in plan mode
+	// would have performed. See PlanAnnotation.
+	// +optional
+	PlannedOperations []PlannedOperation `json:"plannedOperations,omitempty"`
 }
 
 // Addon defines the properties of an addon.
@@ -16,6 +16,11 @@
 
 package v1beta2
 
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 const (
 	ControlPlaneRole         = "control-plane"
 	ControlPlaneEndpointRole = "control-plane-endpoint"
@@ -193,6 +198,31 @@ type InstanceSourceViaImageConfig struct {
 	BootVolumeVpusPerGB *int64 `json:"bootVolumeVpusPerGB,omitempty"`
 }
 
+// InstanceSourceViaBootVolumeConfig defines the boot volume an instance is booted from. Exactly one of the boot
+// volume and the boot volume backup has to be set.
+type InstanceSourceViaBootVolumeConfig struct {
+	// BootVolumeId defines the OCID of the boot volume the instance is booted from. The instance is launched in
+	// the availability domain of the boot volume. The boot volume is preserved when the instance is terminated,
+	// whatever the value of preserveBootVolume.
+	// +optional
+	BootVolumeId *string `json:"bootVolumeId,omitempty"`
+
+	// BootVolumeBackupId defines the OCID of the boot volume backup a new boot volume is restored from. The boot
+	// volume is restored in the availability domain the instance is launched in.
+	// +optional
+	BootVolumeBackupId *string `json:"bootVolumeBackupId,omitempty"`
+
+	// KmsKeyId defines the OCID of the Key Management key to assign as the master encryption key for the boot
+	// volume restored from the backup.
+	// +optional
+	KmsKeyId *string `json:"kmsKeyId,omitempty"`
+
+	// BootVolumeVpusPerGB defines the number of volume performance units (VPUs) that will be applied to the boot
+	// volume restored from the backup per GB.
+	// +optional
+	BootVolumeVpusPerGB *int64 `json:"bootVolumeVpusPerGB,omitempty"`
+}
+
 // LaunchInstanceAvailabilityConfigDetailsRecoveryActionEnum Enum with underlying type: string
 type PlatformConfigTypeEnum string
 
@@ -865,6 +895,38 @@ type Subnet struct {
 	// within this subnet (for example, `bminstance1.subnet123.vcn1.oraclevcn.com`).
 	// +optional
 	DnsLabel *string `json:"dnsLabel,omitempty"`
+
+	// CompartmentId is the compartment to create the subnet in. If not set, the compartment
+	// of the network is used.
+	// +optional
+	CompartmentId string `json:"compartmentId,omitempty"`
+
+	// FlowLog defines the VCN flow log configuration of the subnet. If set, the flow log is
+	// created in OCI Logging and deleted along with the cluster. If the flow log configuration is removed or its
+	// log group changes, the previous flow log is deleted.
+	// +optional
+	FlowLog *FlowLog `json:"flowLog,omitempty"`
+
+	// DeletionPolicy defines whether the subnet is deleted or retained when the cluster is deleted. If not set, the
+	// deletion policy of the VCN is used.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// FlowLog defines the configuration of a VCN flow log.
+// https://docs.oracle.com/en-us/iaas/Content/Network/Concepts/vcn-flow-logs.htm
+type FlowLog struct {
+	// LogGroupId is the OCID of the log group to create the flow log in.
+	LogGroupId string `json:"logGroupId"`
+
+	// RetentionDuration is the log retention duration in days, in 30-day increments (30, 60, 90 and so on until 180).
+	// +optional
+	RetentionDuration *int `json:"retentionDuration,omitempty"`
+
+	// SamplingRate is the sampling rate of the flow log, a value of 10 captures 1 out of every 10 flows.
+	// If not set, all flows are captured.
+	// +optional
+	SamplingRate *int `json:"samplingRate,omitempty"`
 }
 
 // NSG defines configuration for a Network Security Group.
@@ -883,6 +945,15 @@ type NSG struct {
 	// IngressRules on the NSG.
 	// +optional
 	IngressRules []IngressSecurityRuleForNSG `json:"ingressRules,omitempty"`
+	// CompartmentId is the compartment to create the NSG in. If not set, the compartment
+	// of the network is used.
+	// +optional
+	CompartmentId string `json:"compartmentId,omitempty"`
+
+	// DeletionPolicy defines whether the NSG is deleted or retained when the cluster is deleted. If not set, the
+	// deletion policy of the VCN is used.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // VCN dfines the configuration for a Virtual Cloud Network.
@@ -935,6 +1006,415 @@ type VCN struct {
 	// within this subnet (for example, `bminstance1.subnet123.vcn1.oraclevcn.com`).
 	// +optional
 	DnsLabel *string `json:"dnsLabel,omitempty"`
+
+	// Shared defines whether the VCN, gateways and route tables are shared with other clusters. If set, the
+	// shared resources are tagged with the shared network identifier instead of the cluster's resource identifier,
+	// while subnets and NSGs continue to be owned by the cluster. Every cluster using the network registers itself
+	// on the VCN and the shared resources are deleted only when the last cluster using them is deleted. The
+	// shared route tables do not route to the DRG of a cluster, the peer route rules and the static routes of
+	// the VPN can not be set.
+	// +optional
+	Shared *SharedNetwork `json:"shared,omitempty"`
+
+	// DeletionPolicy defines whether the VCN is deleted or retained when the cluster is deleted. The gateways,
+	// route tables and security lists of a retained VCN are retained along with it.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// SharedNetwork defines the configuration of a network shared by multiple clusters.
+type SharedNetwork struct {
+	// Identifier is the unique identifier of the shared network. All the clusters sharing the
+	// network must specify the same identifier.
+	Identifier string `json:"identifier"`
+}
+
+// DeletionPolicy defines what happens to a resource created by Cluster API when the cluster is deleted.
+// +kubebuilder:validation:Enum=Delete;Retain
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the resource along with the cluster, the default.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyRetain keeps the resource when the cluster is deleted. The resource is tagged as retained,
+	// so that it is not considered orphaned, and can be adopted by a cluster with the same OCIResourceIdentifier.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// DriftPolicy defines how the changes made to the network resources of a cluster outside of Cluster API are
+// handled.
+// +kubebuilder:validation:Enum=Enforce;Report
+type DriftPolicy string
+
+const (
+	// DriftPolicyEnforce reverts the changes made outside of Cluster API, the default.
+	DriftPolicyEnforce DriftPolicy = "Enforce"
+
+	// DriftPolicyReport only reports the changes made outside of Cluster API, the resources are not updated.
+	DriftPolicyReport DriftPolicy = "Report"
+)
+
+// ImageArchitecture is the processor architecture of an image.
+// +kubebuilder:validation:Enum=x86_64;aarch64
+type ImageArchitecture string
+
+const (
+	// ImageArchitectureX86 is the architecture of the images for the AMD and Intel shapes.
+	ImageArchitectureX86 ImageArchitecture = "x86_64"
+
+	// ImageArchitectureArm is the architecture of the images for the Arm shapes.
+	ImageArchitectureArm ImageArchitecture = "aarch64"
+)
+
+// ImageSelector selects an image by its attributes instead of its region specific OCID. The most recently created
+// image which matches all the attributes and is compatible with the shape of the instance is selected.
+type ImageSelector struct {
+	// CompartmentId is the compartment of the custom images to select from, the platform images are always
+	// selected from. Defaults to the compartment of the instance.
+	// +optional
+	CompartmentId *string `json:"compartmentId,omitempty"`
+
+	// DisplayNamePattern is a regular expression which the display name of the image must match, for example
+	// `^Oracle-Linux-8\.9-20\d\d\.`.
+	// +optional
+	DisplayNamePattern string `json:"displayNamePattern,omitempty"`
+
+	// OperatingSystem is the operating system of the image, for example `Oracle Linux`.
+	// +optional
+	OperatingSystem string `json:"operatingSystem,omitempty"`
+
+	// OperatingSystemVersion is the version of the operating system of the image, for example `8`.
+	// +optional
+	OperatingSystemVersion string `json:"operatingSystemVersion,omitempty"`
+
+	// Architecture is the processor architecture of the image. The images for the Arm shapes have aarch64 in
+	// their display name, as the platform images do.
+	// +optional
+	Architecture ImageArchitecture `json:"architecture,omitempty"`
+
+	// FreeformTags are the free form tags which the image must have, for example `k8s-version: v1.29.1`.
+	// +optional
+	FreeformTags map[string]string `json:"freeformTags,omitempty"`
+}
+
+// SelectedImage is the image selected by an ImageSelector. The image is selected again when the selector changes.
+type SelectedImage struct {
+	// ImageId is the OCID of the selected image.
+	ImageId string `json:"imageId"`
+
+	// DisplayName is the display name of the selected image.
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Selector is the image selector which selected the image.
+	Selector ImageSelector `json:"selector"`
+}
+
+// LaunchFallbackPolicy defines the ordered fallbacks tried when an instance can not be launched for lack of
+// capacity. The placements are tried first, then the shapes and then the capacity types, so that the instance is
+// launched as specified wherever possible.
+type LaunchFallbackPolicy struct {
+	// FaultDomains tries each fault domain of the availability domain when the failure domain of the machine does
+	// not pin a fault domain.
+	// +optional
+	FaultDomains bool `json:"faultDomains,omitempty"`
+
+	// AvailabilityDomains tries the other availability domains of the cluster when the failure domain of the
+	// machine is not set.
+	// +optional
+	AvailabilityDomains bool `json:"availabilityDomains,omitempty"`
+
+	// Shapes are the shapes tried in order after the shape of the spec.
+	// +optional
+	Shapes []FallbackShape `json:"shapes,omitempty"`
+
+	// OnDemand launches an on-demand instance when a preemptible instance can not be launched with any of the
+	// shapes.
+	// +optional
+	OnDemand bool `json:"onDemand,omitempty"`
+
+	// UseCapacityReport consults the compute capacity report before launching, the placements and shapes without
+	// capacity are skipped without attempting a launch.
+	// +optional
+	UseCapacityReport bool `json:"useCapacityReport,omitempty"`
+}
+
+// FallbackShape is a shape tried by the launch fallback policy.
+type FallbackShape struct {
+	// Shape is the name of the shape.
+	// +kubebuilder:validation:MinLength=1
+	Shape string `json:"shape"`
+
+	// ShapeConfig is the configuration of the shape, applicable for flex shapes.
+	// +optional
+	ShapeConfig *ShapeConfig `json:"shapeConfig,omitempty"`
+}
+
+// InstancePlacement is where and how an instance is launched.
+type InstancePlacement struct {
+	// AvailabilityDomain is the availability domain of the instance.
+	// +optional
+	AvailabilityDomain string `json:"availabilityDomain,omitempty"`
+
+	// FaultDomain is the fault domain of the instance, empty when the fault domain was chosen by OCI.
+	// +optional
+	FaultDomain string `json:"faultDomain,omitempty"`
+
+	// Shape is the shape of the instance.
+	Shape string `json:"shape"`
+
+	// Preemptible is true when the instance is preemptible.
+	// +optional
+	Preemptible bool `json:"preemptible,omitempty"`
+}
+
+// LaunchAttempt is an attempt to launch an instance which failed for lack of capacity.
+type LaunchAttempt struct {
+	InstancePlacement `json:",inline"`
+
+	// Time is when the launch was attempted.
+	Time metav1.Time `json:"time"`
+
+	// Message is the reason the launch failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// MachineUpdatePolicy defines how the changes to the spec of an OCIMachine are applied to its instance.
+// +kubebuilder:validation:Enum=None;InPlace
+type MachineUpdatePolicy string
+
+const (
+	// MachineUpdatePolicyNone does not update the instance, the machine has to be replaced for the changes to take
+	// effect. This is the default.
+	MachineUpdatePolicyNone MachineUpdatePolicy = "None"
+
+	// MachineUpdatePolicyInPlace updates the running instance. A change to the shape config reboots the instance,
+	// the instances of the control plane are rebooted one at a time.
+	MachineUpdatePolicyInPlace MachineUpdatePolicy = "InPlace"
+)
+
+// ResourceDrift is the difference between the spec of a network resource and the actual resource in OCI.
+type ResourceDrift struct {
+	// ResourceType is the type of the resource, for example vcn or subnet.
+	ResourceType string `json:"resourceType"`
+
+	// Name is the name of the resource in the spec.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ID is the OCID of the resource.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Fields are the fields of the resource which differ from the spec.
+	Fields []FieldDrift `json:"fields"`
+
+	// Corrected is true if the resource has been updated to match the spec, as per the Enforce drift policy.
+	// +optional
+	Corrected bool `json:"corrected,omitempty"`
+}
+
+// FieldDrift is the difference between the desired and the actual value of a field of a resource. The value
+// of a missing rule is empty on the actual side, the value of an unexpected rule is empty on the desired side.
+type FieldDrift struct {
+	// Field is the name of the field in OCI.
+	Field string `json:"field"`
+
+	// Desired is the value of the field in the spec.
+	// +optional
+	Desired string `json:"desired,omitempty"`
+
+	// Actual is the value of the field in OCI.
+	// +optional
+	Actual string `json:"actual,omitempty"`
+}
+
+// PlanAnnotation switches the reconciliation of an OCICluster, OCIMachinePool or OCIManagedControlPlane to plan
+// mode. In plan mode the operations which would be performed on the OCI resources are listed in the status of
+// the object instead of being performed. The plan is applied by removing the annotation.
+const PlanAnnotation = "infrastructure.cluster.x-k8s.io/plan"
+
+// PlannedOperationType is the type of operation of a PlannedOperation.
+type PlannedOperationType string
+
+const (
+	// PlannedOperationCreate creates a resource.
+	PlannedOperationCreate PlannedOperationType = "Create"
+	// PlannedOperationUpdate updates a resource.
+	PlannedOperationUpdate PlannedOperationType = "Update"
+	// PlannedOperationDelete deletes a resource.
+	PlannedOperationDelete PlannedOperationType = "Delete"
+)
+
+// PlannedOperation is an operation on an OCI resource which the reconciliation would perform once the plan
+// annotation is removed.
+type PlannedOperation struct {
+	// Operation is the type of operation, one of Create, Update or Delete.
+	Operation PlannedOperationType `json:"operation"`
+
+	// ResourceType is the type of the OCI resource, for example vcn or subnet.
+	ResourceType string `json:"resourceType"`
+
+	// Name is the display name of the resource, if known.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ID is the OCID of the resource, if known.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Fields are the fields of the resource which would be updated, if known.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+}
+
+// HibernateAnnotation is set on the Cluster to hibernate it. The instances of a hibernated cluster are stopped, and
+// its instance pools and node pools are scaled to zero. The cluster is resumed by removing the annotation.
+const HibernateAnnotation = "infrastructure.cluster.x-k8s.io/hibernate"
+
+// HibernationState is the hibernation state of an OCICluster.
+type HibernationState string
+
+const (
+	// HibernationStateHibernated is the state of a cluster which has the hibernate annotation.
+	HibernationStateHibernated HibernationState = "Hibernated"
+	// HibernationStateResuming is the state of a cluster once the hibernate annotation is removed, until the
+	// backends of the API server load balancer are healthy again. The worker machines are resumed after the
+	// control plane.
+	HibernationStateResuming HibernationState = "Resuming"
+)
+
+// NetworkStatus is the inventory of the network resources of the cluster, as observed in OCI.
+type NetworkStatus struct {
+	// VCN is the observed state of the VCN.
+	// +optional
+	VCN *VCNStatus `json:"vcn,omitempty"`
+
+	// InternetGateway is the observed state of the Internet Gateway.
+	// +optional
+	InternetGateway *NetworkResourceStatus `json:"internetGateway,omitempty"`
+
+	// NATGateway is the observed state of the NAT Gateway.
+	// +optional
+	NATGateway *NetworkResourceStatus `json:"natGateway,omitempty"`
+
+	// ServiceGateway is the observed state of the Service Gateway.
+	// +optional
+	ServiceGateway *NetworkResourceStatus `json:"serviceGateway,omitempty"`
+
+	// PrivateRouteTable is the observed state of the route table of the private subnets.
+	// +optional
+	PrivateRouteTable *NetworkResourceStatus `json:"privateRouteTable,omitempty"`
+
+	// PublicRouteTable is the observed state of the route table of the public subnets.
+	// +optional
+	PublicRouteTable *NetworkResourceStatus `json:"publicRouteTable,omitempty"`
+
+	// Subnets are the observed states of the subnets.
+	// +optional
+	Subnets []SubnetStatus `json:"subnets,omitempty"`
+
+	// NetworkSecurityGroups are the observed states of the network security groups.
+	// +optional
+	NetworkSecurityGroups []NSGStatus `json:"networkSecurityGroups,omitempty"`
+
+	// FlowLogs are the observed states of the VCN flow logs of the subnets.
+	// +optional
+	FlowLogs []FlowLogStatus `json:"flowLogs,omitempty"`
+
+	// DRG is the observed state of the DRG.
+	// +optional
+	DRG *NetworkResourceStatus `json:"drg,omitempty"`
+
+	// DRGVCNAttachment is the observed state of the attachment of the VCN to the DRG.
+	// +optional
+	DRGVCNAttachment *NetworkResourceStatus `json:"drgVcnAttachment,omitempty"`
+
+	// CPE is the observed state of the Customer-Premises Equipment of the Site-to-Site VPN.
+	// +optional
+	CPE *NetworkResourceStatus `json:"cpe,omitempty"`
+
+	// IPSecConnection is the observed state of the IPSec connection of the Site-to-Site VPN.
+	// +optional
+	IPSecConnection *NetworkResourceStatus `json:"ipSecConnection,omitempty"`
+
+	// APIServerLB is the observed state of the API server load balancer.
+	// +optional
+	APIServerLB *NetworkResourceStatus `json:"apiServerLB,omitempty"`
+}
+
+// NetworkResourceStatus is the observed state of a network resource.
+type NetworkResourceStatus struct {
+	// ID is the OCID of the resource.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// LifecycleState is the lifecycle state of the resource in OCI.
+	// +optional
+	LifecycleState string `json:"lifecycleState,omitempty"`
+}
+
+// VCNStatus is the observed state of the VCN.
+type VCNStatus struct {
+	NetworkResourceStatus `json:",inline"`
+
+	// CIDRs are the CIDR blocks of the VCN.
+	// +optional
+	CIDRs []string `json:"cidrs,omitempty"`
+}
+
+// SubnetStatus is the observed state of a subnet.
+type SubnetStatus struct {
+	NetworkResourceStatus `json:",inline"`
+
+	// Name is the name of the subnet in the spec.
+	Name string `json:"name"`
+
+	// Role is the role of the subnet.
+	// +optional
+	Role Role `json:"role,omitempty"`
+
+	// CIDR is the CIDR block of the subnet.
+	// +optional
+	CIDR string `json:"cidr,omitempty"`
+
+	// SecurityListID is the OCID of the security list of the subnet.
+	// +optional
+	SecurityListID *string `json:"securityListId,omitempty"`
+}
+
+// FlowLogStatus is the observed state of the VCN flow log of a subnet. The flow log is kept in the inventory until
+// it is deleted, so that the flow logs which are no longer desired are deleted along with their capture filters.
+type FlowLogStatus struct {
+	NetworkResourceStatus `json:",inline"`
+
+	// SubnetName is the name of the subnet in the spec.
+	SubnetName string `json:"subnetName"`
+
+	// SubnetID is the OCID of the subnet.
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// LogGroupId is the OCID of the log group of the flow log.
+	LogGroupId string `json:"logGroupId"`
+
+	// CaptureFilterID is the OCID of the capture filter of the flow log.
+	// +optional
+	CaptureFilterID *string `json:"captureFilterId,omitempty"`
+}
+
+// NSGStatus is the observed state of a network security group.
+type NSGStatus struct {
+	NetworkResourceStatus `json:",inline"`
+
+	// Name is the name of the network security group in the spec.
+	Name string `json:"name"`
+
+	// Role is the role of the network security group.
+	// +optional
+	Role Role `json:"role,omitempty"`
 }
 
 // LoadBalancerType is an enumeration of the supported load balancer types.
@@ -965,6 +1445,15 @@ type LoadBalancer struct {
 	// The NLB Spec
 	// +optional
 	NLBSpec NLBSpec `json:"nlbSpec,omitempty"`
+
+	// CompartmentId is the compartment to create the load balancer in. If not set, the compartment
+	// of the network is used.
+	// +optional
+	CompartmentId string `json:"compartmentId,omitempty"`
+
+	// DeletionPolicy defines whether the load balancer is deleted or retained when the cluster is deleted.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // NLBSpec specifies the NLB spec.
@@ -1013,6 +1502,11 @@ type NetworkSpec struct {
 	// +optional
 	SkipNetworkManagement bool `json:"skipNetworkManagement,omitempty"`
 
+	// CompartmentId is the compartment to create the network resources in. If not set, the compartment
+	// of the cluster is used. Subnets, NSGs and the API Server LB can override it individually.
+	// +optional
+	CompartmentId string `json:"compartmentId,omitempty"`
+
 	// VCN configuration.
 	// +optional
 	Vcn VCN `json:"vcn,omitempty"`
@@ -1041,6 +1535,11 @@ type VCNPeering struct {
 	// RemotePeeringConnections defines the RPC connections which be established with the
 	// workload cluster DRG.
 	RemotePeeringConnections []RemotePeeringConnection `json:"remotePeeringConnections,omitempty"`
+
+	// VPN defines the Site-to-Site IPSec VPN connection to an on-premises network which will be
+	// established through the workload cluster DRG.
+	// +optional
+	VPN *VPN `json:"vpn,omitempty"`
 }
 
 // DRG defines the configuration for a Dynamic Resource Group.
@@ -1064,6 +1563,11 @@ type DRG struct {
 	// or to the workload cluster DRG.
 	// +optional
 	VcnAttachmentId *string `json:"vcnAttachmentId,omitempty"`
+
+	// DeletionPolicy defines whether the DRG is deleted or retained when the cluster is deleted. The VCN attachment
+	// is retained as well if the VCN is retained.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // PeerRouteRule defines a Route Rule to be routed via a DRG.
@@ -1075,6 +1579,87 @@ type PeerRouteRule struct {
 	VCNCIDRRange string `json:"vcnCIDRRange,omitempty"`
 }
 
+// IPSecTunnelRouting is an enumeration of the supported routing types of an IPSec tunnel.
+type IPSecTunnelRouting string
+
+const (
+	// IPSecTunnelRoutingStatic routes the traffic to the static routes of the IPSec connection.
+	IPSecTunnelRoutingStatic IPSecTunnelRouting = IPSecTunnelRouting("STATIC")
+
+	// IPSecTunnelRoutingBGP exchanges the routes with the on-premises network using BGP.
+	IPSecTunnelRoutingBGP IPSecTunnelRouting = IPSecTunnelRouting("BGP")
+)
+
+// VPN defines a Site-to-Site IPSec VPN connection to an on-premises network.
+// Site-to-Site VPN is explained here - https://docs.oracle.com/en-us/iaas/Content/Network/Tasks/managingIPsec.htm
+type VPN struct {
+	// CPE defines the Customer-Premises Equipment which represents the on-premises end of the VPN.
+	CPE CPE `json:"cpe"`
+
+	// StaticRoutes are the CIDR ranges of the on-premises network. Route rules to these CIDR ranges are
+	// added to the private route table of the workload cluster VCN and directed to the DRG. OCI requires
+	// at least one static route, even if the tunnels use BGP.
+	StaticRoutes []string `json:"staticRoutes"`
+
+	// Tunnels defines the configuration of the two IPSec tunnels of the connection, in order.
+	// If not specified, both tunnels use static routing. Changes to the routing are applied to the
+	// existing tunnels.
+	// +optional
+	// +kubebuilder:validation:MaxItems=2
+	Tunnels []IPSecTunnel `json:"tunnels,omitempty"`
+
+	// SharedSecretName is the name of the Secret in the namespace of the cluster which holds the
+	// pre-shared keys of the tunnels, under the keys `tunnel1` and `tunnel2`. If not specified,
+	// OCI generates the pre-shared keys. The pre-shared keys of the tunnels are rotated when the keys
+	// in the Secret change, on the next reconciliation of the cluster.
+	// +optional
+	SharedSecretName string `json:"sharedSecretName,omitempty"`
+
+	// ID is the OCID of the IPSec connection.
+	// +optional
+	ID *string `json:"id,omitempty"`
+}
+
+// CPE defines a Customer-Premises Equipment object.
+type CPE struct {
+	// IPAddress is the public IP address of the on-premises VPN device. As the IP address of a CPE
+	// cannot be updated in OCI, changing it replaces the CPE and the IPSec connection, and the tunnels
+	// are down until the on-premises device is configured with the new connection.
+	IPAddress string `json:"ipAddress"`
+
+	// DeviceShapeId is the OCID of the CPE device type, used to generate the device configuration.
+	// +optional
+	DeviceShapeId *string `json:"deviceShapeId,omitempty"`
+
+	// ID is the OCID of the CPE.
+	// +optional
+	ID *string `json:"id,omitempty"`
+}
+
+// IPSecTunnel defines the configuration of an IPSec tunnel.
+type IPSecTunnel struct {
+	// Routing is the routing type of the tunnel, STATIC(the default) or BGP.
+	// +kubebuilder:validation:Enum=STATIC;BGP
+	// +optional
+	Routing IPSecTunnelRouting `json:"routing,omitempty"`
+
+	// BGPSession defines the BGP session parameters, required if the routing type is BGP.
+	// +optional
+	BGPSession *BGPSession `json:"bgpSession,omitempty"`
+}
+
+// BGPSession defines the BGP session parameters of an IPSec tunnel.
+type BGPSession struct {
+	// CustomerBgpAsn is the BGP ASN of the on-premises network.
+	CustomerBgpAsn string `json:"customerBgpAsn"`
+
+	// CustomerInterfaceIp is the IP address of the on-premises end of the tunnel inside interface, in CIDR notation.
+	CustomerInterfaceIp string `json:"customerInterfaceIp"`
+
+	// OracleInterfaceIp is the IP address of the Oracle end of the tunnel inside interface, in CIDR notation.
+	OracleInterfaceIp string `json:"oracleInterfaceIp"`
+}
+
 // RemotePeeringConnection is used to peer VCNs residing in different regions(typically).
 // Remote VCN Peering is explained here - https://docs.oracle.com/en-us/iaas/Content/Network/Tasks/remoteVCNpeering.htm
 type RemotePeeringConnection struct {
@@ -1101,6 +1686,36 @@ type RemotePeeringConnection struct {
 
 	// RPCConnectionId is the connection ID of the connection between peer and local RPC.
 	RPCConnectionId *string `json:"rpcConnectionId,omitempty"`
+
+	// PeerIdentityRef is a reference to the OCIClusterIdentity used to manage the peer RPC, if the peer DRG
+	// is in another tenancy. ManagePeerRPC has to be set to true. The cross-tenancy peering requires the
+	// requestor and acceptor IAM policies to be in place in the tenancies of the cluster and of the peer,
+	// as explained here - https://docs.oracle.com/en-us/iaas/Content/Network/Tasks/scenario_e.htm#policies
+	// +optional
+	PeerIdentityRef *corev1.ObjectReference `json:"peerIdentityRef,omitempty"`
+
+	// PeerCompartmentId is the compartment of the peer DRG in which the peer RPC will be created. It is
+	// required if PeerIdentityRef is set, otherwise the network compartment of the cluster is used.
+	// +optional
+	PeerCompartmentId string `json:"peerCompartmentId,omitempty"`
+}
+
+// RemotePeeringConnectionStatus defines the observed state of a Remote Peering Connection.
+type RemotePeeringConnectionStatus struct {
+	// Name is the name of the RemotePeeringConnection in the VCNPeering spec.
+	Name string `json:"name"`
+
+	// RPCConnectionId is the OCID of the local RPC.
+	// +optional
+	RPCConnectionId *string `json:"rpcConnectionId,omitempty"`
+
+	// PeeringStatus is the peering status of the local RPC, one of NEW, PENDING, PEERED, INVALID or REVOKED.
+	// +optional
+	PeeringStatus string `json:"peeringStatus,omitempty"`
+
+	// IsCrossTenancyPeering is true if the local RPC is peered with an RPC in another tenancy.
+	// +optional
+	IsCrossTenancyPeering bool `json:"isCrossTenancyPeering,omitempty"`
 }
 
 // InternetGateway is used to specify the options for creating internet gateway.
@@ -1167,7 +1782,8 @@ type NetworkSecurityGroup struct {
 type VolumeType string
 
 const (
-	IscsiType VolumeType = "iscsi"
+	IscsiType           VolumeType = "iscsi"
+	ParavirtualizedType VolumeType = "paravirtualized"
 )
 
 // EncryptionInTransitTypeEnum Enum with underlying type: string
@@ -1223,6 +1839,72 @@ type LaunchIscsiVolumeAttachment struct {
 	EncryptionInTransitType EncryptionInTransitTypeEnum `json:"encryptionInTransitType,omitempty"`
 }
 
+// PersistentVolumeKey defines which machine a persistent volume belongs to.
+type PersistentVolumeKey string
+
+const (
+	// PersistentVolumeKeyMachineName keeps the volume for the machine of the same name. The machines of
+	// MachineDeployments, MachineSets and control planes get a new name when they are replaced, hence this key is
+	// only meant for Machines created with a fixed name.
+	PersistentVolumeKeyMachineName PersistentVolumeKey = "MachineName"
+	// PersistentVolumeKeyMachineIndex hands the volume to any machine which needs a volume of the claim, the
+	// volumes are numbered and the lowest numbered volume which is not claimed by another machine is used.
+	PersistentVolumeKeyMachineIndex PersistentVolumeKey = "MachineIndex"
+)
+
+// PersistentVolume is a named claim for a block volume which outlives the instances of the machine. When a machine
+// is replaced, the volume is detached from the instance of the old machine and attached to the instance of the new
+// machine. The volume is created in the availability domain of the instance when there is no volume to reuse. The
+// volume is attached once the instance is running, the bootstrap of the node has to wait for the device of the
+// volume.
+type PersistentVolume struct {
+	// Name identifies the volumes of the claim in the cluster, the machines of the templates with the same claim
+	// share its volumes.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// KeyBy defines whether the volume belongs to the machine of the same name (MachineName) or to whichever
+	// machine claims it first (MachineIndex, the default). A replaced machine gets a new name unless the Machine
+	// is created with a fixed name, in which case MachineName gives it back its volume.
+	// +kubebuilder:validation:Enum=MachineName;MachineIndex
+	// +optional
+	KeyBy PersistentVolumeKey `json:"keyBy,omitempty"`
+
+	// AttachmentType defines how the volume is attached to the instance, iscsi or paravirtualized, the default.
+	// +kubebuilder:validation:Enum=iscsi;paravirtualized
+	// +optional
+	AttachmentType VolumeType `json:"attachmentType,omitempty"`
+
+	// Device defines the consistent device path of the volume in the instance, for example
+	// /dev/oracleoci/oraclevdb.
+	// +optional
+	Device *string `json:"device,omitempty"`
+
+	// SizeInGBs defines the size of the volume in GBs.
+	// +optional
+	SizeInGBs *int64 `json:"sizeInGBs,omitempty"`
+
+	// VpusPerGB defines the number of volume performance units (VPUs) that will be applied to the volume per GB.
+	// +optional
+	VpusPerGB *int64 `json:"vpusPerGB,omitempty"`
+
+	// KmsKeyId defines the OCID of the Key Management key to assign as the master encryption key for the volume.
+	// +optional
+	KmsKeyId *string `json:"kmsKeyId,omitempty"`
+}
+
+// PersistentVolumeStatus is the volume claimed by a machine for a persistent volume of the spec.
+type PersistentVolumeStatus struct {
+	// Name is the name of the persistent volume of the spec.
+	Name string `json:"name"`
+
+	// Key is the machine name or the index the volume is keyed by.
+	Key string `json:"key"`
+
+	// VolumeId is the OCID of the volume.
+	VolumeId *string `json:"volumeId,omitempty"`
+}
+
 // LaunchCreateVolumeFromAttributes The details of the volume to create for CreateVolume operation.
 type LaunchCreateVolumeFromAttributes struct {
 
@@ -1258,3 +1940,160 @@ const (
 )
 
 type CNIOptionEnum string
+
+// CaptureConsoleHistoryAnnotation is set on an OCIMachine to capture the serial console history of its instance.
+// The annotation is removed once the capture is requested.
+const CaptureConsoleHistoryAnnotation = "infrastructure.cluster.x-k8s.io/capture-console-history"
+
+// ConsoleHistoryCaptureReason is why the serial console history of an instance was captured.
+type ConsoleHistoryCaptureReason string
+
+const (
+	// ConsoleHistoryCaptureRequested is a capture requested with the CaptureConsoleHistoryAnnotation.
+	ConsoleHistoryCaptureRequested ConsoleHistoryCaptureReason = "Requested"
+	// ConsoleHistoryCaptureBootstrapTimeout is a capture of an instance which did not join the cluster in time.
+	ConsoleHistoryCaptureBootstrapTimeout ConsoleHistoryCaptureReason = "BootstrapTimeout"
+	// ConsoleHistoryCaptureMachineFailed is a capture of the instance of a failed or unhealthy machine.
+	ConsoleHistoryCaptureMachineFailed ConsoleHistoryCaptureReason = "MachineFailed"
+)
+
+// ConsoleHistoryCapture defines when the serial console history of the instance is captured automatically.
+type ConsoleHistoryCapture struct {
+	// BootstrapTimeout captures the console history when the node of the machine has not joined the cluster this
+	// long after the instance was launched.
+	// +optional
+	BootstrapTimeout *metav1.Duration `json:"bootstrapTimeout,omitempty"`
+
+	// OnFailure captures the console history when the machine fails or a MachineHealthCheck marks it unhealthy,
+	// while its instance still exists.
+	// +optional
+	OnFailure bool `json:"onFailure,omitempty"`
+
+	// MaxSizeInBytes caps the size of the console history stored in the Secret, the end of the history is kept.
+	// Defaults to 65536.
+	// +kubebuilder:validation:Minimum=1024
+	// +kubebuilder:validation:Maximum=1000000
+	// +optional
+	MaxSizeInBytes *int `json:"maxSizeInBytes,omitempty"`
+}
+
+// ConsoleHistoryStatus is the last capture of the serial console history of the instance.
+type ConsoleHistoryStatus struct {
+	// ConsoleHistoryId is the OCID of the console history captured in OCI, it is deleted once its content is
+	// stored in the Secret.
+	// +optional
+	ConsoleHistoryId *string `json:"consoleHistoryId,omitempty"`
+
+	// InstanceId is the OCID of the instance the console history was captured from.
+	// +optional
+	InstanceId *string `json:"instanceId,omitempty"`
+
+	// Reason is why the console history was captured.
+	Reason ConsoleHistoryCaptureReason `json:"reason"`
+
+	// SecretName is the name of the Secret in the namespace of the OCIMachine which holds the console history.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// CaptureTime is when the console history was stored in the Secret.
+	// +optional
+	CaptureTime *metav1.Time `json:"captureTime,omitempty"`
+}
+
+// BootstrapFormat is the format of the bootstrap data of a machine.
+type BootstrapFormat string
+
+const (
+	// CloudConfigBootstrapFormat is bootstrap data read by cloud-init.
+	CloudConfigBootstrapFormat BootstrapFormat = "cloud-config"
+	// IgnitionBootstrapFormat is bootstrap data read by Ignition, for example on Flatcar Container Linux or
+	// Fedora CoreOS.
+	IgnitionBootstrapFormat BootstrapFormat = "ignition"
+)
+
+// IgnitionStorage defines where an Ignition config which exceeds the size limit of the instance metadata is stored.
+// The instance reads the config through a pre-authenticated request, the metadata only holds a stub config which
+// points to it.
+type IgnitionStorage struct {
+	// BucketName is the name of the Object Storage bucket of the region of the cluster the Ignition config is
+	// uploaded to.
+	// +kubebuilder:validation:MinLength=1
+	BucketName string `json:"bucketName"`
+
+	// PreauthenticatedRequestTTL is how long the instance can read the Ignition config. Defaults to 1h.
+	// +optional
+	PreauthenticatedRequestTTL *metav1.Duration `json:"preauthenticatedRequestTTL,omitempty"`
+}
+
+// IgnitionStatus is the Ignition config of a machine uploaded to Object Storage.
+type IgnitionStatus struct {
+	// Namespace is the Object Storage namespace of the bucket.
+	Namespace string `json:"namespace"`
+
+	// BucketName is the name of the bucket.
+	BucketName string `json:"bucketName"`
+
+	// ObjectName is the name of the object holding the Ignition config.
+	ObjectName string `json:"objectName"`
+
+	// PreauthenticatedRequestId is the id of the pre-authenticated request the instance reads the object with.
+	// +optional
+	PreauthenticatedRequestId *string `json:"preauthenticatedRequestId,omitempty"`
+}
+
+// RotateWindowsPasswordAnnotation is set on an OCIMachine which stores the Windows credentials of its instance to
+// rotate the password of the user. The annotation is removed once the rotation is requested.
+const RotateWindowsPasswordAnnotation = "infrastructure.cluster.x-k8s.io/rotate-windows-password"
+
+// WindowsCredentialsStatus is the Secret the credentials of the Windows instance are stored in.
+type WindowsCredentialsStatus struct {
+	// SecretName is the name of the Secret in the namespace of the OCIMachine which holds the username and the
+	// password.
+	SecretName string `json:"secretName"`
+
+	// InstanceId is the OCID of the instance the credentials belong to.
+	// +optional
+	InstanceId *string `json:"instanceId,omitempty"`
+
+	// RotationCommandId is the OCID of the Run Command which is changing the password.
+	// +optional
+	RotationCommandId *string `json:"rotationCommandId,omitempty"`
+
+	// RotationStartTime is when the Run Command which is changing the password was created. The rotation is
+	// abandoned when the command has not completed in time.
+	// +optional
+	RotationStartTime *metav1.Time `json:"rotationStartTime,omitempty"`
+
+	// UpdateTime is when the credentials were last stored in the Secret.
+	// +optional
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+}
+
+// RebootMigrateAnnotation is set on an OCIMachine to reboot migrate its instance now instead of waiting for the
+// maintenance reboot OCI scheduled for it. The annotation is removed once the reboot migration is requested.
+const RebootMigrateAnnotation = "infrastructure.cluster.x-k8s.io/reboot-migrate"
+
+// MaintenanceEventHandling configures how an OCIMachine handles the maintenance OCI schedules for its instance.
+type MaintenanceEventHandling struct {
+	// PollInterval is how often the maintenance events of the instance are polled. Defaults to 1h.
+	// +optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+
+	// RemediateBefore marks the Machine for remediation by its MachineHealthCheck this long before the maintenance
+	// of the instance is due, so that the node is drained and replaced ahead of the maintenance. The Machine is not
+	// marked for remediation when it is not set.
+	// +optional
+	RemediateBefore *metav1.Duration `json:"remediateBefore,omitempty"`
+}
+
+// AppliedTags are the free form tags and the defined tags of the spec which were last applied to the resources. A
+// tag which is removed from the spec is removed from the resources, the other tags of the resources are kept.
+type AppliedTags struct {
+	// FreeformTags are the free form tags which were applied.
+	// +optional
+	FreeformTags map[string]string `json:"freeformTags,omitempty"`
+
+	// DefinedTags are the defined tags which were applied.
+	// +optional
+	DefinedTags map[string]map[string]string `json:"definedTags,omitempty"`
+}
@@ -84,6 +84,20 @@ func ValidateNetworkSpec(validRoles []Role, networkSpec NetworkSpec, old Network
 		allErrs = append(allErrs, validateNSGs(validRoles, networkSpec.Vcn.NetworkSecurityGroup.List, fldPath.Child("networkSecurityGroups"))...)
 	}
 
+	allErrs = append(allErrs, validateSharedNetwork(networkSpec, fldPath)...)
+
+	if !networkSpec.SkipNetworkManagement {
+		allErrs = append(allErrs, validateDeletionPolicies(networkSpec, fldPath)...)
+	}
+
+	if networkSpec.VCNPeering != nil {
+		allErrs = append(allErrs, validateRemotePeeringConnections(networkSpec.VCNPeering.RemotePeeringConnections, fldPath.Child("vcnPeering", "remotePeeringConnections"))...)
+	}
+
+	if networkSpec.VCNPeering != nil && networkSpec.VCNPeering.VPN != nil {
+		allErrs = append(allErrs, validateVPN(networkSpec.VCNPeering, fldPath.Child("vcnPeering"))...)
+	}
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -99,6 +113,125 @@ func validateVCNCIDR(vncCIDR string, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// validateVPN validates the Site-to-Site VPN configuration of the VCN peering.
+func validateVPN(vcnPeering *VCNPeering, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	vpn := vcnPeering.VPN
+	if vcnPeering.DRG == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("drg"), "DRG is required for a VPN"))
+	}
+	fldPath = fldPath.Child("vpn")
+	if net.ParseIP(vpn.CPE.IPAddress) == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cpe", "ipAddress"), vpn.CPE.IPAddress, "invalid IP address format"))
+	}
+	if len(vpn.StaticRoutes) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("staticRoutes"), "at least one static route is required"))
+	}
+	for i, staticRoute := range vpn.StaticRoutes {
+		if _, _, err := net.ParseCIDR(staticRoute); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("staticRoutes").Index(i), staticRoute, "invalid CIDR format"))
+		}
+	}
+	for i, tunnel := range vpn.Tunnels {
+		if tunnel.Routing == IPSecTunnelRoutingBGP && tunnel.BGPSession == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("tunnels").Index(i).Child("bgpSession"), "BGP session is required for BGP routing"))
+		}
+	}
+	return allErrs
+}
+
+// validateRemotePeeringConnections validates the cross-tenancy configuration of the remote peering connections.
+func validateRemotePeeringConnections(rpcs []RemotePeeringConnection, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, rpc := range rpcs {
+		if rpc.PeerIdentityRef == nil {
+			continue
+		}
+		if !rpc.ManagePeerRPC {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("managePeerRPC"), rpc.ManagePeerRPC, "managePeerRPC has to be set to true if peerIdentityRef is set"))
+		}
+		if len(rpc.PeerCompartmentId) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("peerCompartmentId"), "peerCompartmentId is required if peerIdentityRef is set"))
+		} else if !ValidOcid(rpc.PeerCompartmentId) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("peerCompartmentId"), rpc.PeerCompartmentId, "invalid peerCompartmentId"))
+		}
+		if rpc.PeerDRGId == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("peerDRGId"), "peerDRGId is required if peerIdentityRef is set"))
+		}
+	}
+	return allErrs
+}
+
+// validateSharedNetwork validates the shared network configuration of a VCN.
+func validateSharedNetwork(networkSpec NetworkSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	shared := networkSpec.Vcn.Shared
+	if shared == nil {
+		return allErrs
+	}
+	if len(shared.Identifier) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("vcn", "shared", "identifier"), "identifier is required for a shared network"))
+	}
+	// the route tables are shared by the clusters, they can not route to the DRG of a single cluster
+	if vcnPeering := networkSpec.VCNPeering; vcnPeering != nil {
+		if len(vcnPeering.PeerRouteRules) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("vcnPeering", "peerRouteRules"),
+				"route rules to the DRG of the cluster can not be added to the route tables of a shared network"))
+		}
+		if vcnPeering.VPN != nil && len(vcnPeering.VPN.StaticRoutes) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("vcnPeering", "vpn", "staticRoutes"),
+				"route rules to the DRG of the cluster can not be added to the route tables of a shared network"))
+		}
+	}
+	return allErrs
+}
+
+// validateDeletionPolicies validates that the retained network resources do not block the deletion of the
+// resources they are in.
+func validateDeletionPolicies(networkSpec NetworkSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if networkSpec.Vcn.DeletionPolicy == DeletionPolicyRetain {
+		if networkSpec.APIServerLB.DeletionPolicy != DeletionPolicyRetain {
+			return nil
+		}
+		for i, subnet := range networkSpec.Vcn.Subnets {
+			if subnet != nil && subnet.Role == ControlPlaneEndpointRole && subnet.DeletionPolicy == DeletionPolicyDelete {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("vcn", "subnets").Index(i).Child("deletionPolicy"),
+					subnet.DeletionPolicy, "the control plane endpoint subnet can not be deleted if the API server load balancer is retained"))
+			}
+		}
+		return allErrs
+	}
+	for i, subnet := range networkSpec.Vcn.Subnets {
+		if subnet != nil && subnet.DeletionPolicy == DeletionPolicyRetain {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("vcn", "subnets").Index(i).Child("deletionPolicy"),
+				subnet.DeletionPolicy, "a subnet can only be retained if the VCN is retained"))
+		}
+	}
+	for i, nsg := range networkSpec.Vcn.NetworkSecurityGroup.List {
+		if nsg != nil && nsg.DeletionPolicy == DeletionPolicyRetain {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("vcn", "networkSecurityGroup", "list").Index(i).Child("deletionPolicy"),
+				nsg.DeletionPolicy, "a network security group can only be retained if the VCN is retained"))
+		}
+	}
+	if networkSpec.APIServerLB.DeletionPolicy == DeletionPolicyRetain {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("apiServerLoadBalancer", "deletionPolicy"),
+			networkSpec.APIServerLB.DeletionPolicy, "the API server load balancer can only be retained if the VCN is retained"))
+	}
+	return allErrs
+}
+
+// ValidateWorkloadResourceCleanup validates the cleanup of the resources created by the workload cluster.
+func ValidateWorkloadResourceCleanup(cleanup *WorkloadResourceCleanup, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	// without tags every load balancer and volume of the compartments would be deleted
+	if cleanup != nil && cleanup.Policy == WorkloadResourceCleanupPolicyDelete &&
+		len(cleanup.FreeformTags) == 0 && len(cleanup.DefinedTags) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "freeformTags or definedTags are required for the Delete policy"))
+	}
+	return allErrs
+}
+
 // ValidateClusterName validates the name of the cluster.
 func ValidateClusterName(name string) field.ErrorList {
 	var allErrs field.ErrorList
@@ -215,8 +348,91 @@ func validateSubnets(validRoles []Role, subnets []*Subnet, vcn VCN, fldPath *fie
 		}
 
 		allErrs = append(allErrs, validateSubnetCIDR(subnet.CIDR, vcn.CIDR, fldPath.Index(i).Child("cidr"))...)
+
+		if subnet.FlowLog != nil {
+			allErrs = append(allErrs, validateFlowLog(subnet.FlowLog, fldPath.Index(i).Child("flowLog"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateFlowLog validates the flow log configuration of a Subnet.
+func validateFlowLog(flowLog *FlowLog, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if !ValidOcid(flowLog.LogGroupId) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("logGroupId"), flowLog.LogGroupId, "field is invalid"))
 	}
+	if flowLog.RetentionDuration != nil {
+		retention := *flowLog.RetentionDuration
+		if retention < 30 || retention > 180 || retention%30 != 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("retentionDuration"), retention,
+				"retention duration must be a multiple of 30 between 30 and 180"))
+		}
+	}
+	if flowLog.SamplingRate != nil && *flowLog.SamplingRate < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("samplingRate"), *flowLog.SamplingRate,
+			"sampling rate must be greater than 0"))
+	}
+	return allErrs
+}
 
+// validateImageSelector validates that the image is set either by its OCID or by an image selector and that the
+// display name pattern of the selector is a valid regular expression.
+func validateImageSelector(imageId string, selector *ImageSelector, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if selector == nil {
+		return allErrs
+	}
+	if imageId != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("imageSelector"), "imageId and imageSelector are mutually exclusive"))
+	}
+	if _, err := regexp.Compile(selector.DisplayNamePattern); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("imageSelector", "displayNamePattern"),
+			selector.DisplayNamePattern, fmt.Sprintf("pattern is invalid - %s", err)))
+	}
+	if selector.CompartmentId != nil && !ValidOcid(*selector.CompartmentId) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("imageSelector", "compartmentId"),
+			*selector.CompartmentId, "field is invalid"))
+	}
+	return allErrs
+}
+
+// validateBootVolumeSource validates that the instance is booted either from an image or from exactly one of a
+// boot volume and a boot volume backup, and that the OCIDs of the boot volume source and the backup policy are valid.
+func validateBootVolumeSource(spec OCIMachineSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.BootVolumeBackupPolicyId != nil && !ValidOcid(*spec.BootVolumeBackupPolicyId) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("bootVolumeBackupPolicyId"),
+			*spec.BootVolumeBackupPolicyId, "field is invalid"))
+	}
+	source := spec.InstanceSourceViaBootVolumeDetails
+	if source == nil {
+		return allErrs
+	}
+	sourcePath := fldPath.Child("instanceSourceViaBootVolumeConfig")
+	if spec.ImageId != "" || spec.ImageSelector != nil || spec.InstanceSourceViaImageDetails != nil {
+		allErrs = append(allErrs, field.Forbidden(sourcePath,
+			"instanceSourceViaBootVolumeConfig is mutually exclusive with imageId, imageSelector and instanceSourceViaImageConfig"))
+	}
+	switch {
+	case source.BootVolumeId == nil && source.BootVolumeBackupId == nil:
+		allErrs = append(allErrs, field.Required(sourcePath, "one of bootVolumeId and bootVolumeBackupId is required"))
+	case source.BootVolumeId != nil && source.BootVolumeBackupId != nil:
+		allErrs = append(allErrs, field.Forbidden(sourcePath, "bootVolumeId and bootVolumeBackupId are mutually exclusive"))
+	case source.BootVolumeId != nil:
+		if !ValidOcid(*source.BootVolumeId) {
+			allErrs = append(allErrs, field.Invalid(sourcePath.Child("bootVolumeId"), *source.BootVolumeId, "field is invalid"))
+		}
+		if source.KmsKeyId != nil || source.BootVolumeVpusPerGB != nil || spec.BootVolumeSizeInGBs != "" {
+			allErrs = append(allErrs, field.Forbidden(sourcePath.Child("bootVolumeId"),
+				"kmsKeyId, bootVolumeVpusPerGB and bootVolumeSizeInGBs only apply to a boot volume restored from a backup"))
+		}
+	default:
+		if !ValidOcid(*source.BootVolumeBackupId) {
+			allErrs = append(allErrs, field.Invalid(sourcePath.Child("bootVolumeBackupId"), *source.BootVolumeBackupId, "field is invalid"))
+		}
+	}
 	return allErrs
 }
 
@@ -425,6 +425,61 @@ func (in *AmdVmPlatformConfig) DeepCopy() *AmdVmPlatformConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppliedTags) DeepCopyInto(out *AppliedTags) {
+	*out = *in
+	if in.FreeformTags != nil {
+		in, out := &in.FreeformTags, &out.FreeformTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DefinedTags != nil {
+		in, out := &in.DefinedTags, &out.DefinedTags
+		*out = make(map[string]map[string]string, len(*in))
+		for key, val := range *in {
+			var outVal map[string]string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(map[string]string, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppliedTags.
+func (in *AppliedTags) DeepCopy() *AppliedTags {
+	if in == nil {
+		return nil
+	}
+	out := new(AppliedTags)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPSession) DeepCopyInto(out *BGPSession) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPSession.
+func (in *BGPSession) DeepCopy() *BGPSession {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendSetDetails) DeepCopyInto(out *BackendSetDetails) {
 	*out = *in
@@ -456,6 +511,31 @@ func (in *BackendSetDetails) DeepCopy() *BackendSetDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPE) DeepCopyInto(out *CPE) {
+	*out = *in
+	if in.DeviceShapeId != nil {
+		in, out := &in.DeviceShapeId, &out.DeviceShapeId
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPE.
+func (in *CPE) DeepCopy() *CPE {
+	if in == nil {
+		return nil
+	}
+	out := new(CPE)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientOverrides) DeepCopyInto(out *ClientOverrides) {
 	*out = *in
@@ -499,6 +579,16 @@ func (in *ClientOverrides) DeepCopyInto(out *ClientOverrides) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LoggingClientUrl != nil {
+		in, out := &in.LoggingClientUrl, &out.LoggingClientUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.BlockStorageClientUrl != nil {
+		in, out := &in.BlockStorageClientUrl, &out.BlockStorageClientUrl
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientOverrides.
@@ -551,6 +641,60 @@ func (in *ClusterPodNetworkOptions) DeepCopy() *ClusterPodNetworkOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleHistoryCapture) DeepCopyInto(out *ConsoleHistoryCapture) {
+	*out = *in
+	if in.BootstrapTimeout != nil {
+		in, out := &in.BootstrapTimeout, &out.BootstrapTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxSizeInBytes != nil {
+		in, out := &in.MaxSizeInBytes, &out.MaxSizeInBytes
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleHistoryCapture.
+func (in *ConsoleHistoryCapture) DeepCopy() *ConsoleHistoryCapture {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleHistoryCapture)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleHistoryStatus) DeepCopyInto(out *ConsoleHistoryStatus) {
+	*out = *in
+	if in.ConsoleHistoryId != nil {
+		in, out := &in.ConsoleHistoryId, &out.ConsoleHistoryId
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceId != nil {
+		in, out := &in.InstanceId, &out.InstanceId
+		*out = new(string)
+		**out = **in
+	}
+	if in.CaptureTime != nil {
+		in, out := &in.CaptureTime, &out.CaptureTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleHistoryStatus.
+func (in *ConsoleHistoryStatus) DeepCopy() *ConsoleHistoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleHistoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DRG) DeepCopyInto(out *DRG) {
 	*out = *in
@@ -657,6 +801,92 @@ func (in *EndpointConfig) DeepCopy() *EndpointConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FallbackShape) DeepCopyInto(out *FallbackShape) {
+	*out = *in
+	if in.ShapeConfig != nil {
+		in, out := &in.ShapeConfig, &out.ShapeConfig
+		*out = new(ShapeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FallbackShape.
+func (in *FallbackShape) DeepCopy() *FallbackShape {
+	if in == nil {
+		return nil
+	}
+	out := new(FallbackShape)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldDrift) DeepCopyInto(out *FieldDrift) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldDrift.
+func (in *FieldDrift) DeepCopy() *FieldDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowLog) DeepCopyInto(out *FlowLog) {
+	*out = *in
+	if in.RetentionDuration != nil {
+		in, out := &in.RetentionDuration, &out.RetentionDuration
+		*out = new(int)
+		**out = **in
+	}
+	if in.SamplingRate != nil {
+		in, out := &in.SamplingRate, &out.SamplingRate
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLog.
+func (in *FlowLog) DeepCopy() *FlowLog {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowLogStatus) DeepCopyInto(out *FlowLogStatus) {
+	*out = *in
+	in.NetworkResourceStatus.DeepCopyInto(&out.NetworkResourceStatus)
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CaptureFilterID != nil {
+		in, out := &in.CaptureFilterID, &out.CaptureFilterID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogStatus.
+func (in *FlowLogStatus) DeepCopy() *FlowLogStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowLogStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthChecker) DeepCopyInto(out *HealthChecker) {
 	*out = *in
@@ -677,6 +907,26 @@ func (in *HealthChecker) DeepCopy() *HealthChecker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPSecTunnel) DeepCopyInto(out *IPSecTunnel) {
+	*out = *in
+	if in.BGPSession != nil {
+		in, out := &in.BGPSession, &out.BGPSession
+		*out = new(BGPSession)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPSecTunnel.
+func (in *IPSecTunnel) DeepCopy() *IPSecTunnel {
+	if in == nil {
+		return nil
+	}
+	out := new(IPSecTunnel)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IcmpOptions) DeepCopyInto(out *IcmpOptions) {
 	*out = *in
@@ -702,6 +952,46 @@ func (in *IcmpOptions) DeepCopy() *IcmpOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnitionStatus) DeepCopyInto(out *IgnitionStatus) {
+	*out = *in
+	if in.PreauthenticatedRequestId != nil {
+		in, out := &in.PreauthenticatedRequestId, &out.PreauthenticatedRequestId
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionStatus.
+func (in *IgnitionStatus) DeepCopy() *IgnitionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnitionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnitionStorage) DeepCopyInto(out *IgnitionStorage) {
+	*out = *in
+	if in.PreauthenticatedRequestTTL != nil {
+		in, out := &in.PreauthenticatedRequestTTL, &out.PreauthenticatedRequestTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnitionStorage.
+func (in *IgnitionStorage) DeepCopy() *IgnitionStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnitionStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImagePolicyConfig) DeepCopyInto(out *ImagePolicyConfig) {
 	*out = *in
@@ -729,6 +1019,33 @@ func (in *ImagePolicyConfig) DeepCopy() *ImagePolicyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSelector) DeepCopyInto(out *ImageSelector) {
+	*out = *in
+	if in.CompartmentId != nil {
+		in, out := &in.CompartmentId, &out.CompartmentId
+		*out = new(string)
+		**out = **in
+	}
+	if in.FreeformTags != nil {
+		in, out := &in.FreeformTags, &out.FreeformTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSelector.
+func (in *ImageSelector) DeepCopy() *ImageSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressSecurityRule) DeepCopyInto(out *IngressSecurityRule) {
 	*out = *in
@@ -835,6 +1152,56 @@ func (in *InstanceOptions) DeepCopy() *InstanceOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancePlacement) DeepCopyInto(out *InstancePlacement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancePlacement.
+func (in *InstancePlacement) DeepCopy() *InstancePlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancePlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceSourceViaBootVolumeConfig) DeepCopyInto(out *InstanceSourceViaBootVolumeConfig) {
+	*out = *in
+	if in.BootVolumeId != nil {
+		in, out := &in.BootVolumeId, &out.BootVolumeId
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootVolumeBackupId != nil {
+		in, out := &in.BootVolumeBackupId, &out.BootVolumeBackupId
+		*out = new(string)
+		**out = **in
+	}
+	if in.KmsKeyId != nil {
+		in, out := &in.KmsKeyId, &out.KmsKeyId
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootVolumeVpusPerGB != nil {
+		in, out := &in.BootVolumeVpusPerGB, &out.BootVolumeVpusPerGB
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSourceViaBootVolumeConfig.
+func (in *InstanceSourceViaBootVolumeConfig) DeepCopy() *InstanceSourceViaBootVolumeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceSourceViaBootVolumeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceSourceViaImageConfig) DeepCopyInto(out *InstanceSourceViaImageConfig) {
 	*out = *in
@@ -1035,6 +1402,23 @@ func (in *KubernetesNetworkConfig) DeepCopy() *KubernetesNetworkConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchAttempt) DeepCopyInto(out *LaunchAttempt) {
+	*out = *in
+	out.InstancePlacement = in.InstancePlacement
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchAttempt.
+func (in *LaunchAttempt) DeepCopy() *LaunchAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchCreateVolumeFromAttributes) DeepCopyInto(out *LaunchCreateVolumeFromAttributes) {
 	*out = *in
@@ -1075,6 +1459,28 @@ func (in *LaunchCreateVolumeFromAttributes) DeepCopy() *LaunchCreateVolumeFromAt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchFallbackPolicy) DeepCopyInto(out *LaunchFallbackPolicy) {
+	*out = *in
+	if in.Shapes != nil {
+		in, out := &in.Shapes, &out.Shapes
+		*out = make([]FallbackShape, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchFallbackPolicy.
+func (in *LaunchFallbackPolicy) DeepCopy() *LaunchFallbackPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchFallbackPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchInstanceAgentConfig) DeepCopyInto(out *LaunchInstanceAgentConfig) {
 	*out = *in
@@ -1240,6 +1646,31 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceEventHandling) DeepCopyInto(out *MaintenanceEventHandling) {
+	*out = *in
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RemediateBefore != nil {
+		in, out := &in.RemediateBefore, &out.RemediateBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceEventHandling.
+func (in *MaintenanceEventHandling) DeepCopy() *MaintenanceEventHandling {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceEventHandling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NATGateway) DeepCopyInto(out *NATGateway) {
 	*out = *in
@@ -1310,6 +1741,22 @@ func (in *NSG) DeepCopy() *NSG {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NSGStatus) DeepCopyInto(out *NSGStatus) {
+	*out = *in
+	in.NetworkResourceStatus.DeepCopyInto(&out.NetworkResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NSGStatus.
+func (in *NSGStatus) DeepCopy() *NSGStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NSGStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkDetails) DeepCopyInto(out *NetworkDetails) {
 	*out = *in
@@ -1365,6 +1812,26 @@ func (in *NetworkDetails) DeepCopy() *NetworkDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkResourceStatus) DeepCopyInto(out *NetworkResourceStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkResourceStatus.
+func (in *NetworkResourceStatus) DeepCopy() *NetworkResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSecurityGroup) DeepCopyInto(out *NetworkSecurityGroup) {
 	*out = *in
@@ -1413,6 +1880,97 @@ func (in *NetworkSpec) DeepCopy() *NetworkSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+	*out = *in
+	if in.VCN != nil {
+		in, out := &in.VCN, &out.VCN
+		*out = new(VCNStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InternetGateway != nil {
+		in, out := &in.InternetGateway, &out.InternetGateway
+		*out = new(NetworkResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NATGateway != nil {
+		in, out := &in.NATGateway, &out.NATGateway
+		*out = new(NetworkResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceGateway != nil {
+		in, out := &in.ServiceGateway, &out.ServiceGateway
+		*out = new(NetworkResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateRouteTable != nil {
+		in, out := &in.PrivateRouteTable, &out.PrivateRouteTable
+		*out = new(NetworkResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicRouteTable != nil {
+		in, out := &in.PublicRouteTable, &out.PublicRouteTable
+		*out = new(NetworkResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]SubnetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkSecurityGroups != nil {
+		in, out := &in.NetworkSecurityGroups, &out.NetworkSecurityGroups
+		*out = make([]NSGStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = make([]FlowLogStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DRG != nil {
+		in, out := &in.DRG, &out.DRG
+		*out = new(NetworkResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DRGVCNAttachment != nil {
+		in, out := &in.DRGVCNAttachment, &out.DRGVCNAttachment
+		*out = new(NetworkResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CPE != nil {
+		in, out := &in.CPE, &out.CPE
+		*out = new(NetworkResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPSecConnection != nil {
+		in, out := &in.IPSecConnection, &out.IPSecConnection
+		*out = new(NetworkResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIServerLB != nil {
+		in, out := &in.APIServerLB, &out.APIServerLB
+		*out = new(NetworkResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OCIAvailabilityDomain) DeepCopyInto(out *OCIAvailabilityDomain) {
 	*out = *in
@@ -1641,6 +2199,11 @@ func (in *OCIClusterSpec) DeepCopyInto(out *OCIClusterSpec) {
 		*out = new(ClientOverrides)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WorkloadResourceCleanup != nil {
+		in, out := &in.WorkloadResourceCleanup, &out.WorkloadResourceCleanup
+		*out = new(WorkloadResourceCleanup)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIClusterSpec.
@@ -1663,6 +2226,37 @@ func (in *OCIClusterStatus) DeepCopyInto(out *OCIClusterStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.RemotePeeringConnections != nil {
+		in, out := &in.RemotePeeringConnections, &out.RemotePeeringConnections
+		*out = make([]RemotePeeringConnectionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]ResourceDrift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(NetworkStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlannedOperations != nil {
+		in, out := &in.PlannedOperations, &out.PlannedOperations
+		*out = make([]PlannedOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AppliedTags != nil {
+		in, out := &in.AppliedTags, &out.AppliedTags
+		*out = new(AppliedTags)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -1839,6 +2433,11 @@ func (in *OCIMachineSpec) DeepCopyInto(out *OCIMachineSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ImageSelector != nil {
+		in, out := &in.ImageSelector, &out.ImageSelector
+		*out = new(ImageSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ComputeClusterId != nil {
 		in, out := &in.ComputeClusterId, &out.ComputeClusterId
 		*out = new(string)
@@ -1908,6 +2507,11 @@ func (in *OCIMachineSpec) DeepCopyInto(out *OCIMachineSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FailureDomain != nil {
+		in, out := &in.FailureDomain, &out.FailureDomain
+		*out = new(string)
+		**out = **in
+	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = make(map[string]string, len(*in))
@@ -1947,6 +2551,43 @@ func (in *OCIMachineSpec) DeepCopyInto(out *OCIMachineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LaunchFallbackPolicy != nil {
+		in, out := &in.LaunchFallbackPolicy, &out.LaunchFallbackPolicy
+		*out = new(LaunchFallbackPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceSourceViaBootVolumeDetails != nil {
+		in, out := &in.InstanceSourceViaBootVolumeDetails, &out.InstanceSourceViaBootVolumeDetails
+		*out = new(InstanceSourceViaBootVolumeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootVolumeBackupPolicyId != nil {
+		in, out := &in.BootVolumeBackupPolicyId, &out.BootVolumeBackupPolicyId
+		*out = new(string)
+		**out = **in
+	}
+	if in.PersistentVolumes != nil {
+		in, out := &in.PersistentVolumes, &out.PersistentVolumes
+		*out = make([]PersistentVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConsoleHistoryCapture != nil {
+		in, out := &in.ConsoleHistoryCapture, &out.ConsoleHistoryCapture
+		*out = new(ConsoleHistoryCapture)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IgnitionStorage != nil {
+		in, out := &in.IgnitionStorage, &out.IgnitionStorage
+		*out = new(IgnitionStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceEventHandling != nil {
+		in, out := &in.MaintenanceEventHandling, &out.MaintenanceEventHandling
+		*out = new(MaintenanceEventHandling)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIMachineSpec.
@@ -1977,6 +2618,55 @@ func (in *OCIMachineStatus) DeepCopyInto(out *OCIMachineStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(SelectedImage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LaunchAttempts != nil {
+		in, out := &in.LaunchAttempts, &out.LaunchAttempts
+		*out = make([]LaunchAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(InstancePlacement)
+		**out = **in
+	}
+	if in.BootVolumeId != nil {
+		in, out := &in.BootVolumeId, &out.BootVolumeId
+		*out = new(string)
+		**out = **in
+	}
+	if in.PersistentVolumes != nil {
+		in, out := &in.PersistentVolumes, &out.PersistentVolumes
+		*out = make([]PersistentVolumeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConsoleHistory != nil {
+		in, out := &in.ConsoleHistory, &out.ConsoleHistory
+		*out = new(ConsoleHistoryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ignition != nil {
+		in, out := &in.Ignition, &out.Ignition
+		*out = new(IgnitionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WindowsCredentials != nil {
+		in, out := &in.WindowsCredentials, &out.WindowsCredentials
+		*out = new(WindowsCredentialsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppliedTags != nil {
+		in, out := &in.AppliedTags, &out.AppliedTags
+		*out = new(AppliedTags)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -2192,6 +2882,11 @@ func (in *OCIManagedClusterSpec) DeepCopyInto(out *OCIManagedClusterSpec) {
 		*out = new(ClientOverrides)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WorkloadResourceCleanup != nil {
+		in, out := &in.WorkloadResourceCleanup, &out.WorkloadResourceCleanup
+		*out = new(WorkloadResourceCleanup)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIManagedClusterSpec.
@@ -2214,6 +2909,30 @@ func (in *OCIManagedClusterStatus) DeepCopyInto(out *OCIManagedClusterStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.RemotePeeringConnections != nil {
+		in, out := &in.RemotePeeringConnections, &out.RemotePeeringConnections
+		*out = make([]RemotePeeringConnectionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]ResourceDrift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(NetworkStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppliedTags != nil {
+		in, out := &in.AppliedTags, &out.AppliedTags
+		*out = new(AppliedTags)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -2453,6 +3172,13 @@ func (in *OCIManagedControlPlaneStatus) DeepCopyInto(out *OCIManagedControlPlane
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.PlannedOperations != nil {
+		in, out := &in.PlannedOperations, &out.PlannedOperations
+		*out = make([]PlannedOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIManagedControlPlaneStatus.
@@ -2570,6 +3296,86 @@ func (in *PeerRouteRule) DeepCopy() *PeerRouteRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolume) DeepCopyInto(out *PersistentVolume) {
+	*out = *in
+	if in.Device != nil {
+		in, out := &in.Device, &out.Device
+		*out = new(string)
+		**out = **in
+	}
+	if in.SizeInGBs != nil {
+		in, out := &in.SizeInGBs, &out.SizeInGBs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.VpusPerGB != nil {
+		in, out := &in.VpusPerGB, &out.VpusPerGB
+		*out = new(int64)
+		**out = **in
+	}
+	if in.KmsKeyId != nil {
+		in, out := &in.KmsKeyId, &out.KmsKeyId
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolume.
+func (in *PersistentVolume) DeepCopy() *PersistentVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeStatus) DeepCopyInto(out *PersistentVolumeStatus) {
+	*out = *in
+	if in.VolumeId != nil {
+		in, out := &in.VolumeId, &out.VolumeId
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeStatus.
+func (in *PersistentVolumeStatus) DeepCopy() *PersistentVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedOperation) DeepCopyInto(out *PlannedOperation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedOperation.
+func (in *PlannedOperation) DeepCopy() *PlannedOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlatformConfig) DeepCopyInto(out *PlatformConfig) {
 	*out = *in
@@ -2655,6 +3461,11 @@ func (in *RemotePeeringConnection) DeepCopyInto(out *RemotePeeringConnection) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PeerIdentityRef != nil {
+		in, out := &in.PeerIdentityRef, &out.PeerIdentityRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemotePeeringConnection.
@@ -2667,6 +3478,51 @@ func (in *RemotePeeringConnection) DeepCopy() *RemotePeeringConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemotePeeringConnectionStatus) DeepCopyInto(out *RemotePeeringConnectionStatus) {
+	*out = *in
+	if in.RPCConnectionId != nil {
+		in, out := &in.RPCConnectionId, &out.RPCConnectionId
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemotePeeringConnectionStatus.
+func (in *RemotePeeringConnectionStatus) DeepCopy() *RemotePeeringConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemotePeeringConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDrift) DeepCopyInto(out *ResourceDrift) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]FieldDrift, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDrift.
+func (in *ResourceDrift) DeepCopy() *ResourceDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
@@ -2726,6 +3582,22 @@ func (in *SecurityList) DeepCopy() *SecurityList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectedImage) DeepCopyInto(out *SelectedImage) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectedImage.
+func (in *SelectedImage) DeepCopy() *SelectedImage {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectedImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceGateway) DeepCopyInto(out *ServiceGateway) {
 	*out = *in
@@ -2766,6 +3638,21 @@ func (in *ShapeConfig) DeepCopy() *ShapeConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedNetwork) DeepCopyInto(out *SharedNetwork) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedNetwork.
+func (in *SharedNetwork) DeepCopy() *SharedNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
@@ -2784,6 +3671,11 @@ func (in *Subnet) DeepCopyInto(out *Subnet) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FlowLog != nil {
+		in, out := &in.FlowLog, &out.FlowLog
+		*out = new(FlowLog)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
@@ -2796,6 +3688,27 @@ func (in *Subnet) DeepCopy() *Subnet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
+	*out = *in
+	in.NetworkResourceStatus.DeepCopyInto(&out.NetworkResourceStatus)
+	if in.SecurityListID != nil {
+		in, out := &in.SecurityListID, &out.SecurityListID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatus.
+func (in *SubnetStatus) DeepCopy() *SubnetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TcpOptions) DeepCopyInto(out *TcpOptions) {
 	*out = *in
@@ -2900,6 +3813,11 @@ func (in *VCN) DeepCopyInto(out *VCN) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Shared != nil {
+		in, out := &in.Shared, &out.Shared
+		*out = new(SharedNetwork)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VCN.
@@ -2932,6 +3850,11 @@ func (in *VCNPeering) DeepCopyInto(out *VCNPeering) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.VPN != nil {
+		in, out := &in.VPN, &out.VPN
+		*out = new(VPN)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VCNPeering.
@@ -2944,6 +3867,60 @@ func (in *VCNPeering) DeepCopy() *VCNPeering {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VCNStatus) DeepCopyInto(out *VCNStatus) {
+	*out = *in
+	in.NetworkResourceStatus.DeepCopyInto(&out.NetworkResourceStatus)
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VCNStatus.
+func (in *VCNStatus) DeepCopy() *VCNStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VCNStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPN) DeepCopyInto(out *VPN) {
+	*out = *in
+	in.CPE.DeepCopyInto(&out.CPE)
+	if in.StaticRoutes != nil {
+		in, out := &in.StaticRoutes, &out.StaticRoutes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tunnels != nil {
+		in, out := &in.Tunnels, &out.Tunnels
+		*out = make([]IPSecTunnel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPN.
+func (in *VPN) DeepCopy() *VPN {
+	if in == nil {
+		return nil
+	}
+	out := new(VPN)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VnicAttachment) DeepCopyInto(out *VnicAttachment) {
 	*out = *in
@@ -2973,3 +3950,76 @@ func (in *VnicAttachment) DeepCopy() *VnicAttachment {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WindowsCredentialsStatus) DeepCopyInto(out *WindowsCredentialsStatus) {
+	*out = *in
+	if in.InstanceId != nil {
+		in, out := &in.InstanceId, &out.InstanceId
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotationCommandId != nil {
+		in, out := &in.RotationCommandId, &out.RotationCommandId
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotationStartTime != nil {
+		in, out := &in.RotationStartTime, &out.RotationStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdateTime != nil {
+		in, out := &in.UpdateTime, &out.UpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WindowsCredentialsStatus.
+func (in *WindowsCredentialsStatus) DeepCopy() *WindowsCredentialsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WindowsCredentialsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadResourceCleanup) DeepCopyInto(out *WorkloadResourceCleanup) {
+	*out = *in
+	if in.FreeformTags != nil {
+		in, out := &in.FreeformTags, &out.FreeformTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DefinedTags != nil {
+		in, out := &in.DefinedTags, &out.DefinedTags
+		*out = make(map[string]map[string]string, len(*in))
+		for key, val := range *in {
+			var outVal map[string]string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(map[string]string, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadResourceCleanup.
+func (in *WorkloadResourceCleanup) DeepCopy() *WorkloadResourceCleanup {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadResourceCleanup)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -35,6 +35,14 @@ const (
 	StatusCode       = "status_code"
 	Operation        = "operation"
 
+	OrphanedResources             = "orphaned_resources"
+	OrphanedResourcesDeletedTotal = "orphaned_resources_deleted_total"
+	ResourceType                  = "resource_type"
+
+	NetworkDrift = "network_drift"
+	Namespace    = "namespace"
+	Cluster      = "cluster"
+
 	Region        = "region"
 	Get    string = "get"
 	List   string = "list"
@@ -57,6 +65,30 @@ var (
 		Name:      Duration,
 		Help:      "Duration/Latency of HTTP requests to OCI",
 	}, []string{Resource, Operation, Region})
+	orphanedResourcesGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: SubSystemOCI,
+			Name:      OrphanedResources,
+			Help:      "OCI resources tagged by Cluster API Provider for OCI which are not owned by any cluster.",
+		},
+		[]string{ResourceType},
+	)
+	orphanedResourcesDeletedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: SubSystemOCI,
+			Name:      OrphanedResourcesDeletedTotal,
+			Help:      "Orphaned OCI resources deleted total.",
+		},
+		[]string{ResourceType},
+	)
+	networkDriftGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: SubSystemOCI,
+			Name:      NetworkDrift,
+			Help:      "Network resources of a cluster which differ from the spec and have not been corrected.",
+		},
+		[]string{Namespace, Cluster, ResourceType},
+	)
 )
 
 // IncRequestCounter increments the request count metric for the given resource.
@@ -83,7 +115,40 @@ func ObserverRequestDuration(resource string, operation string, region string, d
 	}).Observe(duration.Seconds())
 }
 
+// SetOrphanedResources sets the number of orphaned resources found in the last scan, per resource type.
+func SetOrphanedResources(counts map[string]int) {
+	orphanedResourcesGauge.Reset()
+	for resourceType, count := range counts {
+		orphanedResourcesGauge.With(prometheus.Labels{ResourceType: resourceType}).Set(float64(count))
+	}
+}
+
+// IncOrphanedResourcesDeletedCounter increments the deleted orphaned resources count metric for the given resource type.
+func IncOrphanedResourcesDeletedCounter(resourceType string) {
+	orphanedResourcesDeletedCounter.With(prometheus.Labels{ResourceType: resourceType}).Inc()
+}
+
+// SetClusterDrift sets the number of network resources of the cluster with uncorrected drift, per resource type.
+func SetClusterDrift(namespace string, cluster string, counts map[string]int) {
+	DeleteClusterDrift(namespace, cluster)
+	for resourceType, count := range counts {
+		networkDriftGauge.With(prometheus.Labels{
+			Namespace:    namespace,
+			Cluster:      cluster,
+			ResourceType: resourceType,
+		}).Set(float64(count))
+	}
+}
+
+// DeleteClusterDrift removes the drift metrics of the cluster.
+func DeleteClusterDrift(namespace string, cluster string) {
+	networkDriftGauge.DeletePartialMatch(prometheus.Labels{Namespace: namespace, Cluster: cluster})
+}
+
 func init() {
 	metrics.Registry.MustRegister(ociRequestCounter)
 	metrics.Registry.MustRegister(ociRequestDurationSeconds)
+	metrics.Registry.MustRegister(orphanedResourcesGauge)
+	metrics.Registry.MustRegister(orphanedResourcesDeletedCounter)
+	metrics.Registry.MustRegister(networkDriftGauge)
 }
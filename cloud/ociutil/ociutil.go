@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	lb "github.com/oracle/cluster-api-provider-oci/cloud/services/loadbalancer"
@@ -41,6 +42,12 @@ const (
 	CreatedBy                 = "CreatedBy"
 	OCIClusterAPIProvider     = "OCIClusterAPIProvider"
 	ClusterResourceIdentifier = "ClusterResourceIdentifier"
+	// OCIClusterAPIProviderRetained is the value of the CreatedBy tag of the resources which have been retained
+	// on the deletion of the cluster which created them
+	OCIClusterAPIProviderRetained = "OCIClusterAPIProviderRetained"
+	// SharedNetworkConsumerPrefix is the prefix of the tag which a cluster adds to a shared VCN to register
+	// itself as a consumer of the shared network
+	SharedNetworkConsumerPrefix = "SharedNetworkConsumer-"
 )
 
 // ErrNotFound is for simulation during testing, OCI SDK does not have a way
@@ -61,6 +68,54 @@ func IsNotFound(err error) bool {
 	return ok && serviceErr.GetHTTPStatusCode() == http.StatusNotFound
 }
 
+// ErrOutOfHostCapacity is for simulation during testing, OCI SDK does not have a way
+// to create Service Errors
+var ErrOutOfHostCapacity = errors.New("out of host capacity")
+
+// IsOutOfHostCapacity returns true if the given error indicates that an instance could not be launched because
+// there is no capacity for its shape in its availability domain or fault domain.
+func IsOutOfHostCapacity(err error) bool {
+	if err == nil {
+		return false
+	}
+	err = errors.Cause(err)
+	if err.Error() == ErrOutOfHostCapacity.Error() {
+		return true
+	}
+	serviceErr, ok := common.IsServiceError(err)
+	return ok && strings.Contains(strings.ToLower(serviceErr.GetMessage()), "out of host capacity")
+}
+
+// ErrPreconditionFailed is for simulation during testing, OCI SDK does not have a way
+// to create Service Errors
+var ErrPreconditionFailed = errors.New("precondition failed")
+
+// IsPreconditionFailed returns true if the given error indicates that an update was rejected because the etag of
+// its If-Match header does not match the resource anymore.
+func IsPreconditionFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	err = errors.Cause(err)
+	if err.Error() == ErrPreconditionFailed.Error() {
+		return true
+	}
+	serviceErr, ok := common.IsServiceError(err)
+	return ok && serviceErr.GetHTTPStatusCode() == http.StatusPreconditionFailed
+}
+
+// ConditionMessage returns the message of a condition marked false because of the given error. For an OCI
+// service error the message holds the error code and the opc-request-id, which identify the failed request
+// for Oracle support.
+func ConditionMessage(err error) string {
+	var serviceErr common.ServiceError
+	if errors.As(err, &serviceErr) {
+		return fmt.Sprintf("%s: %s (opc-request-id: %s)", serviceErr.GetCode(), serviceErr.GetMessage(),
+			serviceErr.GetOpcRequestID())
+	}
+	return err.Error()
+}
+
 // AwaitNLBWorkRequest waits for the LB work request to either succeed, fail. See k8s.io/apimachinery/pkg/util/wait
 func AwaitNLBWorkRequest(ctx context.Context, networkLoadBalancerClient nlb.NetworkLoadBalancerClient, workRequestId *string) (*networkloadbalancer.WorkRequest, error) {
 	var wr *networkloadbalancer.WorkRequest
@@ -152,6 +207,101 @@ func BuildClusterTags(ClusterResourceUID string) map[string]string {
 	return tags
 }
 
+// IsClusterResource returns true if the freeform tags mark the resource as created by Cluster API Provider for OCI
+// for the cluster with the provided resource identifier. Retained resources remain resources of the cluster, so
+// that a cluster created with the same resource identifier adopts them.
+func IsClusterResource(freeformTags map[string]string, ClusterResourceUID string) bool {
+	if freeformTags[ClusterResourceIdentifier] != ClusterResourceUID {
+		return false
+	}
+	return freeformTags[CreatedBy] == OCIClusterAPIProvider || freeformTags[CreatedBy] == OCIClusterAPIProviderRetained
+}
+
+// BuildRetainedTags returns a copy of the freeform tags of a resource created by Cluster API Provider for OCI with
+// the CreatedBy tag marking the resource as retained.
+func BuildRetainedTags(freeformTags map[string]string) map[string]string {
+	tags := make(map[string]string)
+	for k, v := range freeformTags {
+		tags[k] = v
+	}
+	tags[CreatedBy] = OCIClusterAPIProviderRetained
+	return tags
+}
+
+// MergeFreeformTags returns the free form tags of a resource with the desired tags applied, and whether they differ
+// from the actual tags. The previously applied tags which are no longer desired are removed, unless their value was
+// changed outside of Cluster API. Other tags, such as the tags added by other tools, are kept as they are.
+func MergeFreeformTags(actual map[string]string, desired map[string]string, previous map[string]string) (map[string]string, bool) {
+	tags := make(map[string]string)
+	for k, v := range actual {
+		tags[k] = v
+	}
+	changed := false
+	for k, v := range desired {
+		if current, ok := actual[k]; !ok || current != v {
+			tags[k] = v
+			changed = true
+		}
+	}
+	for k, v := range previous {
+		if _, ok := desired[k]; ok {
+			continue
+		}
+		if current, ok := actual[k]; ok && current == v {
+			delete(tags, k)
+			changed = true
+		}
+	}
+	return tags, changed
+}
+
+// MergeDefinedTags returns the defined tags of a resource with the desired tags applied, and whether they differ
+// from the actual tags. The previously applied tags which are no longer desired are removed, unless their value was
+// changed outside of Cluster API. Other tags, such as the tags added by tag defaults, are kept as they are.
+func MergeDefinedTags(actual map[string]map[string]interface{}, desired map[string]map[string]interface{}, previous map[string]map[string]string) (map[string]map[string]interface{}, bool) {
+	tags := make(map[string]map[string]interface{})
+	for ns, mapNs := range actual {
+		tags[ns] = make(map[string]interface{})
+		for k, v := range mapNs {
+			tags[ns][k] = v
+		}
+	}
+	changed := false
+	for ns, mapNs := range desired {
+		for k, v := range mapNs {
+			if current, ok := actual[ns][k]; ok && fmt.Sprint(current) == fmt.Sprint(v) {
+				continue
+			}
+			if tags[ns] == nil {
+				tags[ns] = make(map[string]interface{})
+			}
+			tags[ns][k] = v
+			changed = true
+		}
+	}
+	for ns, mapNs := range previous {
+		for k, v := range mapNs {
+			if _, ok := desired[ns][k]; ok {
+				continue
+			}
+			if current, ok := actual[ns][k]; ok && fmt.Sprint(current) == v {
+				delete(tags[ns], k)
+				if len(tags[ns]) == 0 {
+					delete(tags, ns)
+				}
+				changed = true
+			}
+		}
+	}
+	return tags, changed
+}
+
+// BuildSharedNetworkConsumerTagKey returns the key of the tag which registers the cluster with the provided
+// resource identifier as a consumer of a shared network
+func BuildSharedNetworkConsumerTagKey(ClusterResourceUID string) string {
+	return SharedNetworkConsumerPrefix + ClusterResourceUID
+}
+
 // DerefString returns the string value if the pointer isn't nil, otherwise returns empty string
 func DerefString(s *string) string {
 	if s != nil {
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
 )
 
 func TestGetCloudProviderConfig(t *testing.T) {
@@ -83,3 +84,117 @@ func TestAddToDefaultClusterTags(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeTags(t *testing.T) {
+	freeformTags, changed := MergeFreeformTags(map[string]string{"CreatedBy": "OCIClusterAPIProvider", "team": "a"},
+		map[string]string{"CreatedBy": "OCIClusterAPIProvider"}, nil)
+	if changed || !reflect.DeepEqual(freeformTags, map[string]string{"CreatedBy": "OCIClusterAPIProvider", "team": "a"}) {
+		t.Errorf("Free form tags should not change, Actual: %v", freeformTags)
+	}
+	freeformTags, changed = MergeFreeformTags(map[string]string{"cost-center": "1", "team": "a"},
+		map[string]string{"cost-center": "2"}, nil)
+	if !changed || !reflect.DeepEqual(freeformTags, map[string]string{"cost-center": "2", "team": "a"}) {
+		t.Errorf("Free form tags don't match, Actual: %v", freeformTags)
+	}
+
+	definedTags, changed := MergeDefinedTags(map[string]map[string]interface{}{"Oracle-Tags": {"CreatedBy": "user"}},
+		map[string]map[string]interface{}{"finance": {"cost-center": "2"}}, nil)
+	expected := map[string]map[string]interface{}{"Oracle-Tags": {"CreatedBy": "user"}, "finance": {"cost-center": "2"}}
+	if !changed || !reflect.DeepEqual(definedTags, expected) {
+		t.Errorf("Defined tags don't match Expected: %v, Actual: %v", expected, definedTags)
+	}
+	_, changed = MergeDefinedTags(expected, map[string]map[string]interface{}{"finance": {"cost-center": "2"}}, nil)
+	if changed {
+		t.Errorf("Defined tags should not change")
+	}
+
+	// the tags removed from the spec are removed, unless they were changed outside of Cluster API
+	freeformTags, changed = MergeFreeformTags(map[string]string{"cost-center": "1", "team": "a", "owner": "b"},
+		map[string]string{"cost-center": "1"}, map[string]string{"cost-center": "1", "team": "a", "owner": "c"})
+	if !changed || !reflect.DeepEqual(freeformTags, map[string]string{"cost-center": "1", "owner": "b"}) {
+		t.Errorf("Free form tags don't match, Actual: %v", freeformTags)
+	}
+	definedTags, changed = MergeDefinedTags(expected, map[string]map[string]interface{}{},
+		map[string]map[string]string{"finance": {"cost-center": "2"}})
+	expected = map[string]map[string]interface{}{"Oracle-Tags": {"CreatedBy": "user"}}
+	if !changed || !reflect.DeepEqual(definedTags, expected) {
+		t.Errorf("Defined tags don't match Expected: %v, Actual: %v", expected, definedTags)
+	}
+}
+
+type fakeServiceError struct{}
+
+func (fakeServiceError) Error() string          { return "service error" }
+func (fakeServiceError) GetHTTPStatusCode() int { return 404 }
+func (fakeServiceError) GetMessage() string {
+	return "Authorization failed or requested resource not found."
+}
+func (fakeServiceError) GetCode() string         { return "NotAuthorizedOrNotFound" }
+func (fakeServiceError) GetOpcRequestID() string { return "request-id" }
+
+func TestConditionMessage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "service error",
+			err:      errors.Wrap(fakeServiceError{}, "failed to get vcn"),
+			expected: "NotAuthorizedOrNotFound: Authorization failed or requested resource not found. (opc-request-id: request-id)",
+		},
+		{
+			name:     "other error",
+			err:      errors.Wrap(errors.New("some error"), "failed to get vcn"),
+			expected: "failed to get vcn: some error",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := ConditionMessage(tc.err); actual != tc.expected {
+				t.Errorf("Condition message don't match, Expected: %s, Actual: %s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+type fakeCapacityError struct{ fakeServiceError }
+
+func (fakeCapacityError) GetHTTPStatusCode() int { return 500 }
+func (fakeCapacityError) GetMessage() string     { return "Out of host capacity." }
+func (fakeCapacityError) GetCode() string        { return "InternalError" }
+
+func TestIsOutOfHostCapacity(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "out of host capacity",
+			err:      errors.Wrap(fakeCapacityError{}, "failed to launch instance"),
+			expected: true,
+		},
+		{
+			name:     "other service error",
+			err:      errors.Wrap(fakeServiceError{}, "failed to launch instance"),
+			expected: false,
+		},
+		{
+			name:     "simulated error",
+			err:      ErrOutOfHostCapacity,
+			expected: true,
+		},
+		{
+			name:     "no error",
+			expected: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := IsOutOfHostCapacity(tc.err); actual != tc.expected {
+				t.Errorf("Out of host capacity don't match, Expected: %t, Actual: %t", tc.expected, actual)
+			}
+		})
+	}
+}
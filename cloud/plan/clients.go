@@ -0,0 +1,478 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"fmt"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/blockstorage"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/computemanagement"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/containerengine"
+	lb "github.com/oracle/cluster-api-provider-oci/cloud/services/loadbalancer"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/logging"
+	nlb "github.com/oracle/cluster-api-provider-oci/cloud/services/networkloadbalancer"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn"
+	oke "github.com/oracle/oci-go-sdk/v65/containerengine"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	ocilogging "github.com/oracle/oci-go-sdk/v65/logging"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+)
+
+// NewVCNClient returns a vcn.Client which records the operations which modify OCI resources in the
+// recorder instead of performing them. The other operations are performed by client.
+func (r *Recorder) NewVCNClient(client vcn.Client) vcn.Client {
+	return vcnClient{Client: client, recorder: r}
+}
+
+type vcnClient struct {
+	vcn.Client
+	recorder *Recorder
+}
+
+func (c vcnClient) CreateVcn(ctx context.Context, request core.CreateVcnRequest) (core.CreateVcnResponse, error) {
+	return core.CreateVcnResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "vcn", request.CreateVcnDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateVcn(ctx context.Context, request core.UpdateVcnRequest) (core.UpdateVcnResponse, error) {
+	return core.UpdateVcnResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "vcn", nil, request.VcnId, request.UpdateVcnDetails)
+}
+
+func (c vcnClient) DeleteVcn(ctx context.Context, request core.DeleteVcnRequest) (core.DeleteVcnResponse, error) {
+	return core.DeleteVcnResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "vcn", nil, request.VcnId, nil)
+}
+
+func (c vcnClient) CreateSubnet(ctx context.Context, request core.CreateSubnetRequest) (core.CreateSubnetResponse, error) {
+	return core.CreateSubnetResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "subnet", request.CreateSubnetDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateSubnet(ctx context.Context, request core.UpdateSubnetRequest) (core.UpdateSubnetResponse, error) {
+	return core.UpdateSubnetResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "subnet", nil, request.SubnetId, request.UpdateSubnetDetails)
+}
+
+func (c vcnClient) DeleteSubnet(ctx context.Context, request core.DeleteSubnetRequest) (core.DeleteSubnetResponse, error) {
+	return core.DeleteSubnetResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "subnet", nil, request.SubnetId, nil)
+}
+
+func (c vcnClient) CreateRouteTable(ctx context.Context, request core.CreateRouteTableRequest) (core.CreateRouteTableResponse, error) {
+	return core.CreateRouteTableResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "routeTable", request.CreateRouteTableDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateRouteTable(ctx context.Context, request core.UpdateRouteTableRequest) (core.UpdateRouteTableResponse, error) {
+	return core.UpdateRouteTableResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "routeTable", nil, request.RtId, request.UpdateRouteTableDetails)
+}
+
+func (c vcnClient) DeleteRouteTable(ctx context.Context, request core.DeleteRouteTableRequest) (core.DeleteRouteTableResponse, error) {
+	return core.DeleteRouteTableResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "routeTable", nil, request.RtId, nil)
+}
+
+func (c vcnClient) CreateSecurityList(ctx context.Context, request core.CreateSecurityListRequest) (core.CreateSecurityListResponse, error) {
+	return core.CreateSecurityListResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "securityList", request.CreateSecurityListDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateSecurityList(ctx context.Context, request core.UpdateSecurityListRequest) (core.UpdateSecurityListResponse, error) {
+	return core.UpdateSecurityListResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "securityList", nil, request.SecurityListId, request.UpdateSecurityListDetails)
+}
+
+func (c vcnClient) DeleteSecurityList(ctx context.Context, request core.DeleteSecurityListRequest) (core.DeleteSecurityListResponse, error) {
+	return core.DeleteSecurityListResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "securityList", nil, request.SecurityListId, nil)
+}
+
+func (c vcnClient) CreateInternetGateway(ctx context.Context, request core.CreateInternetGatewayRequest) (core.CreateInternetGatewayResponse, error) {
+	return core.CreateInternetGatewayResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "internetGateway", request.CreateInternetGatewayDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateInternetGateway(ctx context.Context, request core.UpdateInternetGatewayRequest) (core.UpdateInternetGatewayResponse, error) {
+	return core.UpdateInternetGatewayResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "internetGateway", nil, request.IgId, request.UpdateInternetGatewayDetails)
+}
+
+func (c vcnClient) DeleteInternetGateway(ctx context.Context, request core.DeleteInternetGatewayRequest) (core.DeleteInternetGatewayResponse, error) {
+	return core.DeleteInternetGatewayResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "internetGateway", nil, request.IgId, nil)
+}
+
+func (c vcnClient) CreateNatGateway(ctx context.Context, request core.CreateNatGatewayRequest) (core.CreateNatGatewayResponse, error) {
+	return core.CreateNatGatewayResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "natGateway", request.CreateNatGatewayDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateNatGateway(ctx context.Context, request core.UpdateNatGatewayRequest) (core.UpdateNatGatewayResponse, error) {
+	return core.UpdateNatGatewayResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "natGateway", nil, request.NatGatewayId, request.UpdateNatGatewayDetails)
+}
+
+func (c vcnClient) DeleteNatGateway(ctx context.Context, request core.DeleteNatGatewayRequest) (core.DeleteNatGatewayResponse, error) {
+	return core.DeleteNatGatewayResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "natGateway", nil, request.NatGatewayId, nil)
+}
+
+func (c vcnClient) CreateServiceGateway(ctx context.Context, request core.CreateServiceGatewayRequest) (core.CreateServiceGatewayResponse, error) {
+	return core.CreateServiceGatewayResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "serviceGateway", request.CreateServiceGatewayDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateServiceGateway(ctx context.Context, request core.UpdateServiceGatewayRequest) (core.UpdateServiceGatewayResponse, error) {
+	return core.UpdateServiceGatewayResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "serviceGateway", nil, request.ServiceGatewayId, request.UpdateServiceGatewayDetails)
+}
+
+func (c vcnClient) DeleteServiceGateway(ctx context.Context, request core.DeleteServiceGatewayRequest) (core.DeleteServiceGatewayResponse, error) {
+	return core.DeleteServiceGatewayResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "serviceGateway", nil, request.ServiceGatewayId, nil)
+}
+
+func (c vcnClient) UpdateVnic(ctx context.Context, request core.UpdateVnicRequest) (core.UpdateVnicResponse, error) {
+	return core.UpdateVnicResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "vnic", nil, request.VnicId, request.UpdateVnicDetails)
+}
+
+func (c vcnClient) CreateNetworkSecurityGroup(ctx context.Context, request core.CreateNetworkSecurityGroupRequest) (core.CreateNetworkSecurityGroupResponse, error) {
+	return core.CreateNetworkSecurityGroupResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "networkSecurityGroup", request.CreateNetworkSecurityGroupDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateNetworkSecurityGroup(ctx context.Context, request core.UpdateNetworkSecurityGroupRequest) (core.UpdateNetworkSecurityGroupResponse, error) {
+	return core.UpdateNetworkSecurityGroupResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "networkSecurityGroup", nil, request.NetworkSecurityGroupId, request.UpdateNetworkSecurityGroupDetails)
+}
+
+func (c vcnClient) DeleteNetworkSecurityGroup(ctx context.Context, request core.DeleteNetworkSecurityGroupRequest) (core.DeleteNetworkSecurityGroupResponse, error) {
+	return core.DeleteNetworkSecurityGroupResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "networkSecurityGroup", nil, request.NetworkSecurityGroupId, nil)
+}
+
+func (c vcnClient) AddNetworkSecurityGroupSecurityRules(ctx context.Context, request core.AddNetworkSecurityGroupSecurityRulesRequest) (core.AddNetworkSecurityGroupSecurityRulesResponse, error) {
+	return core.AddNetworkSecurityGroupSecurityRulesResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "networkSecurityGroupRules", nil, request.NetworkSecurityGroupId, nil)
+}
+
+func (c vcnClient) UpdateNetworkSecurityGroupSecurityRules(ctx context.Context, request core.UpdateNetworkSecurityGroupSecurityRulesRequest) (core.UpdateNetworkSecurityGroupSecurityRulesResponse, error) {
+	return core.UpdateNetworkSecurityGroupSecurityRulesResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "networkSecurityGroupRules", nil, request.NetworkSecurityGroupId, nil)
+}
+
+func (c vcnClient) RemoveNetworkSecurityGroupSecurityRules(ctx context.Context, request core.RemoveNetworkSecurityGroupSecurityRulesRequest) (core.RemoveNetworkSecurityGroupSecurityRulesResponse, error) {
+	return core.RemoveNetworkSecurityGroupSecurityRulesResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "networkSecurityGroupRules", nil, request.NetworkSecurityGroupId, nil)
+}
+
+func (c vcnClient) CreateCaptureFilter(ctx context.Context, request core.CreateCaptureFilterRequest) (core.CreateCaptureFilterResponse, error) {
+	return core.CreateCaptureFilterResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "captureFilter", request.CreateCaptureFilterDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateCaptureFilter(ctx context.Context, request core.UpdateCaptureFilterRequest) (core.UpdateCaptureFilterResponse, error) {
+	return core.UpdateCaptureFilterResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "captureFilter", nil, request.CaptureFilterId, request.UpdateCaptureFilterDetails)
+}
+
+func (c vcnClient) DeleteCaptureFilter(ctx context.Context, request core.DeleteCaptureFilterRequest) (core.DeleteCaptureFilterResponse, error) {
+	return core.DeleteCaptureFilterResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "captureFilter", nil, request.CaptureFilterId, nil)
+}
+
+func (c vcnClient) CreateDrg(ctx context.Context, request core.CreateDrgRequest) (core.CreateDrgResponse, error) {
+	return core.CreateDrgResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "drg", request.CreateDrgDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateDrg(ctx context.Context, request core.UpdateDrgRequest) (core.UpdateDrgResponse, error) {
+	return core.UpdateDrgResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "drg", nil, request.DrgId, request.UpdateDrgDetails)
+}
+
+func (c vcnClient) DeleteDrg(ctx context.Context, request core.DeleteDrgRequest) (core.DeleteDrgResponse, error) {
+	return core.DeleteDrgResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "drg", nil, request.DrgId, nil)
+}
+
+func (c vcnClient) CreateDrgAttachment(ctx context.Context, request core.CreateDrgAttachmentRequest) (core.CreateDrgAttachmentResponse, error) {
+	return core.CreateDrgAttachmentResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "drgAttachment", request.CreateDrgAttachmentDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateDrgAttachment(ctx context.Context, request core.UpdateDrgAttachmentRequest) (core.UpdateDrgAttachmentResponse, error) {
+	return core.UpdateDrgAttachmentResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "drgAttachment", nil, request.DrgAttachmentId, request.UpdateDrgAttachmentDetails)
+}
+
+func (c vcnClient) DeleteDrgAttachment(ctx context.Context, request core.DeleteDrgAttachmentRequest) (core.DeleteDrgAttachmentResponse, error) {
+	return core.DeleteDrgAttachmentResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "drgAttachment", nil, request.DrgAttachmentId, nil)
+}
+
+func (c vcnClient) CreateRemotePeeringConnection(ctx context.Context, request core.CreateRemotePeeringConnectionRequest) (core.CreateRemotePeeringConnectionResponse, error) {
+	return core.CreateRemotePeeringConnectionResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "remotePeeringConnection", request.CreateRemotePeeringConnectionDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateRemotePeeringConnection(ctx context.Context, request core.UpdateRemotePeeringConnectionRequest) (core.UpdateRemotePeeringConnectionResponse, error) {
+	return core.UpdateRemotePeeringConnectionResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "remotePeeringConnection", nil, request.RemotePeeringConnectionId, request.UpdateRemotePeeringConnectionDetails)
+}
+
+func (c vcnClient) DeleteRemotePeeringConnection(ctx context.Context, request core.DeleteRemotePeeringConnectionRequest) (core.DeleteRemotePeeringConnectionResponse, error) {
+	return core.DeleteRemotePeeringConnectionResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "remotePeeringConnection", nil, request.RemotePeeringConnectionId, nil)
+}
+
+func (c vcnClient) ConnectRemotePeeringConnections(ctx context.Context, request core.ConnectRemotePeeringConnectionsRequest) (core.ConnectRemotePeeringConnectionsResponse, error) {
+	return core.ConnectRemotePeeringConnectionsResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "remotePeeringConnection", nil, request.RemotePeeringConnectionId, request.ConnectRemotePeeringConnectionsDetails)
+}
+
+func (c vcnClient) CreateCpe(ctx context.Context, request core.CreateCpeRequest) (core.CreateCpeResponse, error) {
+	return core.CreateCpeResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "cpe", request.CreateCpeDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) DeleteCpe(ctx context.Context, request core.DeleteCpeRequest) (core.DeleteCpeResponse, error) {
+	return core.DeleteCpeResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "cpe", nil, request.CpeId, nil)
+}
+
+func (c vcnClient) CreateIPSecConnection(ctx context.Context, request core.CreateIPSecConnectionRequest) (core.CreateIPSecConnectionResponse, error) {
+	return core.CreateIPSecConnectionResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "ipSecConnection", request.CreateIpSecConnectionDetails.DisplayName, nil, nil)
+}
+
+func (c vcnClient) UpdateIPSecConnection(ctx context.Context, request core.UpdateIPSecConnectionRequest) (core.UpdateIPSecConnectionResponse, error) {
+	return core.UpdateIPSecConnectionResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "ipSecConnection", nil, request.IpscId, request.UpdateIpSecConnectionDetails)
+}
+
+func (c vcnClient) UpdateIPSecConnectionTunnel(ctx context.Context, request core.UpdateIPSecConnectionTunnelRequest) (core.UpdateIPSecConnectionTunnelResponse, error) {
+	return core.UpdateIPSecConnectionTunnelResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "ipSecConnectionTunnel", nil, request.TunnelId, request.UpdateIpSecConnectionTunnelDetails)
+}
+
+func (c vcnClient) UpdateIPSecConnectionTunnelSharedSecret(ctx context.Context, request core.UpdateIPSecConnectionTunnelSharedSecretRequest) (core.UpdateIPSecConnectionTunnelSharedSecretResponse, error) {
+	return core.UpdateIPSecConnectionTunnelSharedSecretResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "ipSecConnectionTunnel", nil, request.TunnelId, request.UpdateIpSecConnectionTunnelSharedSecretDetails)
+}
+
+func (c vcnClient) DeleteIPSecConnection(ctx context.Context, request core.DeleteIPSecConnectionRequest) (core.DeleteIPSecConnectionResponse, error) {
+	return core.DeleteIPSecConnectionResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "ipSecConnection", nil, request.IpscId, nil)
+}
+
+// NewLoadBalancerClient returns a lb.LoadBalancerClient which records the operations which modify OCI resources in the
+// recorder instead of performing them. The other operations are performed by client.
+func (r *Recorder) NewLoadBalancerClient(client lb.LoadBalancerClient) lb.LoadBalancerClient {
+	return loadBalancerClient{LoadBalancerClient: client, recorder: r}
+}
+
+type loadBalancerClient struct {
+	lb.LoadBalancerClient
+	recorder *Recorder
+}
+
+func (c loadBalancerClient) CreateBackend(ctx context.Context, request loadbalancer.CreateBackendRequest) (loadbalancer.CreateBackendResponse, error) {
+	return loadbalancer.CreateBackendResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "backend", backendName(request.BackendSetName, request.IpAddress, request.Port), request.LoadBalancerId, nil)
+}
+
+func (c loadBalancerClient) DeleteBackend(ctx context.Context, request loadbalancer.DeleteBackendRequest) (loadbalancer.DeleteBackendResponse, error) {
+	return loadbalancer.DeleteBackendResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "backend", backendName(request.BackendSetName, request.BackendName, nil), request.LoadBalancerId, nil)
+}
+
+func (c loadBalancerClient) CreateLoadBalancer(ctx context.Context, request loadbalancer.CreateLoadBalancerRequest) (loadbalancer.CreateLoadBalancerResponse, error) {
+	return loadbalancer.CreateLoadBalancerResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "loadBalancer", request.CreateLoadBalancerDetails.DisplayName, nil, nil)
+}
+
+func (c loadBalancerClient) UpdateLoadBalancer(ctx context.Context, request loadbalancer.UpdateLoadBalancerRequest) (loadbalancer.UpdateLoadBalancerResponse, error) {
+	return loadbalancer.UpdateLoadBalancerResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "loadBalancer", nil, request.LoadBalancerId, request.UpdateLoadBalancerDetails)
+}
+
+func (c loadBalancerClient) DeleteLoadBalancer(ctx context.Context, request loadbalancer.DeleteLoadBalancerRequest) (loadbalancer.DeleteLoadBalancerResponse, error) {
+	return loadbalancer.DeleteLoadBalancerResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "loadBalancer", nil, request.LoadBalancerId, nil)
+}
+
+// NewNetworkLoadBalancerClient returns a nlb.NetworkLoadBalancerClient which records the operations which modify OCI resources in the
+// recorder instead of performing them. The other operations are performed by client.
+func (r *Recorder) NewNetworkLoadBalancerClient(client nlb.NetworkLoadBalancerClient) nlb.NetworkLoadBalancerClient {
+	return networkLoadBalancerClient{NetworkLoadBalancerClient: client, recorder: r}
+}
+
+type networkLoadBalancerClient struct {
+	nlb.NetworkLoadBalancerClient
+	recorder *Recorder
+}
+
+func (c networkLoadBalancerClient) CreateBackend(ctx context.Context, request networkloadbalancer.CreateBackendRequest) (networkloadbalancer.CreateBackendResponse, error) {
+	return networkloadbalancer.CreateBackendResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "backend", backendName(request.BackendSetName, request.IpAddress, request.Port), request.NetworkLoadBalancerId, nil)
+}
+
+func (c networkLoadBalancerClient) DeleteBackend(ctx context.Context, request networkloadbalancer.DeleteBackendRequest) (networkloadbalancer.DeleteBackendResponse, error) {
+	return networkloadbalancer.DeleteBackendResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "backend", backendName(request.BackendSetName, request.BackendName, nil), request.NetworkLoadBalancerId, nil)
+}
+
+func (c networkLoadBalancerClient) CreateNetworkLoadBalancer(ctx context.Context, request networkloadbalancer.CreateNetworkLoadBalancerRequest) (networkloadbalancer.CreateNetworkLoadBalancerResponse, error) {
+	return networkloadbalancer.CreateNetworkLoadBalancerResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "networkLoadBalancer", request.CreateNetworkLoadBalancerDetails.DisplayName, nil, nil)
+}
+
+func (c networkLoadBalancerClient) UpdateNetworkLoadBalancer(ctx context.Context, request networkloadbalancer.UpdateNetworkLoadBalancerRequest) (networkloadbalancer.UpdateNetworkLoadBalancerResponse, error) {
+	return networkloadbalancer.UpdateNetworkLoadBalancerResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "networkLoadBalancer", nil, request.NetworkLoadBalancerId, request.UpdateNetworkLoadBalancerDetails)
+}
+
+func (c networkLoadBalancerClient) DeleteNetworkLoadBalancer(ctx context.Context, request networkloadbalancer.DeleteNetworkLoadBalancerRequest) (networkloadbalancer.DeleteNetworkLoadBalancerResponse, error) {
+	return networkloadbalancer.DeleteNetworkLoadBalancerResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "networkLoadBalancer", nil, request.NetworkLoadBalancerId, nil)
+}
+
+// NewComputeManagementClient returns a computemanagement.Client which records the operations which modify OCI resources in the
+// recorder instead of performing them. The other operations are performed by client.
+func (r *Recorder) NewComputeManagementClient(client computemanagement.Client) computemanagement.Client {
+	return computeManagementClient{Client: client, recorder: r}
+}
+
+type computeManagementClient struct {
+	computemanagement.Client
+	recorder *Recorder
+}
+
+func (c computeManagementClient) CreateInstancePool(ctx context.Context, request core.CreateInstancePoolRequest) (core.CreateInstancePoolResponse, error) {
+	return core.CreateInstancePoolResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "instancePool", request.CreateInstancePoolDetails.DisplayName, nil, nil)
+}
+
+func (c computeManagementClient) UpdateInstancePool(ctx context.Context, request core.UpdateInstancePoolRequest) (core.UpdateInstancePoolResponse, error) {
+	return core.UpdateInstancePoolResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "instancePool", nil, request.InstancePoolId, request.UpdateInstancePoolDetails)
+}
+
+func (c computeManagementClient) TerminateInstancePool(ctx context.Context, request core.TerminateInstancePoolRequest) (core.TerminateInstancePoolResponse, error) {
+	return core.TerminateInstancePoolResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "instancePool", nil, request.InstancePoolId, nil)
+}
+
+func (c computeManagementClient) CreateInstanceConfiguration(ctx context.Context, request core.CreateInstanceConfigurationRequest) (core.CreateInstanceConfigurationResponse, error) {
+	return core.CreateInstanceConfigurationResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "instanceConfiguration", instanceConfigurationName(request.CreateInstanceConfiguration), nil, nil)
+}
+
+func (c computeManagementClient) DeleteInstanceConfiguration(ctx context.Context, request core.DeleteInstanceConfigurationRequest) (core.DeleteInstanceConfigurationResponse, error) {
+	return core.DeleteInstanceConfigurationResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "instanceConfiguration", nil, request.InstanceConfigurationId, nil)
+}
+
+// NewContainerEngineClient returns a containerengine.Client which records the operations which modify OCI resources in the
+// recorder instead of performing them. The other operations are performed by client.
+func (r *Recorder) NewContainerEngineClient(client containerengine.Client) containerengine.Client {
+	return containerEngineClient{Client: client, recorder: r}
+}
+
+type containerEngineClient struct {
+	containerengine.Client
+	recorder *Recorder
+}
+
+func (c containerEngineClient) CreateCluster(ctx context.Context, request oke.CreateClusterRequest) (oke.CreateClusterResponse, error) {
+	return oke.CreateClusterResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "cluster", request.CreateClusterDetails.Name, nil, nil)
+}
+
+func (c containerEngineClient) UpdateCluster(ctx context.Context, request oke.UpdateClusterRequest) (oke.UpdateClusterResponse, error) {
+	return oke.UpdateClusterResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "cluster", nil, request.ClusterId, request.UpdateClusterDetails)
+}
+
+func (c containerEngineClient) DeleteCluster(ctx context.Context, request oke.DeleteClusterRequest) (oke.DeleteClusterResponse, error) {
+	return oke.DeleteClusterResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "cluster", nil, request.ClusterId, nil)
+}
+
+func (c containerEngineClient) CreateNodePool(ctx context.Context, request oke.CreateNodePoolRequest) (oke.CreateNodePoolResponse, error) {
+	return oke.CreateNodePoolResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "nodePool", request.CreateNodePoolDetails.Name, nil, nil)
+}
+
+func (c containerEngineClient) UpdateNodePool(ctx context.Context, request oke.UpdateNodePoolRequest) (oke.UpdateNodePoolResponse, error) {
+	return oke.UpdateNodePoolResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "nodePool", nil, request.NodePoolId, request.UpdateNodePoolDetails)
+}
+
+func (c containerEngineClient) DeleteNodePool(ctx context.Context, request oke.DeleteNodePoolRequest) (oke.DeleteNodePoolResponse, error) {
+	return oke.DeleteNodePoolResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "nodePool", nil, request.NodePoolId, nil)
+}
+
+func (c containerEngineClient) CreateVirtualNodePool(ctx context.Context, request oke.CreateVirtualNodePoolRequest) (oke.CreateVirtualNodePoolResponse, error) {
+	return oke.CreateVirtualNodePoolResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "virtualNodePool", request.CreateVirtualNodePoolDetails.DisplayName, nil, nil)
+}
+
+func (c containerEngineClient) UpdateVirtualNodePool(ctx context.Context, request oke.UpdateVirtualNodePoolRequest) (oke.UpdateVirtualNodePoolResponse, error) {
+	return oke.UpdateVirtualNodePoolResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "virtualNodePool", nil, request.VirtualNodePoolId, request.UpdateVirtualNodePoolDetails)
+}
+
+func (c containerEngineClient) DeleteVirtualNodePool(ctx context.Context, request oke.DeleteVirtualNodePoolRequest) (oke.DeleteVirtualNodePoolResponse, error) {
+	return oke.DeleteVirtualNodePoolResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "virtualNodePool", nil, request.VirtualNodePoolId, nil)
+}
+
+func (c containerEngineClient) InstallAddon(ctx context.Context, request oke.InstallAddonRequest) (oke.InstallAddonResponse, error) {
+	return oke.InstallAddonResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "addon", request.InstallAddonDetails.AddonName, request.ClusterId, nil)
+}
+
+func (c containerEngineClient) UpdateAddon(ctx context.Context, request oke.UpdateAddonRequest) (oke.UpdateAddonResponse, error) {
+	return oke.UpdateAddonResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "addon", request.AddonName, request.ClusterId, request.UpdateAddonDetails)
+}
+
+func (c containerEngineClient) DisableAddon(ctx context.Context, request oke.DisableAddonRequest) (oke.DisableAddonResponse, error) {
+	return oke.DisableAddonResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "addon", request.AddonName, request.ClusterId, nil)
+}
+
+// NewLoggingClient returns a logging.Client which records the operations which modify OCI resources in the
+// recorder instead of performing them. The other operations are performed by client.
+func (r *Recorder) NewLoggingClient(client logging.Client) logging.Client {
+	return loggingClient{Client: client, recorder: r}
+}
+
+type loggingClient struct {
+	logging.Client
+	recorder *Recorder
+}
+
+func (c loggingClient) CreateLog(ctx context.Context, request ocilogging.CreateLogRequest) (ocilogging.CreateLogResponse, error) {
+	return ocilogging.CreateLogResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "log", request.CreateLogDetails.DisplayName, nil, nil)
+}
+
+func (c loggingClient) UpdateLog(ctx context.Context, request ocilogging.UpdateLogRequest) (ocilogging.UpdateLogResponse, error) {
+	return ocilogging.UpdateLogResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "log", nil, request.LogId, request.UpdateLogDetails)
+}
+
+func (c loggingClient) DeleteLog(ctx context.Context, request ocilogging.DeleteLogRequest) (ocilogging.DeleteLogResponse, error) {
+	return ocilogging.DeleteLogResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "log", nil, request.LogId, nil)
+}
+
+// NewBlockStorageClient returns a blockstorage.Client which records the operations which modify OCI resources in the
+// recorder instead of performing them. The other operations are performed by client.
+func (r *Recorder) NewBlockStorageClient(client blockstorage.Client) blockstorage.Client {
+	return blockStorageClient{Client: client, recorder: r}
+}
+
+type blockStorageClient struct {
+	blockstorage.Client
+	recorder *Recorder
+}
+
+func (c blockStorageClient) CreateVolume(ctx context.Context, request core.CreateVolumeRequest) (core.CreateVolumeResponse, error) {
+	return core.CreateVolumeResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "volume", request.CreateVolumeDetails.DisplayName, nil, nil)
+}
+
+func (c blockStorageClient) DeleteVolume(ctx context.Context, request core.DeleteVolumeRequest) (core.DeleteVolumeResponse, error) {
+	return core.DeleteVolumeResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "volume", nil, request.VolumeId, nil)
+}
+
+func (c blockStorageClient) CreateBootVolume(ctx context.Context, request core.CreateBootVolumeRequest) (core.CreateBootVolumeResponse, error) {
+	return core.CreateBootVolumeResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "bootVolume", request.CreateBootVolumeDetails.DisplayName, nil, nil)
+}
+
+func (c blockStorageClient) UpdateBootVolume(ctx context.Context, request core.UpdateBootVolumeRequest) (core.UpdateBootVolumeResponse, error) {
+	return core.UpdateBootVolumeResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationUpdate, "bootVolume", nil, request.BootVolumeId, request.UpdateBootVolumeDetails)
+}
+
+func (c blockStorageClient) DeleteBootVolume(ctx context.Context, request core.DeleteBootVolumeRequest) (core.DeleteBootVolumeResponse, error) {
+	return core.DeleteBootVolumeResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "bootVolume", nil, request.BootVolumeId, nil)
+}
+
+func (c blockStorageClient) CreateVolumeBackupPolicyAssignment(ctx context.Context, request core.CreateVolumeBackupPolicyAssignmentRequest) (core.CreateVolumeBackupPolicyAssignmentResponse, error) {
+	return core.CreateVolumeBackupPolicyAssignmentResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationCreate, "volumeBackupPolicyAssignment", nil, request.CreateVolumeBackupPolicyAssignmentDetails.AssetId, nil)
+}
+
+func (c blockStorageClient) DeleteVolumeBackupPolicyAssignment(ctx context.Context, request core.DeleteVolumeBackupPolicyAssignmentRequest) (core.DeleteVolumeBackupPolicyAssignmentResponse, error) {
+	return core.DeleteVolumeBackupPolicyAssignmentResponse{}, c.recorder.planned(infrastructurev1beta2.PlannedOperationDelete, "volumeBackupPolicyAssignment", nil, request.PolicyAssignmentId, nil)
+}
+
+// backendName returns the name of a backend of a load balancer, which is made of the name of the backend set and
+// of the IP address and port of the backend.
+func backendName(backendSetName *string, ipAddress *string, port *int) *string {
+	name := fmt.Sprintf("%s/%s", deref(backendSetName), deref(ipAddress))
+	if port != nil {
+		name = fmt.Sprintf("%s:%d", name, *port)
+	}
+	return &name
+}
+
+// instanceConfigurationName returns the display name of the instance configuration of a create request.
+func instanceConfigurationName(details core.CreateInstanceConfigurationBase) *string {
+	if details == nil {
+		return nil
+	}
+	return details.GetDisplayName()
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
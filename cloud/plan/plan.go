@@ -0,0 +1,164 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+// Package plan records the operations which the reconciliation would perform on the OCI resources, instead of
+// performing them, when an object has the plan annotation.
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// IsPlanMode returns true if the plan annotation is set on the object.
+func IsPlanMode(o metav1.Object) bool {
+	_, ok := o.GetAnnotations()[infrastructurev1beta2.PlanAnnotation]
+	return ok
+}
+
+// ReportEvent emits an event on the object when the planned operations differ from the operations planned by
+// the previous reconciliation.
+func ReportEvent(recorder record.EventRecorder, object runtime.Object, previous []infrastructurev1beta2.PlannedOperation, current []infrastructurev1beta2.PlannedOperation) {
+	if len(previous) == len(current) && reflect.DeepEqual(previous, current) {
+		return
+	}
+	recorder.Eventf(object, corev1.EventTypeNormal, "PlanUpdated", "%d operations are planned, remove the %s annotation to apply them",
+		len(current), infrastructurev1beta2.PlanAnnotation)
+}
+
+// PlannedError is returned by the clients of a Recorder in place of performing an operation. The reconciliation
+// stops at the first planned operation, as the steps after it depend on the outcome of the operation.
+type PlannedError struct {
+	Operation infrastructurev1beta2.PlannedOperation
+}
+
+func (e *PlannedError) Error() string {
+	return fmt.Sprintf("%s of %s %s is planned", e.Operation.Operation, e.Operation.ResourceType, describe(e.Operation))
+}
+
+// IsPlanned returns true if the error, or an error it wraps, is a PlannedError.
+func IsPlanned(err error) bool {
+	var plannedErr *PlannedError
+	return errors.As(err, &plannedErr)
+}
+
+func describe(operation infrastructurev1beta2.PlannedOperation) string {
+	if operation.Name != "" {
+		return operation.Name
+	}
+	if operation.ID != nil {
+		return *operation.ID
+	}
+	return ""
+}
+
+// Recorder records the planned operations of a reconciliation. The network resources are reconciled
+// concurrently, hence a Recorder is safe for concurrent use.
+type Recorder struct {
+	lock       sync.Mutex
+	operations []infrastructurev1beta2.PlannedOperation
+}
+
+// NewRecorder returns an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Record adds an operation to the plan.
+func (r *Recorder) Record(operation infrastructurev1beta2.PlannedOperationType, resourceType string, name *string, id *string, fields ...string) {
+	r.add(newPlannedOperation(operation, resourceType, name, id, fields))
+}
+
+func (r *Recorder) add(operation infrastructurev1beta2.PlannedOperation) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.operations = append(r.operations, operation)
+}
+
+// Operations returns the recorded operations, in a stable order so that the status of the object does not
+// change between reconciliations of the same plan.
+func (r *Recorder) Operations() []infrastructurev1beta2.PlannedOperation {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	operations := append([]infrastructurev1beta2.PlannedOperation(nil), r.operations...)
+	sort.SliceStable(operations, func(i, j int) bool {
+		if operations[i].ResourceType != operations[j].ResourceType {
+			return operations[i].ResourceType < operations[j].ResourceType
+		}
+		if operations[i].Name != operations[j].Name {
+			return operations[i].Name < operations[j].Name
+		}
+		if describe(operations[i]) != describe(operations[j]) {
+			return describe(operations[i]) < describe(operations[j])
+		}
+		return operations[i].Operation < operations[j].Operation
+	})
+	return operations
+}
+
+// planned records an operation performed by a client and returns the PlannedError returned by the client. The
+// fields of an update are the fields set in the details of the request.
+func (r *Recorder) planned(operation infrastructurev1beta2.PlannedOperationType, resourceType string, name *string, id *string, details interface{}) error {
+	plannedOperation := newPlannedOperation(operation, resourceType, name, id, fieldsOf(details))
+	r.add(plannedOperation)
+	return &PlannedError{Operation: plannedOperation}
+}
+
+func newPlannedOperation(operation infrastructurev1beta2.PlannedOperationType, resourceType string, name *string, id *string, fields []string) infrastructurev1beta2.PlannedOperation {
+	plannedOperation := infrastructurev1beta2.PlannedOperation{
+		Operation:    operation,
+		ResourceType: resourceType,
+		ID:           id,
+		Fields:       fields,
+	}
+	if name != nil {
+		plannedOperation.Name = *name
+	}
+	return plannedOperation
+}
+
+// fieldsOf returns the names of the fields which are set in the details of an update request.
+func fieldsOf(details interface{}) []string {
+	if details == nil {
+		return nil
+	}
+	b, err := json.Marshal(details)
+	if err != nil {
+		return nil
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil
+	}
+	var fields []string
+	for field, value := range values {
+		if value != nil {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,145 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package plan
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn/mock_vcn"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestIsPlanMode(t *testing.T) {
+	g := NewWithT(t)
+	object := &metav1.ObjectMeta{}
+	g.Expect(IsPlanMode(object)).To(BeFalse())
+	object.Annotations = map[string]string{infrastructurev1beta2.PlanAnnotation: ""}
+	g.Expect(IsPlanMode(object)).To(BeTrue())
+}
+
+func TestRecorder_Operations(t *testing.T) {
+	g := NewWithT(t)
+	recorder := NewRecorder()
+	recorder.Record(infrastructurev1beta2.PlannedOperationUpdate, "vcn", nil, common.String("vcn-id"), "freeformTags")
+	recorder.Record(infrastructurev1beta2.PlannedOperationCreate, "subnet", common.String("worker"), nil)
+	recorder.Record(infrastructurev1beta2.PlannedOperationCreate, "subnet", common.String("control-plane"), nil)
+	g.Expect(recorder.Operations()).To(Equal([]infrastructurev1beta2.PlannedOperation{
+		{Operation: infrastructurev1beta2.PlannedOperationCreate, ResourceType: "subnet", Name: "control-plane"},
+		{Operation: infrastructurev1beta2.PlannedOperationCreate, ResourceType: "subnet", Name: "worker"},
+		{Operation: infrastructurev1beta2.PlannedOperationUpdate, ResourceType: "vcn", ID: common.String("vcn-id"), Fields: []string{"freeformTags"}},
+	}))
+}
+
+func TestVCNClient(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	vcnClient := mock_vcn.NewMockClient(mockCtrl)
+	vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{VcnId: common.String("vcn-id")})).
+		Return(core.GetVcnResponse{Vcn: core.Vcn{Id: common.String("vcn-id")}}, nil)
+
+	recorder := NewRecorder()
+	client := recorder.NewVCNClient(vcnClient)
+	ctx := context.Background()
+
+	resp, err := client.GetVcn(ctx, core.GetVcnRequest{VcnId: common.String("vcn-id")})
+	g.Expect(err).To(BeNil())
+	g.Expect(resp.Vcn.Id).To(Equal(common.String("vcn-id")))
+
+	_, err = client.CreateVcn(ctx, core.CreateVcnRequest{CreateVcnDetails: core.CreateVcnDetails{DisplayName: common.String("cluster")}})
+	g.Expect(IsPlanned(err)).To(BeTrue())
+	g.Expect(IsPlanned(errors.Wrap(err, "failed to create vcn"))).To(BeTrue())
+	g.Expect(IsPlanned(errors.New("another error"))).To(BeFalse())
+
+	_, err = client.UpdateVcn(ctx, core.UpdateVcnRequest{
+		VcnId: common.String("vcn-id"),
+		UpdateVcnDetails: core.UpdateVcnDetails{
+			DisplayName:  common.String("cluster"),
+			FreeformTags: map[string]string{"foo": "bar"},
+		},
+	})
+	g.Expect(IsPlanned(err)).To(BeTrue())
+
+	_, err = client.DeleteSubnet(ctx, core.DeleteSubnetRequest{SubnetId: common.String("subnet-id")})
+	g.Expect(IsPlanned(err)).To(BeTrue())
+
+	g.Expect(recorder.Operations()).To(Equal([]infrastructurev1beta2.PlannedOperation{
+		{Operation: infrastructurev1beta2.PlannedOperationDelete, ResourceType: "subnet", ID: common.String("subnet-id")},
+		{Operation: infrastructurev1beta2.PlannedOperationUpdate, ResourceType: "vcn", ID: common.String("vcn-id"), Fields: []string{"displayName", "freeformTags"}},
+		{Operation: infrastructurev1beta2.PlannedOperationCreate, ResourceType: "vcn", Name: "cluster"},
+	}))
+}
+
+// TestClientsOverrideMutatingOperations checks that the operations which modify OCI resources are recorded by the
+// clients of the recorder. The clients wrap no client, so an operation passed through panics.
+func TestClientsOverrideMutatingOperations(t *testing.T) {
+	recorder := NewRecorder()
+	clients := map[string]interface{}{
+		"vcn":                 recorder.NewVCNClient(nil),
+		"loadBalancer":        recorder.NewLoadBalancerClient(nil),
+		"networkLoadBalancer": recorder.NewNetworkLoadBalancerClient(nil),
+		"computeManagement":   recorder.NewComputeManagementClient(nil),
+		"containerEngine":     recorder.NewContainerEngineClient(nil),
+		"logging":             recorder.NewLoggingClient(nil),
+		"blockStorage":        recorder.NewBlockStorageClient(nil),
+	}
+	mutating := regexp.MustCompile(`^(Create|Update|Delete|Add|Remove|Connect|Terminate|Install|Disable)`)
+	for name, client := range clients {
+		value := reflect.ValueOf(client)
+		for i := 0; i < value.NumMethod(); i++ {
+			methodName := value.Type().Method(i).Name
+			// CreateKubeconfig generates a kubeconfig, it does not modify the cluster
+			if !mutating.MatchString(methodName) || methodName == "CreateKubeconfig" {
+				continue
+			}
+			method := value.Method(i)
+			t.Run(name+"/"+methodName, func(t *testing.T) {
+				g := NewWithT(t)
+				var results []reflect.Value
+				g.Expect(func() {
+					results = method.Call([]reflect.Value{reflect.ValueOf(context.Background()), reflect.New(method.Type().In(1)).Elem()})
+				}).NotTo(Panic())
+				err, _ := results[1].Interface().(error)
+				g.Expect(IsPlanned(err)).To(BeTrue())
+			})
+		}
+	}
+}
+
+func TestReportEvent(t *testing.T) {
+	g := NewWithT(t)
+	recorder := record.NewFakeRecorder(2)
+	object := &infrastructurev1beta2.OCICluster{}
+	operations := []infrastructurev1beta2.PlannedOperation{
+		{Operation: infrastructurev1beta2.PlannedOperationCreate, ResourceType: "vcn", Name: "cluster"},
+	}
+	ReportEvent(recorder, object, nil, operations)
+	ReportEvent(recorder, object, operations, operations)
+	g.Expect(recorder.Events).To(HaveLen(1))
+	g.Expect(<-recorder.Events).To(Equal("Normal PlanUpdated 1 operations are planned, remove the " +
+		infrastructurev1beta2.PlanAnnotation + " annotation to apply them"))
+}
@@ -0,0 +1,169 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+)
+
+// ErrBootVolumeNotAvailable is returned while the boot volume the instance is booted from is not available, for
+// example while it is restored from the boot volume backup.
+var ErrBootVolumeNotAvailable = errors.New("the boot volume is not available")
+
+// getBootVolumeSource returns the boot volume the instance is booted from. A boot volume backup is restored in the
+// availability domain first, and the restored boot volume is recorded in the status.
+func (m *MachineScope) getBootVolumeSource(ctx context.Context, availabilityDomain string) (*core.BootVolume, error) {
+	source := m.OCIMachine.Spec.InstanceSourceViaBootVolumeDetails
+	bootVolumeId := source.BootVolumeId
+	if source.BootVolumeBackupId != nil {
+		if m.OCIMachine.Status.BootVolumeId == nil {
+			restoredBootVolumeId, err := m.restoreBootVolume(ctx, availabilityDomain)
+			if err != nil {
+				return nil, err
+			}
+			m.OCIMachine.Status.BootVolumeId = restoredBootVolumeId
+		}
+		bootVolumeId = m.OCIMachine.Status.BootVolumeId
+	}
+
+	resp, err := m.BlockStorageClient.GetBootVolume(ctx, core.GetBootVolumeRequest{
+		BootVolumeId: bootVolumeId,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the boot volume %s", *bootVolumeId)
+	}
+	if resp.LifecycleState != core.BootVolumeLifecycleStateAvailable {
+		m.Logger.Info("Boot volume is not available", "bootVolume", *bootVolumeId, "state", resp.LifecycleState)
+		return nil, errors.Wrapf(ErrBootVolumeNotAvailable, "boot volume %s is %s", *bootVolumeId, resp.LifecycleState)
+	}
+	return &resp.BootVolume, nil
+}
+
+// restoreBootVolume restores the boot volume backup of the spec to a new boot volume in the availability domain.
+func (m *MachineScope) restoreBootVolume(ctx context.Context, availabilityDomain string) (*string, error) {
+	source := m.OCIMachine.Spec.InstanceSourceViaBootVolumeDetails
+	sizeInGBs, err := m.getBootVolumeSizeInGBs()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := m.BlockStorageClient.CreateBootVolume(ctx, core.CreateBootVolumeRequest{
+		CreateBootVolumeDetails: core.CreateBootVolumeDetails{
+			CompartmentId:      common.String(m.getCompartmentId()),
+			AvailabilityDomain: common.String(availabilityDomain),
+			DisplayName:        common.String(m.OCIMachine.Name),
+			SourceDetails: core.BootVolumeSourceFromBootVolumeBackupDetails{
+				Id: source.BootVolumeBackupId,
+			},
+			KmsKeyId:       source.KmsKeyId,
+			VpusPerGB:      source.BootVolumeVpusPerGB,
+			SizeInGBs:      sizeInGBs,
+			BackupPolicyId: m.OCIMachine.Spec.BootVolumeBackupPolicyId,
+			FreeformTags:   m.getFreeFormTags(),
+			DefinedTags:    ConvertMachineDefinedTags(m.OCIMachine.Spec.DefinedTags),
+		},
+		OpcRetryToken: ociutil.GetOPCRetryToken("%s-boot-volume", string(m.OCIMachine.UID)),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to restore the boot volume backup %s", *source.BootVolumeBackupId)
+	}
+	m.Logger.Info("Restoring the boot volume from the backup", "bootVolume", *resp.Id,
+		"bootVolumeBackup", *source.BootVolumeBackupId)
+	return resp.Id, nil
+}
+
+// DeleteRestoredBootVolume deletes the boot volume restored from the boot volume backup when no instance was
+// launched from it, the boot volume of an instance is deleted with the instance.
+func (m *MachineScope) DeleteRestoredBootVolume(ctx context.Context) error {
+	if m.OCIMachine.Status.BootVolumeId == nil || m.OCIMachine.Spec.InstanceId != nil {
+		return nil
+	}
+	_, err := m.BlockStorageClient.DeleteBootVolume(ctx, core.DeleteBootVolumeRequest{
+		BootVolumeId: m.OCIMachine.Status.BootVolumeId,
+	})
+	if err != nil && !ociutil.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete the restored boot volume %s", *m.OCIMachine.Status.BootVolumeId)
+	}
+	m.Logger.Info("Deleted the restored boot volume", "bootVolume", *m.OCIMachine.Status.BootVolumeId)
+	m.OCIMachine.Status.BootVolumeId = nil
+	return nil
+}
+
+// ReconcileBootVolumeBackupPolicy assigns the backup policy of the spec to the boot volume of the instance,
+// replacing the backup policy assigned to it before. The backup policy of the boot volume is left as is when the
+// spec does not have a backup policy.
+func (m *MachineScope) ReconcileBootVolumeBackupPolicy(ctx context.Context, instance *core.Instance) error {
+	policyId := m.OCIMachine.Spec.BootVolumeBackupPolicyId
+	if policyId == nil {
+		return nil
+	}
+	resp, err := m.ComputeClient.ListBootVolumeAttachments(ctx, core.ListBootVolumeAttachmentsRequest{
+		AvailabilityDomain: instance.AvailabilityDomain,
+		CompartmentId:      instance.CompartmentId,
+		InstanceId:         instance.Id,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list the boot volume attachments")
+	}
+	for _, attachment := range resp.Items {
+		if attachment.LifecycleState != core.BootVolumeAttachmentLifecycleStateAttached || attachment.BootVolumeId == nil {
+			continue
+		}
+		if err := m.assignBootVolumeBackupPolicy(ctx, *attachment.BootVolumeId, *policyId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MachineScope) assignBootVolumeBackupPolicy(ctx context.Context, bootVolumeId string, policyId string) error {
+	resp, err := m.BlockStorageClient.GetVolumeBackupPolicyAssetAssignment(ctx, core.GetVolumeBackupPolicyAssetAssignmentRequest{
+		AssetId: common.String(bootVolumeId),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get the backup policy assignment of the boot volume")
+	}
+	for _, assignment := range resp.Items {
+		if ociutil.DerefString(assignment.PolicyId) == policyId {
+			return nil
+		}
+	}
+	// a volume has at most one backup policy
+	for _, assignment := range resp.Items {
+		_, err := m.BlockStorageClient.DeleteVolumeBackupPolicyAssignment(ctx, core.DeleteVolumeBackupPolicyAssignmentRequest{
+			PolicyAssignmentId: assignment.Id,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to delete the backup policy assignment of the boot volume")
+		}
+	}
+	_, err = m.BlockStorageClient.CreateVolumeBackupPolicyAssignment(ctx, core.CreateVolumeBackupPolicyAssignmentRequest{
+		CreateVolumeBackupPolicyAssignmentDetails: core.CreateVolumeBackupPolicyAssignmentDetails{
+			AssetId:  common.String(bootVolumeId),
+			PolicyId: common.String(policyId),
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to assign the backup policy to the boot volume")
+	}
+	m.Logger.Info("Assigned the backup policy to the boot volume", "bootVolume", bootVolumeId, "policy", policyId)
+	return nil
+}
@@ -0,0 +1,301 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/blockstorage/mock_blockstorage"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute/mock_compute"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2/klogr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func bootVolumeTestScope(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) *MachineScope {
+	log := klogr.New()
+	ms := &MachineScope{
+		Logger: &log,
+		OCIMachine: &infrastructurev1beta2.OCIMachine{
+			Spec: infrastructurev1beta2.OCIMachineSpec{
+				CompartmentId: "test-compartment",
+			},
+		},
+		Machine:            &clusterv1.Machine{},
+		ComputeClient:      computeClient,
+		BlockStorageClient: blockStorageClient,
+		OCIClusterAccessor: OCISelfManagedCluster{OCICluster: &infrastructurev1beta2.OCICluster{}},
+	}
+	ms.OCIMachine.Name = "machine"
+	ms.OCIMachine.UID = "machine-uid"
+	return ms
+}
+
+func TestGetBootVolumeSource(t *testing.T) {
+	tests := []struct {
+		name                 string
+		source               infrastructurev1beta2.InstanceSourceViaBootVolumeConfig
+		restoredBootVolumeId *string
+		setup                func(blockStorageClient *mock_blockstorage.MockClient)
+		errorExpected        bool
+		notAvailable         bool
+		expectedBootVolumeId string
+		expectedStatus       *string
+	}{
+		{
+			name:   "existing boot volume",
+			source: infrastructurev1beta2.InstanceSourceViaBootVolumeConfig{BootVolumeId: common.String("boot-volume")},
+			setup: func(blockStorageClient *mock_blockstorage.MockClient) {
+				blockStorageClient.EXPECT().GetBootVolume(gomock.Any(), gomock.Eq(core.GetBootVolumeRequest{
+					BootVolumeId: common.String("boot-volume"),
+				})).Return(core.GetBootVolumeResponse{BootVolume: core.BootVolume{
+					Id:                 common.String("boot-volume"),
+					AvailabilityDomain: common.String("ad-2"),
+					LifecycleState:     core.BootVolumeLifecycleStateAvailable,
+				}}, nil)
+			},
+			expectedBootVolumeId: "boot-volume",
+		},
+		{
+			name: "boot volume restored from the backup",
+			source: infrastructurev1beta2.InstanceSourceViaBootVolumeConfig{
+				BootVolumeBackupId:  common.String("backup"),
+				BootVolumeVpusPerGB: common.Int64(20),
+			},
+			setup: func(blockStorageClient *mock_blockstorage.MockClient) {
+				blockStorageClient.EXPECT().CreateBootVolume(gomock.Any(), gomock.Eq(core.CreateBootVolumeRequest{
+					CreateBootVolumeDetails: core.CreateBootVolumeDetails{
+						CompartmentId:      common.String("test-compartment"),
+						AvailabilityDomain: common.String("ad-1"),
+						DisplayName:        common.String("machine"),
+						SourceDetails:      core.BootVolumeSourceFromBootVolumeBackupDetails{Id: common.String("backup")},
+						VpusPerGB:          common.Int64(20),
+						FreeformTags: map[string]string{
+							ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+							ociutil.ClusterResourceIdentifier: "",
+						},
+						DefinedTags: map[string]map[string]interface{}{},
+					},
+					OpcRetryToken: ociutil.GetOPCRetryToken("machine-uid-boot-volume"),
+				})).Return(core.CreateBootVolumeResponse{BootVolume: core.BootVolume{Id: common.String("restored")}}, nil)
+				blockStorageClient.EXPECT().GetBootVolume(gomock.Any(), gomock.Eq(core.GetBootVolumeRequest{
+					BootVolumeId: common.String("restored"),
+				})).Return(core.GetBootVolumeResponse{BootVolume: core.BootVolume{
+					Id:             common.String("restored"),
+					LifecycleState: core.BootVolumeLifecycleStateProvisioning,
+				}}, nil)
+			},
+			errorExpected:  true,
+			notAvailable:   true,
+			expectedStatus: common.String("restored"),
+		},
+		{
+			name:                 "boot volume already restored from the backup",
+			source:               infrastructurev1beta2.InstanceSourceViaBootVolumeConfig{BootVolumeBackupId: common.String("backup")},
+			restoredBootVolumeId: common.String("restored"),
+			setup: func(blockStorageClient *mock_blockstorage.MockClient) {
+				blockStorageClient.EXPECT().GetBootVolume(gomock.Any(), gomock.Eq(core.GetBootVolumeRequest{
+					BootVolumeId: common.String("restored"),
+				})).Return(core.GetBootVolumeResponse{BootVolume: core.BootVolume{
+					Id:             common.String("restored"),
+					LifecycleState: core.BootVolumeLifecycleStateAvailable,
+				}}, nil)
+			},
+			expectedBootVolumeId: "restored",
+			expectedStatus:       common.String("restored"),
+		},
+		{
+			name:   "backup can not be restored",
+			source: infrastructurev1beta2.InstanceSourceViaBootVolumeConfig{BootVolumeBackupId: common.String("backup")},
+			setup: func(blockStorageClient *mock_blockstorage.MockClient) {
+				blockStorageClient.EXPECT().CreateBootVolume(gomock.Any(), gomock.Any()).
+					Return(core.CreateBootVolumeResponse{}, errors.New("request failed"))
+			},
+			errorExpected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			blockStorageClient := mock_blockstorage.NewMockClient(mockCtrl)
+			tc.setup(blockStorageClient)
+			ms := bootVolumeTestScope(nil, blockStorageClient)
+			ms.OCIMachine.Spec.InstanceSourceViaBootVolumeDetails = &tc.source
+			ms.OCIMachine.Status.BootVolumeId = tc.restoredBootVolumeId
+
+			bootVolume, err := ms.getBootVolumeSource(context.Background(), "ad-1")
+			if tc.errorExpected {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(errors.Is(err, ErrBootVolumeNotAvailable)).To(Equal(tc.notAvailable))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(*bootVolume.Id).To(Equal(tc.expectedBootVolumeId))
+			}
+			g.Expect(ms.OCIMachine.Status.BootVolumeId).To(Equal(tc.expectedStatus))
+		})
+	}
+}
+
+func TestDeleteRestoredBootVolume(t *testing.T) {
+	tests := []struct {
+		name                 string
+		instanceId           *string
+		restoredBootVolumeId *string
+		setup                func(blockStorageClient *mock_blockstorage.MockClient)
+		expectedStatus       *string
+	}{
+		{
+			name:                 "boot volume without an instance",
+			restoredBootVolumeId: common.String("restored"),
+			setup: func(blockStorageClient *mock_blockstorage.MockClient) {
+				blockStorageClient.EXPECT().DeleteBootVolume(gomock.Any(), gomock.Eq(core.DeleteBootVolumeRequest{
+					BootVolumeId: common.String("restored"),
+				})).Return(core.DeleteBootVolumeResponse{}, nil)
+			},
+		},
+		{
+			name:                 "boot volume of an instance",
+			instanceId:           common.String("instance"),
+			restoredBootVolumeId: common.String("restored"),
+			setup:                func(blockStorageClient *mock_blockstorage.MockClient) {},
+			expectedStatus:       common.String("restored"),
+		},
+		{
+			name:  "no restored boot volume",
+			setup: func(blockStorageClient *mock_blockstorage.MockClient) {},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			blockStorageClient := mock_blockstorage.NewMockClient(mockCtrl)
+			tc.setup(blockStorageClient)
+			ms := bootVolumeTestScope(nil, blockStorageClient)
+			ms.OCIMachine.Spec.InstanceId = tc.instanceId
+			ms.OCIMachine.Status.BootVolumeId = tc.restoredBootVolumeId
+
+			g.Expect(ms.DeleteRestoredBootVolume(context.Background())).To(Succeed())
+			g.Expect(ms.OCIMachine.Status.BootVolumeId).To(Equal(tc.expectedStatus))
+		})
+	}
+}
+
+func TestReconcileBootVolumeBackupPolicy(t *testing.T) {
+	instance := &core.Instance{
+		Id:                 common.String("instance"),
+		AvailabilityDomain: common.String("ad-1"),
+		CompartmentId:      common.String("test-compartment"),
+	}
+	expectAttachments := func(computeClient *mock_compute.MockComputeClient) {
+		computeClient.EXPECT().ListBootVolumeAttachments(gomock.Any(), gomock.Eq(core.ListBootVolumeAttachmentsRequest{
+			AvailabilityDomain: common.String("ad-1"),
+			CompartmentId:      common.String("test-compartment"),
+			InstanceId:         common.String("instance"),
+		})).Return(core.ListBootVolumeAttachmentsResponse{Items: []core.BootVolumeAttachment{{
+			BootVolumeId:   common.String("boot-volume"),
+			LifecycleState: core.BootVolumeAttachmentLifecycleStateAttached,
+		}}}, nil)
+	}
+	tests := []struct {
+		name          string
+		policyId      *string
+		setup         func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient)
+		errorExpected bool
+	}{
+		{
+			name: "no backup policy",
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+			},
+		},
+		{
+			name:     "backup policy already assigned",
+			policyId: common.String("policy"),
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				expectAttachments(computeClient)
+				blockStorageClient.EXPECT().GetVolumeBackupPolicyAssetAssignment(gomock.Any(), gomock.Eq(core.GetVolumeBackupPolicyAssetAssignmentRequest{
+					AssetId: common.String("boot-volume"),
+				})).Return(core.GetVolumeBackupPolicyAssetAssignmentResponse{Items: []core.VolumeBackupPolicyAssignment{{
+					Id:       common.String("assignment"),
+					PolicyId: common.String("policy"),
+				}}}, nil)
+			},
+		},
+		{
+			name:     "backup policy replaces the assigned backup policy",
+			policyId: common.String("policy"),
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				expectAttachments(computeClient)
+				blockStorageClient.EXPECT().GetVolumeBackupPolicyAssetAssignment(gomock.Any(), gomock.Any()).
+					Return(core.GetVolumeBackupPolicyAssetAssignmentResponse{Items: []core.VolumeBackupPolicyAssignment{{
+						Id:       common.String("assignment"),
+						PolicyId: common.String("other-policy"),
+					}}}, nil)
+				blockStorageClient.EXPECT().DeleteVolumeBackupPolicyAssignment(gomock.Any(), gomock.Eq(core.DeleteVolumeBackupPolicyAssignmentRequest{
+					PolicyAssignmentId: common.String("assignment"),
+				})).Return(core.DeleteVolumeBackupPolicyAssignmentResponse{}, nil)
+				blockStorageClient.EXPECT().CreateVolumeBackupPolicyAssignment(gomock.Any(), gomock.Eq(core.CreateVolumeBackupPolicyAssignmentRequest{
+					CreateVolumeBackupPolicyAssignmentDetails: core.CreateVolumeBackupPolicyAssignmentDetails{
+						AssetId:  common.String("boot-volume"),
+						PolicyId: common.String("policy"),
+					},
+				})).Return(core.CreateVolumeBackupPolicyAssignmentResponse{}, nil)
+			},
+		},
+		{
+			name:     "backup policy can not be assigned",
+			policyId: common.String("policy"),
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				expectAttachments(computeClient)
+				blockStorageClient.EXPECT().GetVolumeBackupPolicyAssetAssignment(gomock.Any(), gomock.Any()).
+					Return(core.GetVolumeBackupPolicyAssetAssignmentResponse{}, nil)
+				blockStorageClient.EXPECT().CreateVolumeBackupPolicyAssignment(gomock.Any(), gomock.Any()).
+					Return(core.CreateVolumeBackupPolicyAssignmentResponse{}, errors.New("request failed"))
+			},
+			errorExpected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			computeClient := mock_compute.NewMockComputeClient(mockCtrl)
+			blockStorageClient := mock_blockstorage.NewMockClient(mockCtrl)
+			tc.setup(computeClient, blockStorageClient)
+			ms := bootVolumeTestScope(computeClient, blockStorageClient)
+			ms.OCIMachine.Spec.BootVolumeBackupPolicyId = tc.policyId
+
+			err := ms.ReconcileBootVolumeBackupPolicy(context.Background(), instance)
+			if tc.errorExpected {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -25,20 +25,29 @@ import (
 	"github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/metrics"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/base"
+	blockStorageClient "github.com/oracle/cluster-api-provider-oci/cloud/services/blockstorage"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute"
+	computeInstanceAgentClient "github.com/oracle/cluster-api-provider-oci/cloud/services/computeinstanceagent"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/computemanagement"
 	containerEngineClient "github.com/oracle/cluster-api-provider-oci/cloud/services/containerengine"
 	identityClient "github.com/oracle/cluster-api-provider-oci/cloud/services/identity"
 	lb "github.com/oracle/cluster-api-provider-oci/cloud/services/loadbalancer"
+	loggingClient "github.com/oracle/cluster-api-provider-oci/cloud/services/logging"
 	nlb "github.com/oracle/cluster-api-provider-oci/cloud/services/networkloadbalancer"
+	objectStorageClient "github.com/oracle/cluster-api-provider-oci/cloud/services/objectstorage"
+	resourceSearchClient "github.com/oracle/cluster-api-provider-oci/cloud/services/resourcesearch"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn"
 	"github.com/oracle/cluster-api-provider-oci/version"
 	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
 	"github.com/oracle/oci-go-sdk/v65/containerengine"
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"github.com/oracle/oci-go-sdk/v65/identity"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/logging"
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+	"github.com/oracle/oci-go-sdk/v65/resourcesearch"
 	"github.com/pkg/errors"
 	"k8s.io/klog/v2/klogr"
 )
@@ -52,6 +61,11 @@ type OCIClients struct {
 	LoadBalancerClient        lb.LoadBalancerClient
 	IdentityClient            identityClient.Client
 	ContainerEngineClient     containerEngineClient.Client
+	LoggingClient             loggingClient.Client
+	ResourceSearchClient      resourceSearchClient.Client
+	BlockStorageClient        blockStorageClient.Client
+	ObjectStorageClient       objectStorageClient.Client
+	InstanceAgentClient       computeInstanceAgentClient.Client
 	BaseClient                base.BaseClient
 }
 
@@ -161,6 +175,26 @@ func (c *ClientProvider) createClients(region string) (OCIClients, error) {
 	if err != nil {
 		return OCIClients{}, err
 	}
+	loggingClt, err := c.createLoggingClient(region, c.ociAuthConfigProvider, c.Logger)
+	if err != nil {
+		return OCIClients{}, err
+	}
+	resourceSearchClt, err := c.createResourceSearchClient(region, c.ociAuthConfigProvider, c.Logger)
+	if err != nil {
+		return OCIClients{}, err
+	}
+	blockStorageClt, err := c.createBlockStorageClient(region, c.ociAuthConfigProvider, c.Logger)
+	if err != nil {
+		return OCIClients{}, err
+	}
+	objectStorageClt, err := c.createObjectStorageClient(region, c.ociAuthConfigProvider, c.Logger)
+	if err != nil {
+		return OCIClients{}, err
+	}
+	instanceAgentClt, err := c.createInstanceAgentClient(region, c.ociAuthConfigProvider, c.Logger)
+	if err != nil {
+		return OCIClients{}, err
+	}
 	baseClient, err := c.createBaseClient(region, c.ociAuthConfigProvider, c.Logger)
 	if err != nil {
 		return OCIClients{}, err
@@ -178,6 +212,11 @@ func (c *ClientProvider) createClients(region string) (OCIClients, error) {
 		ComputeClient:             computeClient,
 		ComputeManagementClient:   computeManagementClient,
 		ContainerEngineClient:     containerEngineClt,
+		LoggingClient:             loggingClt,
+		ResourceSearchClient:      resourceSearchClt,
+		BlockStorageClient:        blockStorageClt,
+		ObjectStorageClient:       objectStorageClt,
+		InstanceAgentClient:       instanceAgentClt,
 		BaseClient:                baseClient,
 	}, err
 }
@@ -308,6 +347,84 @@ func (c *ClientProvider) createContainerEngineClient(region string, ociAuthConfi
 	return &containerEngineClt, nil
 }
 
+func (c *ClientProvider) createLoggingClient(region string, ociAuthConfigProvider common.ConfigurationProvider, logger *logr.Logger) (*logging.LoggingManagementClient, error) {
+	loggingClt, err := logging.NewLoggingManagementClientWithConfigurationProvider(ociAuthConfigProvider)
+	if err != nil {
+		logger.Error(err, "unable to create OCI Logging Client")
+		return nil, err
+	}
+	loggingClt.SetRegion(region)
+	dispatcher := loggingClt.HTTPClient
+	loggingClt.HTTPClient = metrics.NewHttpRequestDispatcherWrapper(dispatcher, region)
+
+	if c.ociClientOverrides != nil && c.ociClientOverrides.LoggingClientUrl != nil {
+		loggingClt.Host = *c.ociClientOverrides.LoggingClientUrl
+	}
+	loggingClt.Interceptor = setVersionHeader()
+
+	return &loggingClt, nil
+}
+
+func (c *ClientProvider) createResourceSearchClient(region string, ociAuthConfigProvider common.ConfigurationProvider, logger *logr.Logger) (*resourcesearch.ResourceSearchClient, error) {
+	resourceSearchClt, err := resourcesearch.NewResourceSearchClientWithConfigurationProvider(ociAuthConfigProvider)
+	if err != nil {
+		logger.Error(err, "unable to create OCI Resource Search Client")
+		return nil, err
+	}
+	resourceSearchClt.SetRegion(region)
+	dispatcher := resourceSearchClt.HTTPClient
+	resourceSearchClt.HTTPClient = metrics.NewHttpRequestDispatcherWrapper(dispatcher, region)
+	resourceSearchClt.Interceptor = setVersionHeader()
+
+	return &resourceSearchClt, nil
+}
+
+func (c *ClientProvider) createBlockStorageClient(region string, ociAuthConfigProvider common.ConfigurationProvider, logger *logr.Logger) (*core.BlockstorageClient, error) {
+	blockStorageClt, err := core.NewBlockstorageClientWithConfigurationProvider(ociAuthConfigProvider)
+	if err != nil {
+		logger.Error(err, "unable to create OCI Block Storage Client")
+		return nil, err
+	}
+	blockStorageClt.SetRegion(region)
+	dispatcher := blockStorageClt.HTTPClient
+	blockStorageClt.HTTPClient = metrics.NewHttpRequestDispatcherWrapper(dispatcher, region)
+
+	if c.ociClientOverrides != nil && c.ociClientOverrides.BlockStorageClientUrl != nil {
+		blockStorageClt.Host = *c.ociClientOverrides.BlockStorageClientUrl
+	}
+	blockStorageClt.Interceptor = setVersionHeader()
+
+	return &blockStorageClt, nil
+}
+
+func (c *ClientProvider) createObjectStorageClient(region string, ociAuthConfigProvider common.ConfigurationProvider, logger *logr.Logger) (*objectstorage.ObjectStorageClient, error) {
+	objectStorageClt, err := objectstorage.NewObjectStorageClientWithConfigurationProvider(ociAuthConfigProvider)
+	if err != nil {
+		logger.Error(err, "unable to create OCI Object Storage Client")
+		return nil, err
+	}
+	objectStorageClt.SetRegion(region)
+	dispatcher := objectStorageClt.HTTPClient
+	objectStorageClt.HTTPClient = metrics.NewHttpRequestDispatcherWrapper(dispatcher, region)
+	objectStorageClt.Interceptor = setVersionHeader()
+
+	return &objectStorageClt, nil
+}
+
+func (c *ClientProvider) createInstanceAgentClient(region string, ociAuthConfigProvider common.ConfigurationProvider, logger *logr.Logger) (*computeinstanceagent.ComputeInstanceAgentClient, error) {
+	instanceAgentClt, err := computeinstanceagent.NewComputeInstanceAgentClientWithConfigurationProvider(ociAuthConfigProvider)
+	if err != nil {
+		logger.Error(err, "unable to create OCI Compute Instance Agent Client")
+		return nil, err
+	}
+	instanceAgentClt.SetRegion(region)
+	dispatcher := instanceAgentClt.HTTPClient
+	instanceAgentClt.HTTPClient = metrics.NewHttpRequestDispatcherWrapper(dispatcher, region)
+	instanceAgentClt.Interceptor = setVersionHeader()
+
+	return &instanceAgentClt, nil
+}
+
 func (c *ClientProvider) createBaseClient(region string, ociAuthConfigProvider common.ConfigurationProvider, logger *logr.Logger) (base.BaseClient, error) {
 	baseClient, err := base.NewBaseClient(ociAuthConfigProvider, logger)
 	if err != nil {
@@ -28,6 +28,7 @@ import (
 	"github.com/oracle/cluster-api-provider-oci/cloud/config"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/identity"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/resourcesearch"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
@@ -40,6 +41,7 @@ type MockOCIClients struct {
 	NetworkLoadBalancerClient *networkloadbalancer.NetworkLoadBalancerClient
 	LoadBalancerClient        *loadbalancer.LoadBalancerClient
 	IdentityClient            identity.Client
+	ResourceSearchClient      resourcesearch.Client
 }
 
 var (
@@ -54,6 +56,7 @@ func MockNewClientProvider(mockClients MockOCIClients) (*ClientProvider, error)
 		LoadBalancerClient:        mockClients.LoadBalancerClient,
 		IdentityClient:            mockClients.IdentityClient,
 		ComputeClient:             mockClients.ComputeClient,
+		ResourceSearchClient:      mockClients.ResourceSearchClient,
 	}}
 
 	authConfig, err := MockAuthConfig()
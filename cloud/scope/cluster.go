@@ -21,12 +21,17 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/go-logr/logr"
 	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/plan"
+	blockStorageClient "github.com/oracle/cluster-api-provider-oci/cloud/services/blockstorage"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute"
 	identityClient "github.com/oracle/cluster-api-provider-oci/cloud/services/identity"
 	lb "github.com/oracle/cluster-api-provider-oci/cloud/services/loadbalancer"
+	loggingClient "github.com/oracle/cluster-api-provider-oci/cloud/services/logging"
 	nlb "github.com/oracle/cluster-api-provider-oci/cloud/services/networkloadbalancer"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn"
 	"github.com/oracle/oci-go-sdk/v65/common"
@@ -55,6 +60,9 @@ type ClusterScopeParams struct {
 	NetworkLoadBalancerClient nlb.NetworkLoadBalancerClient
 	LoadBalancerClient        lb.LoadBalancerClient
 	IdentityClient            identityClient.Client
+	LoggingClient             loggingClient.Client
+	BlockStorageClient        blockStorageClient.Client
+	ComputeClient             compute.ComputeClient
 	// RegionIdentifier Identifier as specified here https://docs.oracle.com/en-us/iaas/Content/General/Concepts/regions.htm
 	RegionIdentifier      string
 	OCIAuthConfigProvider common.ConfigurationProvider
@@ -62,6 +70,12 @@ type ClusterScopeParams struct {
 	OCIClusterAccessor    OCIClusterAccessor
 	// RegionIdentifier Key as specified here https://docs.oracle.com/en-us/iaas/Content/General/Concepts/regions.htm
 	RegionKey string
+	// PeerClientProviders are the client providers of the peer identities of the Remote Peering Connections,
+	// keyed by the name of the Remote Peering Connection
+	PeerClientProviders map[string]*ClientProvider
+	// PlanRecorder records the operations which modify OCI resources instead of performing them, if the cluster
+	// is reconciled in plan mode
+	PlanRecorder *plan.Recorder
 }
 
 type ClusterScope struct {
@@ -73,12 +87,25 @@ type ClusterScope struct {
 	NetworkLoadBalancerClient nlb.NetworkLoadBalancerClient
 	LoadBalancerClient        lb.LoadBalancerClient
 	IdentityClient            identityClient.Client
+	LoggingClient             loggingClient.Client
+	BlockStorageClient        blockStorageClient.Client
+	ComputeClient             compute.ComputeClient
 	// RegionIdentifier Identifier as specified here https://docs.oracle.com/en-us/iaas/Content/General/Concepts/regions.htm
 	RegionIdentifier   string
 	ClientProvider     *ClientProvider
 	OCIClusterAccessor OCIClusterAccessor
 	// RegionIdentifier Key as specified here https://docs.oracle.com/en-us/iaas/Content/General/Concepts/regions.htm
 	RegionKey string
+	// PeerClientProviders are the client providers of the peer identities of the Remote Peering Connections,
+	// keyed by the name of the Remote Peering Connection
+	PeerClientProviders map[string]*ClientProvider
+	// PlanRecorder records the operations which modify OCI resources instead of performing them, if the cluster
+	// is reconciled in plan mode
+	PlanRecorder *plan.Recorder
+	// driftLock serializes the updates of the drift in the status of the cluster
+	driftLock sync.Mutex
+	// networkStatusLock serializes the updates of the inventory of the network resources in the status of the cluster
+	networkStatusLock sync.Mutex
 }
 
 // NewClusterScope creates a ClusterScope given the ClusterScopeParams
@@ -96,6 +123,14 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		params.Logger = &log
 	}
 
+	if params.PlanRecorder != nil {
+		params.VCNClient = params.PlanRecorder.NewVCNClient(params.VCNClient)
+		params.NetworkLoadBalancerClient = params.PlanRecorder.NewNetworkLoadBalancerClient(params.NetworkLoadBalancerClient)
+		params.LoadBalancerClient = params.PlanRecorder.NewLoadBalancerClient(params.LoadBalancerClient)
+		params.LoggingClient = params.PlanRecorder.NewLoggingClient(params.LoggingClient)
+		params.BlockStorageClient = params.PlanRecorder.NewBlockStorageClient(params.BlockStorageClient)
+	}
+
 	return &ClusterScope{
 		Logger:                    params.Logger,
 		client:                    params.Client,
@@ -104,21 +139,26 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		NetworkLoadBalancerClient: params.NetworkLoadBalancerClient,
 		LoadBalancerClient:        params.LoadBalancerClient,
 		IdentityClient:            params.IdentityClient,
+		LoggingClient:             params.LoggingClient,
+		BlockStorageClient:        params.BlockStorageClient,
+		ComputeClient:             params.ComputeClient,
 		RegionIdentifier:          params.RegionIdentifier,
 		ClientProvider:            params.ClientProvider,
 		OCIClusterAccessor:        params.OCIClusterAccessor,
 		RegionKey:                 params.RegionKey,
+		PeerClientProviders:       params.PeerClientProviders,
+		PlanRecorder:              params.PlanRecorder,
 	}, nil
 }
 
+// GetPlanRecorder returns the recorder of the planned operations, which is nil unless the cluster is reconciled
+// in plan mode.
+func (s *ClusterScope) GetPlanRecorder() *plan.Recorder {
+	return s.PlanRecorder
+}
+
 func (s *ClusterScope) IsResourceCreatedByClusterAPI(resourceFreeFormTags map[string]string) bool {
-	tagsAddedByClusterAPI := ociutil.BuildClusterTags(s.OCIClusterAccessor.GetOCIResourceIdentifier())
-	for k, v := range tagsAddedByClusterAPI {
-		if resourceFreeFormTags[k] != v {
-			return false
-		}
-	}
-	return true
+	return ociutil.IsClusterResource(resourceFreeFormTags, s.OCIClusterAccessor.GetOCIResourceIdentifier())
 }
 
 func (s *ClusterScope) ReconcileFailureDomains(ctx context.Context) error {
@@ -150,13 +190,13 @@ func (s *ClusterScope) setFailureDomains(ctx context.Context) error {
 	}
 
 	numOfAds := len(adMap)
-	if numOfAds != 1 && numOfAds != 3 {
-		err := errors.New(fmt.Sprintf("invalid number of Availability Domains, should be either 1 or 3, but got %d", numOfAds))
+	if numOfAds == 0 {
+		err := errors.New("no Availability Domains found")
 		s.Logger.Error(err, "invalid number of Availability Domains")
 		return err
 	}
 
-	if numOfAds == 3 {
+	if numOfAds > 1 {
 		for k := range adMap {
 			adIndex := strings.LastIndexAny(k, "-")
 			if adIndex < 0 {
@@ -250,6 +290,40 @@ func (s *ClusterScope) GetCompartmentId() string {
 	return s.OCIClusterAccessor.GetCompartmentId()
 }
 
+// GetNetworkCompartmentId returns the compartment of the network resources, defaulting to the
+// compartment of the cluster
+func (s *ClusterScope) GetNetworkCompartmentId() string {
+	if s.OCIClusterAccessor.GetNetworkSpec().CompartmentId != "" {
+		return s.OCIClusterAccessor.GetNetworkSpec().CompartmentId
+	}
+	return s.GetCompartmentId()
+}
+
+// GetSubnetCompartmentId returns the compartment of the subnet, defaulting to the compartment of the network
+func (s *ClusterScope) GetSubnetCompartmentId(subnet infrastructurev1beta2.Subnet) string {
+	if subnet.CompartmentId != "" {
+		return subnet.CompartmentId
+	}
+	return s.GetNetworkCompartmentId()
+}
+
+// GetNSGCompartmentId returns the compartment of the NSG, defaulting to the compartment of the network
+func (s *ClusterScope) GetNSGCompartmentId(nsg infrastructurev1beta2.NSG) string {
+	if nsg.CompartmentId != "" {
+		return nsg.CompartmentId
+	}
+	return s.GetNetworkCompartmentId()
+}
+
+// GetLoadBalancerCompartmentId returns the compartment of the API Server LB, defaulting to the compartment
+// of the network
+func (s *ClusterScope) GetLoadBalancerCompartmentId() string {
+	if s.OCIClusterAccessor.GetNetworkSpec().APIServerLB.CompartmentId != "" {
+		return s.OCIClusterAccessor.GetNetworkSpec().APIServerLB.CompartmentId
+	}
+	return s.GetNetworkCompartmentId()
+}
+
 // APIServerPort returns the APIServerPort to use when creating the load balancer.
 func (s *ClusterScope) APIServerPort() int32 {
 	if s.Cluster.Spec.ClusterNetwork != nil && s.Cluster.Spec.ClusterNetwork.APIServerPort != nil {
@@ -44,6 +44,17 @@ type OCIClusterAccessor interface {
 	GetRegion() string
 	// GetClientOverrides returns the client host url overrides for the cluster
 	GetClientOverrides() *infrastructurev1beta2.ClientOverrides
+	// GetWorkloadResourceCleanup returns the cleanup configuration of the cloud resources created by the workload cluster
+	GetWorkloadResourceCleanup() *infrastructurev1beta2.WorkloadResourceCleanup
+	// GetDriftPolicy returns the drift policy of the network resources of the cluster.
+	GetDriftPolicy() infrastructurev1beta2.DriftPolicy
+	// AddResourceDrift adds the drift of a network resource to the status of the cluster.
+	AddResourceDrift(drift infrastructurev1beta2.ResourceDrift)
+	// GetAppliedTags returns the tags of the spec which were last applied to the resources of the cluster.
+	GetAppliedTags() *infrastructurev1beta2.AppliedTags
+	// GetNetworkStatus returns the inventory of the network resources in the status of the cluster, the inventory
+	// is created if it does not exist yet.
+	GetNetworkStatus() *infrastructurev1beta2.NetworkStatus
 	// GetNetworkSpec returns the NetworkSpec of the cluster.
 	GetNetworkSpec() *infrastructurev1beta2.NetworkSpec
 	// GetControlPlaneEndpoint returns the control plane endpoint of the cluster.
@@ -58,10 +69,16 @@ type OCIClusterAccessor interface {
 	GetAvailabilityDomains() map[string]infrastructurev1beta2.OCIAvailabilityDomain
 	// SetAvailabilityDomains sets the availability domain.
 	SetAvailabilityDomains(ads map[string]infrastructurev1beta2.OCIAvailabilityDomain)
+	// SetRemotePeeringConnectionStatus sets the observed state of a Remote Peering Connection.
+	SetRemotePeeringConnectionStatus(status infrastructurev1beta2.RemotePeeringConnectionStatus)
 	// MarkConditionFalse marks the provided condition as false in the cluster object
 	MarkConditionFalse(t clusterv1.ConditionType, reason string, severity clusterv1.ConditionSeverity, messageFormat string, messageArgs ...interface{})
+	// MarkConditionTrue marks the provided condition as true in the cluster object
+	MarkConditionTrue(t clusterv1.ConditionType)
 	// GetIdentityRef returns the Identity reference of the cluster
 	GetIdentityRef() *corev1.ObjectReference
 	// GetProviderID returns the provider id for the instance
 	GetProviderID(instanceId string) string
+	// GetHibernationState returns the hibernation state of the control plane of the cluster.
+	GetHibernationState() infrastructurev1beta2.HibernationState
 }
@@ -2,6 +2,8 @@ package scope
 
 import (
 	"context"
+
+	"github.com/oracle/cluster-api-provider-oci/cloud/plan"
 )
 
 type ClusterScopeClient interface {
@@ -12,6 +14,7 @@ type ClusterScopeClient interface {
 	ReconcileNSG(ctx context.Context) error
 	ReconcileRouteTable(ctx context.Context) error
 	ReconcileSubnet(ctx context.Context) error
+	ReconcileFlowLogs(ctx context.Context) error
 	ReconcileApiServerNLB(ctx context.Context) error
 	ReconcileApiServerLB(ctx context.Context) error
 	ReconcileFailureDomains(ctx context.Context) error
@@ -19,18 +22,26 @@ type ClusterScopeClient interface {
 	DeleteDRG(ctx context.Context) error
 	ReconcileDRGVCNAttachment(ctx context.Context) error
 	ReconcileDRGRPCAttachment(ctx context.Context) error
+	ReconcileVPN(ctx context.Context) error
 	DeleteApiServerNLB(ctx context.Context) error
 	DeleteApiServerLB(ctx context.Context) error
 	DeleteNSGs(ctx context.Context) error
 	DeleteSubnets(ctx context.Context) error
+	DeleteFlowLogs(ctx context.Context) error
 	DeleteRouteTables(ctx context.Context) error
 	DeleteSecurityLists(ctx context.Context) error
 	DeleteServiceGateway(ctx context.Context) error
 	DeleteNatGateway(ctx context.Context) error
 	DeleteInternetGateway(ctx context.Context) error
 	DeleteVCN(ctx context.Context) error
+	ReleaseSharedNetwork(ctx context.Context) error
 	DeleteDRGVCNAttachment(ctx context.Context) error
 	DeleteDRGRPCAttachment(ctx context.Context) error
+	DeleteVPN(ctx context.Context) error
+	DeleteWorkloadResources(ctx context.Context) error
 	GetOCIClusterAccessor() OCIClusterAccessor
 	SetRegionKey(ctx context.Context) error
+	GetPlanRecorder() *plan.Recorder
+	IsHibernated() bool
+	IsAPIServerLBHealthy(ctx context.Context) (bool, error)
 }
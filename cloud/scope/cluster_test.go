@@ -18,6 +18,8 @@ package scope
 
 import (
 	"context"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
@@ -194,11 +196,12 @@ func TestClusterScope_ReconcileFailureDomains(t *testing.T) {
 	})).Return(identity.ListFaultDomainsResponse{}, errors.New("some error"))
 
 	tests := []struct {
-		name                string
-		spec                infrastructurev1beta2.OCIClusterSpec
-		wantErr             bool
-		expectedError       string
-		expectedErrorPrefix string
+		name                   string
+		spec                   infrastructurev1beta2.OCIClusterSpec
+		wantErr                bool
+		expectedError          string
+		expectedErrorPrefix    string
+		expectedFailureDomains []string
 	}{
 		{
 			name: "3ad region",
@@ -209,10 +212,9 @@ func TestClusterScope_ReconcileFailureDomains(t *testing.T) {
 			spec: infrastructurev1beta2.OCIClusterSpec{CompartmentId: "1ad"},
 		},
 		{
-			name:          "2ad region",
-			spec:          infrastructurev1beta2.OCIClusterSpec{CompartmentId: "2ad"},
-			wantErr:       true,
-			expectedError: "invalid number of Availability Domains, should be either 1 or 3, but got 2",
+			name:                   "2ad region",
+			spec:                   infrastructurev1beta2.OCIClusterSpec{CompartmentId: "2ad"},
+			expectedFailureDomains: []string{"1", "2"},
 		},
 		{
 			name:          "list ad error",
@@ -304,6 +306,16 @@ func TestClusterScope_ReconcileFailureDomains(t *testing.T) {
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ReconcileFailureDomains() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if tt.expectedFailureDomains != nil {
+				var failureDomains []string
+				for id := range ociClusterAccessor.GetFailureDomains() {
+					failureDomains = append(failureDomains, id)
+				}
+				sort.Strings(failureDomains)
+				if !reflect.DeepEqual(failureDomains, tt.expectedFailureDomains) {
+					t.Errorf("ReconcileFailureDomains() expected failure domains = %v, actual %v", tt.expectedFailureDomains, failureDomains)
+				}
+			}
 			if err != nil {
 				if tt.expectedErrorPrefix != "" {
 					if !strings.HasPrefix(err.Error(), tt.expectedErrorPrefix) {
@@ -343,3 +355,99 @@ func (o *matcherCustomizer) String() string {
 	}
 	return o.err.Error()
 }
+
+func TestClusterScope_GetNetworkCompartmentIds(t *testing.T) {
+	tests := []struct {
+		name                   string
+		spec                   infrastructurev1beta2.OCIClusterSpec
+		wantNetworkCompartment string
+		wantSubnetCompartment  string
+		wantNSGCompartment     string
+		wantLBCompartment      string
+	}{
+		{
+			name: "defaults to cluster compartment",
+			spec: infrastructurev1beta2.OCIClusterSpec{
+				CompartmentId: "cluster",
+				NetworkSpec: infrastructurev1beta2.NetworkSpec{
+					Vcn: infrastructurev1beta2.VCN{
+						Subnets: []*infrastructurev1beta2.Subnet{{Name: "subnet"}},
+						NetworkSecurityGroup: infrastructurev1beta2.NetworkSecurityGroup{
+							List: []*infrastructurev1beta2.NSG{{Name: "nsg"}},
+						},
+					},
+				},
+			},
+			wantNetworkCompartment: "cluster",
+			wantSubnetCompartment:  "cluster",
+			wantNSGCompartment:     "cluster",
+			wantLBCompartment:      "cluster",
+		},
+		{
+			name: "defaults to network compartment",
+			spec: infrastructurev1beta2.OCIClusterSpec{
+				CompartmentId: "cluster",
+				NetworkSpec: infrastructurev1beta2.NetworkSpec{
+					CompartmentId: "network",
+					Vcn: infrastructurev1beta2.VCN{
+						Subnets: []*infrastructurev1beta2.Subnet{{Name: "subnet"}},
+						NetworkSecurityGroup: infrastructurev1beta2.NetworkSecurityGroup{
+							List: []*infrastructurev1beta2.NSG{{Name: "nsg"}},
+						},
+					},
+				},
+			},
+			wantNetworkCompartment: "network",
+			wantSubnetCompartment:  "network",
+			wantNSGCompartment:     "network",
+			wantLBCompartment:      "network",
+		},
+		{
+			name: "resource compartments override network compartment",
+			spec: infrastructurev1beta2.OCIClusterSpec{
+				CompartmentId: "cluster",
+				NetworkSpec: infrastructurev1beta2.NetworkSpec{
+					CompartmentId: "network",
+					Vcn: infrastructurev1beta2.VCN{
+						Subnets: []*infrastructurev1beta2.Subnet{{Name: "subnet", CompartmentId: "subnet"}},
+						NetworkSecurityGroup: infrastructurev1beta2.NetworkSecurityGroup{
+							List: []*infrastructurev1beta2.NSG{{Name: "nsg", CompartmentId: "nsg"}},
+						},
+					},
+					APIServerLB: infrastructurev1beta2.LoadBalancer{
+						CompartmentId: "lb",
+					},
+				},
+			},
+			wantNetworkCompartment: "network",
+			wantSubnetCompartment:  "subnet",
+			wantNSGCompartment:     "nsg",
+			wantLBCompartment:      "lb",
+		},
+	}
+	l := log.FromContext(context.Background())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &ClusterScope{
+				OCIClusterAccessor: OCISelfManagedCluster{
+					&infrastructurev1beta2.OCICluster{
+						Spec: tt.spec,
+					},
+				},
+				Logger: &l,
+			}
+			if got := s.GetNetworkCompartmentId(); got != tt.wantNetworkCompartment {
+				t.Errorf("GetNetworkCompartmentId() = %v, want %v", got, tt.wantNetworkCompartment)
+			}
+			if got := s.GetSubnetCompartmentId(*tt.spec.NetworkSpec.Vcn.Subnets[0]); got != tt.wantSubnetCompartment {
+				t.Errorf("GetSubnetCompartmentId() = %v, want %v", got, tt.wantSubnetCompartment)
+			}
+			if got := s.GetNSGCompartmentId(*tt.spec.NetworkSpec.Vcn.NetworkSecurityGroup.List[0]); got != tt.wantNSGCompartment {
+				t.Errorf("GetNSGCompartmentId() = %v, want %v", got, tt.wantNSGCompartment)
+			}
+			if got := s.GetLoadBalancerCompartmentId(); got != tt.wantLBCompartment {
+				t.Errorf("GetLoadBalancerCompartmentId() = %v, want %v", got, tt.wantLBCompartment)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// ConsoleHistorySecretKey is the key of the console history in the Secret it is stored in.
+	ConsoleHistorySecretKey = "consoleHistory"
+	// defaultConsoleHistoryMaxSizeInBytes is the size the console history is capped at when the spec does not
+	// define it.
+	defaultConsoleHistoryMaxSizeInBytes = 65536
+)
+
+// ErrConsoleHistoryNotCaptured is returned while OCI captures the console history of the instance.
+var ErrConsoleHistoryNotCaptured = errors.New("the console history is not captured yet")
+
+// ReconcileConsoleHistory captures the serial console history of the instance when it is requested with the
+// CaptureConsoleHistoryAnnotation, when the machine fails or is marked unhealthy or when the node has not joined the cluster before the
+// bootstrap timeout, and stores it in a Secret owned by the OCIMachine once OCI has captured it.
+func (m *MachineScope) ReconcileConsoleHistory(ctx context.Context, instance *core.Instance) error {
+	status := m.OCIMachine.Status.ConsoleHistory
+	if status != nil && status.ConsoleHistoryId != nil {
+		return m.storeConsoleHistory(ctx, status)
+	}
+
+	reason := m.getConsoleHistoryCaptureReason(instance)
+	if reason == "" {
+		return nil
+	}
+	// the request is consumed whether the capture succeeds or not, a failed capture is not retried
+	delete(m.OCIMachine.Annotations, infrastructurev1beta2.CaptureConsoleHistoryAnnotation)
+	resp, err := m.ComputeClient.CaptureConsoleHistory(ctx, core.CaptureConsoleHistoryRequest{
+		CaptureConsoleHistoryDetails: core.CaptureConsoleHistoryDetails{
+			InstanceId:   instance.Id,
+			DisplayName:  common.String(m.OCIMachine.Name),
+			FreeformTags: m.getFreeFormTags(),
+			DefinedTags:  ConvertMachineDefinedTags(m.OCIMachine.Spec.DefinedTags),
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to capture the console history of the instance %s", *instance.Id)
+	}
+	m.OCIMachine.Status.ConsoleHistory = &infrastructurev1beta2.ConsoleHistoryStatus{
+		ConsoleHistoryId: resp.Id,
+		InstanceId:       instance.Id,
+		Reason:           reason,
+	}
+	m.Logger.Info("Capturing the console history of the instance", "reason", reason, "consoleHistory", *resp.Id)
+	return ErrConsoleHistoryNotCaptured
+}
+
+// GetBootstrapTimeoutRequeue returns how long to wait before the bootstrap of the node times out, or zero when the
+// bootstrap does not time out or has already timed out.
+func (m *MachineScope) GetBootstrapTimeoutRequeue(instance *core.Instance) time.Duration {
+	deadline := m.getBootstrapDeadline(instance)
+	if deadline == nil {
+		return 0
+	}
+	if remaining := time.Until(*deadline); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// DeleteConsoleHistory deletes the console history which is being captured in OCI.
+func (m *MachineScope) DeleteConsoleHistory(ctx context.Context) error {
+	status := m.OCIMachine.Status.ConsoleHistory
+	if status == nil || status.ConsoleHistoryId == nil {
+		return nil
+	}
+	_, err := m.ComputeClient.DeleteConsoleHistory(ctx, core.DeleteConsoleHistoryRequest{
+		InstanceConsoleHistoryId: status.ConsoleHistoryId,
+	})
+	if err != nil && !ociutil.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete the console history %s", *status.ConsoleHistoryId)
+	}
+	status.ConsoleHistoryId = nil
+	return nil
+}
+
+func (m *MachineScope) getConsoleHistoryCaptureReason(instance *core.Instance) infrastructurev1beta2.ConsoleHistoryCaptureReason {
+	if _, ok := m.OCIMachine.Annotations[infrastructurev1beta2.CaptureConsoleHistoryAnnotation]; ok {
+		return infrastructurev1beta2.ConsoleHistoryCaptureRequested
+	}
+	capture := m.OCIMachine.Spec.ConsoleHistoryCapture
+	if capture == nil {
+		return ""
+	}
+	switch instance.LifecycleState {
+	case core.InstanceLifecycleStateTerminating, core.InstanceLifecycleStateTerminated:
+		return ""
+	}
+	// the console history is captured automatically only once for an instance
+	status := m.OCIMachine.Status.ConsoleHistory
+	if status != nil && ociutil.DerefString(status.InstanceId) == ociutil.DerefString(instance.Id) &&
+		status.Reason != infrastructurev1beta2.ConsoleHistoryCaptureRequested {
+		return ""
+	}
+	if capture.OnFailure && m.isMachineFailed() {
+		return infrastructurev1beta2.ConsoleHistoryCaptureMachineFailed
+	}
+	if deadline := m.getBootstrapDeadline(instance); deadline != nil && !time.Now().Before(*deadline) {
+		return infrastructurev1beta2.ConsoleHistoryCaptureBootstrapTimeout
+	}
+	return ""
+}
+
+// isMachineFailed returns whether the machine failed or a MachineHealthCheck marked it unhealthy. The instance of an
+// unhealthy machine still runs until the machine is remediated, which is when its console history can be captured.
+func (m *MachineScope) isMachineFailed() bool {
+	if m.OCIMachine.Status.FailureReason != nil || m.Machine.Status.FailureReason != nil {
+		return true
+	}
+	return conditions.IsFalse(m.Machine, clusterv1.MachineHealthCheckSucceededCondition)
+}
+
+// getBootstrapDeadline returns when the bootstrap of the running instance times out, or nil when the node has
+// joined the cluster or the spec does not define a bootstrap timeout.
+func (m *MachineScope) getBootstrapDeadline(instance *core.Instance) *time.Time {
+	capture := m.OCIMachine.Spec.ConsoleHistoryCapture
+	if capture == nil || capture.BootstrapTimeout == nil || m.Machine.Status.NodeRef != nil {
+		return nil
+	}
+	if instance.LifecycleState != core.InstanceLifecycleStateRunning || instance.TimeCreated == nil {
+		return nil
+	}
+	deadline := instance.TimeCreated.Add(capture.BootstrapTimeout.Duration)
+	return &deadline
+}
+
+// storeConsoleHistory stores the console history captured by OCI in the Secret and deletes it from OCI.
+func (m *MachineScope) storeConsoleHistory(ctx context.Context, status *infrastructurev1beta2.ConsoleHistoryStatus) error {
+	resp, err := m.ComputeClient.GetConsoleHistory(ctx, core.GetConsoleHistoryRequest{
+		InstanceConsoleHistoryId: status.ConsoleHistoryId,
+	})
+	if err != nil {
+		if ociutil.IsNotFound(err) {
+			status.ConsoleHistoryId = nil
+			return errors.New("the console history was deleted before it was stored")
+		}
+		return errors.Wrapf(err, "failed to get the console history %s", *status.ConsoleHistoryId)
+	}
+	switch resp.LifecycleState {
+	case core.ConsoleHistoryLifecycleStateSucceeded:
+	case core.ConsoleHistoryLifecycleStateFailed:
+		if err := m.DeleteConsoleHistory(ctx); err != nil {
+			return err
+		}
+		return errors.New("OCI failed to capture the console history")
+	default:
+		return ErrConsoleHistoryNotCaptured
+	}
+
+	content, err := m.getConsoleHistoryContent(ctx, status.ConsoleHistoryId)
+	if err != nil {
+		return err
+	}
+	secretName := fmt.Sprintf("%s-console-history", m.OCIMachine.Name)
+	if err := m.writeMachineSecret(ctx, secretName, map[string][]byte{
+		ConsoleHistorySecretKey: []byte(content),
+	}); err != nil {
+		return err
+	}
+	if err := m.DeleteConsoleHistory(ctx); err != nil {
+		return err
+	}
+	now := metav1.Now()
+	status.SecretName = secretName
+	status.CaptureTime = &now
+	m.Logger.Info("Stored the console history of the instance", "secret", secretName, "size", len(content))
+	return nil
+}
+
+// getConsoleHistoryContent returns the end of the console history, capped at the max size of the spec.
+func (m *MachineScope) getConsoleHistoryContent(ctx context.Context, consoleHistoryId *string) (string, error) {
+	maxSize := defaultConsoleHistoryMaxSizeInBytes
+	if capture := m.OCIMachine.Spec.ConsoleHistoryCapture; capture != nil && capture.MaxSizeInBytes != nil {
+		maxSize = *capture.MaxSizeInBytes
+	}
+	req := core.GetConsoleHistoryContentRequest{
+		InstanceConsoleHistoryId: consoleHistoryId,
+		Length:                   common.Int(maxSize),
+	}
+	resp, err := m.ComputeClient.GetConsoleHistoryContent(ctx, req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get the content of the console history %s", *consoleHistoryId)
+	}
+	// the console history is longer than the max size, the bytes which were not read are at its start
+	if resp.OpcBytesRemaining != nil && *resp.OpcBytesRemaining > 0 {
+		req.Offset = resp.OpcBytesRemaining
+		resp, err = m.ComputeClient.GetConsoleHistoryContent(ctx, req)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get the content of the console history %s", *consoleHistoryId)
+		}
+	}
+	return ociutil.DerefString(resp.Value), nil
+}
@@ -0,0 +1,286 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute/mock_compute"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/klogr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileConsoleHistory(t *testing.T) {
+	launched := common.SDKTime{Time: time.Now().Add(-time.Hour)}
+	failed := capierrors.CreateMachineError
+
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		capture        *infrastructurev1beta2.ConsoleHistoryCapture
+		status         *infrastructurev1beta2.ConsoleHistoryStatus
+		failureReason  *capierrors.MachineStatusError
+		nodeRef        *corev1.ObjectReference
+		machineConds   clusterv1.Conditions
+		setup          func(computeClient *mock_compute.MockComputeClient)
+		errorExpected  bool
+		notCaptured    bool
+		expectedReason infrastructurev1beta2.ConsoleHistoryCaptureReason
+		expectedSecret string
+	}{
+		{
+			name: "no capture",
+		},
+		{
+			name:        "capture requested with the annotation",
+			annotations: map[string]string{infrastructurev1beta2.CaptureConsoleHistoryAnnotation: ""},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().CaptureConsoleHistory(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req core.CaptureConsoleHistoryRequest) (core.CaptureConsoleHistoryResponse, error) {
+						if *req.InstanceId != "instance" || *req.DisplayName != "machine" {
+							return core.CaptureConsoleHistoryResponse{}, errors.New("unexpected request")
+						}
+						return core.CaptureConsoleHistoryResponse{ConsoleHistory: core.ConsoleHistory{Id: common.String("history")}}, nil
+					})
+			},
+			errorExpected:  true,
+			notCaptured:    true,
+			expectedReason: infrastructurev1beta2.ConsoleHistoryCaptureRequested,
+		},
+		{
+			name:    "capture when the bootstrap times out",
+			capture: &infrastructurev1beta2.ConsoleHistoryCapture{BootstrapTimeout: &metav1.Duration{Duration: 10 * time.Minute}},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().CaptureConsoleHistory(gomock.Any(), gomock.Any()).
+					Return(core.CaptureConsoleHistoryResponse{ConsoleHistory: core.ConsoleHistory{Id: common.String("history")}}, nil)
+			},
+			errorExpected:  true,
+			notCaptured:    true,
+			expectedReason: infrastructurev1beta2.ConsoleHistoryCaptureBootstrapTimeout,
+		},
+		{
+			name:    "no capture before the bootstrap times out",
+			capture: &infrastructurev1beta2.ConsoleHistoryCapture{BootstrapTimeout: &metav1.Duration{Duration: 2 * time.Hour}},
+		},
+		{
+			name:    "no capture when the node joined the cluster",
+			capture: &infrastructurev1beta2.ConsoleHistoryCapture{BootstrapTimeout: &metav1.Duration{Duration: 10 * time.Minute}},
+			nodeRef: &corev1.ObjectReference{Name: "node"},
+		},
+		{
+			name:    "no capture when the instance was captured automatically",
+			capture: &infrastructurev1beta2.ConsoleHistoryCapture{BootstrapTimeout: &metav1.Duration{Duration: 10 * time.Minute}},
+			status: &infrastructurev1beta2.ConsoleHistoryStatus{
+				InstanceId: common.String("instance"),
+				Reason:     infrastructurev1beta2.ConsoleHistoryCaptureBootstrapTimeout,
+				SecretName: "machine-console-history",
+			},
+			expectedReason: infrastructurev1beta2.ConsoleHistoryCaptureBootstrapTimeout,
+		},
+		{
+			name:          "capture when the machine fails",
+			capture:       &infrastructurev1beta2.ConsoleHistoryCapture{OnFailure: true},
+			failureReason: &failed,
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().CaptureConsoleHistory(gomock.Any(), gomock.Any()).
+					Return(core.CaptureConsoleHistoryResponse{ConsoleHistory: core.ConsoleHistory{Id: common.String("history")}}, nil)
+			},
+			errorExpected:  true,
+			notCaptured:    true,
+			expectedReason: infrastructurev1beta2.ConsoleHistoryCaptureMachineFailed,
+		},
+		{
+			name:    "capture when the machine is marked unhealthy",
+			capture: &infrastructurev1beta2.ConsoleHistoryCapture{OnFailure: true},
+			machineConds: clusterv1.Conditions{
+				*conditions.FalseCondition(clusterv1.MachineHealthCheckSucceededCondition, clusterv1.UnhealthyNodeConditionReason, clusterv1.ConditionSeverityWarning, ""),
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().CaptureConsoleHistory(gomock.Any(), gomock.Any()).
+					Return(core.CaptureConsoleHistoryResponse{ConsoleHistory: core.ConsoleHistory{Id: common.String("history")}}, nil)
+			},
+			errorExpected:  true,
+			notCaptured:    true,
+			expectedReason: infrastructurev1beta2.ConsoleHistoryCaptureMachineFailed,
+		},
+		{
+			name:    "no capture of a healthy machine",
+			capture: &infrastructurev1beta2.ConsoleHistoryCapture{OnFailure: true},
+			machineConds: clusterv1.Conditions{
+				*conditions.TrueCondition(clusterv1.MachineHealthCheckSucceededCondition),
+			},
+		},
+		{
+			name: "console history being captured",
+			status: &infrastructurev1beta2.ConsoleHistoryStatus{
+				ConsoleHistoryId: common.String("history"),
+				Reason:           infrastructurev1beta2.ConsoleHistoryCaptureRequested,
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().GetConsoleHistory(gomock.Any(), gomock.Eq(core.GetConsoleHistoryRequest{
+					InstanceConsoleHistoryId: common.String("history"),
+				})).Return(core.GetConsoleHistoryResponse{ConsoleHistory: core.ConsoleHistory{
+					LifecycleState: core.ConsoleHistoryLifecycleStateGettingHistory,
+				}}, nil)
+			},
+			errorExpected:  true,
+			notCaptured:    true,
+			expectedReason: infrastructurev1beta2.ConsoleHistoryCaptureRequested,
+		},
+		{
+			name:    "end of the console history stored in the secret",
+			capture: &infrastructurev1beta2.ConsoleHistoryCapture{MaxSizeInBytes: common.Int(1024)},
+			status: &infrastructurev1beta2.ConsoleHistoryStatus{
+				ConsoleHistoryId: common.String("history"),
+				Reason:           infrastructurev1beta2.ConsoleHistoryCaptureRequested,
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().GetConsoleHistory(gomock.Any(), gomock.Any()).
+					Return(core.GetConsoleHistoryResponse{ConsoleHistory: core.ConsoleHistory{
+						LifecycleState: core.ConsoleHistoryLifecycleStateSucceeded,
+					}}, nil)
+				computeClient.EXPECT().GetConsoleHistoryContent(gomock.Any(), gomock.Eq(core.GetConsoleHistoryContentRequest{
+					InstanceConsoleHistoryId: common.String("history"),
+					Length:                   common.Int(1024),
+				})).Return(core.GetConsoleHistoryContentResponse{
+					Value:             common.String("start"),
+					OpcBytesRemaining: common.Int(100),
+				}, nil)
+				computeClient.EXPECT().GetConsoleHistoryContent(gomock.Any(), gomock.Eq(core.GetConsoleHistoryContentRequest{
+					InstanceConsoleHistoryId: common.String("history"),
+					Offset:                   common.Int(100),
+					Length:                   common.Int(1024),
+				})).Return(core.GetConsoleHistoryContentResponse{Value: common.String("end")}, nil)
+				computeClient.EXPECT().DeleteConsoleHistory(gomock.Any(), gomock.Eq(core.DeleteConsoleHistoryRequest{
+					InstanceConsoleHistoryId: common.String("history"),
+				})).Return(core.DeleteConsoleHistoryResponse{}, nil)
+			},
+			expectedReason: infrastructurev1beta2.ConsoleHistoryCaptureRequested,
+			expectedSecret: "end",
+		},
+		{
+			name: "console history which could not be captured",
+			status: &infrastructurev1beta2.ConsoleHistoryStatus{
+				ConsoleHistoryId: common.String("history"),
+				Reason:           infrastructurev1beta2.ConsoleHistoryCaptureRequested,
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().GetConsoleHistory(gomock.Any(), gomock.Any()).
+					Return(core.GetConsoleHistoryResponse{ConsoleHistory: core.ConsoleHistory{
+						LifecycleState: core.ConsoleHistoryLifecycleStateFailed,
+					}}, nil)
+				computeClient.EXPECT().DeleteConsoleHistory(gomock.Any(), gomock.Any()).
+					Return(core.DeleteConsoleHistoryResponse{}, nil)
+			},
+			errorExpected:  true,
+			expectedReason: infrastructurev1beta2.ConsoleHistoryCaptureRequested,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			computeClient := mock_compute.NewMockComputeClient(mockCtrl)
+			if tc.setup != nil {
+				tc.setup(computeClient)
+			}
+			log := klogr.New()
+			ms := &MachineScope{
+				Logger:  &log,
+				Client:  fake.NewClientBuilder().Build(),
+				Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}},
+				Machine: &clusterv1.Machine{Status: clusterv1.MachineStatus{NodeRef: tc.nodeRef, Conditions: tc.machineConds}},
+				OCIMachine: &infrastructurev1beta2.OCIMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default", Annotations: tc.annotations},
+					Spec:       infrastructurev1beta2.OCIMachineSpec{ConsoleHistoryCapture: tc.capture},
+					Status: infrastructurev1beta2.OCIMachineStatus{
+						ConsoleHistory: tc.status,
+						FailureReason:  tc.failureReason,
+					},
+				},
+				ComputeClient:      computeClient,
+				OCIClusterAccessor: OCISelfManagedCluster{OCICluster: &infrastructurev1beta2.OCICluster{}},
+			}
+			instance := &core.Instance{
+				Id:             common.String("instance"),
+				LifecycleState: core.InstanceLifecycleStateRunning,
+				TimeCreated:    &launched,
+			}
+
+			err := ms.ReconcileConsoleHistory(context.Background(), instance)
+			if tc.errorExpected {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(errors.Is(err, ErrConsoleHistoryNotCaptured)).To(Equal(tc.notCaptured))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(ms.OCIMachine.Annotations).NotTo(HaveKey(infrastructurev1beta2.CaptureConsoleHistoryAnnotation))
+			if tc.expectedReason == "" {
+				g.Expect(ms.OCIMachine.Status.ConsoleHistory).To(BeNil())
+			} else {
+				g.Expect(ms.OCIMachine.Status.ConsoleHistory.Reason).To(Equal(tc.expectedReason))
+			}
+			if tc.expectedSecret != "" {
+				secret := &corev1.Secret{}
+				g.Expect(ms.Client.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "machine-console-history"}, secret)).To(Succeed())
+				g.Expect(string(secret.Data[ConsoleHistorySecretKey])).To(Equal(tc.expectedSecret))
+				g.Expect(secret.OwnerReferences).To(HaveLen(1))
+				g.Expect(ms.OCIMachine.Status.ConsoleHistory.SecretName).To(Equal("machine-console-history"))
+				g.Expect(ms.OCIMachine.Status.ConsoleHistory.ConsoleHistoryId).To(BeNil())
+				g.Expect(ms.OCIMachine.Status.ConsoleHistory.CaptureTime).NotTo(BeNil())
+			}
+		})
+	}
+}
+
+func TestGetBootstrapTimeoutRequeue(t *testing.T) {
+	g := NewWithT(t)
+	launched := common.SDKTime{Time: time.Now().Add(-time.Hour)}
+	ms := &MachineScope{
+		Machine: &clusterv1.Machine{},
+		OCIMachine: &infrastructurev1beta2.OCIMachine{
+			Spec: infrastructurev1beta2.OCIMachineSpec{
+				ConsoleHistoryCapture: &infrastructurev1beta2.ConsoleHistoryCapture{
+					BootstrapTimeout: &metav1.Duration{Duration: 2 * time.Hour},
+				},
+			},
+		},
+	}
+	instance := &core.Instance{LifecycleState: core.InstanceLifecycleStateRunning, TimeCreated: &launched}
+
+	requeueAfter := ms.GetBootstrapTimeoutRequeue(instance)
+	g.Expect(requeueAfter).To(BeNumerically(">", 59*time.Minute))
+	g.Expect(requeueAfter).To(BeNumerically("<=", time.Hour))
+
+	ms.OCIMachine.Spec.ConsoleHistoryCapture.BootstrapTimeout.Duration = 10 * time.Minute
+	g.Expect(ms.GetBootstrapTimeoutRequeue(instance)).To(BeZero())
+}
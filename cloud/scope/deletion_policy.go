@@ -0,0 +1,260 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/pkg/errors"
+)
+
+// isVCNRetained returns true if the VCN, and the gateways, route tables and security lists in it, are retained
+// when the cluster is deleted.
+func (s *ClusterScope) isVCNRetained() bool {
+	return s.OCIClusterAccessor.GetNetworkSpec().Vcn.DeletionPolicy == infrastructurev1beta2.DeletionPolicyRetain
+}
+
+// isSubnetRetained returns true if the subnet is retained when the cluster is deleted, subnets without a
+// deletion policy follow the VCN.
+func (s *ClusterScope) isSubnetRetained(subnet infrastructurev1beta2.Subnet) bool {
+	if subnet.DeletionPolicy == "" {
+		return s.isVCNRetained()
+	}
+	return subnet.DeletionPolicy == infrastructurev1beta2.DeletionPolicyRetain
+}
+
+// isNSGRetained returns true if the NSG is retained when the cluster is deleted, NSGs without a deletion policy
+// follow the VCN.
+func (s *ClusterScope) isNSGRetained(nsg infrastructurev1beta2.NSG) bool {
+	if nsg.DeletionPolicy == "" {
+		return s.isVCNRetained()
+	}
+	return nsg.DeletionPolicy == infrastructurev1beta2.DeletionPolicyRetain
+}
+
+// isDRGRetained returns true if the DRG is retained when the cluster is deleted.
+func (s *ClusterScope) isDRGRetained() bool {
+	return s.getDRG() != nil && s.getDRG().DeletionPolicy == infrastructurev1beta2.DeletionPolicyRetain
+}
+
+// isDRGVCNAttachmentRetained returns true if the attachment of the VCN to the DRG is retained when the cluster is
+// deleted, which is the case if the VCN is retained and the DRG is either retained or not managed by the cluster.
+func (s *ClusterScope) isDRGVCNAttachmentRetained() bool {
+	drg := s.getDRG()
+	if drg == nil {
+		return false
+	}
+	return s.isVCNRetained() && (!drg.Manage || drg.DeletionPolicy == infrastructurev1beta2.DeletionPolicyRetain)
+}
+
+// isAPIServerLBRetained returns true if the API server load balancer is retained when the cluster is deleted.
+func (s *ClusterScope) isAPIServerLBRetained() bool {
+	return s.OCIClusterAccessor.GetNetworkSpec().APIServerLB.DeletionPolicy == infrastructurev1beta2.DeletionPolicyRetain
+}
+
+func (s *ClusterScope) retainVCN(ctx context.Context, vcn *core.Vcn) error {
+	_, err := s.VCNClient.UpdateVcn(ctx, core.UpdateVcnRequest{
+		VcnId: vcn.Id,
+		UpdateVcnDetails: core.UpdateVcnDetails{
+			FreeformTags: ociutil.BuildRetainedTags(vcn.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain vcn")
+		return errors.Wrap(err, "failed to retain vcn")
+	}
+	s.Logger.Info("Retained VCN", "vcn", vcn.Id)
+	return nil
+}
+
+func (s *ClusterScope) retainSubnet(ctx context.Context, subnet *core.Subnet) error {
+	_, err := s.VCNClient.UpdateSubnet(ctx, core.UpdateSubnetRequest{
+		SubnetId: subnet.Id,
+		UpdateSubnetDetails: core.UpdateSubnetDetails{
+			FreeformTags: ociutil.BuildRetainedTags(subnet.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain subnet")
+		return errors.Wrap(err, "failed to retain subnet")
+	}
+	s.Logger.Info("Retained subnet", "subnet", subnet.Id)
+	return nil
+}
+
+func (s *ClusterScope) retainNSG(ctx context.Context, nsg *core.NetworkSecurityGroup) error {
+	_, err := s.VCNClient.UpdateNetworkSecurityGroup(ctx, core.UpdateNetworkSecurityGroupRequest{
+		NetworkSecurityGroupId: nsg.Id,
+		UpdateNetworkSecurityGroupDetails: core.UpdateNetworkSecurityGroupDetails{
+			FreeformTags: ociutil.BuildRetainedTags(nsg.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain nsg")
+		return errors.Wrap(err, "failed to retain nsg")
+	}
+	s.Logger.Info("Retained nsg", "nsg", nsg.Id)
+	return nil
+}
+
+func (s *ClusterScope) retainInternetGateway(ctx context.Context, igw *core.InternetGateway) error {
+	_, err := s.VCNClient.UpdateInternetGateway(ctx, core.UpdateInternetGatewayRequest{
+		IgId: igw.Id,
+		UpdateInternetGatewayDetails: core.UpdateInternetGatewayDetails{
+			FreeformTags: ociutil.BuildRetainedTags(igw.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain InternetGateway")
+		return errors.Wrap(err, "failed to retain InternetGateway")
+	}
+	s.Logger.Info("Retained InternetGateway", "igw", igw.Id)
+	return nil
+}
+
+func (s *ClusterScope) retainNatGateway(ctx context.Context, ngw *core.NatGateway) error {
+	_, err := s.VCNClient.UpdateNatGateway(ctx, core.UpdateNatGatewayRequest{
+		NatGatewayId: ngw.Id,
+		UpdateNatGatewayDetails: core.UpdateNatGatewayDetails{
+			FreeformTags: ociutil.BuildRetainedTags(ngw.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain NatGateway")
+		return errors.Wrap(err, "failed to retain NatGateway")
+	}
+	s.Logger.Info("Retained NatGateway", "ngw", ngw.Id)
+	return nil
+}
+
+func (s *ClusterScope) retainServiceGateway(ctx context.Context, sgw *core.ServiceGateway) error {
+	_, err := s.VCNClient.UpdateServiceGateway(ctx, core.UpdateServiceGatewayRequest{
+		ServiceGatewayId: sgw.Id,
+		UpdateServiceGatewayDetails: core.UpdateServiceGatewayDetails{
+			FreeformTags: ociutil.BuildRetainedTags(sgw.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain ServiceGateway")
+		return errors.Wrap(err, "failed to retain ServiceGateway")
+	}
+	s.Logger.Info("Retained ServiceGateway", "sgw", sgw.Id)
+	return nil
+}
+
+func (s *ClusterScope) retainRouteTable(ctx context.Context, rt *core.RouteTable) error {
+	_, err := s.VCNClient.UpdateRouteTable(ctx, core.UpdateRouteTableRequest{
+		RtId: rt.Id,
+		UpdateRouteTableDetails: core.UpdateRouteTableDetails{
+			FreeformTags: ociutil.BuildRetainedTags(rt.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain route table")
+		return errors.Wrap(err, "failed to retain route table")
+	}
+	s.Logger.Info("Retained route table", "route-table", rt.Id)
+	return nil
+}
+
+func (s *ClusterScope) retainSecurityList(ctx context.Context, securityList *core.SecurityList) error {
+	_, err := s.VCNClient.UpdateSecurityList(ctx, core.UpdateSecurityListRequest{
+		SecurityListId: securityList.Id,
+		UpdateSecurityListDetails: core.UpdateSecurityListDetails{
+			FreeformTags: ociutil.BuildRetainedTags(securityList.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain security list")
+		return errors.Wrap(err, "failed to retain security list")
+	}
+	s.Logger.Info("Retained security list", "securityList", securityList.Id)
+	return nil
+}
+
+func (s *ClusterScope) retainDRG(ctx context.Context, drg *core.Drg) error {
+	_, err := s.VCNClient.UpdateDrg(ctx, core.UpdateDrgRequest{
+		DrgId: drg.Id,
+		UpdateDrgDetails: core.UpdateDrgDetails{
+			FreeformTags: ociutil.BuildRetainedTags(drg.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain drg")
+		return errors.Wrap(err, "failed to retain drg")
+	}
+	s.Logger.Info("Retained DRG", "drg", drg.Id)
+	return nil
+}
+
+func (s *ClusterScope) retainDRGAttachment(ctx context.Context, attachment *core.DrgAttachment) error {
+	_, err := s.VCNClient.UpdateDrgAttachment(ctx, core.UpdateDrgAttachmentRequest{
+		DrgAttachmentId: attachment.Id,
+		UpdateDrgAttachmentDetails: core.UpdateDrgAttachmentDetails{
+			FreeformTags: ociutil.BuildRetainedTags(attachment.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain drg vcn attachment")
+		return errors.Wrap(err, "failed to retain drg vcn attachment")
+	}
+	s.Logger.Info("Retained DRG VCN Attachment", "attachment", attachment.Id)
+	return nil
+}
+
+func (s *ClusterScope) retainApiServerLB(ctx context.Context, lb *loadbalancer.LoadBalancer) error {
+	lbResponse, err := s.LoadBalancerClient.UpdateLoadBalancer(ctx, loadbalancer.UpdateLoadBalancerRequest{
+		LoadBalancerId: lb.Id,
+		UpdateLoadBalancerDetails: loadbalancer.UpdateLoadBalancerDetails{
+			FreeformTags: ociutil.BuildRetainedTags(lb.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain apiserver lb")
+		return errors.Wrap(err, "failed to retain apiserver lb")
+	}
+	_, err = ociutil.AwaitLBWorkRequest(ctx, s.LoadBalancerClient, lbResponse.OpcWorkRequestId)
+	if err != nil {
+		return errors.Wrap(err, "work request to retain lb failed")
+	}
+	s.Logger.Info("Retained apiserver lb", "lb", lb.Id)
+	return nil
+}
+
+func (s *ClusterScope) retainApiServerNLB(ctx context.Context, nlb *networkloadbalancer.NetworkLoadBalancer) error {
+	nlbResponse, err := s.NetworkLoadBalancerClient.UpdateNetworkLoadBalancer(ctx, networkloadbalancer.UpdateNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: nlb.Id,
+		UpdateNetworkLoadBalancerDetails: networkloadbalancer.UpdateNetworkLoadBalancerDetails{
+			FreeformTags: ociutil.BuildRetainedTags(nlb.FreeformTags),
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to retain apiserver nlb")
+		return errors.Wrap(err, "failed to retain apiserver nlb")
+	}
+	_, err = ociutil.AwaitNLBWorkRequest(ctx, s.NetworkLoadBalancerClient, nlbResponse.OpcWorkRequestId)
+	if err != nil {
+		return errors.Wrap(err, "work request to retain nlb failed")
+	}
+	s.Logger.Info("Retained apiserver nlb", "nlb", nlb.Id)
+	return nil
+}
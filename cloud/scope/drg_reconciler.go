@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
@@ -46,8 +47,31 @@ func (s *ClusterScope) ReconcileDRG(ctx context.Context) error {
 	}
 	if drg != nil {
 		s.getDRG().ID = drg.Id
-		s.Logger.Info("No Reconciliation Required for DRG", "drg", drg.Id)
-		return nil
+		s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+			status.DRG = newNetworkResourceStatus(drg.Id, string(drg.LifecycleState))
+		})
+		if err := s.reconcileDRGTags(ctx, drg); err != nil {
+			return err
+		}
+		var drift driftDiff
+		drift.compare("displayName", s.GetDRGName(), drg.DisplayName)
+		if len(drift) == 0 {
+			s.Logger.Info("No Reconciliation Required for DRG", "drg", drg.Id)
+			return nil
+		}
+		return s.reconcileDrift(DriftResourceDRG, s.GetDRGName(), drg.Id, drift, func() error {
+			_, err := s.VCNClient.UpdateDrg(ctx, core.UpdateDrgRequest{
+				DrgId: drg.Id,
+				UpdateDrgDetails: core.UpdateDrgDetails{
+					DisplayName: common.String(s.GetDRGName()),
+				},
+			})
+			if err != nil {
+				s.Logger.Error(err, "failed to reconcile the drg, failed to update")
+				return errors.Wrap(err, "failed to reconcile the drg, failed to update")
+			}
+			return nil
+		})
 	}
 
 	drg, err = s.createDRG(ctx)
@@ -55,6 +79,9 @@ func (s *ClusterScope) ReconcileDRG(ctx context.Context) error {
 		return err
 	}
 	s.getDRG().ID = drg.Id
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		status.DRG = newNetworkResourceStatus(drg.Id, string(drg.LifecycleState))
+	})
 	s.Logger.Info("Successfully created DRG", "drg", *drg.Id)
 	return nil
 }
@@ -84,7 +111,7 @@ func (s *ClusterScope) GetDRG(ctx context.Context) (*core.Drg, error) {
 
 	for {
 		response, err := s.VCNClient.ListDrgs(ctx, core.ListDrgsRequest{
-			CompartmentId: common.String(s.GetCompartmentId()),
+			CompartmentId: common.String(s.GetNetworkCompartmentId()),
 			Page:          page,
 		})
 		if err != nil {
@@ -112,7 +139,7 @@ func (s *ClusterScope) GetDRG(ctx context.Context) (*core.Drg, error) {
 func (s *ClusterScope) createDRG(ctx context.Context) (*core.Drg, error) {
 	response, err := s.VCNClient.CreateDrg(ctx, core.CreateDrgRequest{
 		CreateDrgDetails: core.CreateDrgDetails{
-			CompartmentId: common.String(s.GetCompartmentId()),
+			CompartmentId: common.String(s.GetNetworkCompartmentId()),
 			FreeformTags:  s.GetFreeFormTags(),
 			DefinedTags:   s.GetDefinedTags(),
 			DisplayName:   common.String(s.GetDRGName()),
@@ -156,6 +183,9 @@ func (s *ClusterScope) DeleteDRG(ctx context.Context) error {
 		s.Logger.Info("DRG is already deleted")
 		return nil
 	}
+	if s.isDRGRetained() {
+		return s.retainDRG(ctx, drg)
+	}
 	_, err = s.VCNClient.DeleteDrg(ctx, core.DeleteDrgRequest{
 		DrgId: drg.Id,
 	})
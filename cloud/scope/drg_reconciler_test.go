@@ -156,6 +156,7 @@ func TestDRGReconciliation(t *testing.T) {
 					Return(core.GetDrgResponse{
 						Drg: core.Drg{
 							Id:           common.String("drg-id"),
+							DisplayName:  common.String("cluster"),
 							FreeformTags: tags,
 							DefinedTags:  make(map[string]map[string]interface{}),
 						},
@@ -20,6 +20,7 @@ import (
 	"context"
 	"time"
 
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
 	vcn "github.com/oracle/cluster-api-provider-oci/cloud/services/vcn"
 	"github.com/oracle/oci-go-sdk/v65/common"
@@ -48,7 +49,7 @@ func (s *ClusterScope) ReconcileDRGRPCAttachment(ctx context.Context) error {
 	}
 
 	for _, rpcSpec := range s.OCIClusterAccessor.GetNetworkSpec().VCNPeering.RemotePeeringConnections {
-		localRpc, err := s.lookupRPC(ctx, s.getDrgID(), rpcSpec.RPCConnectionId, s.VCNClient)
+		localRpc, err := s.lookupRPC(ctx, s.getDrgID(), rpcSpec.RPCConnectionId, s.GetNetworkCompartmentId(), s.VCNClient)
 		if err != nil {
 			return err
 		}
@@ -56,7 +57,7 @@ func (s *ClusterScope) ReconcileDRGRPCAttachment(ctx context.Context) error {
 			rpcSpec.RPCConnectionId = localRpc.Id
 			s.Logger.Info("Local RPC exists", "rpcId", localRpc.Id)
 		} else {
-			localRpc, err = s.createRPC(ctx, s.getDrgID(), s.OCIClusterAccessor.GetName(), s.VCNClient)
+			localRpc, err = s.createRPC(ctx, s.getDrgID(), s.OCIClusterAccessor.GetName(), s.GetNetworkCompartmentId(), s.GetDefinedTags(), s.VCNClient)
 			if err != nil {
 				return err
 			}
@@ -67,16 +68,17 @@ func (s *ClusterScope) ReconcileDRGRPCAttachment(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		s.setRPCStatus(rpcSpec.Name, localRpc)
 
 		if rpcSpec.PeerDRGId == nil {
 			return errors.New("peer DRG ID has not been specified")
 		}
 		if rpcSpec.ManagePeerRPC {
-			clientProvider, err := s.ClientProvider.GetOrBuildClient(rpcSpec.PeerRegionName)
+			peerVCNClient, peerCompartmentId, err := s.getPeerRPCTarget(rpcSpec)
 			if err != nil {
 				return err
 			}
-			remoteRpc, err := s.lookupRPC(ctx, rpcSpec.PeerDRGId, rpcSpec.PeerRPCConnectionId, clientProvider.VCNClient)
+			remoteRpc, err := s.lookupRPC(ctx, rpcSpec.PeerDRGId, rpcSpec.PeerRPCConnectionId, peerCompartmentId, peerVCNClient)
 			if err != nil {
 				return err
 			}
@@ -85,14 +87,14 @@ func (s *ClusterScope) ReconcileDRGRPCAttachment(ctx context.Context) error {
 				s.Logger.Info("Connection status of 2 peered RPCs", "status", localRpc.PeeringStatus)
 				rpcSpec.PeerRPCConnectionId = remoteRpc.Id
 			} else {
-				remoteRpc, err = s.createRPC(ctx, rpcSpec.PeerDRGId, s.OCIClusterAccessor.GetName(), clientProvider.VCNClient)
+				remoteRpc, err = s.createRPC(ctx, rpcSpec.PeerDRGId, s.OCIClusterAccessor.GetName(), peerCompartmentId, s.getPeerRPCDefinedTags(rpcSpec), peerVCNClient)
 				if err != nil {
 					return err
 				}
 				s.Logger.Info("Remote RPC has been created", "rpcId", remoteRpc.Id)
 				rpcSpec.PeerRPCConnectionId = remoteRpc.Id
 			}
-			err = s.waitForRPCToBeProvisioned(ctx, remoteRpc, clientProvider.VCNClient)
+			err = s.waitForRPCToBeProvisioned(ctx, remoteRpc, peerVCNClient)
 			if err != nil {
 				return err
 			}
@@ -121,6 +123,7 @@ func (s *ClusterScope) ReconcileDRGRPCAttachment(ctx context.Context) error {
 					return true, err
 				}
 				s.Logger.Info("RPC peering status", "rpcId", rpc.Id, "peeringStatus", localRpc.PeeringStatus)
+				s.setRPCStatus(rpcSpec.Name, rpc)
 				switch rpc.PeeringStatus {
 				case core.RemotePeeringConnectionPeeringStatusPeered:
 					return true, nil
@@ -141,14 +144,15 @@ func (s *ClusterScope) ReconcileDRGRPCAttachment(ctx context.Context) error {
 	return nil
 }
 
-func (s *ClusterScope) createRPC(ctx context.Context, drgId *string, displayName string, vcnClient vcn.Client) (*core.RemotePeeringConnection, error) {
+func (s *ClusterScope) createRPC(ctx context.Context, drgId *string, displayName string, compartmentId string,
+	definedTags map[string]map[string]interface{}, vcnClient vcn.Client) (*core.RemotePeeringConnection, error) {
 	response, err := vcnClient.CreateRemotePeeringConnection(ctx, core.CreateRemotePeeringConnectionRequest{
 		CreateRemotePeeringConnectionDetails: core.CreateRemotePeeringConnectionDetails{
 			DisplayName:   common.String(displayName),
 			DrgId:         drgId,
 			FreeformTags:  s.GetFreeFormTags(),
-			DefinedTags:   s.GetDefinedTags(),
-			CompartmentId: common.String(s.GetCompartmentId()),
+			DefinedTags:   definedTags,
+			CompartmentId: common.String(compartmentId),
 		},
 	})
 	if err != nil {
@@ -158,7 +162,7 @@ func (s *ClusterScope) createRPC(ctx context.Context, drgId *string, displayName
 	return &response.RemotePeeringConnection, nil
 }
 
-func (s *ClusterScope) lookupRPC(ctx context.Context, drgId *string, rpcId *string, vcnClient vcn.Client) (*core.RemotePeeringConnection, error) {
+func (s *ClusterScope) lookupRPC(ctx context.Context, drgId *string, rpcId *string, compartmentId string, vcnClient vcn.Client) (*core.RemotePeeringConnection, error) {
 	if rpcId != nil {
 		attachment, err := s.getRPC(ctx, rpcId, vcnClient)
 		if err != nil {
@@ -175,7 +179,7 @@ func (s *ClusterScope) lookupRPC(ctx context.Context, drgId *string, rpcId *stri
 			var page *string
 			response, err := vcnClient.ListRemotePeeringConnections(ctx, core.ListRemotePeeringConnectionsRequest{
 				DrgId:         drgId,
-				CompartmentId: common.String(s.GetCompartmentId()),
+				CompartmentId: common.String(compartmentId),
 				Page:          page,
 			})
 			if err != nil {
@@ -305,7 +309,7 @@ func (s *ClusterScope) DeleteDRGRPCAttachment(ctx context.Context) error {
 	}
 
 	for _, rpcSpec := range s.OCIClusterAccessor.GetNetworkSpec().VCNPeering.RemotePeeringConnections {
-		localRpc, err := s.lookupRPC(ctx, s.getDrgID(), rpcSpec.RPCConnectionId, s.VCNClient)
+		localRpc, err := s.lookupRPC(ctx, s.getDrgID(), rpcSpec.RPCConnectionId, s.GetNetworkCompartmentId(), s.VCNClient)
 		if err != nil && !ociutil.IsNotFound(err) {
 			return err
 		}
@@ -320,11 +324,11 @@ func (s *ClusterScope) DeleteDRGRPCAttachment(ctx context.Context) error {
 			s.Logger.Info("Local RPC has been deleted", "rpcId", localRpc.Id)
 		}
 		if rpcSpec.ManagePeerRPC {
-			clientProvider, err := s.ClientProvider.GetOrBuildClient(rpcSpec.PeerRegionName)
+			peerVCNClient, peerCompartmentId, err := s.getPeerRPCTarget(rpcSpec)
 			if err != nil {
 				return err
 			}
-			remoteRpc, err := s.lookupRPC(ctx, rpcSpec.PeerDRGId, rpcSpec.PeerRPCConnectionId, clientProvider.VCNClient)
+			remoteRpc, err := s.lookupRPC(ctx, rpcSpec.PeerDRGId, rpcSpec.PeerRPCConnectionId, peerCompartmentId, peerVCNClient)
 			if err != nil && !ociutil.IsNotFound(err) {
 				return err
 			}
@@ -332,7 +336,7 @@ func (s *ClusterScope) DeleteDRGRPCAttachment(ctx context.Context) error {
 				s.Logger.Info("Remote RPC is already deleted")
 				return nil
 			} else {
-				err := s.deleteRPC(ctx, remoteRpc.Id, clientProvider.VCNClient)
+				err := s.deleteRPC(ctx, remoteRpc.Id, peerVCNClient)
 				if err != nil {
 					return err
 				}
@@ -342,3 +346,50 @@ func (s *ClusterScope) DeleteDRGRPCAttachment(ctx context.Context) error {
 	}
 	return nil
 }
+
+// getPeerRPCTarget returns the VCN client and the compartment used to manage the peer RPC of the connection.
+// If the peer DRG is in another tenancy, the client is built from the peer identity of the connection.
+func (s *ClusterScope) getPeerRPCTarget(rpcSpec infrastructurev1beta2.RemotePeeringConnection) (vcn.Client, string, error) {
+	if rpcSpec.PeerIdentityRef == nil {
+		clients, err := s.ClientProvider.GetOrBuildClient(rpcSpec.PeerRegionName)
+		if err != nil {
+			return nil, "", err
+		}
+		return s.peerVCNClient(clients.VCNClient), s.GetNetworkCompartmentId(), nil
+	}
+	clientProvider, ok := s.PeerClientProviders[rpcSpec.Name]
+	if !ok || clientProvider == nil {
+		return nil, "", errors.Errorf("client provider for the peer identity of RPC %s has not been initialized", rpcSpec.Name)
+	}
+	clients, err := clientProvider.GetOrBuildClient(rpcSpec.PeerRegionName)
+	if err != nil {
+		return nil, "", err
+	}
+	return s.peerVCNClient(clients.VCNClient), rpcSpec.PeerCompartmentId, nil
+}
+
+// peerVCNClient returns the VCN client of the peer, which records the operations in plan mode.
+func (s *ClusterScope) peerVCNClient(client vcn.Client) vcn.Client {
+	if s.PlanRecorder != nil {
+		return s.PlanRecorder.NewVCNClient(client)
+	}
+	return client
+}
+
+// getPeerRPCDefinedTags returns the defined tags of the peer RPC. Tag namespaces are local to a tenancy,
+// hence the defined tags of the cluster are not applied to a peer RPC in another tenancy.
+func (s *ClusterScope) getPeerRPCDefinedTags(rpcSpec infrastructurev1beta2.RemotePeeringConnection) map[string]map[string]interface{} {
+	if rpcSpec.PeerIdentityRef != nil {
+		return make(map[string]map[string]interface{})
+	}
+	return s.GetDefinedTags()
+}
+
+func (s *ClusterScope) setRPCStatus(name string, rpc *core.RemotePeeringConnection) {
+	s.OCIClusterAccessor.SetRemotePeeringConnectionStatus(infrastructurev1beta2.RemotePeeringConnectionStatus{
+		Name:                  name,
+		RPCConnectionId:       rpc.Id,
+		PeeringStatus:         string(rpc.PeeringStatus),
+		IsCrossTenancyPeering: rpc.IsCrossTenancyPeering != nil && *rpc.IsCrossTenancyPeering,
+	})
+}
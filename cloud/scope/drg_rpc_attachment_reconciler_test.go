@@ -28,6 +28,7 @@ import (
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn/mock_vcn"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -95,6 +96,7 @@ func TestDRGRPCAttachmentReconciliation(t *testing.T) {
 		eventNotExpected    string
 		matchError          error
 		errorSubStringMatch bool
+		expectedRPCStatus   []infrastructurev1beta2.RemotePeeringConnectionStatus
 		testSpecificSetup   func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient)
 	}{
 		{
@@ -261,6 +263,142 @@ func TestDRGRPCAttachmentReconciliation(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name:          "create cross tenancy remote rpc",
+			errorExpected: false,
+			expectedRPCStatus: []infrastructurev1beta2.RemotePeeringConnectionStatus{
+				{
+					Name:                  "cross-tenancy",
+					RPCConnectionId:       common.String("local-connection-id"),
+					PeeringStatus:         string(core.RemotePeeringConnectionPeeringStatusPeered),
+					IsCrossTenancyPeering: true,
+				},
+			},
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+				crossTenancyVcnClient := mock_vcn.NewMockClient(mockCtrl)
+				crossTenancyProvider, err := MockNewClientProvider(MockOCIClients{
+					VCNClient: crossTenancyVcnClient,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				clusterScope.PeerClientProviders = map[string]*ClientProvider{
+					"cross-tenancy": crossTenancyProvider,
+				}
+				vcnPeering.DRG = &infrastructurev1beta2.DRG{}
+				vcnPeering.DRG.ID = common.String("drg-id")
+				vcnPeering.RemotePeeringConnections = []infrastructurev1beta2.RemotePeeringConnection{
+					{
+						Name:              "cross-tenancy",
+						ManagePeerRPC:     true,
+						PeerDRGId:         common.String("peer-drg-id"),
+						PeerRegionName:    MockTestRegion,
+						PeerIdentityRef:   &corev1.ObjectReference{Name: "peer-identity", Namespace: "default"},
+						PeerCompartmentId: "peer-compartment-id",
+					},
+				}
+				ociClusterAccessor.OCICluster.Spec.NetworkSpec.VCNPeering = &vcnPeering
+				ociClusterAccessor.OCICluster.Spec.DefinedTags = map[string]map[string]string{
+					"ns": {"key": "value"},
+				}
+				vcnClient.EXPECT().ListRemotePeeringConnections(gomock.Any(), gomock.Eq(core.ListRemotePeeringConnectionsRequest{
+					DrgId:         common.String("drg-id"),
+					CompartmentId: common.String("compartment-id"),
+				})).
+					Return(core.ListRemotePeeringConnectionsResponse{}, nil)
+				vcnClient.EXPECT().CreateRemotePeeringConnection(gomock.Any(), gomock.Eq(core.CreateRemotePeeringConnectionRequest{
+					CreateRemotePeeringConnectionDetails: core.CreateRemotePeeringConnectionDetails{
+						DrgId:         common.String("drg-id"),
+						CompartmentId: common.String("compartment-id"),
+						DisplayName:   common.String("cluster"),
+						FreeformTags:  tags,
+						DefinedTags:   map[string]map[string]interface{}{"ns": {"key": "value"}},
+					},
+				})).
+					Return(core.CreateRemotePeeringConnectionResponse{
+						RemotePeeringConnection: core.RemotePeeringConnection{
+							Id:             common.String("local-connection-id"),
+							LifecycleState: core.RemotePeeringConnectionLifecycleStateAvailable,
+							PeeringStatus:  core.RemotePeeringConnectionPeeringStatusNew,
+						},
+					}, nil)
+
+				crossTenancyVcnClient.EXPECT().ListRemotePeeringConnections(gomock.Any(), gomock.Eq(core.ListRemotePeeringConnectionsRequest{
+					DrgId:         common.String("peer-drg-id"),
+					CompartmentId: common.String("peer-compartment-id"),
+				})).
+					Return(core.ListRemotePeeringConnectionsResponse{}, nil)
+				crossTenancyVcnClient.EXPECT().CreateRemotePeeringConnection(gomock.Any(), gomock.Eq(core.CreateRemotePeeringConnectionRequest{
+					CreateRemotePeeringConnectionDetails: core.CreateRemotePeeringConnectionDetails{
+						DrgId:         common.String("peer-drg-id"),
+						CompartmentId: common.String("peer-compartment-id"),
+						DisplayName:   common.String("cluster"),
+						FreeformTags:  tags,
+						DefinedTags:   make(map[string]map[string]interface{}),
+					},
+				})).
+					Return(core.CreateRemotePeeringConnectionResponse{
+						RemotePeeringConnection: core.RemotePeeringConnection{
+							Id:             common.String("peer-connection-id"),
+							LifecycleState: core.RemotePeeringConnectionLifecycleStateAvailable,
+						},
+					}, nil)
+
+				vcnClient.EXPECT().ConnectRemotePeeringConnections(gomock.Any(), gomock.Eq(core.ConnectRemotePeeringConnectionsRequest{
+					RemotePeeringConnectionId: common.String("local-connection-id"),
+					ConnectRemotePeeringConnectionsDetails: core.ConnectRemotePeeringConnectionsDetails{
+						PeerId:         common.String("peer-connection-id"),
+						PeerRegionName: common.String(MockTestRegion),
+					},
+				})).
+					Return(core.ConnectRemotePeeringConnectionsResponse{}, nil)
+				vcnClient.EXPECT().GetRemotePeeringConnection(gomock.Any(), gomock.Eq(core.GetRemotePeeringConnectionRequest{
+					RemotePeeringConnectionId: common.String("local-connection-id"),
+				})).
+					Return(core.GetRemotePeeringConnectionResponse{
+						RemotePeeringConnection: core.RemotePeeringConnection{
+							Id:                    common.String("local-connection-id"),
+							PeeringStatus:         core.RemotePeeringConnectionPeeringStatusPeered,
+							IsCrossTenancyPeering: common.Bool(true),
+						},
+					}, nil)
+			},
+		},
+		{
+			name:                "peer identity client provider not initialized",
+			errorExpected:       true,
+			errorSubStringMatch: true,
+			matchError:          errors.New("client provider for the peer identity of RPC cross-tenancy has not been initialized"),
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+				vcnPeering.DRG = &infrastructurev1beta2.DRG{}
+				vcnPeering.DRG.ID = common.String("drg-id")
+				vcnPeering.RemotePeeringConnections = []infrastructurev1beta2.RemotePeeringConnection{
+					{
+						Name:              "cross-tenancy",
+						ManagePeerRPC:     true,
+						PeerDRGId:         common.String("peer-drg-id"),
+						PeerRegionName:    MockTestRegion,
+						PeerIdentityRef:   &corev1.ObjectReference{Name: "peer-identity", Namespace: "default"},
+						PeerCompartmentId: "peer-compartment-id",
+					},
+				}
+				ociClusterAccessor.OCICluster.Spec.NetworkSpec.VCNPeering = &vcnPeering
+				vcnClient.EXPECT().ListRemotePeeringConnections(gomock.Any(), gomock.Eq(core.ListRemotePeeringConnectionsRequest{
+					DrgId:         common.String("drg-id"),
+					CompartmentId: common.String("compartment-id"),
+				})).
+					Return(core.ListRemotePeeringConnectionsResponse{
+						Items: []core.RemotePeeringConnection{
+							{
+								Id:             common.String("local-connection-id"),
+								DisplayName:    common.String("cluster"),
+								FreeformTags:   tags,
+								LifecycleState: core.RemotePeeringConnectionLifecycleStateAvailable,
+							},
+						},
+					}, nil)
+			},
+		},
 		{
 			name:                "drg not provided",
 			errorExpected:       true,
@@ -825,6 +963,9 @@ func TestDRGRPCAttachmentReconciliation(t *testing.T) {
 			} else {
 				g.Expect(err).To(BeNil())
 			}
+			if tc.expectedRPCStatus != nil {
+				g.Expect(ociClusterAccessor.OCICluster.Status.RemotePeeringConnections).To(Equal(tc.expectedRPCStatus))
+			}
 		})
 	}
 }
@@ -19,6 +19,7 @@ package scope
 import (
 	"context"
 
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
@@ -39,6 +40,9 @@ func (s *ClusterScope) ReconcileDRGVCNAttachment(ctx context.Context) error {
 
 	if attachment != nil {
 		s.getDRG().VcnAttachmentId = attachment.Id
+		s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+			status.DRGVCNAttachment = newNetworkResourceStatus(attachment.Id, string(attachment.LifecycleState))
+		})
 		s.Logger.Info("DRG already attached to VCN")
 		if err != nil {
 			return err
@@ -59,6 +63,9 @@ func (s *ClusterScope) ReconcileDRGVCNAttachment(ctx context.Context) error {
 		return err
 	}
 	s.getDRG().VcnAttachmentId = response.Id
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		status.DRGVCNAttachment = newNetworkResourceStatus(response.Id, string(response.LifecycleState))
+	})
 	s.Logger.Info("DRG has been attached", "attachmentId", response.Id)
 	return nil
 }
@@ -83,7 +90,7 @@ func (s *ClusterScope) GetDRGAttachment(ctx context.Context) (*core.DrgAttachmen
 		DisplayName:    common.String(s.OCIClusterAccessor.GetName()),
 		DrgId:          s.getDrgID(),
 		NetworkId:      s.OCIClusterAccessor.GetNetworkSpec().Vcn.ID,
-		CompartmentId:  common.String(s.GetCompartmentId()),
+		CompartmentId:  common.String(s.GetNetworkCompartmentId()),
 	})
 
 	if err != nil {
@@ -132,6 +139,9 @@ func (s *ClusterScope) DeleteDRGVCNAttachment(ctx context.Context) error {
 		s.Logger.Info("DRG VCN Attachment is already deleted")
 		return nil
 	}
+	if s.isDRGVCNAttachmentRetained() {
+		return s.retainDRGAttachment(ctx, attachment)
+	}
 	_, err = s.VCNClient.DeleteDrgAttachment(ctx, core.DeleteDrgAttachmentRequest{
 		DrgAttachmentId: attachment.Id,
 	})
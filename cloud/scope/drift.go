@@ -0,0 +1,158 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/plan"
+)
+
+// The resource types of the drift reported in the status of the cluster.
+const (
+	DriftResourceVCN                 = "vcn"
+	DriftResourceSubnet              = "subnet"
+	DriftResourceSecurityList        = "securityList"
+	DriftResourceNSG                 = "networkSecurityGroup"
+	DriftResourceNSGRules            = "networkSecurityGroupRules"
+	DriftResourceRouteTable          = "routeTable"
+	DriftResourceInternetGateway     = "internetGateway"
+	DriftResourceNATGateway          = "natGateway"
+	DriftResourceServiceGateway      = "serviceGateway"
+	DriftResourceDRG                 = "drg"
+	DriftResourceLoadBalancer        = "loadBalancer"
+	DriftResourceNetworkLoadBalancer = "networkLoadBalancer"
+)
+
+// driftDiff is the structured difference between the spec of a resource and the actual resource.
+type driftDiff []infrastructurev1beta2.FieldDrift
+
+// compare adds the field if the desired and the actual values differ.
+func (d *driftDiff) compare(field string, desired string, actual *string) {
+	var actualValue string
+	if actual != nil {
+		actualValue = *actual
+	}
+	if desired != actualValue {
+		*d = append(*d, infrastructurev1beta2.FieldDrift{Field: field, Desired: desired, Actual: actualValue})
+	}
+}
+
+// missing adds a value of the field, typically a rule, which is in the spec but not in the actual resource.
+func (d *driftDiff) missing(field string, desired interface{}) {
+	*d = append(*d, infrastructurev1beta2.FieldDrift{Field: field, Desired: driftValue(desired)})
+}
+
+// unexpected adds a value of the field, typically a rule, which is in the actual resource but not in the spec.
+func (d *driftDiff) unexpected(field string, actual interface{}) {
+	*d = append(*d, infrastructurev1beta2.FieldDrift{Field: field, Actual: driftValue(actual)})
+}
+
+// driftValue renders a value of a drifted field, the rules are rendered as JSON.
+func driftValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
+
+// joinIds renders a list of OCIDs or CIDR blocks in a stable order.
+func joinIds(ids []string) string {
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// containsString returns true if the list contains the value.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileDrift reports the drift of a network resource in the status of the cluster and, unless the drift
+// policy of the cluster is Report, calls enforce to update the resource to match the spec.
+func (s *ClusterScope) reconcileDrift(resourceType string, name string, id *string, drift driftDiff, enforce func() error) error {
+	return s.reconcileDriftOfMutableFields(resourceType, name, id, drift, nil, enforce)
+}
+
+// reconcileDriftOfMutableFields is reconcileDrift for a resource of which some fields can not be updated. The drift
+// of the immutable fields is reported but never corrected, enforce is only called if other fields drifted.
+func (s *ClusterScope) reconcileDriftOfMutableFields(resourceType string, name string, id *string, drift driftDiff, immutableFields []string, enforce func() error) error {
+	if len(drift) == 0 {
+		return nil
+	}
+	if s.OCIClusterAccessor.GetDriftPolicy() == infrastructurev1beta2.DriftPolicyReport {
+		s.Logger.Info("Drift detected, the resource is not updated as per the drift policy", "resourceType", resourceType, "name", name)
+		s.addResourceDrift(resourceType, name, id, drift, false)
+		return nil
+	}
+	mutable := 0
+	for _, field := range drift {
+		if !containsString(immutableFields, field.Field) {
+			mutable++
+		}
+	}
+	if mutable == 0 {
+		s.Logger.Info("Drift detected in fields which can not be updated", "resourceType", resourceType, "name", name)
+		s.addResourceDrift(resourceType, name, id, drift, false)
+		return nil
+	}
+	s.Logger.Info("Drift detected, updating the resource", "resourceType", resourceType, "name", name)
+	err := enforce()
+	if plan.IsPlanned(err) {
+		// the update is listed in the plan, the reconciliation goes on as the resource exists
+		s.addResourceDrift(resourceType, name, id, drift, false)
+		return nil
+	}
+	s.addResourceDrift(resourceType, name, id, drift, err == nil && mutable == len(drift))
+	return err
+}
+
+// addResourceDrift adds the drift to the status of the cluster, the network resources are reconciled
+// concurrently.
+func (s *ClusterScope) addResourceDrift(resourceType string, name string, id *string, drift driftDiff, corrected bool) {
+	sort.SliceStable(drift, func(i, j int) bool {
+		if drift[i].Field != drift[j].Field {
+			return drift[i].Field < drift[j].Field
+		}
+		if drift[i].Desired != drift[j].Desired {
+			return drift[i].Desired < drift[j].Desired
+		}
+		return drift[i].Actual < drift[j].Actual
+	})
+	s.driftLock.Lock()
+	defer s.driftLock.Unlock()
+	s.OCIClusterAccessor.AddResourceDrift(infrastructurev1beta2.ResourceDrift{
+		ResourceType: resourceType,
+		Name:         name,
+		ID:           id,
+		Fields:       drift,
+		Corrected:    corrected,
+	})
+}
@@ -0,0 +1,383 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn/mock_vcn"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestClusterScope_Drift(t *testing.T) {
+	tags := make(map[string]string)
+	tags[ociutil.CreatedBy] = ociutil.OCIClusterAPIProvider
+	tags[ociutil.ClusterResourceIdentifier] = "resource_uid"
+
+	vcnSpec := infrastructurev1beta2.NetworkSpec{
+		Vcn: infrastructurev1beta2.VCN{
+			ID:   common.String("vcn_id"),
+			Name: "foo1",
+		},
+	}
+	routeTableSpec := infrastructurev1beta2.NetworkSpec{
+		Vcn: infrastructurev1beta2.VCN{
+			ID: common.String("vcn_id"),
+			InternetGateway: infrastructurev1beta2.InternetGateway{
+				Id: common.String("igw"),
+			},
+			RouteTable: infrastructurev1beta2.RouteTable{
+				PublicRouteTableId: common.String("public"),
+			},
+			Subnets: []*infrastructurev1beta2.Subnet{
+				{
+					Type: infrastructurev1beta2.Public,
+					Role: infrastructurev1beta2.ControlPlaneRole,
+				},
+				{
+					Type: infrastructurev1beta2.Public,
+					Role: infrastructurev1beta2.WorkerRole,
+				},
+			},
+		},
+	}
+	expectGetVcn := func(vcnClient *mock_vcn.MockClient) {
+		vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{
+			VcnId: common.String("vcn_id"),
+		})).
+			Return(core.GetVcnResponse{
+				Vcn: core.Vcn{
+					Id:           common.String("vcn_id"),
+					FreeformTags: tags,
+					DisplayName:  common.String("foo"),
+					CidrBlocks:   []string{VcnDefaultCidr},
+				},
+			}, nil)
+	}
+	expectUpdateVcn := func(vcnClient *mock_vcn.MockClient, err error) {
+		vcnClient.EXPECT().UpdateVcn(gomock.Any(), gomock.Eq(core.UpdateVcnRequest{
+			VcnId: common.String("vcn_id"),
+			UpdateVcnDetails: core.UpdateVcnDetails{
+				DisplayName: common.String("foo1"),
+			},
+		})).
+			Return(core.UpdateVcnResponse{
+				Vcn: core.Vcn{
+					Id: common.String("vcn_id"),
+				},
+			}, err)
+	}
+	vcnDrift := []infrastructurev1beta2.FieldDrift{
+		{
+			Field:   "displayName",
+			Desired: "foo1",
+			Actual:  "foo",
+		},
+	}
+
+	tests := []struct {
+		name              string
+		driftPolicy       infrastructurev1beta2.DriftPolicy
+		networkSpec       infrastructurev1beta2.NetworkSpec
+		reconcile         func(s *ClusterScope) error
+		errorExpected     bool
+		matchError        string
+		expectedDrift     []infrastructurev1beta2.ResourceDrift
+		testSpecificSetup func(vcnClient *mock_vcn.MockClient)
+	}{
+		{
+			name:        "vcn drift is reported",
+			driftPolicy: infrastructurev1beta2.DriftPolicyReport,
+			networkSpec: vcnSpec,
+			reconcile: func(s *ClusterScope) error {
+				return s.ReconcileVCN(context.Background())
+			},
+			expectedDrift: []infrastructurev1beta2.ResourceDrift{
+				{
+					ResourceType: DriftResourceVCN,
+					Name:         "foo1",
+					ID:           common.String("vcn_id"),
+					Fields:       vcnDrift,
+				},
+			},
+			testSpecificSetup: func(vcnClient *mock_vcn.MockClient) {
+				expectGetVcn(vcnClient)
+			},
+		},
+		{
+			name:        "vcn drift is corrected",
+			networkSpec: vcnSpec,
+			reconcile: func(s *ClusterScope) error {
+				return s.ReconcileVCN(context.Background())
+			},
+			expectedDrift: []infrastructurev1beta2.ResourceDrift{
+				{
+					ResourceType: DriftResourceVCN,
+					Name:         "foo1",
+					ID:           common.String("vcn_id"),
+					Fields:       vcnDrift,
+					Corrected:    true,
+				},
+			},
+			testSpecificSetup: func(vcnClient *mock_vcn.MockClient) {
+				expectGetVcn(vcnClient)
+				expectUpdateVcn(vcnClient, nil)
+			},
+		},
+		{
+			name:          "vcn drift correction failed",
+			driftPolicy:   infrastructurev1beta2.DriftPolicyEnforce,
+			networkSpec:   vcnSpec,
+			errorExpected: true,
+			matchError:    "failed to reconcile the vcn, failed to update: some error",
+			reconcile: func(s *ClusterScope) error {
+				return s.ReconcileVCN(context.Background())
+			},
+			expectedDrift: []infrastructurev1beta2.ResourceDrift{
+				{
+					ResourceType: DriftResourceVCN,
+					Name:         "foo1",
+					ID:           common.String("vcn_id"),
+					Fields:       vcnDrift,
+				},
+			},
+			testSpecificSetup: func(vcnClient *mock_vcn.MockClient) {
+				expectGetVcn(vcnClient)
+				expectUpdateVcn(vcnClient, errors.New("some error"))
+			},
+		},
+		{
+			name:        "route rule drift is reported",
+			driftPolicy: infrastructurev1beta2.DriftPolicyReport,
+			networkSpec: routeTableSpec,
+			reconcile: func(s *ClusterScope) error {
+				return s.ReconcileRouteTable(context.Background())
+			},
+			expectedDrift: []infrastructurev1beta2.ResourceDrift{
+				{
+					ResourceType: DriftResourceRouteTable,
+					Name:         PublicRouteTableName,
+					ID:           common.String("public"),
+					Fields: []infrastructurev1beta2.FieldDrift{
+						{
+							Field:  "routeRules",
+							Actual: "0.0.0.0/0 (CIDR_BLOCK) via other-igw",
+						},
+						{
+							Field:   "routeRules",
+							Desired: "0.0.0.0/0 (CIDR_BLOCK) via igw",
+						},
+					},
+				},
+			},
+			testSpecificSetup: func(vcnClient *mock_vcn.MockClient) {
+				vcnClient.EXPECT().GetRouteTable(gomock.Any(), gomock.Eq(core.GetRouteTableRequest{
+					RtId: common.String("public"),
+				})).
+					Return(core.GetRouteTableResponse{
+						RouteTable: core.RouteTable{
+							Id:           common.String("public"),
+							FreeformTags: tags,
+							RouteRules: []core.RouteRule{
+								{
+									DestinationType: core.RouteRuleDestinationTypeCidrBlock,
+									Destination:     common.String("0.0.0.0/0"),
+									NetworkEntityId: common.String("other-igw"),
+								},
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "vcn cidr drift is reported, not corrected",
+			networkSpec: infrastructurev1beta2.NetworkSpec{
+				Vcn: infrastructurev1beta2.VCN{
+					ID:       common.String("vcn_id"),
+					Name:     "foo",
+					CIDRS:    []string{"10.1.0.0/16", "10.0.0.0/16"},
+					DnsLabel: common.String("label"),
+				},
+			},
+			reconcile: func(s *ClusterScope) error {
+				return s.ReconcileVCN(context.Background())
+			},
+			expectedDrift: []infrastructurev1beta2.ResourceDrift{
+				{
+					ResourceType: DriftResourceVCN,
+					Name:         "foo",
+					ID:           common.String("vcn_id"),
+					Fields: []infrastructurev1beta2.FieldDrift{
+						{
+							Field:   "cidrBlocks",
+							Desired: "10.0.0.0/16,10.1.0.0/16",
+							Actual:  "10.0.0.0/16",
+						},
+						{
+							Field:   "dnsLabel",
+							Desired: "label",
+							Actual:  "other",
+						},
+					},
+				},
+			},
+			testSpecificSetup: func(vcnClient *mock_vcn.MockClient) {
+				vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{
+					VcnId: common.String("vcn_id"),
+				})).
+					Return(core.GetVcnResponse{
+						Vcn: core.Vcn{
+							Id:           common.String("vcn_id"),
+							FreeformTags: tags,
+							DisplayName:  common.String("foo"),
+							CidrBlocks:   []string{VcnDefaultCidr},
+							DnsLabel:     common.String("other"),
+						},
+					}, nil)
+			},
+		},
+		{
+			name:        "route rule drift is corrected, the route rules added out of cluster api are kept",
+			networkSpec: routeTableSpec,
+			reconcile: func(s *ClusterScope) error {
+				return s.ReconcileRouteTable(context.Background())
+			},
+			expectedDrift: []infrastructurev1beta2.ResourceDrift{
+				{
+					ResourceType: DriftResourceRouteTable,
+					Name:         PublicRouteTableName,
+					ID:           common.String("public"),
+					Fields: []infrastructurev1beta2.FieldDrift{
+						{
+							Field:   "routeRules",
+							Desired: "0.0.0.0/0 (CIDR_BLOCK) via igw",
+						},
+					},
+					Corrected: true,
+				},
+			},
+			testSpecificSetup: func(vcnClient *mock_vcn.MockClient) {
+				lpgRule := core.RouteRule{
+					DestinationType: core.RouteRuleDestinationTypeCidrBlock,
+					Destination:     common.String("10.20.0.0/16"),
+					NetworkEntityId: common.String("lpg"),
+					Description:     common.String("peering"),
+				}
+				vcnClient.EXPECT().GetRouteTable(gomock.Any(), gomock.Eq(core.GetRouteTableRequest{
+					RtId: common.String("public"),
+				})).
+					Return(core.GetRouteTableResponse{
+						RouteTable: core.RouteTable{
+							Id:           common.String("public"),
+							FreeformTags: tags,
+							RouteRules:   []core.RouteRule{lpgRule},
+						},
+					}, nil)
+				vcnClient.EXPECT().UpdateRouteTable(gomock.Any(), gomock.Eq(core.UpdateRouteTableRequest{
+					RtId: common.String("public"),
+					UpdateRouteTableDetails: core.UpdateRouteTableDetails{
+						RouteRules: []core.RouteRule{
+							lpgRule,
+							{
+								DestinationType: core.RouteRuleDestinationTypeCidrBlock,
+								Destination:     common.String("0.0.0.0/0"),
+								NetworkEntityId: common.String("igw"),
+								Description:     common.String("traffic to/from internet"),
+							},
+						},
+					},
+				})).
+					Return(core.UpdateRouteTableResponse{}, nil)
+			},
+		},
+		{
+			name:        "no drift",
+			driftPolicy: infrastructurev1beta2.DriftPolicyReport,
+			networkSpec: routeTableSpec,
+			reconcile: func(s *ClusterScope) error {
+				return s.ReconcileRouteTable(context.Background())
+			},
+			testSpecificSetup: func(vcnClient *mock_vcn.MockClient) {
+				vcnClient.EXPECT().GetRouteTable(gomock.Any(), gomock.Eq(core.GetRouteTableRequest{
+					RtId: common.String("public"),
+				})).
+					Return(core.GetRouteTableResponse{
+						RouteTable: core.RouteTable{
+							Id:           common.String("public"),
+							FreeformTags: tags,
+							RouteRules: []core.RouteRule{
+								{
+									DestinationType: core.RouteRuleDestinationTypeCidrBlock,
+									Destination:     common.String("0.0.0.0/0"),
+									NetworkEntityId: common.String("igw"),
+									Description:     common.String("traffic to/from internet"),
+								},
+							},
+						},
+					}, nil)
+			},
+		},
+	}
+	l := log.FromContext(context.Background())
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			vcnClient := mock_vcn.NewMockClient(mockCtrl)
+			ociCluster := &infrastructurev1beta2.OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					UID: "cluster_uid",
+				},
+				Spec: infrastructurev1beta2.OCIClusterSpec{
+					OCIResourceIdentifier: "resource_uid",
+					DriftPolicy:           tc.driftPolicy,
+					NetworkSpec:           tc.networkSpec,
+				},
+			}
+			s := &ClusterScope{
+				VCNClient:          vcnClient,
+				OCIClusterAccessor: OCISelfManagedCluster{OCICluster: ociCluster},
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						UID: "resource_uid",
+					},
+				},
+				Logger: &l,
+			}
+			tc.testSpecificSetup(vcnClient)
+			err := tc.reconcile(s)
+			if tc.errorExpected {
+				g.Expect(err).To(Not(BeNil()))
+				g.Expect(err.Error()).To(Equal(tc.matchError))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(ociCluster.Status.Drift).To(Equal(tc.expectedDrift))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"sort"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// getFailureDomain returns the failure domain the instance is launched in. A failure domain set on the Machine
+// has to be one of the failure domains of the cluster, otherwise the least loaded failure domain is chosen.
+func (m *MachineScope) getFailureDomain(ctx context.Context) (string, error) {
+	failureDomains := m.OCIClusterAccessor.GetFailureDomains()
+	if m.Machine.Spec.FailureDomain != nil {
+		failureDomain := *m.Machine.Spec.FailureDomain
+		if _, ok := failureDomains[failureDomain]; !ok {
+			return "", errors.New(fmt.Sprintf("failure domain %s is not a failure domain of the cluster", failureDomain))
+		}
+		m.Logger.Info("Failure Domain being used", "failure-domain", failureDomain)
+		return failureDomain, nil
+	}
+	if len(failureDomains) == 0 {
+		return "", errors.New("the cluster does not have any failure domains")
+	}
+	counts, err := m.countMachinesPerFailureDomain(ctx)
+	if err != nil {
+		return "", err
+	}
+	failureDomain, err := leastLoadedFailureDomain(failureDomains, counts)
+	if err != nil {
+		return "", err
+	}
+	m.Logger.Info("Least loaded Failure Domain being used", "failure-domain", failureDomain)
+	return failureDomain, nil
+}
+
+// countMachinesPerFailureDomain counts the machines of the MachineDeployment, or else the MachineSet, of the
+// Machine in each failure domain. A machine which is not yet placed is counted in the failure domain of its
+// OCIMachine, as Cluster API copies it to the Machine only after the instance has been created.
+func (m *MachineScope) countMachinesPerFailureDomain(ctx context.Context) (map[string]int, error) {
+	counts := make(map[string]int)
+	labels := client.MatchingLabels{clusterv1.ClusterNameLabel: m.Cluster.Name}
+	if name, ok := m.Machine.Labels[clusterv1.MachineDeploymentNameLabel]; ok {
+		labels[clusterv1.MachineDeploymentNameLabel] = name
+	} else if name, ok := m.Machine.Labels[clusterv1.MachineSetNameLabel]; ok {
+		labels[clusterv1.MachineSetNameLabel] = name
+	} else {
+		return counts, nil
+	}
+
+	machines := &clusterv1.MachineList{}
+	if err := m.Client.List(ctx, machines, client.InNamespace(m.Machine.Namespace), labels); err != nil {
+		return nil, errors.Wrap(err, "failed to list the machines")
+	}
+	ociMachines := &infrastructurev1beta2.OCIMachineList{}
+	if err := m.Client.List(ctx, ociMachines, client.InNamespace(m.Machine.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: m.Cluster.Name}); err != nil {
+		return nil, errors.Wrap(err, "failed to list the OCIMachines")
+	}
+	ociMachineFailureDomains := make(map[string]*string)
+	for _, ociMachine := range ociMachines.Items {
+		ociMachineFailureDomains[ociMachine.Name] = ociMachine.Spec.FailureDomain
+	}
+
+	for _, machine := range machines.Items {
+		if machine.Name == m.Machine.Name || !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		failureDomain := machine.Spec.FailureDomain
+		if failureDomain == nil {
+			failureDomain = ociMachineFailureDomains[machine.Spec.InfrastructureRef.Name]
+		}
+		if failureDomain != nil {
+			counts[*failureDomain]++
+		}
+	}
+	return counts, nil
+}
+
+// leastLoadedFailureDomain returns the failure domain with the fewest machines, ties are broken at random.
+func leastLoadedFailureDomain(failureDomains clusterv1.FailureDomains, counts map[string]int) (string, error) {
+	var candidates []string
+	for name := range failureDomains {
+		switch {
+		case len(candidates) == 0 || counts[name] < counts[candidates[0]]:
+			candidates = []string{name}
+		case counts[name] == counts[candidates[0]]:
+			candidates = append(candidates, name)
+		}
+	}
+	sort.Strings(candidates)
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(len(candidates))))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to choose a failure domain")
+	}
+	return candidates[index.Int64()], nil
+}
+
+// recordFailureDomain records the failure domain of the instance on the OCIMachine, so that Cluster API copies it
+// to the Machine. The instance may have been launched outside the chosen failure domain by the launch fallback
+// policy, hence the failure domain is looked up from the placement of the instance.
+func (m *MachineScope) recordFailureDomain(instance *core.Instance) {
+	if m.OCIMachine.Spec.FailureDomain != nil {
+		return
+	}
+	if m.Machine.Spec.FailureDomain != nil {
+		m.OCIMachine.Spec.FailureDomain = common.String(*m.Machine.Spec.FailureDomain)
+		return
+	}
+	availabilityDomain := ociutil.DerefString(instance.AvailabilityDomain)
+	faultDomain := ociutil.DerefString(instance.FaultDomain)
+	failureDomains := m.OCIClusterAccessor.GetFailureDomains()
+	var names []string
+	for name := range failureDomains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		attributes := failureDomains[name].Attributes
+		if attributes[AvailabilityDomain] != availabilityDomain {
+			continue
+		}
+		if attributes[FaultDomain] == "" || attributes[FaultDomain] == faultDomain {
+			m.OCIMachine.Spec.FailureDomain = common.String(name)
+			return
+		}
+	}
+}
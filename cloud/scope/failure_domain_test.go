@@ -0,0 +1,194 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/klogr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func failureDomainTestCluster() *infrastructurev1beta2.OCICluster {
+	return &infrastructurev1beta2.OCICluster{
+		Status: infrastructurev1beta2.OCIClusterStatus{
+			FailureDomains: clusterv1.FailureDomains{
+				"1": {Attributes: map[string]string{AvailabilityDomain: "ad-1", FaultDomain: "fd-1"}},
+				"2": {Attributes: map[string]string{AvailabilityDomain: "ad-1", FaultDomain: "fd-2"}},
+				"3": {Attributes: map[string]string{AvailabilityDomain: "ad-1", FaultDomain: "fd-3"}},
+			},
+		},
+	}
+}
+
+func failureDomainTestMachine(name string, deployment string, failureDomain *string) *clusterv1.Machine {
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster"},
+		},
+		Spec: clusterv1.MachineSpec{
+			ClusterName:       "cluster",
+			FailureDomain:     failureDomain,
+			InfrastructureRef: corev1.ObjectReference{Name: name},
+		},
+	}
+	if deployment != "" {
+		machine.Labels[clusterv1.MachineDeploymentNameLabel] = deployment
+	}
+	return machine
+}
+
+func failureDomainTestOCIMachine(name string, failureDomain *string) *infrastructurev1beta2.OCIMachine {
+	return &infrastructurev1beta2.OCIMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster"},
+		},
+		Spec: infrastructurev1beta2.OCIMachineSpec{FailureDomain: failureDomain},
+	}
+}
+
+func TestGetFailureDomain(t *testing.T) {
+	tests := []struct {
+		name          string
+		machine       *clusterv1.Machine
+		objects       []client.Object
+		expected      []string
+		errorExpected bool
+		matchError    string
+	}{
+		{
+			name:     "failure domain of the machine",
+			machine:  failureDomainTestMachine("machine", "md", common.String("3")),
+			expected: []string{"3"},
+		},
+		{
+			name:          "failure domain which is not a failure domain of the cluster",
+			machine:       failureDomainTestMachine("machine", "md", common.String("4")),
+			errorExpected: true,
+			matchError:    "failure domain 4 is not a failure domain of the cluster",
+		},
+		{
+			name:    "least loaded failure domain",
+			machine: failureDomainTestMachine("machine", "md", nil),
+			objects: []client.Object{
+				failureDomainTestMachine("machine-1", "md", common.String("1")),
+				failureDomainTestMachine("machine-2", "md", common.String("1")),
+				failureDomainTestMachine("machine-3", "md", common.String("2")),
+				failureDomainTestMachine("machine-4", "md", common.String("3")),
+				failureDomainTestMachine("machine-5", "other", common.String("2")),
+			},
+			expected: []string{"2", "3"},
+		},
+		{
+			name:    "failure domain of the OCIMachine of a machine which is not yet placed",
+			machine: failureDomainTestMachine("machine", "md", nil),
+			objects: []client.Object{
+				failureDomainTestMachine("machine-1", "md", common.String("1")),
+				failureDomainTestMachine("machine-2", "md", nil),
+				failureDomainTestOCIMachine("machine-2", common.String("2")),
+			},
+			expected: []string{"3"},
+		},
+		{
+			name:    "machine which is not part of a deployment or a set",
+			machine: failureDomainTestMachine("machine", "", nil),
+			objects: []client.Object{
+				failureDomainTestMachine("machine-1", "", common.String("1")),
+			},
+			expected: []string{"1", "2", "3"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			log := klogr.New()
+			m := &MachineScope{
+				Logger:             &log,
+				Client:             fake.NewClientBuilder().WithObjects(tc.objects...).Build(),
+				Cluster:            &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}},
+				Machine:            tc.machine,
+				OCIMachine:         &infrastructurev1beta2.OCIMachine{},
+				OCIClusterAccessor: OCISelfManagedCluster{OCICluster: failureDomainTestCluster()},
+			}
+			failureDomain, err := m.getFailureDomain(context.Background())
+			if tc.errorExpected {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(Equal(tc.matchError))
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(tc.expected).To(ContainElement(failureDomain))
+		})
+	}
+}
+
+func TestRecordFailureDomain(t *testing.T) {
+	tests := []struct {
+		name             string
+		machineDomain    *string
+		ociMachineDomain *string
+		instance         core.Instance
+		expected         *string
+	}{
+		{
+			name:          "failure domain of the machine",
+			machineDomain: common.String("2"),
+			instance:      core.Instance{AvailabilityDomain: common.String("ad-1"), FaultDomain: common.String("fd-1")},
+			expected:      common.String("2"),
+		},
+		{
+			name:     "failure domain of the placement of the instance",
+			instance: core.Instance{AvailabilityDomain: common.String("ad-1"), FaultDomain: common.String("fd-3")},
+			expected: common.String("3"),
+		},
+		{
+			name:     "placement outside the failure domains",
+			instance: core.Instance{AvailabilityDomain: common.String("ad-2"), FaultDomain: common.String("fd-1")},
+		},
+		{
+			name:             "failure domain already recorded",
+			ociMachineDomain: common.String("1"),
+			instance:         core.Instance{AvailabilityDomain: common.String("ad-1"), FaultDomain: common.String("fd-3")},
+			expected:         common.String("1"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			m := &MachineScope{
+				Machine:            failureDomainTestMachine("machine", "md", tc.machineDomain),
+				OCIMachine:         failureDomainTestOCIMachine("machine", tc.ociMachineDomain),
+				OCIClusterAccessor: OCISelfManagedCluster{OCICluster: failureDomainTestCluster()},
+			}
+			m.recordFailureDomain(&tc.instance)
+			g.Expect(m.OCIMachine.Spec.FailureDomain).To(Equal(tc.expected))
+		})
+	}
+}
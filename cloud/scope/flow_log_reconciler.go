@@ -0,0 +1,453 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/logging"
+	"github.com/pkg/errors"
+)
+
+const (
+	// FlowLogService is the OCI Logging service name of VCN flow logs.
+	FlowLogService = "flowlogs"
+	// FlowLogCategory is the OCI Logging category of VCN flow logs.
+	FlowLogCategory = "all"
+	// FlowLogCaptureFilterParameter is the OCI Logging source parameter which refers to the capture filter.
+	FlowLogCaptureFilterParameter = "capture_filter"
+)
+
+// ReconcileFlowLogs reconciles the VCN flow logs of the subnets which have a flow log configuration. The flow logs
+// of the inventory which are no longer desired, because the flow log configuration was removed from the subnet or
+// its log group changed, are deleted along with their capture filters.
+func (s *ClusterScope) ReconcileFlowLogs(ctx context.Context) error {
+	deleting, err := s.deleteRemovedFlowLogs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, subnet := range s.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets {
+		if subnet.FlowLog == nil {
+			continue
+		}
+		if subnet.ID == nil {
+			return errors.Errorf("subnet %s has not been created yet", subnet.Name)
+		}
+		flowLog, err := s.GetFlowLog(ctx, *subnet, subnet.ID)
+		if err != nil {
+			return err
+		}
+		if flowLog == nil {
+			// a subnet has a single flow log, the new flow log is created once the previous one is deleted
+			if deleting[subnet.Name] {
+				continue
+			}
+			err = s.CreateFlowLog(ctx, *subnet)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		captureFilterId, err := s.reconcileFlowLogCaptureFilter(ctx, *subnet, flowLog)
+		if err != nil {
+			return err
+		}
+		s.setFlowLogStatus(infrastructurev1beta2.FlowLogStatus{
+			NetworkResourceStatus: *newNetworkResourceStatus(flowLog.Id, string(flowLog.LifecycleState)),
+			SubnetName:            subnet.Name,
+			SubnetID:              subnet.ID,
+			LogGroupId:            subnet.FlowLog.LogGroupId,
+			CaptureFilterID:       captureFilterId,
+		})
+		if subnet.FlowLog.RetentionDuration == nil || reflect.DeepEqual(flowLog.RetentionDuration, subnet.FlowLog.RetentionDuration) {
+			s.Logger.Info("No Reconciliation Required for Flow Log", "log", *flowLog.Id)
+			continue
+		}
+		_, err = s.LoggingClient.UpdateLog(ctx, logging.UpdateLogRequest{
+			LogGroupId: common.String(subnet.FlowLog.LogGroupId),
+			LogId:      flowLog.Id,
+			UpdateLogDetails: logging.UpdateLogDetails{
+				RetentionDuration: subnet.FlowLog.RetentionDuration,
+			},
+		})
+		if err != nil {
+			s.Logger.Error(err, "failed to update flow log")
+			return errors.Wrap(err, "failed to update flow log")
+		}
+		s.Logger.Info("Successfully updated flow log", "log", *flowLog.Id)
+	}
+	if len(deleting) > 0 {
+		var subnetNames []string
+		for subnetName := range deleting {
+			subnetNames = append(subnetNames, subnetName)
+		}
+		sort.Strings(subnetNames)
+		return errors.Errorf("waiting for the removed flow logs of subnets %s to be deleted", strings.Join(subnetNames, ", "))
+	}
+	return nil
+}
+
+// GetFlowLog returns the flow log of the subnet created by Cluster API, or nil if it does not exist or is being
+// deleted.
+func (s *ClusterScope) GetFlowLog(ctx context.Context, subnet infrastructurev1beta2.Subnet, subnetId *string) (*logging.LogSummary, error) {
+	flowLog, err := s.findFlowLog(ctx, subnet.FlowLog.LogGroupId, subnetId)
+	if err != nil || flowLog == nil || flowLog.LifecycleState == logging.LogLifecycleStateDeleting {
+		return nil, err
+	}
+	return flowLog, nil
+}
+
+// findFlowLog returns the flow log of the subnet in the log group created by Cluster API, including a flow log
+// which is being deleted, or nil if it does not exist.
+func (s *ClusterScope) findFlowLog(ctx context.Context, logGroupId string, subnetId *string) (*logging.LogSummary, error) {
+	resp, err := s.LoggingClient.ListLogs(ctx, logging.ListLogsRequest{
+		LogGroupId:     common.String(logGroupId),
+		SourceService:  common.String(FlowLogService),
+		SourceResource: subnetId,
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to list flow logs")
+		return nil, errors.Wrap(err, "failed to list flow logs")
+	}
+	var deleting *logging.LogSummary
+	for i, log := range resp.Items {
+		if !s.IsResourceCreatedByClusterAPI(log.FreeformTags) {
+			continue
+		}
+		if log.LifecycleState != logging.LogLifecycleStateDeleting {
+			return &resp.Items[i], nil
+		}
+		deleting = &resp.Items[i]
+	}
+	return deleting, nil
+}
+
+// CreateFlowLog creates the flow log of the subnet, along with the capture filter if a sampling rate is set.
+func (s *ClusterScope) CreateFlowLog(ctx context.Context, subnet infrastructurev1beta2.Subnet) error {
+	source := logging.OciService{
+		Service:  common.String(FlowLogService),
+		Resource: subnet.ID,
+		Category: common.String(FlowLogCategory),
+	}
+	var captureFilterId *string
+	if subnet.FlowLog.SamplingRate != nil {
+		var err error
+		captureFilterId, err = s.CreateFlowLogCaptureFilter(ctx, subnet,
+			ociutil.GetOPCRetryToken("%s-%s-%s", "create-capture-filter", s.OCIClusterAccessor.GetOCIResourceIdentifier(), subnet.Name))
+		if err != nil {
+			return err
+		}
+		source.Parameters = map[string]string{FlowLogCaptureFilterParameter: *captureFilterId}
+	}
+	// the log is created asynchronously, its OCID is recorded in the inventory once it is listed
+	s.setFlowLogStatus(infrastructurev1beta2.FlowLogStatus{
+		SubnetName:      subnet.Name,
+		SubnetID:        subnet.ID,
+		LogGroupId:      subnet.FlowLog.LogGroupId,
+		CaptureFilterID: captureFilterId,
+	})
+	_, err := s.LoggingClient.CreateLog(ctx, logging.CreateLogRequest{
+		LogGroupId: common.String(subnet.FlowLog.LogGroupId),
+		CreateLogDetails: logging.CreateLogDetails{
+			DisplayName: common.String(s.getFlowLogName(subnet)),
+			LogType:     logging.CreateLogDetailsLogTypeService,
+			IsEnabled:   common.Bool(true),
+			Configuration: &logging.Configuration{
+				Source:        source,
+				CompartmentId: common.String(s.GetSubnetCompartmentId(subnet)),
+			},
+			RetentionDuration: subnet.FlowLog.RetentionDuration,
+			FreeformTags:      s.GetFreeFormTags(),
+			DefinedTags:       s.GetDefinedTags(),
+		},
+		OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", "create-flow-log", s.OCIClusterAccessor.GetOCIResourceIdentifier(), subnet.Name),
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to create flow log")
+		return errors.Wrap(err, "failed to create flow log")
+	}
+	s.Logger.Info("Created the flow log", "subnet", subnet.Name)
+	return nil
+}
+
+// CreateFlowLogCaptureFilter creates the capture filter which samples the flows of the subnet flow log.
+func (s *ClusterScope) CreateFlowLogCaptureFilter(ctx context.Context, subnet infrastructurev1beta2.Subnet, opcRetryToken *string) (*string, error) {
+	resp, err := s.VCNClient.CreateCaptureFilter(ctx, core.CreateCaptureFilterRequest{
+		CreateCaptureFilterDetails: core.CreateCaptureFilterDetails{
+			CompartmentId:             common.String(s.GetSubnetCompartmentId(subnet)),
+			FilterType:                core.CreateCaptureFilterDetailsFilterTypeFlowlog,
+			DisplayName:               common.String(s.getFlowLogName(subnet)),
+			FlowLogCaptureFilterRules: getFlowLogCaptureFilterRules(*subnet.FlowLog),
+			FreeformTags:              s.GetFreeFormTags(),
+			DefinedTags:               s.GetDefinedTags(),
+		},
+		OpcRetryToken: opcRetryToken,
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to create capture filter")
+		return nil, errors.Wrap(err, "failed to create capture filter")
+	}
+	s.Logger.Info("Created the capture filter", "ocid", resp.Id)
+	return resp.Id, nil
+}
+
+// reconcileFlowLogCaptureFilter updates the sampling rate of the capture filter of an existing flow log. If the
+// flow log has no capture filter yet, the capture filter is created and attached to the flow log. It returns the
+// OCID of the capture filter of the flow log.
+func (s *ClusterScope) reconcileFlowLogCaptureFilter(ctx context.Context, subnet infrastructurev1beta2.Subnet, flowLog *logging.LogSummary) (*string, error) {
+	captureFilterId := getFlowLogCaptureFilterId(flowLog)
+	if subnet.FlowLog.SamplingRate == nil {
+		return captureFilterId, nil
+	}
+	if captureFilterId == nil {
+		return s.attachFlowLogCaptureFilter(ctx, subnet, flowLog)
+	}
+	resp, err := s.VCNClient.GetCaptureFilter(ctx, core.GetCaptureFilterRequest{
+		CaptureFilterId: captureFilterId,
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to get capture filter")
+		return nil, errors.Wrap(err, "failed to get capture filter")
+	}
+	desiredRules := getFlowLogCaptureFilterRules(*subnet.FlowLog)
+	if len(resp.FlowLogCaptureFilterRules) == len(desiredRules) &&
+		reflect.DeepEqual(resp.FlowLogCaptureFilterRules[0].SamplingRate, desiredRules[0].SamplingRate) {
+		return captureFilterId, nil
+	}
+	_, err = s.VCNClient.UpdateCaptureFilter(ctx, core.UpdateCaptureFilterRequest{
+		CaptureFilterId: captureFilterId,
+		UpdateCaptureFilterDetails: core.UpdateCaptureFilterDetails{
+			FlowLogCaptureFilterRules: desiredRules,
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to update capture filter")
+		return nil, errors.Wrap(err, "failed to update capture filter")
+	}
+	s.Logger.Info("Successfully updated capture filter", "capturefilter", *captureFilterId)
+	return captureFilterId, nil
+}
+
+// attachFlowLogCaptureFilter creates the capture filter of an existing flow log which has none, and sets it in the
+// source parameters of the flow log.
+func (s *ClusterScope) attachFlowLogCaptureFilter(ctx context.Context, subnet infrastructurev1beta2.Subnet, flowLog *logging.LogSummary) (*string, error) {
+	captureFilterId, err := s.CreateFlowLogCaptureFilter(ctx, subnet,
+		ociutil.GetOPCRetryToken("%s-%s-%s", "create-capture-filter", s.OCIClusterAccessor.GetOCIResourceIdentifier(), *flowLog.Id))
+	if err != nil {
+		return nil, err
+	}
+	// the capture filter is recorded before it is attached, so that it is deleted along with the flow log even if
+	// the update of the flow log fails
+	s.setFlowLogStatus(infrastructurev1beta2.FlowLogStatus{
+		NetworkResourceStatus: *newNetworkResourceStatus(flowLog.Id, string(flowLog.LifecycleState)),
+		SubnetName:            subnet.Name,
+		SubnetID:              subnet.ID,
+		LogGroupId:            subnet.FlowLog.LogGroupId,
+		CaptureFilterID:       captureFilterId,
+	})
+	_, err = s.LoggingClient.UpdateLog(ctx, logging.UpdateLogRequest{
+		LogGroupId: common.String(subnet.FlowLog.LogGroupId),
+		LogId:      flowLog.Id,
+		UpdateLogDetails: logging.UpdateLogDetails{
+			Configuration: &logging.UpdateConfigurationDetails{
+				Source: &logging.SourceUpdateDetails{
+					Parameters: map[string]string{FlowLogCaptureFilterParameter: *captureFilterId},
+				},
+			},
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to attach capture filter to flow log")
+		return nil, errors.Wrap(err, "failed to attach capture filter to flow log")
+	}
+	s.Logger.Info("Successfully attached capture filter to flow log", "log", *flowLog.Id, "capturefilter", *captureFilterId)
+	return captureFilterId, nil
+}
+
+// deleteRemovedFlowLogs deletes the flow logs of the inventory whose subnet no longer has a flow log configuration
+// in the same log group. It returns the names of the subnets whose previous flow log is still being deleted.
+func (s *ClusterScope) deleteRemovedFlowLogs(ctx context.Context) (map[string]bool, error) {
+	desired := make(map[string]string)
+	for _, subnet := range s.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets {
+		if subnet.FlowLog != nil {
+			desired[subnet.Name] = subnet.FlowLog.LogGroupId
+		}
+	}
+	deleting := make(map[string]bool)
+	for _, flowLogStatus := range s.getFlowLogStatuses() {
+		if logGroupId, ok := desired[flowLogStatus.SubnetName]; ok && logGroupId == flowLogStatus.LogGroupId {
+			continue
+		}
+		deleted, err := s.deleteFlowLog(ctx, flowLogStatus)
+		if err != nil {
+			return nil, err
+		}
+		if !deleted {
+			deleting[flowLogStatus.SubnetName] = true
+		}
+	}
+	return deleting, nil
+}
+
+// DeleteFlowLogs deletes the VCN flow logs of the subnets, along with their capture filters. The capture filters
+// are deleted once the flow logs are gone, as the deletion of the flow logs is asynchronous and a capture filter
+// cannot be deleted while a flow log refers to it.
+func (s *ClusterScope) DeleteFlowLogs(ctx context.Context) error {
+	var deleting []string
+	flowLogs := s.getFlowLogStatuses()
+	for _, flowLogStatus := range flowLogs {
+		deleted, err := s.deleteFlowLog(ctx, flowLogStatus)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			deleting = append(deleting, flowLogStatus.SubnetName)
+		}
+	}
+	// the flow logs created by earlier versions of the controller are not in the inventory
+	for _, desiredSubnet := range s.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets {
+		if desiredSubnet.FlowLog == nil || hasFlowLogStatus(flowLogs, desiredSubnet.Name, desiredSubnet.FlowLog.LogGroupId) {
+			continue
+		}
+		subnet, err := s.GetSubnet(ctx, *desiredSubnet)
+		if err != nil && !ociutil.IsNotFound(err) {
+			return err
+		}
+		if subnet == nil {
+			s.Logger.Info("subnet is already deleted, skipping deletion of flow log", "subnet", desiredSubnet.Name)
+			continue
+		}
+		flowLog, err := s.findFlowLog(ctx, desiredSubnet.FlowLog.LogGroupId, subnet.Id)
+		if err != nil {
+			return err
+		}
+		if flowLog == nil {
+			s.Logger.Info("flow log is already deleted", "subnet", desiredSubnet.Name)
+			continue
+		}
+		flowLogStatus := infrastructurev1beta2.FlowLogStatus{
+			NetworkResourceStatus: *newNetworkResourceStatus(flowLog.Id, string(flowLog.LifecycleState)),
+			SubnetName:            desiredSubnet.Name,
+			SubnetID:              subnet.Id,
+			LogGroupId:            desiredSubnet.FlowLog.LogGroupId,
+			CaptureFilterID:       getFlowLogCaptureFilterId(flowLog),
+		}
+		// the capture filter is recorded so that it is deleted once the flow log is gone
+		s.setFlowLogStatus(flowLogStatus)
+		err = s.deleteExistingFlowLog(ctx, flowLogStatus, flowLog)
+		if err != nil {
+			return err
+		}
+		deleting = append(deleting, desiredSubnet.Name)
+	}
+	if len(deleting) > 0 {
+		return errors.Errorf("waiting for the flow logs of subnets %s to be deleted", strings.Join(deleting, ", "))
+	}
+	return nil
+}
+
+// deleteFlowLog deletes the flow log of the inventory and, once the flow log is gone, its capture filter. It
+// returns true once both are deleted, the flow log is then removed from the inventory.
+func (s *ClusterScope) deleteFlowLog(ctx context.Context, flowLogStatus infrastructurev1beta2.FlowLogStatus) (bool, error) {
+	var flowLog *logging.LogSummary
+	if flowLogStatus.SubnetID != nil {
+		var err error
+		flowLog, err = s.findFlowLog(ctx, flowLogStatus.LogGroupId, flowLogStatus.SubnetID)
+		if err != nil {
+			return false, err
+		}
+	}
+	if flowLog != nil {
+		return false, s.deleteExistingFlowLog(ctx, flowLogStatus, flowLog)
+	}
+	if flowLogStatus.CaptureFilterID != nil {
+		_, err := s.VCNClient.DeleteCaptureFilter(ctx, core.DeleteCaptureFilterRequest{
+			CaptureFilterId: flowLogStatus.CaptureFilterID,
+		})
+		if err != nil && !ociutil.IsNotFound(err) {
+			s.Logger.Error(err, "failed to delete capture filter")
+			return false, errors.Wrap(err, "failed to delete capture filter")
+		}
+		s.Logger.Info("Successfully deleted capture filter", "capturefilter", *flowLogStatus.CaptureFilterID)
+	}
+	s.removeFlowLogStatus(flowLogStatus.SubnetName, flowLogStatus.LogGroupId)
+	s.Logger.Info("Successfully deleted flow log", "subnet", flowLogStatus.SubnetName)
+	return true, nil
+}
+
+// deleteExistingFlowLog starts the deletion of the flow log, unless it is already being deleted.
+func (s *ClusterScope) deleteExistingFlowLog(ctx context.Context, flowLogStatus infrastructurev1beta2.FlowLogStatus, flowLog *logging.LogSummary) error {
+	if flowLog.LifecycleState == logging.LogLifecycleStateDeleting {
+		return nil
+	}
+	_, err := s.LoggingClient.DeleteLog(ctx, logging.DeleteLogRequest{
+		LogGroupId: common.String(flowLogStatus.LogGroupId),
+		LogId:      flowLog.Id,
+	})
+	if err != nil && !ociutil.IsNotFound(err) {
+		s.Logger.Error(err, "failed to delete flow log")
+		return errors.Wrap(err, "failed to delete flow log")
+	}
+	s.Logger.Info("Deleting the flow log", "subnet", flowLogStatus.SubnetName, "log", *flowLog.Id)
+	return nil
+}
+
+func hasFlowLogStatus(flowLogs []infrastructurev1beta2.FlowLogStatus, subnetName string, logGroupId string) bool {
+	for _, flowLogStatus := range flowLogs {
+		if flowLogStatus.SubnetName == subnetName && flowLogStatus.LogGroupId == logGroupId {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *ClusterScope) getFlowLogName(subnet infrastructurev1beta2.Subnet) string {
+	return fmt.Sprintf("%s-flow-log", subnet.Name)
+}
+
+func getFlowLogCaptureFilterRules(flowLog infrastructurev1beta2.FlowLog) []core.FlowLogCaptureFilterRuleDetails {
+	return []core.FlowLogCaptureFilterRuleDetails{
+		{
+			IsEnabled:    common.Bool(true),
+			SamplingRate: flowLog.SamplingRate,
+			FlowLogType:  core.FlowLogCaptureFilterRuleDetailsFlowLogTypeAll,
+			RuleAction:   core.FlowLogCaptureFilterRuleDetailsRuleActionInclude,
+		},
+	}
+}
+
+func getFlowLogCaptureFilterId(flowLog *logging.LogSummary) *string {
+	if flowLog.Configuration == nil {
+		return nil
+	}
+	source, ok := flowLog.Configuration.Source.(logging.OciService)
+	if !ok {
+		return nil
+	}
+	if captureFilterId, ok := source.Parameters[FlowLogCaptureFilterParameter]; ok {
+		return common.String(captureFilterId)
+	}
+	return nil
+}
@@ -0,0 +1,759 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/logging/mock_logging"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn/mock_vcn"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestFlowLogReconciliation(t *testing.T) {
+	var (
+		cs                 *ClusterScope
+		mockCtrl           *gomock.Controller
+		vcnClient          *mock_vcn.MockClient
+		loggingClient      *mock_logging.MockClient
+		ociClusterAccessor OCISelfManagedCluster
+		tags               map[string]string
+	)
+
+	setup := func(t *testing.T, g *WithT) {
+		var err error
+		mockCtrl = gomock.NewController(t)
+		vcnClient = mock_vcn.NewMockClient(mockCtrl)
+		loggingClient = mock_logging.NewMockClient(mockCtrl)
+		client := fake.NewClientBuilder().Build()
+		ociClusterAccessor = OCISelfManagedCluster{
+			&infrastructurev1beta2.OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					UID:  "cluster_uid",
+					Name: "cluster",
+				},
+				Spec: infrastructurev1beta2.OCIClusterSpec{
+					CompartmentId:         "compartment-id",
+					OCIResourceIdentifier: "resource_uid",
+				},
+			},
+		}
+		cs, err = NewClusterScope(ClusterScopeParams{
+			VCNClient:          vcnClient,
+			LoggingClient:      loggingClient,
+			Cluster:            &clusterv1.Cluster{},
+			OCIClusterAccessor: ociClusterAccessor,
+			Client:             client,
+		})
+		tags = make(map[string]string)
+		tags[ociutil.CreatedBy] = ociutil.OCIClusterAPIProvider
+		tags[ociutil.ClusterResourceIdentifier] = "resource_uid"
+		g.Expect(err).To(BeNil())
+	}
+	teardown := func(t *testing.T, g *WithT) {
+		mockCtrl.Finish()
+	}
+
+	tests := []struct {
+		name              string
+		errorExpected     bool
+		matchError        error
+		testSpecificSetup func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient)
+		validate          func(g *WithT, clusterScope *ClusterScope)
+	}{
+		{
+			name:          "flow log not configured",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name: "subnet",
+						ID:   common.String("subnet-id"),
+					},
+				}
+			},
+		},
+		{
+			name:          "subnet not created",
+			errorExpected: true,
+			matchError:    errors.New("subnet subnet has not been created yet"),
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name:    "subnet",
+						FlowLog: &infrastructurev1beta2.FlowLog{LogGroupId: "log-group-id"},
+					},
+				}
+			},
+		},
+		{
+			name:          "flow log create",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name: "subnet",
+						ID:   common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{
+							LogGroupId:        "log-group-id",
+							RetentionDuration: common.Int(60),
+						},
+					},
+				}
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Eq(logging.ListLogsRequest{
+					LogGroupId:     common.String("log-group-id"),
+					SourceService:  common.String(FlowLogService),
+					SourceResource: common.String("subnet-id"),
+				})).
+					Return(logging.ListLogsResponse{}, nil)
+				loggingClient.EXPECT().CreateLog(gomock.Any(), gomock.Eq(logging.CreateLogRequest{
+					LogGroupId: common.String("log-group-id"),
+					CreateLogDetails: logging.CreateLogDetails{
+						DisplayName: common.String("subnet-flow-log"),
+						LogType:     logging.CreateLogDetailsLogTypeService,
+						IsEnabled:   common.Bool(true),
+						Configuration: &logging.Configuration{
+							Source: logging.OciService{
+								Service:  common.String(FlowLogService),
+								Resource: common.String("subnet-id"),
+								Category: common.String(FlowLogCategory),
+							},
+							CompartmentId: common.String("compartment-id"),
+						},
+						RetentionDuration: common.Int(60),
+						FreeformTags:      tags,
+						DefinedTags:       make(map[string]map[string]interface{}),
+					},
+					OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", "create-flow-log", "resource_uid", "subnet"),
+				})).
+					Return(logging.CreateLogResponse{}, nil)
+			},
+		},
+		{
+			name:          "flow log create with capture filter",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name: "subnet",
+						ID:   common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{
+							LogGroupId:   "log-group-id",
+							SamplingRate: common.Int(10),
+						},
+					},
+				}
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Any()).
+					Return(logging.ListLogsResponse{}, nil)
+				vcnClient.EXPECT().CreateCaptureFilter(gomock.Any(), gomock.Eq(core.CreateCaptureFilterRequest{
+					CreateCaptureFilterDetails: core.CreateCaptureFilterDetails{
+						CompartmentId: common.String("compartment-id"),
+						FilterType:    core.CreateCaptureFilterDetailsFilterTypeFlowlog,
+						DisplayName:   common.String("subnet-flow-log"),
+						FlowLogCaptureFilterRules: []core.FlowLogCaptureFilterRuleDetails{
+							{
+								IsEnabled:    common.Bool(true),
+								SamplingRate: common.Int(10),
+								FlowLogType:  core.FlowLogCaptureFilterRuleDetailsFlowLogTypeAll,
+								RuleAction:   core.FlowLogCaptureFilterRuleDetailsRuleActionInclude,
+							},
+						},
+						FreeformTags: tags,
+						DefinedTags:  make(map[string]map[string]interface{}),
+					},
+					OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", "create-capture-filter", "resource_uid", "subnet"),
+				})).
+					Return(core.CreateCaptureFilterResponse{
+						CaptureFilter: core.CaptureFilter{
+							Id: common.String("capture-filter-id"),
+						},
+					}, nil)
+				loggingClient.EXPECT().CreateLog(gomock.Any(), gomock.Eq(logging.CreateLogRequest{
+					LogGroupId: common.String("log-group-id"),
+					CreateLogDetails: logging.CreateLogDetails{
+						DisplayName: common.String("subnet-flow-log"),
+						LogType:     logging.CreateLogDetailsLogTypeService,
+						IsEnabled:   common.Bool(true),
+						Configuration: &logging.Configuration{
+							Source: logging.OciService{
+								Service:    common.String(FlowLogService),
+								Resource:   common.String("subnet-id"),
+								Category:   common.String(FlowLogCategory),
+								Parameters: map[string]string{FlowLogCaptureFilterParameter: "capture-filter-id"},
+							},
+							CompartmentId: common.String("compartment-id"),
+						},
+						FreeformTags: tags,
+						DefinedTags:  make(map[string]map[string]interface{}),
+					},
+					OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", "create-flow-log", "resource_uid", "subnet"),
+				})).
+					Return(logging.CreateLogResponse{}, nil)
+			},
+		},
+		{
+			name:          "flow log no reconciliation needed",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name: "subnet",
+						ID:   common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{
+							LogGroupId:        "log-group-id",
+							RetentionDuration: common.Int(60),
+						},
+					},
+				}
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Any()).
+					Return(logging.ListLogsResponse{
+						Items: []logging.LogSummary{
+							{
+								Id:                common.String("log-id"),
+								RetentionDuration: common.Int(60),
+								FreeformTags:      tags,
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name:          "flow log update retention and sampling rate",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name: "subnet",
+						ID:   common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{
+							LogGroupId:        "log-group-id",
+							RetentionDuration: common.Int(90),
+							SamplingRate:      common.Int(5),
+						},
+					},
+				}
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Any()).
+					Return(logging.ListLogsResponse{
+						Items: []logging.LogSummary{
+							{
+								Id:                common.String("log-id"),
+								RetentionDuration: common.Int(60),
+								FreeformTags:      tags,
+								Configuration: &logging.Configuration{
+									Source: logging.OciService{
+										Parameters: map[string]string{FlowLogCaptureFilterParameter: "capture-filter-id"},
+									},
+								},
+							},
+						},
+					}, nil)
+				vcnClient.EXPECT().GetCaptureFilter(gomock.Any(), gomock.Eq(core.GetCaptureFilterRequest{
+					CaptureFilterId: common.String("capture-filter-id"),
+				})).
+					Return(core.GetCaptureFilterResponse{
+						CaptureFilter: core.CaptureFilter{
+							FlowLogCaptureFilterRules: []core.FlowLogCaptureFilterRuleDetails{
+								{
+									SamplingRate: common.Int(10),
+								},
+							},
+						},
+					}, nil)
+				vcnClient.EXPECT().UpdateCaptureFilter(gomock.Any(), gomock.Eq(core.UpdateCaptureFilterRequest{
+					CaptureFilterId: common.String("capture-filter-id"),
+					UpdateCaptureFilterDetails: core.UpdateCaptureFilterDetails{
+						FlowLogCaptureFilterRules: []core.FlowLogCaptureFilterRuleDetails{
+							{
+								IsEnabled:    common.Bool(true),
+								SamplingRate: common.Int(5),
+								FlowLogType:  core.FlowLogCaptureFilterRuleDetailsFlowLogTypeAll,
+								RuleAction:   core.FlowLogCaptureFilterRuleDetailsRuleActionInclude,
+							},
+						},
+					},
+				})).
+					Return(core.UpdateCaptureFilterResponse{}, nil)
+				loggingClient.EXPECT().UpdateLog(gomock.Any(), gomock.Eq(logging.UpdateLogRequest{
+					LogGroupId: common.String("log-group-id"),
+					LogId:      common.String("log-id"),
+					UpdateLogDetails: logging.UpdateLogDetails{
+						RetentionDuration: common.Int(90),
+					},
+				})).
+					Return(logging.UpdateLogResponse{}, nil)
+			},
+		},
+		{
+			name:          "flow log attach capture filter",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name: "subnet",
+						ID:   common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{
+							LogGroupId:   "log-group-id",
+							SamplingRate: common.Int(10),
+						},
+					},
+				}
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Any()).
+					Return(logging.ListLogsResponse{
+						Items: []logging.LogSummary{
+							{
+								Id:             common.String("log-id"),
+								LifecycleState: logging.LogLifecycleStateActive,
+								FreeformTags:   tags,
+								Configuration: &logging.Configuration{
+									Source: logging.OciService{},
+								},
+							},
+						},
+					}, nil)
+				vcnClient.EXPECT().CreateCaptureFilter(gomock.Any(), gomock.Eq(core.CreateCaptureFilterRequest{
+					CreateCaptureFilterDetails: core.CreateCaptureFilterDetails{
+						CompartmentId: common.String("compartment-id"),
+						FilterType:    core.CreateCaptureFilterDetailsFilterTypeFlowlog,
+						DisplayName:   common.String("subnet-flow-log"),
+						FlowLogCaptureFilterRules: []core.FlowLogCaptureFilterRuleDetails{
+							{
+								IsEnabled:    common.Bool(true),
+								SamplingRate: common.Int(10),
+								FlowLogType:  core.FlowLogCaptureFilterRuleDetailsFlowLogTypeAll,
+								RuleAction:   core.FlowLogCaptureFilterRuleDetailsRuleActionInclude,
+							},
+						},
+						FreeformTags: tags,
+						DefinedTags:  make(map[string]map[string]interface{}),
+					},
+					OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", "create-capture-filter", "resource_uid", "log-id"),
+				})).
+					Return(core.CreateCaptureFilterResponse{
+						CaptureFilter: core.CaptureFilter{
+							Id: common.String("capture-filter-id"),
+						},
+					}, nil)
+				loggingClient.EXPECT().UpdateLog(gomock.Any(), gomock.Eq(logging.UpdateLogRequest{
+					LogGroupId: common.String("log-group-id"),
+					LogId:      common.String("log-id"),
+					UpdateLogDetails: logging.UpdateLogDetails{
+						Configuration: &logging.UpdateConfigurationDetails{
+							Source: &logging.SourceUpdateDetails{
+								Parameters: map[string]string{FlowLogCaptureFilterParameter: "capture-filter-id"},
+							},
+						},
+					},
+				})).
+					Return(logging.UpdateLogResponse{}, nil)
+			},
+			validate: func(g *WithT, clusterScope *ClusterScope) {
+				g.Expect(clusterScope.OCIClusterAccessor.GetNetworkStatus().FlowLogs).To(Equal([]infrastructurev1beta2.FlowLogStatus{
+					{
+						NetworkResourceStatus: infrastructurev1beta2.NetworkResourceStatus{
+							ID:             common.String("log-id"),
+							LifecycleState: string(logging.LogLifecycleStateActive),
+						},
+						SubnetName:      "subnet",
+						SubnetID:        common.String("subnet-id"),
+						LogGroupId:      "log-group-id",
+						CaptureFilterID: common.String("capture-filter-id"),
+					},
+				}))
+			},
+		},
+		{
+			name:          "removed flow log delete",
+			errorExpected: true,
+			matchError:    errors.New("waiting for the removed flow logs of subnets subnet to be deleted"),
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name: "subnet",
+						ID:   common.String("subnet-id"),
+					},
+				}
+				clusterScope.OCIClusterAccessor.GetNetworkStatus().FlowLogs = []infrastructurev1beta2.FlowLogStatus{
+					{
+						NetworkResourceStatus: infrastructurev1beta2.NetworkResourceStatus{ID: common.String("log-id")},
+						SubnetName:            "subnet",
+						SubnetID:              common.String("subnet-id"),
+						LogGroupId:            "log-group-id",
+						CaptureFilterID:       common.String("capture-filter-id"),
+					},
+				}
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Eq(logging.ListLogsRequest{
+					LogGroupId:     common.String("log-group-id"),
+					SourceService:  common.String(FlowLogService),
+					SourceResource: common.String("subnet-id"),
+				})).
+					Return(logging.ListLogsResponse{
+						Items: []logging.LogSummary{
+							{
+								Id:             common.String("log-id"),
+								LifecycleState: logging.LogLifecycleStateActive,
+								FreeformTags:   tags,
+							},
+						},
+					}, nil)
+				loggingClient.EXPECT().DeleteLog(gomock.Any(), gomock.Eq(logging.DeleteLogRequest{
+					LogGroupId: common.String("log-group-id"),
+					LogId:      common.String("log-id"),
+				})).
+					Return(logging.DeleteLogResponse{}, nil)
+			},
+			validate: func(g *WithT, clusterScope *ClusterScope) {
+				g.Expect(clusterScope.OCIClusterAccessor.GetNetworkStatus().FlowLogs).To(HaveLen(1))
+			},
+		},
+		{
+			name:          "flow log log group changed",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name:    "subnet",
+						ID:      common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{LogGroupId: "new-log-group-id"},
+					},
+				}
+				clusterScope.OCIClusterAccessor.GetNetworkStatus().FlowLogs = []infrastructurev1beta2.FlowLogStatus{
+					{
+						NetworkResourceStatus: infrastructurev1beta2.NetworkResourceStatus{ID: common.String("log-id")},
+						SubnetName:            "subnet",
+						SubnetID:              common.String("subnet-id"),
+						LogGroupId:            "log-group-id",
+						CaptureFilterID:       common.String("capture-filter-id"),
+					},
+				}
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Eq(logging.ListLogsRequest{
+					LogGroupId:     common.String("log-group-id"),
+					SourceService:  common.String(FlowLogService),
+					SourceResource: common.String("subnet-id"),
+				})).
+					Return(logging.ListLogsResponse{}, nil)
+				vcnClient.EXPECT().DeleteCaptureFilter(gomock.Any(), gomock.Eq(core.DeleteCaptureFilterRequest{
+					CaptureFilterId: common.String("capture-filter-id"),
+				})).
+					Return(core.DeleteCaptureFilterResponse{}, nil)
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Eq(logging.ListLogsRequest{
+					LogGroupId:     common.String("new-log-group-id"),
+					SourceService:  common.String(FlowLogService),
+					SourceResource: common.String("subnet-id"),
+				})).
+					Return(logging.ListLogsResponse{}, nil)
+				loggingClient.EXPECT().CreateLog(gomock.Any(), gomock.Any()).
+					Return(logging.CreateLogResponse{}, nil)
+			},
+			validate: func(g *WithT, clusterScope *ClusterScope) {
+				g.Expect(clusterScope.OCIClusterAccessor.GetNetworkStatus().FlowLogs).To(Equal([]infrastructurev1beta2.FlowLogStatus{
+					{
+						SubnetName: "subnet",
+						SubnetID:   common.String("subnet-id"),
+						LogGroupId: "new-log-group-id",
+					},
+				}))
+			},
+		},
+		{
+			name:          "flow log list error",
+			errorExpected: true,
+			matchError:    errors.New("failed to list flow logs: request failed"),
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name:    "subnet",
+						ID:      common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{LogGroupId: "log-group-id"},
+					},
+				}
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Any()).
+					Return(logging.ListLogsResponse{}, errors.New("request failed"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			defer teardown(t, g)
+			setup(t, g)
+			tc.testSpecificSetup(cs, vcnClient, loggingClient)
+			err := cs.ReconcileFlowLogs(context.Background())
+			if tc.errorExpected {
+				g.Expect(err).To(Not(BeNil()))
+				g.Expect(err.Error()).To(Equal(tc.matchError.Error()))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			if tc.validate != nil {
+				tc.validate(g, cs)
+			}
+		})
+	}
+}
+
+func TestFlowLogDeletion(t *testing.T) {
+	var (
+		cs                 *ClusterScope
+		mockCtrl           *gomock.Controller
+		vcnClient          *mock_vcn.MockClient
+		loggingClient      *mock_logging.MockClient
+		ociClusterAccessor OCISelfManagedCluster
+		tags               map[string]string
+	)
+
+	setup := func(t *testing.T, g *WithT) {
+		var err error
+		mockCtrl = gomock.NewController(t)
+		vcnClient = mock_vcn.NewMockClient(mockCtrl)
+		loggingClient = mock_logging.NewMockClient(mockCtrl)
+		client := fake.NewClientBuilder().Build()
+		ociClusterAccessor = OCISelfManagedCluster{
+			&infrastructurev1beta2.OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					UID:  "cluster_uid",
+					Name: "cluster",
+				},
+				Spec: infrastructurev1beta2.OCIClusterSpec{
+					CompartmentId:         "compartment-id",
+					OCIResourceIdentifier: "resource_uid",
+				},
+			},
+		}
+		cs, err = NewClusterScope(ClusterScopeParams{
+			VCNClient:          vcnClient,
+			LoggingClient:      loggingClient,
+			Cluster:            &clusterv1.Cluster{},
+			OCIClusterAccessor: ociClusterAccessor,
+			Client:             client,
+		})
+		tags = make(map[string]string)
+		tags[ociutil.CreatedBy] = ociutil.OCIClusterAPIProvider
+		tags[ociutil.ClusterResourceIdentifier] = "resource_uid"
+		g.Expect(err).To(BeNil())
+	}
+	teardown := func(t *testing.T, g *WithT) {
+		mockCtrl.Finish()
+	}
+
+	tests := []struct {
+		name              string
+		errorExpected     bool
+		matchError        error
+		testSpecificSetup func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient)
+		validate          func(g *WithT, clusterScope *ClusterScope)
+	}{
+		{
+			name:          "subnet already deleted",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name:    "subnet",
+						ID:      common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{LogGroupId: "log-group-id"},
+					},
+				}
+				vcnClient.EXPECT().GetSubnet(gomock.Any(), gomock.Eq(core.GetSubnetRequest{
+					SubnetId: common.String("subnet-id"),
+				})).
+					Return(core.GetSubnetResponse{}, ociutil.ErrNotFound)
+			},
+		},
+		{
+			name:          "flow log and capture filter delete",
+			errorExpected: true,
+			matchError:    errors.New("waiting for the flow logs of subnets subnet to be deleted"),
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name:    "subnet",
+						ID:      common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{LogGroupId: "log-group-id"},
+					},
+				}
+				vcnClient.EXPECT().GetSubnet(gomock.Any(), gomock.Eq(core.GetSubnetRequest{
+					SubnetId: common.String("subnet-id"),
+				})).
+					Return(core.GetSubnetResponse{
+						Subnet: core.Subnet{
+							Id:           common.String("subnet-id"),
+							FreeformTags: tags,
+						},
+					}, nil)
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Any()).
+					Return(logging.ListLogsResponse{
+						Items: []logging.LogSummary{
+							{
+								Id:           common.String("log-id"),
+								LogGroupId:   common.String("log-group-id"),
+								FreeformTags: tags,
+								Configuration: &logging.Configuration{
+									Source: logging.OciService{
+										Parameters: map[string]string{FlowLogCaptureFilterParameter: "capture-filter-id"},
+									},
+								},
+							},
+						},
+					}, nil)
+				loggingClient.EXPECT().DeleteLog(gomock.Any(), gomock.Eq(logging.DeleteLogRequest{
+					LogGroupId: common.String("log-group-id"),
+					LogId:      common.String("log-id"),
+				})).
+					Return(logging.DeleteLogResponse{}, nil)
+			},
+			validate: func(g *WithT, clusterScope *ClusterScope) {
+				g.Expect(clusterScope.OCIClusterAccessor.GetNetworkStatus().FlowLogs).To(Equal([]infrastructurev1beta2.FlowLogStatus{
+					{
+						NetworkResourceStatus: infrastructurev1beta2.NetworkResourceStatus{ID: common.String("log-id")},
+						SubnetName:            "subnet",
+						SubnetID:              common.String("subnet-id"),
+						LogGroupId:            "log-group-id",
+						CaptureFilterID:       common.String("capture-filter-id"),
+					},
+				}))
+			},
+		},
+		{
+			name:          "flow log being deleted",
+			errorExpected: true,
+			matchError:    errors.New("waiting for the flow logs of subnets subnet to be deleted"),
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name:    "subnet",
+						ID:      common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{LogGroupId: "log-group-id"},
+					},
+				}
+				clusterScope.OCIClusterAccessor.GetNetworkStatus().FlowLogs = []infrastructurev1beta2.FlowLogStatus{
+					{
+						NetworkResourceStatus: infrastructurev1beta2.NetworkResourceStatus{ID: common.String("log-id")},
+						SubnetName:            "subnet",
+						SubnetID:              common.String("subnet-id"),
+						LogGroupId:            "log-group-id",
+						CaptureFilterID:       common.String("capture-filter-id"),
+					},
+				}
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Any()).
+					Return(logging.ListLogsResponse{
+						Items: []logging.LogSummary{
+							{
+								Id:             common.String("log-id"),
+								LifecycleState: logging.LogLifecycleStateDeleting,
+								FreeformTags:   tags,
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name:          "capture filter delete once the flow log is deleted",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name:    "subnet",
+						ID:      common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{LogGroupId: "log-group-id"},
+					},
+				}
+				clusterScope.OCIClusterAccessor.GetNetworkStatus().FlowLogs = []infrastructurev1beta2.FlowLogStatus{
+					{
+						NetworkResourceStatus: infrastructurev1beta2.NetworkResourceStatus{ID: common.String("log-id")},
+						SubnetName:            "subnet",
+						SubnetID:              common.String("subnet-id"),
+						LogGroupId:            "log-group-id",
+						CaptureFilterID:       common.String("capture-filter-id"),
+					},
+				}
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Any()).
+					Return(logging.ListLogsResponse{}, nil)
+				vcnClient.EXPECT().DeleteCaptureFilter(gomock.Any(), gomock.Eq(core.DeleteCaptureFilterRequest{
+					CaptureFilterId: common.String("capture-filter-id"),
+				})).
+					Return(core.DeleteCaptureFilterResponse{}, nil)
+			},
+			validate: func(g *WithT, clusterScope *ClusterScope) {
+				g.Expect(clusterScope.OCIClusterAccessor.GetNetworkStatus().FlowLogs).To(BeEmpty())
+			},
+		},
+		{
+			name:          "flow log delete error",
+			errorExpected: true,
+			matchError:    errors.New("failed to delete flow log: request failed"),
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient, loggingClient *mock_logging.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().Vcn.Subnets = []*infrastructurev1beta2.Subnet{
+					{
+						Name:    "subnet",
+						ID:      common.String("subnet-id"),
+						FlowLog: &infrastructurev1beta2.FlowLog{LogGroupId: "log-group-id"},
+					},
+				}
+				vcnClient.EXPECT().GetSubnet(gomock.Any(), gomock.Any()).
+					Return(core.GetSubnetResponse{
+						Subnet: core.Subnet{
+							Id:           common.String("subnet-id"),
+							FreeformTags: tags,
+						},
+					}, nil)
+				loggingClient.EXPECT().ListLogs(gomock.Any(), gomock.Any()).
+					Return(logging.ListLogsResponse{
+						Items: []logging.LogSummary{
+							{
+								Id:           common.String("log-id"),
+								LogGroupId:   common.String("log-group-id"),
+								FreeformTags: tags,
+							},
+						},
+					}, nil)
+				loggingClient.EXPECT().DeleteLog(gomock.Any(), gomock.Any()).
+					Return(logging.DeleteLogResponse{}, errors.New("request failed"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			defer teardown(t, g)
+			setup(t, g)
+			tc.testSpecificSetup(cs, vcnClient, loggingClient)
+			err := cs.DeleteFlowLogs(context.Background())
+			if tc.errorExpected {
+				g.Expect(err).To(Not(BeNil()))
+				g.Expect(err.Error()).To(Equal(tc.matchError.Error()))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			if tc.validate != nil {
+				tc.validate(g, cs)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	oke "github.com/oracle/oci-go-sdk/v65/containerengine"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/pkg/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// IsHibernated returns true if the hibernate annotation is set on the cluster.
+func IsHibernated(cluster *clusterv1.Cluster) bool {
+	if cluster == nil {
+		return false
+	}
+	_, ok := cluster.GetAnnotations()[infrastructurev1beta2.HibernateAnnotation]
+	return ok
+}
+
+// ClusterHibernationChanged returns a predicate which accepts the updates of a Cluster which add or remove the
+// hibernate annotation.
+func ClusterHibernationChanged(logger logr.Logger) predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCluster, ok := e.ObjectOld.(*clusterv1.Cluster)
+			if !ok {
+				return false
+			}
+			newCluster, ok := e.ObjectNew.(*clusterv1.Cluster)
+			if !ok {
+				return false
+			}
+			if IsHibernated(oldCluster) != IsHibernated(newCluster) {
+				logger.V(4).Info("Cluster hibernation changed, allowing further processing", "cluster", newCluster.Name)
+				return true
+			}
+			return false
+		},
+		CreateFunc:  func(e event.CreateEvent) bool { return false },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return false },
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+	}
+}
+
+// IsHibernated returns true if the cluster has the hibernate annotation.
+func (s *ClusterScope) IsHibernated() bool {
+	return IsHibernated(s.Cluster)
+}
+
+// IsAPIServerLBHealthy returns true if all the backends of the API server load balancer are healthy.
+func (s *ClusterScope) IsAPIServerLBHealthy(ctx context.Context) (bool, error) {
+	apiServerLB := s.OCIClusterAccessor.GetNetworkSpec().APIServerLB
+	if apiServerLB.LoadBalancerId == nil {
+		return false, nil
+	}
+	if apiServerLB.LoadBalancerType == infrastructurev1beta2.LoadBalancerTypeLB {
+		resp, err := s.LoadBalancerClient.GetBackendSetHealth(ctx, loadbalancer.GetBackendSetHealthRequest{
+			LoadBalancerId: apiServerLB.LoadBalancerId,
+			BackendSetName: common.String(APIServerLBBackendSetName),
+		})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to get the health of the API server load balancer backends")
+		}
+		return resp.Status == loadbalancer.BackendSetHealthStatusOk, nil
+	}
+	resp, err := s.NetworkLoadBalancerClient.GetBackendSetHealth(ctx, networkloadbalancer.GetBackendSetHealthRequest{
+		NetworkLoadBalancerId: apiServerLB.LoadBalancerId,
+		BackendSetName:        common.String(APIServerLBBackendSetName),
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get the health of the API server network load balancer backends")
+	}
+	return resp.Status == networkloadbalancer.BackendSetHealthStatusOk, nil
+}
+
+// IsHibernated returns true if the cluster of the machine has the hibernate annotation.
+func (m *MachineScope) IsHibernated() bool {
+	return IsHibernated(m.Cluster)
+}
+
+// IsWaitingForControlPlaneResume returns true if the machine is a worker machine and the control plane of the
+// cluster has not resumed from the hibernation yet.
+func (m *MachineScope) IsWaitingForControlPlaneResume() bool {
+	return !m.IsControlPlane() && m.OCIClusterAccessor.GetHibernationState() != ""
+}
+
+// StopInstance stops the instance gracefully, the instance is shut down after a timeout if it does not stop.
+func (m *MachineScope) StopInstance(ctx context.Context, instance *core.Instance) error {
+	m.Info("Stopping instance", "InstanceID", *instance.Id)
+	_, err := m.ComputeClient.InstanceAction(ctx, core.InstanceActionRequest{
+		InstanceId: instance.Id,
+		Action:     core.InstanceActionActionSoftstop,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to stop instance")
+	}
+	return nil
+}
+
+// StartInstance starts a stopped instance.
+func (m *MachineScope) StartInstance(ctx context.Context, instance *core.Instance) error {
+	m.Info("Starting instance", "InstanceID", *instance.Id)
+	_, err := m.ComputeClient.InstanceAction(ctx, core.InstanceActionRequest{
+		InstanceId: instance.Id,
+		Action:     core.InstanceActionActionStart,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to start instance")
+	}
+	return nil
+}
+
+// isHibernated returns true if the instance pool is scaled to zero by the hibernation of the cluster. Once the
+// cluster resumes, the instance pool stays scaled to zero until the control plane has resumed.
+func (m *MachinePoolScope) isHibernated() bool {
+	if IsHibernated(m.Cluster) {
+		return true
+	}
+	return m.OCIMachinePool.Status.HibernatedReplicas != nil && m.OCIClusterAccesor.GetHibernationState() != ""
+}
+
+// IsWaitingForControlPlaneResume returns true if the instance pool stays scaled to zero until the control plane of
+// the cluster has resumed from the hibernation.
+func (m *MachinePoolScope) IsWaitingForControlPlaneResume() bool {
+	return !IsHibernated(m.Cluster) && m.isHibernated()
+}
+
+// desiredSize returns the size of the instance pool.
+func (m *MachinePoolScope) desiredSize() int {
+	if m.isHibernated() {
+		return 0
+	}
+	if m.MachinePool.Spec.Replicas != nil {
+		return int(*m.MachinePool.Spec.Replicas)
+	}
+	return 0
+}
+
+// recordHibernation records the size of the instance pool when it is scaled to zero by the hibernation, and
+// clears it once the instance pool is resumed.
+func (m *MachinePoolScope) recordHibernation(instancePool *core.InstancePool) {
+	if !m.isHibernated() {
+		m.OCIMachinePool.Status.HibernatedReplicas = nil
+		return
+	}
+	if m.OCIMachinePool.Status.HibernatedReplicas == nil && instancePool.Size != nil {
+		replicas := int32(*instancePool.Size)
+		m.OCIMachinePool.Status.HibernatedReplicas = &replicas
+	}
+}
+
+// nodePoolSize returns the size of the node pool, and false if the size of the node pool is left as it is
+// because the replicas are managed by an external autoscaler. The node pool is scaled to zero while the cluster
+// is hibernated, a node pool whose replicas are managed by an external autoscaler is scaled back to its size
+// before the hibernation once the cluster resumes.
+func (m *ManagedMachinePoolScope) nodePoolSize() (int, bool) {
+	if IsHibernated(m.Cluster) {
+		return 0, true
+	}
+	if annotations.ReplicasManagedByExternalAutoscaler(m.MachinePool) {
+		if m.OCIManagedMachinePool.Status.HibernatedReplicas != nil {
+			return int(*m.OCIManagedMachinePool.Status.HibernatedReplicas), true
+		}
+		return 0, false
+	}
+	return int(*m.MachinePool.Spec.Replicas), true
+}
+
+// recordHibernation records the size of the node pool when it is scaled to zero by the hibernation.
+func (m *ManagedMachinePoolScope) recordHibernation(pool *oke.NodePool) {
+	if !IsHibernated(m.Cluster) || m.OCIManagedMachinePool.Status.HibernatedReplicas != nil {
+		return
+	}
+	if pool.NodeConfigDetails != nil && pool.NodeConfigDetails.Size != nil {
+		replicas := int32(*pool.NodeConfigDetails.Size)
+		m.OCIManagedMachinePool.Status.HibernatedReplicas = &replicas
+	}
+}
@@ -0,0 +1,238 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// maxMetadataSizeInBytes is the size limit of the metadata of an instance.
+	maxMetadataSizeInBytes = 32000
+	// defaultIgnitionPreauthenticatedRequestTTL is how long an instance can read its Ignition config from Object
+	// Storage when the Ignition storage does not define it.
+	defaultIgnitionPreauthenticatedRequestTTL = time.Hour
+)
+
+// getBootstrapFormat returns the declared format of the bootstrap data, or else the format of the bootstrap data
+// secret, which defaults to cloud-config.
+func getBootstrapFormat(declared infrastructurev1beta2.BootstrapFormat, secret *corev1.Secret) infrastructurev1beta2.BootstrapFormat {
+	if declared != "" {
+		return declared
+	}
+	if format, ok := secret.Data["format"]; ok && len(format) > 0 {
+		return infrastructurev1beta2.BootstrapFormat(format)
+	}
+	return infrastructurev1beta2.CloudConfigBootstrapFormat
+}
+
+// getIgnitionVersion returns the spec version of the Ignition config.
+func getIgnitionVersion(config string) (string, error) {
+	var ignition struct {
+		Ignition struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}
+	if err := json.Unmarshal([]byte(config), &ignition); err != nil {
+		return "", errors.Wrap(err, "the bootstrap data is not a valid Ignition config")
+	}
+	version := ignition.Ignition.Version
+	if version == "" || strings.HasPrefix(version, "1.") {
+		return "", errors.Errorf("the Ignition config version %q is not supported, the version must be 2.0 or later", version)
+	}
+	return version, nil
+}
+
+// getIgnitionStub returns an Ignition config of the version which replaces itself with the config at the source.
+func getIgnitionStub(version string, source string) (string, error) {
+	stub := map[string]interface{}{
+		"ignition": map[string]interface{}{
+			"version": version,
+			"config": map[string]interface{}{
+				"replace": map[string]interface{}{
+					"source": source,
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(stub)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create the Ignition stub config")
+	}
+	return string(data), nil
+}
+
+// fitsInMetadata returns whether the user data fits in the metadata of the instance with the other metadata.
+func fitsInMetadata(metadata map[string]string, userData string) bool {
+	size := len("user_data") + len(userData)
+	for key, value := range metadata {
+		if key != "user_data" {
+			size += len(key) + len(value)
+		}
+	}
+	return size <= maxMetadataSizeInBytes
+}
+
+// getUserData returns the base64 encoded user data of the instance. An Ignition config which does not fit in the
+// metadata of the instance is uploaded to Object Storage, the user data is then a stub config which points to it.
+func (m *MachineScope) getUserData(ctx context.Context, bootstrapData string, format infrastructurev1beta2.BootstrapFormat,
+	metadata map[string]string) (string, error) {
+	userData := base64.StdEncoding.EncodeToString([]byte(bootstrapData))
+	if format != infrastructurev1beta2.IgnitionBootstrapFormat {
+		return userData, nil
+	}
+	version, err := getIgnitionVersion(bootstrapData)
+	if err != nil {
+		return "", err
+	}
+	if fitsInMetadata(metadata, userData) {
+		return userData, nil
+	}
+	if m.OCIMachine.Spec.IgnitionStorage == nil {
+		return "", errors.Errorf("the Ignition config of %d bytes exceeds the size limit of the instance metadata, "+
+			"the ignitionStorage has to be set to store it in Object Storage", len(bootstrapData))
+	}
+	source, err := m.uploadIgnitionConfig(ctx, bootstrapData)
+	if err != nil {
+		return "", err
+	}
+	stub, err := getIgnitionStub(version, source)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString([]byte(stub)), nil
+}
+
+// uploadIgnitionConfig uploads the Ignition config to the bucket of the Ignition storage and returns the URL of a
+// pre-authenticated request which reads it.
+func (m *MachineScope) uploadIgnitionConfig(ctx context.Context, config string) (string, error) {
+	storage := m.OCIMachine.Spec.IgnitionStorage
+	status := m.OCIMachine.Status.Ignition
+	var namespace string
+	if status != nil && status.BucketName == storage.BucketName {
+		// the config of an earlier launch is overwritten, its pre-authenticated request is replaced
+		if err := m.deleteIgnitionPreauthenticatedRequest(ctx, status); err != nil {
+			return "", err
+		}
+		namespace = status.Namespace
+	} else {
+		// the config of an earlier launch uploaded to another bucket is not overwritten
+		if err := m.DeleteIgnitionConfig(ctx); err != nil {
+			return "", err
+		}
+		resp, err := m.ObjectStorageClient.GetNamespace(ctx, objectstorage.GetNamespaceRequest{})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to get the Object Storage namespace")
+		}
+		namespace = ociutil.DerefString(resp.Value)
+	}
+
+	objectName := fmt.Sprintf("%s/%s/ignition.json", m.OCIClusterAccessor.GetOCIResourceIdentifier(), m.OCIMachine.Name)
+	_, err := m.ObjectStorageClient.PutObject(ctx, objectstorage.PutObjectRequest{
+		NamespaceName: common.String(namespace),
+		BucketName:    common.String(storage.BucketName),
+		ObjectName:    common.String(objectName),
+		ContentLength: common.Int64(int64(len(config))),
+		ContentType:   common.String("application/json"),
+		PutObjectBody: io.NopCloser(strings.NewReader(config)),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to upload the Ignition config to the bucket %s", storage.BucketName)
+	}
+	// the object is recorded before the pre-authenticated request is created so that it is deleted with the machine
+	m.OCIMachine.Status.Ignition = &infrastructurev1beta2.IgnitionStatus{
+		Namespace:  namespace,
+		BucketName: storage.BucketName,
+		ObjectName: objectName,
+	}
+
+	ttl := defaultIgnitionPreauthenticatedRequestTTL
+	if storage.PreauthenticatedRequestTTL != nil {
+		ttl = storage.PreauthenticatedRequestTTL.Duration
+	}
+	resp, err := m.ObjectStorageClient.CreatePreauthenticatedRequest(ctx, objectstorage.CreatePreauthenticatedRequestRequest{
+		NamespaceName: common.String(namespace),
+		BucketName:    common.String(storage.BucketName),
+		CreatePreauthenticatedRequestDetails: objectstorage.CreatePreauthenticatedRequestDetails{
+			Name:        common.String(fmt.Sprintf("%s-ignition", m.OCIMachine.Name)),
+			ObjectName:  common.String(objectName),
+			AccessType:  objectstorage.CreatePreauthenticatedRequestDetailsAccessTypeObjectread,
+			TimeExpires: &common.SDKTime{Time: time.Now().Add(ttl)},
+		},
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create the pre-authenticated request of the Ignition config")
+	}
+	if resp.FullPath == nil {
+		return "", errors.New("the pre-authenticated request of the Ignition config does not have a URL")
+	}
+	m.OCIMachine.Status.Ignition.PreauthenticatedRequestId = resp.Id
+	m.Logger.Info("Uploaded the Ignition config to Object Storage", "bucket", storage.BucketName, "object", objectName)
+	return *resp.FullPath, nil
+}
+
+// DeleteIgnitionConfig deletes the Ignition config uploaded to Object Storage and its pre-authenticated request.
+func (m *MachineScope) DeleteIgnitionConfig(ctx context.Context) error {
+	status := m.OCIMachine.Status.Ignition
+	if status == nil {
+		return nil
+	}
+	if err := m.deleteIgnitionPreauthenticatedRequest(ctx, status); err != nil {
+		return err
+	}
+	_, err := m.ObjectStorageClient.DeleteObject(ctx, objectstorage.DeleteObjectRequest{
+		NamespaceName: common.String(status.Namespace),
+		BucketName:    common.String(status.BucketName),
+		ObjectName:    common.String(status.ObjectName),
+	})
+	if err != nil && !ociutil.IsNotFound(err) {
+		return errors.Wrap(err, "failed to delete the Ignition config")
+	}
+	m.OCIMachine.Status.Ignition = nil
+	m.Logger.Info("Deleted the Ignition config from Object Storage", "bucket", status.BucketName, "object", status.ObjectName)
+	return nil
+}
+
+// deleteIgnitionPreauthenticatedRequest deletes the pre-authenticated request which reads the Ignition config.
+func (m *MachineScope) deleteIgnitionPreauthenticatedRequest(ctx context.Context, status *infrastructurev1beta2.IgnitionStatus) error {
+	if status.PreauthenticatedRequestId == nil {
+		return nil
+	}
+	_, err := m.ObjectStorageClient.DeletePreauthenticatedRequest(ctx, objectstorage.DeletePreauthenticatedRequestRequest{
+		NamespaceName: common.String(status.Namespace),
+		BucketName:    common.String(status.BucketName),
+		ParId:         status.PreauthenticatedRequestId,
+	})
+	if err != nil && !ociutil.IsNotFound(err) {
+		return errors.Wrap(err, "failed to delete the pre-authenticated request of the Ignition config")
+	}
+	status.PreauthenticatedRequestId = nil
+	return nil
+}
@@ -0,0 +1,272 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/objectstorage/mock_objectstorage"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/klogr"
+)
+
+func TestGetBootstrapFormat(t *testing.T) {
+	g := NewWithT(t)
+	ignition := &corev1.Secret{Data: map[string][]byte{"format": []byte("ignition")}}
+	g.Expect(getBootstrapFormat("", ignition)).To(Equal(infrastructurev1beta2.IgnitionBootstrapFormat))
+	g.Expect(getBootstrapFormat(infrastructurev1beta2.CloudConfigBootstrapFormat, ignition)).
+		To(Equal(infrastructurev1beta2.CloudConfigBootstrapFormat))
+	g.Expect(getBootstrapFormat("", &corev1.Secret{})).To(Equal(infrastructurev1beta2.CloudConfigBootstrapFormat))
+}
+
+func TestGetUserData(t *testing.T) {
+	largeIgnition := fmt.Sprintf(`{"ignition":{"version":"3.4.0"},"storage":{"files":[{"path":"/etc/large","contents":{"source":"data:,%s"}}]}}`,
+		strings.Repeat("a", maxMetadataSizeInBytes))
+
+	tests := []struct {
+		name             string
+		bootstrapData    string
+		format           infrastructurev1beta2.BootstrapFormat
+		storage          *infrastructurev1beta2.IgnitionStorage
+		status           *infrastructurev1beta2.IgnitionStatus
+		setup            func(objectStorageClient *mock_objectstorage.MockClient)
+		errorExpected    bool
+		expectedUserData string
+		expectedStatus   *infrastructurev1beta2.IgnitionStatus
+	}{
+		{
+			name:             "cloud-config",
+			bootstrapData:    "#cloud-config",
+			format:           infrastructurev1beta2.CloudConfigBootstrapFormat,
+			expectedUserData: "#cloud-config",
+		},
+		{
+			name:             "Ignition config in the metadata",
+			bootstrapData:    `{"ignition":{"version":"3.4.0"}}`,
+			format:           infrastructurev1beta2.IgnitionBootstrapFormat,
+			expectedUserData: `{"ignition":{"version":"3.4.0"}}`,
+		},
+		{
+			name:          "invalid Ignition config",
+			bootstrapData: "#cloud-config",
+			format:        infrastructurev1beta2.IgnitionBootstrapFormat,
+			errorExpected: true,
+		},
+		{
+			name:          "unsupported Ignition config version",
+			bootstrapData: `{"ignitionVersion":1}`,
+			format:        infrastructurev1beta2.IgnitionBootstrapFormat,
+			errorExpected: true,
+		},
+		{
+			name:          "large Ignition config without storage",
+			bootstrapData: largeIgnition,
+			format:        infrastructurev1beta2.IgnitionBootstrapFormat,
+			errorExpected: true,
+		},
+		{
+			name:          "large Ignition config in Object Storage",
+			bootstrapData: largeIgnition,
+			format:        infrastructurev1beta2.IgnitionBootstrapFormat,
+			storage:       &infrastructurev1beta2.IgnitionStorage{BucketName: "bucket"},
+			setup: func(objectStorageClient *mock_objectstorage.MockClient) {
+				objectStorageClient.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).
+					Return(objectstorage.GetNamespaceResponse{Value: common.String("namespace")}, nil)
+				objectStorageClient.EXPECT().PutObject(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req objectstorage.PutObjectRequest) (objectstorage.PutObjectResponse, error) {
+						if *req.NamespaceName != "namespace" || *req.BucketName != "bucket" ||
+							*req.ObjectName != "resource-uid/machine/ignition.json" || *req.ContentLength != int64(len(largeIgnition)) {
+							return objectstorage.PutObjectResponse{}, fmt.Errorf("unexpected request")
+						}
+						return objectstorage.PutObjectResponse{}, nil
+					})
+				objectStorageClient.EXPECT().CreatePreauthenticatedRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req objectstorage.CreatePreauthenticatedRequestRequest) (objectstorage.CreatePreauthenticatedRequestResponse, error) {
+						if *req.ObjectName != "resource-uid/machine/ignition.json" ||
+							req.AccessType != objectstorage.CreatePreauthenticatedRequestDetailsAccessTypeObjectread {
+							return objectstorage.CreatePreauthenticatedRequestResponse{}, fmt.Errorf("unexpected request")
+						}
+						return objectstorage.CreatePreauthenticatedRequestResponse{PreauthenticatedRequest: objectstorage.PreauthenticatedRequest{
+							Id:       common.String("par"),
+							FullPath: common.String("https://objectstorage.example.com/p/token/n/namespace/b/bucket/o/ignition.json"),
+						}}, nil
+					})
+			},
+			expectedUserData: `{"ignition":{"config":{"replace":{"source":"https://objectstorage.example.com/p/token/n/namespace/b/bucket/o/ignition.json"}},"version":"3.4.0"}}`,
+			expectedStatus: &infrastructurev1beta2.IgnitionStatus{
+				Namespace:                 "namespace",
+				BucketName:                "bucket",
+				ObjectName:                "resource-uid/machine/ignition.json",
+				PreauthenticatedRequestId: common.String("par"),
+			},
+		},
+		{
+			name:          "Ignition config of a relaunched instance",
+			bootstrapData: largeIgnition,
+			format:        infrastructurev1beta2.IgnitionBootstrapFormat,
+			storage:       &infrastructurev1beta2.IgnitionStorage{BucketName: "bucket"},
+			status: &infrastructurev1beta2.IgnitionStatus{
+				Namespace:                 "namespace",
+				BucketName:                "bucket",
+				ObjectName:                "resource-uid/machine/ignition.json",
+				PreauthenticatedRequestId: common.String("old-par"),
+			},
+			setup: func(objectStorageClient *mock_objectstorage.MockClient) {
+				objectStorageClient.EXPECT().DeletePreauthenticatedRequest(gomock.Any(), gomock.Eq(objectstorage.DeletePreauthenticatedRequestRequest{
+					NamespaceName: common.String("namespace"),
+					BucketName:    common.String("bucket"),
+					ParId:         common.String("old-par"),
+				})).Return(objectstorage.DeletePreauthenticatedRequestResponse{}, nil)
+				objectStorageClient.EXPECT().PutObject(gomock.Any(), gomock.Any()).
+					Return(objectstorage.PutObjectResponse{}, nil)
+				objectStorageClient.EXPECT().CreatePreauthenticatedRequest(gomock.Any(), gomock.Any()).
+					Return(objectstorage.CreatePreauthenticatedRequestResponse{PreauthenticatedRequest: objectstorage.PreauthenticatedRequest{
+						Id:       common.String("par"),
+						FullPath: common.String("https://objectstorage.example.com/p/token/n/namespace/b/bucket/o/ignition.json"),
+					}}, nil)
+			},
+			expectedUserData: `{"ignition":{"config":{"replace":{"source":"https://objectstorage.example.com/p/token/n/namespace/b/bucket/o/ignition.json"}},"version":"3.4.0"}}`,
+			expectedStatus: &infrastructurev1beta2.IgnitionStatus{
+				Namespace:                 "namespace",
+				BucketName:                "bucket",
+				ObjectName:                "resource-uid/machine/ignition.json",
+				PreauthenticatedRequestId: common.String("par"),
+			},
+		},
+		{
+			name:          "Ignition config of a relaunched instance moved to another bucket",
+			bootstrapData: largeIgnition,
+			format:        infrastructurev1beta2.IgnitionBootstrapFormat,
+			storage:       &infrastructurev1beta2.IgnitionStorage{BucketName: "bucket"},
+			status: &infrastructurev1beta2.IgnitionStatus{
+				Namespace:                 "namespace",
+				BucketName:                "old-bucket",
+				ObjectName:                "resource-uid/machine/ignition.json",
+				PreauthenticatedRequestId: common.String("old-par"),
+			},
+			setup: func(objectStorageClient *mock_objectstorage.MockClient) {
+				objectStorageClient.EXPECT().DeletePreauthenticatedRequest(gomock.Any(), gomock.Eq(objectstorage.DeletePreauthenticatedRequestRequest{
+					NamespaceName: common.String("namespace"),
+					BucketName:    common.String("old-bucket"),
+					ParId:         common.String("old-par"),
+				})).Return(objectstorage.DeletePreauthenticatedRequestResponse{}, nil)
+				objectStorageClient.EXPECT().DeleteObject(gomock.Any(), gomock.Eq(objectstorage.DeleteObjectRequest{
+					NamespaceName: common.String("namespace"),
+					BucketName:    common.String("old-bucket"),
+					ObjectName:    common.String("resource-uid/machine/ignition.json"),
+				})).Return(objectstorage.DeleteObjectResponse{}, nil)
+				objectStorageClient.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).
+					Return(objectstorage.GetNamespaceResponse{Value: common.String("namespace")}, nil)
+				objectStorageClient.EXPECT().PutObject(gomock.Any(), gomock.Any()).
+					Return(objectstorage.PutObjectResponse{}, nil)
+				objectStorageClient.EXPECT().CreatePreauthenticatedRequest(gomock.Any(), gomock.Any()).
+					Return(objectstorage.CreatePreauthenticatedRequestResponse{PreauthenticatedRequest: objectstorage.PreauthenticatedRequest{
+						Id:       common.String("par"),
+						FullPath: common.String("https://objectstorage.example.com/p/token/n/namespace/b/bucket/o/ignition.json"),
+					}}, nil)
+			},
+			expectedUserData: `{"ignition":{"config":{"replace":{"source":"https://objectstorage.example.com/p/token/n/namespace/b/bucket/o/ignition.json"}},"version":"3.4.0"}}`,
+			expectedStatus: &infrastructurev1beta2.IgnitionStatus{
+				Namespace:                 "namespace",
+				BucketName:                "bucket",
+				ObjectName:                "resource-uid/machine/ignition.json",
+				PreauthenticatedRequestId: common.String("par"),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			objectStorageClient := mock_objectstorage.NewMockClient(mockCtrl)
+			if tc.setup != nil {
+				tc.setup(objectStorageClient)
+			}
+			log := klogr.New()
+			ms := &MachineScope{
+				Logger: &log,
+				OCIMachine: &infrastructurev1beta2.OCIMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "machine"},
+					Spec:       infrastructurev1beta2.OCIMachineSpec{IgnitionStorage: tc.storage},
+					Status:     infrastructurev1beta2.OCIMachineStatus{Ignition: tc.status},
+				},
+				ObjectStorageClient: objectStorageClient,
+				OCIClusterAccessor: OCISelfManagedCluster{OCICluster: &infrastructurev1beta2.OCICluster{
+					Spec: infrastructurev1beta2.OCIClusterSpec{OCIResourceIdentifier: "resource-uid"},
+				}},
+			}
+
+			userData, err := ms.getUserData(context.Background(), tc.bootstrapData, tc.format, map[string]string{"key": "value"})
+			if tc.errorExpected {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			decoded, err := base64.StdEncoding.DecodeString(userData)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(decoded)).To(Equal(tc.expectedUserData))
+			g.Expect(ms.OCIMachine.Status.Ignition).To(Equal(tc.expectedStatus))
+		})
+	}
+}
+
+func TestDeleteIgnitionConfig(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	objectStorageClient := mock_objectstorage.NewMockClient(mockCtrl)
+	objectStorageClient.EXPECT().DeletePreauthenticatedRequest(gomock.Any(), gomock.Eq(objectstorage.DeletePreauthenticatedRequestRequest{
+		NamespaceName: common.String("namespace"),
+		BucketName:    common.String("bucket"),
+		ParId:         common.String("par"),
+	})).Return(objectstorage.DeletePreauthenticatedRequestResponse{}, nil)
+	objectStorageClient.EXPECT().DeleteObject(gomock.Any(), gomock.Eq(objectstorage.DeleteObjectRequest{
+		NamespaceName: common.String("namespace"),
+		BucketName:    common.String("bucket"),
+		ObjectName:    common.String("resource-uid/machine/ignition.json"),
+	})).Return(objectstorage.DeleteObjectResponse{}, nil)
+	log := klogr.New()
+	ms := &MachineScope{
+		Logger: &log,
+		OCIMachine: &infrastructurev1beta2.OCIMachine{
+			Status: infrastructurev1beta2.OCIMachineStatus{
+				Ignition: &infrastructurev1beta2.IgnitionStatus{
+					Namespace:                 "namespace",
+					BucketName:                "bucket",
+					ObjectName:                "resource-uid/machine/ignition.json",
+					PreauthenticatedRequestId: common.String("par"),
+				},
+			},
+		},
+		ObjectStorageClient: objectStorageClient,
+	}
+
+	g.Expect(ms.DeleteIgnitionConfig(context.Background())).To(Succeed())
+	g.Expect(ms.OCIMachine.Status.Ignition).To(BeNil())
+	g.Expect(ms.DeleteIgnitionConfig(context.Background())).To(Succeed())
+}
@@ -0,0 +1,137 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+)
+
+// selectImage returns the most recently created image which matches the selector and is compatible with the
+// shape, the custom images are listed from the compartment of the selector or else the default compartment.
+func selectImage(ctx context.Context, computeClient compute.ComputeClient, compartmentId string, shape string,
+	selector infrastructurev1beta2.ImageSelector) (*core.Image, error) {
+	var displayNamePattern *regexp.Regexp
+	if selector.DisplayNamePattern != "" {
+		var err error
+		displayNamePattern, err = regexp.Compile(selector.DisplayNamePattern)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid display name pattern of the image selector")
+		}
+	}
+	if selector.CompartmentId != nil && *selector.CompartmentId != "" {
+		compartmentId = *selector.CompartmentId
+	}
+	req := core.ListImagesRequest{
+		CompartmentId:  common.String(compartmentId),
+		LifecycleState: core.ImageLifecycleStateAvailable,
+		SortBy:         core.ListImagesSortByTimecreated,
+		SortOrder:      core.ListImagesSortOrderDesc,
+	}
+	if selector.OperatingSystem != "" {
+		req.OperatingSystem = common.String(selector.OperatingSystem)
+	}
+	if selector.OperatingSystemVersion != "" {
+		req.OperatingSystemVersion = common.String(selector.OperatingSystemVersion)
+	}
+	if shape != "" {
+		req.Shape = common.String(shape)
+	}
+	for {
+		resp, err := computeClient.ListImages(ctx, req)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list the images")
+		}
+		for i := range resp.Items {
+			if imageMatches(resp.Items[i], displayNamePattern, selector) {
+				return &resp.Items[i], nil
+			}
+		}
+		if resp.OpcNextPage == nil {
+			return nil, errors.New("no image matches the image selector")
+		}
+		req.Page = resp.OpcNextPage
+	}
+}
+
+func imageMatches(image core.Image, displayNamePattern *regexp.Regexp, selector infrastructurev1beta2.ImageSelector) bool {
+	displayName := ociutil.DerefString(image.DisplayName)
+	if displayNamePattern != nil && !displayNamePattern.MatchString(displayName) {
+		return false
+	}
+	switch selector.Architecture {
+	case infrastructurev1beta2.ImageArchitectureArm:
+		if !strings.Contains(displayName, string(infrastructurev1beta2.ImageArchitectureArm)) {
+			return false
+		}
+	case infrastructurev1beta2.ImageArchitectureX86:
+		if strings.Contains(displayName, string(infrastructurev1beta2.ImageArchitectureArm)) {
+			return false
+		}
+	}
+	for k, v := range selector.FreeformTags {
+		if value, ok := image.FreeformTags[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// selectedImageId returns the OCID of the image selected before if it was selected by the same selector, so that
+// a more recent image is only picked up when the selector changes.
+func selectedImageId(selected *infrastructurev1beta2.SelectedImage, selector infrastructurev1beta2.ImageSelector) string {
+	if selected == nil || !reflect.DeepEqual(selected.Selector, selector) {
+		return ""
+	}
+	return selected.ImageId
+}
+
+// getImageId returns the OCID of the image to launch the instance with, the image selector of the spec is resolved
+// once and the selected image is recorded in the status.
+func (m *MachineScope) getImageId(ctx context.Context) (string, error) {
+	selector := m.OCIMachine.Spec.ImageSelector
+	if selector == nil {
+		return m.OCIMachine.Spec.ImageId, nil
+	}
+	if imageId := selectedImageId(m.OCIMachine.Status.Image, *selector); imageId != "" {
+		return imageId, nil
+	}
+	image, err := selectImage(ctx, m.ComputeClient, m.getCompartmentId(), m.OCIMachine.Spec.Shape, *selector)
+	if err != nil {
+		return "", err
+	}
+	m.Logger.Info("Selected the image of the instance", "image", image.Id, "displayName", image.DisplayName)
+	m.OCIMachine.Status.Image = newSelectedImage(image, *selector)
+	return *image.Id, nil
+}
+
+func newSelectedImage(image *core.Image, selector infrastructurev1beta2.ImageSelector) *infrastructurev1beta2.SelectedImage {
+	return &infrastructurev1beta2.SelectedImage{
+		ImageId:     *image.Id,
+		DisplayName: ociutil.DerefString(image.DisplayName),
+		Selector:    *selector.DeepCopy(),
+	}
+}
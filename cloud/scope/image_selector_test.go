@@ -0,0 +1,208 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute/mock_compute"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2/klogr"
+)
+
+func TestSelectImage(t *testing.T) {
+	images := []core.Image{
+		{
+			Id:           common.String("aarch64-image"),
+			DisplayName:  common.String("Oracle-Linux-8.9-aarch64-2024.01.26-0"),
+			FreeformTags: map[string]string{"k8s-version": "v1.29.1"},
+		},
+		{
+			Id:           common.String("new-image"),
+			DisplayName:  common.String("Oracle-Linux-8.9-2024.01.26-0"),
+			FreeformTags: map[string]string{"k8s-version": "v1.29.1"},
+		},
+		{
+			Id:           common.String("old-image"),
+			DisplayName:  common.String("Oracle-Linux-8.8-2023.09.26-0"),
+			FreeformTags: map[string]string{"k8s-version": "v1.28.2"},
+		},
+	}
+	tests := []struct {
+		name          string
+		selector      infrastructurev1beta2.ImageSelector
+		setup         func(computeClient *mock_compute.MockComputeClient)
+		errorExpected bool
+		expected      string
+	}{
+		{
+			name: "most recent image is selected",
+			selector: infrastructurev1beta2.ImageSelector{
+				OperatingSystem:        "Oracle Linux",
+				OperatingSystemVersion: "8",
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().ListImages(gomock.Any(), gomock.Eq(core.ListImagesRequest{
+					CompartmentId:          common.String("test-compartment"),
+					OperatingSystem:        common.String("Oracle Linux"),
+					OperatingSystemVersion: common.String("8"),
+					Shape:                  common.String("VM.Standard.E4.Flex"),
+					LifecycleState:         core.ImageLifecycleStateAvailable,
+					SortBy:                 core.ListImagesSortByTimecreated,
+					SortOrder:              core.ListImagesSortOrderDesc,
+				})).Return(core.ListImagesResponse{Items: images}, nil)
+			},
+			expected: "aarch64-image",
+		},
+		{
+			name: "architecture is matched on the display name",
+			selector: infrastructurev1beta2.ImageSelector{
+				Architecture: infrastructurev1beta2.ImageArchitectureX86,
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().ListImages(gomock.Any(), gomock.Any()).
+					Return(core.ListImagesResponse{Items: images}, nil)
+			},
+			expected: "new-image",
+		},
+		{
+			name: "display name pattern and tags are matched",
+			selector: infrastructurev1beta2.ImageSelector{
+				CompartmentId:      common.String("image-compartment"),
+				DisplayNamePattern: `^Oracle-Linux-8\.\d+-20\d\d\.`,
+				FreeformTags:       map[string]string{"k8s-version": "v1.28.2"},
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().ListImages(gomock.Any(), gomock.Eq(core.ListImagesRequest{
+					CompartmentId:  common.String("image-compartment"),
+					Shape:          common.String("VM.Standard.E4.Flex"),
+					LifecycleState: core.ImageLifecycleStateAvailable,
+					SortBy:         core.ListImagesSortByTimecreated,
+					SortOrder:      core.ListImagesSortOrderDesc,
+				})).Return(core.ListImagesResponse{Items: images[:1], OpcNextPage: common.String("next")}, nil)
+				computeClient.EXPECT().ListImages(gomock.Any(), gomock.Eq(core.ListImagesRequest{
+					CompartmentId:  common.String("image-compartment"),
+					Shape:          common.String("VM.Standard.E4.Flex"),
+					LifecycleState: core.ImageLifecycleStateAvailable,
+					SortBy:         core.ListImagesSortByTimecreated,
+					SortOrder:      core.ListImagesSortOrderDesc,
+					Page:           common.String("next"),
+				})).Return(core.ListImagesResponse{Items: images[1:]}, nil)
+			},
+			expected: "old-image",
+		},
+		{
+			name: "no image matches",
+			selector: infrastructurev1beta2.ImageSelector{
+				FreeformTags: map[string]string{"k8s-version": "v1.30.0"},
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().ListImages(gomock.Any(), gomock.Any()).
+					Return(core.ListImagesResponse{Items: images}, nil)
+			},
+			errorExpected: true,
+		},
+		{
+			name:     "list images error",
+			selector: infrastructurev1beta2.ImageSelector{},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().ListImages(gomock.Any(), gomock.Any()).
+					Return(core.ListImagesResponse{}, errors.New("request failed"))
+			},
+			errorExpected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			computeClient := mock_compute.NewMockComputeClient(mockCtrl)
+			tc.setup(computeClient)
+
+			image, err := selectImage(context.Background(), computeClient, "test-compartment", "VM.Standard.E4.Flex", tc.selector)
+			if tc.errorExpected {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(*image.Id).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestMachineScope_GetImageId(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	computeClient := mock_compute.NewMockComputeClient(mockCtrl)
+	log := klogr.New()
+	ociMachine := &infrastructurev1beta2.OCIMachine{
+		Spec: infrastructurev1beta2.OCIMachineSpec{
+			CompartmentId: "test-compartment",
+			ImageSelector: &infrastructurev1beta2.ImageSelector{
+				FreeformTags: map[string]string{"k8s-version": "v1.29.1"},
+			},
+		},
+	}
+	ms := &MachineScope{
+		Logger:        &log,
+		OCIMachine:    ociMachine,
+		ComputeClient: computeClient,
+	}
+
+	computeClient.EXPECT().ListImages(gomock.Any(), gomock.Any()).
+		Return(core.ListImagesResponse{Items: []core.Image{
+			{
+				Id:           common.String("image-1"),
+				DisplayName:  common.String("capi-v1.29.1"),
+				FreeformTags: map[string]string{"k8s-version": "v1.29.1"},
+			},
+		}}, nil)
+	imageId, err := ms.getImageId(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(imageId).To(Equal("image-1"))
+	g.Expect(ociMachine.Status.Image).To(Equal(&infrastructurev1beta2.SelectedImage{
+		ImageId:     "image-1",
+		DisplayName: "capi-v1.29.1",
+		Selector:    *ociMachine.Spec.ImageSelector,
+	}))
+
+	// the selected image is reused while the selector does not change
+	imageId, err = ms.getImageId(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(imageId).To(Equal("image-1"))
+
+	ociMachine.Spec.ImageSelector.FreeformTags["k8s-version"] = "v1.29.2"
+	computeClient.EXPECT().ListImages(gomock.Any(), gomock.Any()).
+		Return(core.ListImagesResponse{Items: []core.Image{
+			{
+				Id:           common.String("image-2"),
+				FreeformTags: map[string]string{"k8s-version": "v1.29.2"},
+			},
+		}}, nil)
+	imageId, err = ms.getImageId(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(imageId).To(Equal("image-2"))
+	g.Expect(ociMachine.Status.Image.ImageId).To(Equal("image-2"))
+}
@@ -19,6 +19,7 @@ package scope
 import (
 	"context"
 
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
@@ -42,14 +43,42 @@ func (s *ClusterScope) ReconcileInternetGateway(ctx context.Context) error {
 	}
 	if igw != nil {
 		s.OCIClusterAccessor.GetNetworkSpec().Vcn.InternetGateway.Id = igw.Id
-		s.Logger.Info("No Reconciliation Required for Internet Gateway", "internet_gateway", igw.Id)
-		return nil
+		s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+			status.InternetGateway = newNetworkResourceStatus(igw.Id, string(igw.LifecycleState))
+		})
+		if err := s.reconcileInternetGatewayTags(ctx, igw); err != nil {
+			return err
+		}
+		var drift driftDiff
+		if igw.IsEnabled != nil && !*igw.IsEnabled {
+			drift.compare("isEnabled", "true", common.String("false"))
+		}
+		if len(drift) == 0 {
+			s.Logger.Info("No Reconciliation Required for Internet Gateway", "internet_gateway", igw.Id)
+			return nil
+		}
+		return s.reconcileDrift(DriftResourceInternetGateway, InternetGatewayName, igw.Id, drift, func() error {
+			_, err := s.VCNClient.UpdateInternetGateway(ctx, core.UpdateInternetGatewayRequest{
+				IgId: igw.Id,
+				UpdateInternetGatewayDetails: core.UpdateInternetGatewayDetails{
+					IsEnabled: common.Bool(true),
+				},
+			})
+			if err != nil {
+				s.Logger.Error(err, "failed to reconcile the internet gateway, failed to update")
+				return errors.Wrap(err, "failed to reconcile the internet gateway, failed to update")
+			}
+			return nil
+		})
 	}
 	internetGateway, err := s.CreateInternetGateway(ctx)
 	if err != nil {
 		return err
 	}
 	s.OCIClusterAccessor.GetNetworkSpec().Vcn.InternetGateway.Id = internetGateway
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		status.InternetGateway = newNetworkResourceStatus(internetGateway, "")
+	})
 	return err
 }
 
@@ -68,14 +97,14 @@ func (s *ClusterScope) GetInternetGateway(ctx context.Context) (*core.InternetGa
 			return nil, err
 		}
 		igw := resp.InternetGateway
-		if s.IsResourceCreatedByClusterAPI(igw.FreeformTags) {
+		if s.IsNetworkResourceCreatedByClusterAPI(igw.FreeformTags) {
 			return &igw, nil
 		} else {
 			return nil, errors.New("cluster api tags have been modified out of context")
 		}
 	}
 	igws, err := s.VCNClient.ListInternetGateways(ctx, core.ListInternetGatewaysRequest{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNetworkCompartmentId()),
 		VcnId:         s.getVcnId(),
 		DisplayName:   common.String(InternetGatewayName),
 	})
@@ -84,7 +113,7 @@ func (s *ClusterScope) GetInternetGateway(ctx context.Context) (*core.InternetGa
 		return nil, errors.Wrap(err, "failed to list internet gateways")
 	}
 	for _, igw := range igws.Items {
-		if s.IsResourceCreatedByClusterAPI(igw.FreeformTags) {
+		if s.IsNetworkResourceCreatedByClusterAPI(igw.FreeformTags) {
 			return &igw, nil
 		}
 	}
@@ -94,11 +123,11 @@ func (s *ClusterScope) GetInternetGateway(ctx context.Context) (*core.InternetGa
 // CreateInternetGateway creates the Internet Gateway for the cluster based on the ClusterScope
 func (s *ClusterScope) CreateInternetGateway(ctx context.Context) (*string, error) {
 	igwDetails := core.CreateInternetGatewayDetails{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNetworkCompartmentId()),
 		DisplayName:   common.String(InternetGatewayName),
 		IsEnabled:     common.Bool(true),
 		VcnId:         s.getVcnId(),
-		FreeformTags:  s.GetFreeFormTags(),
+		FreeformTags:  s.GetNetworkFreeFormTags(),
 		DefinedTags:   s.GetDefinedTags(),
 	}
 	igwResponse, err := s.VCNClient.CreateInternetGateway(ctx, core.CreateInternetGatewayRequest{
@@ -118,6 +147,14 @@ func (s *ClusterScope) DeleteInternetGateway(ctx context.Context) error {
 		s.Logger.Info("Skipping Internet Gateway reconciliation as per spec")
 		return nil
 	}
+	inUse, err := s.IsSharedNetworkInUse(ctx)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		s.Logger.Info("Shared VCN is used by other clusters, skipping deletion of Internet Gateway")
+		return nil
+	}
 	igw, err := s.GetInternetGateway(ctx)
 	if err != nil && !ociutil.IsNotFound(err) {
 		return err
@@ -126,6 +163,9 @@ func (s *ClusterScope) DeleteInternetGateway(ctx context.Context) error {
 		s.Logger.Info("Internet Gateway is already deleted")
 		return nil
 	}
+	if s.isVCNRetained() {
+		return s.retainInternetGateway(ctx, igw)
+	}
 	_, err = s.VCNClient.DeleteInternetGateway(ctx, core.DeleteInternetGatewayRequest{
 		IgId: igw.Id,
 	})
@@ -86,7 +86,8 @@ func TestClusterScope_ReconcileInternetGateway(t *testing.T) {
 			Items: []core.InternetGateway{
 				{
 					Id:           common.String("igw_id"),
-					FreeformTags: tags,
+					FreeformTags: updatedTags,
+					DefinedTags:  definedTagsInterface,
 				},
 			}}, nil)
 
@@ -0,0 +1,393 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	infrav2exp "github.com/oracle/cluster-api-provider-oci/exp/api/v1beta2"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+// maxLaunchAttempts is the number of failed launch attempts kept in the status.
+const maxLaunchAttempts = 10
+
+// launchCandidate is a placement, a shape and a capacity type tried by the launch fallback policy.
+type launchCandidate struct {
+	placement   infrastructurev1beta2.InstancePlacement
+	shapeConfig infrastructurev1beta2.ShapeConfig
+}
+
+// getLaunchCandidates returns the candidates of the launch fallback policy in the order they are tried, the
+// placements first, then the shapes and then the capacity types.
+func (m *MachineScope) getLaunchCandidates(availabilityDomain string, faultDomain string, pinned bool) []launchCandidate {
+	policy := m.OCIMachine.Spec.LaunchFallbackPolicy
+	shapes := append([]infrastructurev1beta2.FallbackShape{{
+		Shape:       m.OCIMachine.Spec.Shape,
+		ShapeConfig: &m.OCIMachine.Spec.ShapeConfig,
+	}}, policy.Shapes...)
+	capacityTypes := []bool{m.OCIMachine.Spec.PreemptibleInstanceConfig != nil}
+	if capacityTypes[0] && policy.OnDemand {
+		capacityTypes = append(capacityTypes, false)
+	}
+
+	availabilityDomains := []string{availabilityDomain}
+	if policy.AvailabilityDomains && !pinned {
+		var others []string
+		for name := range m.OCIClusterAccessor.GetAvailabilityDomains() {
+			if name != availabilityDomain {
+				others = append(others, name)
+			}
+		}
+		sort.Strings(others)
+		availabilityDomains = append(availabilityDomains, others...)
+	}
+	type placement struct{ availabilityDomain, faultDomain string }
+	var placements []placement
+	for _, ad := range availabilityDomains {
+		first := ""
+		if ad == availabilityDomain {
+			first = faultDomain
+		}
+		placements = append(placements, placement{ad, first})
+		// a failure domain which is a fault domain pins the fault domain
+		if !policy.FaultDomains || (pinned && faultDomain != "") {
+			continue
+		}
+		for _, fd := range m.OCIClusterAccessor.GetAvailabilityDomains()[ad].FaultDomains {
+			if fd != first {
+				placements = append(placements, placement{ad, fd})
+			}
+		}
+	}
+
+	var candidates []launchCandidate
+	for _, preemptible := range capacityTypes {
+		for _, shape := range shapes {
+			shapeConfig := infrastructurev1beta2.ShapeConfig{}
+			if shape.ShapeConfig != nil {
+				shapeConfig = *shape.ShapeConfig
+			}
+			for _, p := range placements {
+				candidates = append(candidates, launchCandidate{
+					placement: infrastructurev1beta2.InstancePlacement{
+						AvailabilityDomain: p.availabilityDomain,
+						FaultDomain:        p.faultDomain,
+						Shape:              shape.Shape,
+						Preemptible:        preemptible,
+					},
+					shapeConfig: shapeConfig,
+				})
+			}
+		}
+	}
+	return candidates
+}
+
+// launchInstanceWithFallback launches the instance with the first candidate of the launch fallback policy which
+// has capacity. Every launch which fails for lack of capacity is recorded in the status and the next candidate
+// is tried, any other error is returned right away.
+func (m *MachineScope) launchInstanceWithFallback(ctx context.Context, launchDetails core.LaunchInstanceDetails, pinned bool) (*core.Instance, error) {
+	policy := m.OCIMachine.Spec.LaunchFallbackPolicy
+	candidates := m.getLaunchCandidates(*launchDetails.AvailabilityDomain, ociutil.DerefString(launchDetails.FaultDomain), pinned)
+	preemptibleInstanceConfig := launchDetails.PreemptibleInstanceConfig
+	capacityReports := make(map[string]core.CapacityReportShapeAvailabilityAvailabilityStatusEnum)
+	var lastErr error
+	for i, candidate := range candidates {
+		shapeConfig, err := buildLaunchShapeConfig(candidate.shapeConfig)
+		if err != nil {
+			return nil, err
+		}
+		if policy.UseCapacityReport {
+			status := m.getCapacityStatus(ctx, candidate, shapeConfig, capacityReports)
+			if status != "" && status != core.CapacityReportShapeAvailabilityAvailabilityStatusAvailable {
+				m.recordLaunchAttempt(candidate.placement, fmt.Sprintf("the compute capacity report shows %s", status))
+				continue
+			}
+		}
+
+		launchDetails.AvailabilityDomain = common.String(candidate.placement.AvailabilityDomain)
+		launchDetails.FaultDomain = nil
+		if candidate.placement.FaultDomain != "" {
+			launchDetails.FaultDomain = common.String(candidate.placement.FaultDomain)
+		}
+		launchDetails.Shape = common.String(candidate.placement.Shape)
+		launchDetails.ShapeConfig = nil
+		if (shapeConfig != core.LaunchInstanceShapeConfigDetails{}) {
+			launchDetails.ShapeConfig = &shapeConfig
+		}
+		launchDetails.PreemptibleInstanceConfig = nil
+		if candidate.placement.Preemptible {
+			launchDetails.PreemptibleInstanceConfig = preemptibleInstanceConfig
+		}
+		// the first candidate is the instance as specified, the retry token of the other candidates differs
+		// as the request differs
+		retryToken := ociutil.GetOPCRetryToken(string(m.OCIMachine.UID))
+		if i > 0 {
+			retryToken = ociutil.GetOPCRetryToken("%s-%d", string(m.OCIMachine.UID), i)
+		}
+		resp, err := m.ComputeClient.LaunchInstance(ctx, core.LaunchInstanceRequest{
+			LaunchInstanceDetails: launchDetails,
+			OpcRetryToken:         retryToken,
+		})
+		if err == nil {
+			placement := candidate.placement
+			m.OCIMachine.Status.Placement = &placement
+			m.Logger.Info("Launched the instance", "placement", placement)
+			return &resp.Instance, nil
+		}
+		if !ociutil.IsOutOfHostCapacity(err) {
+			return nil, err
+		}
+		m.Logger.Info("No capacity to launch the instance", "placement", candidate.placement)
+		m.recordLaunchAttempt(candidate.placement, ociutil.ConditionMessage(err))
+		lastErr = err
+	}
+	if lastErr == nil {
+		lastErr = errors.New("the compute capacity report shows no capacity")
+	}
+	return nil, errors.Wrap(lastErr, "failed to launch the instance with any candidate of the launch fallback policy")
+}
+
+// getCapacityStatus returns the availability of the candidate in the compute capacity report, or an empty
+// status when the report is not available so that the launch is attempted anyway.
+func (m *MachineScope) getCapacityStatus(ctx context.Context, candidate launchCandidate, shapeConfig core.LaunchInstanceShapeConfigDetails,
+	reports map[string]core.CapacityReportShapeAvailabilityAvailabilityStatusEnum) core.CapacityReportShapeAvailabilityAvailabilityStatusEnum {
+	key := fmt.Sprintf("%s/%s/%s/%v", candidate.placement.AvailabilityDomain, candidate.placement.FaultDomain,
+		candidate.placement.Shape, candidate.shapeConfig)
+	if status, ok := reports[key]; ok {
+		return status
+	}
+	status, err := getCapacityStatus(ctx, m.ComputeClient.CreateComputeCapacityReport, m.getCompartmentId(),
+		candidate.placement, shapeConfig.Ocpus, shapeConfig.MemoryInGBs)
+	if err != nil {
+		m.Logger.Error(err, "failed to create the compute capacity report")
+	}
+	reports[key] = status
+	return status
+}
+
+// getCapacityStatus returns the availability of the shape in the placement from the compute capacity report.
+func getCapacityStatus(ctx context.Context,
+	createReport func(context.Context, core.CreateComputeCapacityReportRequest) (core.CreateComputeCapacityReportResponse, error),
+	compartmentId string, placement infrastructurev1beta2.InstancePlacement, ocpus *float32, memoryInGBs *float32) (core.CapacityReportShapeAvailabilityAvailabilityStatusEnum, error) {
+	availability := core.CreateCapacityReportShapeAvailabilityDetails{
+		InstanceShape: common.String(placement.Shape),
+	}
+	if placement.FaultDomain != "" {
+		availability.FaultDomain = common.String(placement.FaultDomain)
+	}
+	if ocpus != nil || memoryInGBs != nil {
+		availability.InstanceShapeConfig = &core.CapacityReportInstanceShapeConfig{
+			Ocpus:       ocpus,
+			MemoryInGBs: memoryInGBs,
+		}
+	}
+	resp, err := createReport(ctx, core.CreateComputeCapacityReportRequest{
+		CreateComputeCapacityReportDetails: core.CreateComputeCapacityReportDetails{
+			CompartmentId:       common.String(compartmentId),
+			AvailabilityDomain:  common.String(placement.AvailabilityDomain),
+			ShapeAvailabilities: []core.CreateCapacityReportShapeAvailabilityDetails{availability},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, shapeAvailability := range resp.ShapeAvailabilities {
+		if shapeAvailability.AvailabilityStatus == core.CapacityReportShapeAvailabilityAvailabilityStatusAvailable {
+			return shapeAvailability.AvailabilityStatus, nil
+		}
+	}
+	if len(resp.ShapeAvailabilities) == 0 {
+		return "", nil
+	}
+	return resp.ShapeAvailabilities[0].AvailabilityStatus, nil
+}
+
+func (m *MachineScope) recordLaunchAttempt(placement infrastructurev1beta2.InstancePlacement, message string) {
+	m.OCIMachine.Status.LaunchAttempts = appendLaunchAttempt(m.OCIMachine.Status.LaunchAttempts, placement, message)
+}
+
+// appendLaunchAttempt appends the attempt and drops the oldest attempts beyond maxLaunchAttempts.
+func appendLaunchAttempt(attempts []infrastructurev1beta2.LaunchAttempt, placement infrastructurev1beta2.InstancePlacement, message string) []infrastructurev1beta2.LaunchAttempt {
+	attempts = append(attempts, infrastructurev1beta2.LaunchAttempt{
+		InstancePlacement: placement,
+		Time:              metav1.Now(),
+		Message:           message,
+	})
+	if len(attempts) > maxLaunchAttempts {
+		attempts = attempts[len(attempts)-maxLaunchAttempts:]
+	}
+	return attempts
+}
+
+// FormatPlacement describes a placement for the events of the launch fallback policy.
+func FormatPlacement(placement infrastructurev1beta2.InstancePlacement) string {
+	parts := []string{placement.Shape}
+	if placement.AvailabilityDomain != "" {
+		parts = append(parts, placement.AvailabilityDomain)
+	}
+	if placement.FaultDomain != "" {
+		parts = append(parts, placement.FaultDomain)
+	}
+	if placement.Preemptible {
+		parts = append(parts, "preemptible")
+	} else {
+		parts = append(parts, "on-demand")
+	}
+	return strings.Join(parts, " ")
+}
+
+// poolLaunchShape is a shape and a capacity type of the instance configuration of a machine pool.
+type poolLaunchShape struct {
+	shape       string
+	shapeConfig *infrav2exp.ShapeConfig
+	preemptible bool
+}
+
+// getLaunchShapes returns the shapes and the capacity types of the launch fallback policy of the machine pool in
+// the order they are tried, the first is the instance configuration as specified.
+func (m *MachinePoolScope) getLaunchShapes() []poolLaunchShape {
+	spec := m.OCIMachinePool.Spec.InstanceConfiguration
+	preemptible := spec.PreemptibleInstanceConfig != nil
+	shapes := []poolLaunchShape{{
+		shape:       pointer.StringDeref(spec.Shape, ""),
+		shapeConfig: spec.ShapeConfig,
+		preemptible: preemptible,
+	}}
+	policy := m.OCIMachinePool.Spec.LaunchFallbackPolicy
+	if policy == nil {
+		return shapes
+	}
+	for _, shape := range policy.Shapes {
+		shapes = append(shapes, poolLaunchShape{
+			shape:       shape.Shape,
+			shapeConfig: toPoolShapeConfig(shape.ShapeConfig),
+			preemptible: preemptible,
+		})
+	}
+	if preemptible && policy.OnDemand {
+		for _, shape := range append([]poolLaunchShape{}, shapes...) {
+			shape.preemptible = false
+			shapes = append(shapes, shape)
+		}
+	}
+	return shapes
+}
+
+// getLaunchShape returns the shape and the capacity type of the instance configuration, which is the one selected
+// by the launch fallback policy while it is still one of the candidates of the policy.
+func (m *MachinePoolScope) getLaunchShape() poolLaunchShape {
+	shapes := m.getLaunchShapes()
+	placement := m.OCIMachinePool.Status.Placement
+	if m.OCIMachinePool.Spec.LaunchFallbackPolicy == nil || placement == nil {
+		return shapes[0]
+	}
+	for _, shape := range shapes {
+		if shape.shape == placement.Shape && shape.preemptible == placement.Preemptible {
+			return shape
+		}
+	}
+	return shapes[0]
+}
+
+// reconcileLaunchShape selects the shape and the capacity type of a new instance configuration with the compute
+// capacity report, as the instances of an instance pool are launched asynchronously. The first candidate with
+// capacity in any availability domain of the instance pool is selected, or else the instance configuration as
+// specified.
+func (m *MachinePoolScope) reconcileLaunchShape(ctx context.Context) error {
+	if m.OCIMachinePool.Spec.LaunchFallbackPolicy == nil {
+		m.OCIMachinePool.Status.Placement = nil
+		return nil
+	}
+	placements, err := m.BuildInstancePoolPlacement()
+	if err != nil {
+		return err
+	}
+	shapes := m.getLaunchShapes()
+	selected := shapes[0]
+	for _, shape := range shapes {
+		if m.hasCapacity(ctx, shape, placements) {
+			selected = shape
+			break
+		}
+	}
+	placement := &infrastructurev1beta2.InstancePlacement{
+		Shape:       selected.shape,
+		Preemptible: selected.preemptible,
+	}
+	if !reflect.DeepEqual(placement, m.OCIMachinePool.Status.Placement) {
+		m.Info("Selected the shape of the instance configuration", "placement", placement)
+		m.OCIMachinePool.Status.Placement = placement
+	}
+	return nil
+}
+
+// hasCapacity returns whether the compute capacity report shows capacity for the shape in any of the placements,
+// a shape is assumed to have capacity when the report is not available.
+func (m *MachinePoolScope) hasCapacity(ctx context.Context, shape poolLaunchShape, placements []core.CreateInstancePoolPlacementConfigurationDetails) bool {
+	shapeConfig, err := m.buildInstanceConfigurationShapeConfig(shape.shapeConfig)
+	if err != nil {
+		return false
+	}
+	for _, placementConfig := range placements {
+		placement := infrastructurev1beta2.InstancePlacement{
+			AvailabilityDomain: ociutil.DerefString(placementConfig.AvailabilityDomain),
+			Shape:              shape.shape,
+			Preemptible:        shape.preemptible,
+		}
+		status, err := getCapacityStatus(ctx, m.ComputeClient.CreateComputeCapacityReport, m.getCompartmentId(),
+			placement, shapeConfig.Ocpus, shapeConfig.MemoryInGBs)
+		if err != nil {
+			m.Error(err, "failed to create the compute capacity report")
+			return true
+		}
+		if status == "" || status == core.CapacityReportShapeAvailabilityAvailabilityStatusAvailable {
+			return true
+		}
+		m.OCIMachinePool.Status.LaunchAttempts = appendLaunchAttempt(m.OCIMachinePool.Status.LaunchAttempts, placement,
+			fmt.Sprintf("the compute capacity report shows %s", status))
+	}
+	return false
+}
+
+func toPoolShapeConfig(shapeConfig *infrastructurev1beta2.ShapeConfig) *infrav2exp.ShapeConfig {
+	if shapeConfig == nil {
+		return nil
+	}
+	poolShapeConfig := &infrav2exp.ShapeConfig{
+		BaselineOcpuUtilization: shapeConfig.BaselineOcpuUtilization,
+		Nvmes:                   shapeConfig.Nvmes,
+	}
+	if shapeConfig.Ocpus != "" {
+		poolShapeConfig.Ocpus = common.String(shapeConfig.Ocpus)
+	}
+	if shapeConfig.MemoryInGBs != "" {
+		poolShapeConfig.MemoryInGBs = common.String(shapeConfig.MemoryInGBs)
+	}
+	return poolShapeConfig
+}
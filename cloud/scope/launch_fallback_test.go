@@ -0,0 +1,322 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute/mock_compute"
+	infrav2exp "github.com/oracle/cluster-api-provider-oci/exp/api/v1beta2"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2/klogr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func fallbackTestCluster() *infrastructurev1beta2.OCICluster {
+	return &infrastructurev1beta2.OCICluster{
+		Spec: infrastructurev1beta2.OCIClusterSpec{
+			CompartmentId: "test-compartment",
+			AvailabilityDomains: map[string]infrastructurev1beta2.OCIAvailabilityDomain{
+				"ad-1": {Name: "ad-1", FaultDomains: []string{"fd-1", "fd-2"}},
+				"ad-2": {Name: "ad-2", FaultDomains: []string{"fd-1", "fd-2"}},
+			},
+		},
+	}
+}
+
+func TestGetLaunchCandidates(t *testing.T) {
+	placement := func(ad, fd, shape string, preemptible bool) infrastructurev1beta2.InstancePlacement {
+		return infrastructurev1beta2.InstancePlacement{AvailabilityDomain: ad, FaultDomain: fd, Shape: shape, Preemptible: preemptible}
+	}
+	tests := []struct {
+		name        string
+		spec        infrastructurev1beta2.OCIMachineSpec
+		faultDomain string
+		pinned      bool
+		expected    []infrastructurev1beta2.InstancePlacement
+	}{
+		{
+			name: "fault domains of the availability domain",
+			spec: infrastructurev1beta2.OCIMachineSpec{
+				Shape:                "VM.Standard.E4.Flex",
+				LaunchFallbackPolicy: &infrastructurev1beta2.LaunchFallbackPolicy{FaultDomains: true},
+			},
+			expected: []infrastructurev1beta2.InstancePlacement{
+				placement("ad-1", "", "VM.Standard.E4.Flex", false),
+				placement("ad-1", "fd-1", "VM.Standard.E4.Flex", false),
+				placement("ad-1", "fd-2", "VM.Standard.E4.Flex", false),
+			},
+		},
+		{
+			name: "pinned fault domain",
+			spec: infrastructurev1beta2.OCIMachineSpec{
+				Shape: "VM.Standard.E4.Flex",
+				LaunchFallbackPolicy: &infrastructurev1beta2.LaunchFallbackPolicy{
+					FaultDomains:        true,
+					AvailabilityDomains: true,
+				},
+			},
+			faultDomain: "fd-2",
+			pinned:      true,
+			expected: []infrastructurev1beta2.InstancePlacement{
+				placement("ad-1", "fd-2", "VM.Standard.E4.Flex", false),
+			},
+		},
+		{
+			name: "availability domains, shapes and capacity types",
+			spec: infrastructurev1beta2.OCIMachineSpec{
+				Shape:                     "VM.Standard.E4.Flex",
+				PreemptibleInstanceConfig: &infrastructurev1beta2.PreemptibleInstanceConfig{},
+				LaunchFallbackPolicy: &infrastructurev1beta2.LaunchFallbackPolicy{
+					AvailabilityDomains: true,
+					Shapes:              []infrastructurev1beta2.FallbackShape{{Shape: "VM.Standard.A1.Flex"}},
+					OnDemand:            true,
+				},
+			},
+			expected: []infrastructurev1beta2.InstancePlacement{
+				placement("ad-1", "", "VM.Standard.E4.Flex", true),
+				placement("ad-2", "", "VM.Standard.E4.Flex", true),
+				placement("ad-1", "", "VM.Standard.A1.Flex", true),
+				placement("ad-2", "", "VM.Standard.A1.Flex", true),
+				placement("ad-1", "", "VM.Standard.E4.Flex", false),
+				placement("ad-2", "", "VM.Standard.E4.Flex", false),
+				placement("ad-1", "", "VM.Standard.A1.Flex", false),
+				placement("ad-2", "", "VM.Standard.A1.Flex", false),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ms := &MachineScope{
+				OCIMachine:         &infrastructurev1beta2.OCIMachine{Spec: tc.spec},
+				OCIClusterAccessor: OCISelfManagedCluster{OCICluster: fallbackTestCluster()},
+			}
+			var placements []infrastructurev1beta2.InstancePlacement
+			for _, candidate := range ms.getLaunchCandidates("ad-1", tc.faultDomain, tc.pinned) {
+				placements = append(placements, candidate.placement)
+			}
+			g.Expect(placements).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestLaunchInstanceWithFallback(t *testing.T) {
+	launchDetails := func(ad string, fd *string, shape string, ocpus *float32) core.LaunchInstanceDetails {
+		details := core.LaunchInstanceDetails{
+			AvailabilityDomain: common.String(ad),
+			FaultDomain:        fd,
+			Shape:              common.String(shape),
+			CompartmentId:      common.String("test-compartment"),
+		}
+		if ocpus != nil {
+			details.ShapeConfig = &core.LaunchInstanceShapeConfigDetails{Ocpus: ocpus}
+		}
+		return details
+	}
+	tests := []struct {
+		name              string
+		policy            infrastructurev1beta2.LaunchFallbackPolicy
+		setup             func(computeClient *mock_compute.MockComputeClient)
+		errorExpected     bool
+		expectedPlacement *infrastructurev1beta2.InstancePlacement
+		expectedAttempts  int
+	}{
+		{
+			name: "instance is launched in the next fault domain",
+			policy: infrastructurev1beta2.LaunchFallbackPolicy{
+				FaultDomains: true,
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().LaunchInstance(gomock.Any(), gomock.Eq(core.LaunchInstanceRequest{
+					LaunchInstanceDetails: launchDetails("ad-1", nil, "VM.Standard.E4.Flex", common.Float32(2)),
+					OpcRetryToken:         ociutil.GetOPCRetryToken("machine-uid"),
+				})).Return(core.LaunchInstanceResponse{}, ociutil.ErrOutOfHostCapacity)
+				computeClient.EXPECT().LaunchInstance(gomock.Any(), gomock.Eq(core.LaunchInstanceRequest{
+					LaunchInstanceDetails: launchDetails("ad-1", common.String("fd-1"), "VM.Standard.E4.Flex", common.Float32(2)),
+					OpcRetryToken:         ociutil.GetOPCRetryToken("machine-uid-1"),
+				})).Return(core.LaunchInstanceResponse{Instance: core.Instance{Id: common.String("instance")}}, nil)
+			},
+			expectedPlacement: &infrastructurev1beta2.InstancePlacement{
+				AvailabilityDomain: "ad-1",
+				FaultDomain:        "fd-1",
+				Shape:              "VM.Standard.E4.Flex",
+			},
+			expectedAttempts: 1,
+		},
+		{
+			name: "shape without capacity in the capacity report is skipped",
+			policy: infrastructurev1beta2.LaunchFallbackPolicy{
+				Shapes: []infrastructurev1beta2.FallbackShape{
+					{Shape: "VM.Standard.A1.Flex", ShapeConfig: &infrastructurev1beta2.ShapeConfig{Ocpus: "4"}},
+				},
+				UseCapacityReport: true,
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().CreateComputeCapacityReport(gomock.Any(), gomock.Eq(core.CreateComputeCapacityReportRequest{
+					CreateComputeCapacityReportDetails: core.CreateComputeCapacityReportDetails{
+						CompartmentId:      common.String("test-compartment"),
+						AvailabilityDomain: common.String("ad-1"),
+						ShapeAvailabilities: []core.CreateCapacityReportShapeAvailabilityDetails{{
+							InstanceShape:       common.String("VM.Standard.E4.Flex"),
+							InstanceShapeConfig: &core.CapacityReportInstanceShapeConfig{Ocpus: common.Float32(2)},
+						}},
+					},
+				})).Return(core.CreateComputeCapacityReportResponse{ComputeCapacityReport: core.ComputeCapacityReport{
+					ShapeAvailabilities: []core.CapacityReportShapeAvailability{{
+						AvailabilityStatus: core.CapacityReportShapeAvailabilityAvailabilityStatusOutOfHostCapacity,
+					}},
+				}}, nil)
+				computeClient.EXPECT().CreateComputeCapacityReport(gomock.Any(), gomock.Any()).
+					Return(core.CreateComputeCapacityReportResponse{ComputeCapacityReport: core.ComputeCapacityReport{
+						ShapeAvailabilities: []core.CapacityReportShapeAvailability{{
+							AvailabilityStatus: core.CapacityReportShapeAvailabilityAvailabilityStatusAvailable,
+						}},
+					}}, nil)
+				computeClient.EXPECT().LaunchInstance(gomock.Any(), gomock.Eq(core.LaunchInstanceRequest{
+					LaunchInstanceDetails: launchDetails("ad-1", nil, "VM.Standard.A1.Flex", common.Float32(4)),
+					OpcRetryToken:         ociutil.GetOPCRetryToken("machine-uid-1"),
+				})).Return(core.LaunchInstanceResponse{Instance: core.Instance{Id: common.String("instance")}}, nil)
+			},
+			expectedPlacement: &infrastructurev1beta2.InstancePlacement{
+				AvailabilityDomain: "ad-1",
+				Shape:              "VM.Standard.A1.Flex",
+			},
+			expectedAttempts: 1,
+		},
+		{
+			name: "other errors are not retried",
+			policy: infrastructurev1beta2.LaunchFallbackPolicy{
+				FaultDomains: true,
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().LaunchInstance(gomock.Any(), gomock.Any()).
+					Return(core.LaunchInstanceResponse{}, errors.New("request failed"))
+			},
+			errorExpected: true,
+		},
+		{
+			name: "no candidate has capacity",
+			policy: infrastructurev1beta2.LaunchFallbackPolicy{
+				AvailabilityDomains: true,
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().LaunchInstance(gomock.Any(), gomock.Any()).
+					Return(core.LaunchInstanceResponse{}, ociutil.ErrOutOfHostCapacity).Times(2)
+			},
+			errorExpected:    true,
+			expectedAttempts: 2,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			computeClient := mock_compute.NewMockComputeClient(mockCtrl)
+			tc.setup(computeClient)
+			log := klogr.New()
+			ms := &MachineScope{
+				Logger: &log,
+				OCIMachine: &infrastructurev1beta2.OCIMachine{
+					Spec: infrastructurev1beta2.OCIMachineSpec{
+						CompartmentId:        "test-compartment",
+						Shape:                "VM.Standard.E4.Flex",
+						ShapeConfig:          infrastructurev1beta2.ShapeConfig{Ocpus: "2"},
+						LaunchFallbackPolicy: &tc.policy,
+					},
+				},
+				Machine:            &clusterv1.Machine{},
+				ComputeClient:      computeClient,
+				OCIClusterAccessor: OCISelfManagedCluster{OCICluster: fallbackTestCluster()},
+			}
+			ms.OCIMachine.UID = "machine-uid"
+
+			instance, err := ms.launchInstanceWithFallback(context.Background(),
+				launchDetails("ad-1", nil, "VM.Standard.E4.Flex", common.Float32(2)), false)
+			if tc.errorExpected {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(*instance.Id).To(Equal("instance"))
+			}
+			g.Expect(ms.OCIMachine.Status.Placement).To(Equal(tc.expectedPlacement))
+			g.Expect(ms.OCIMachine.Status.LaunchAttempts).To(HaveLen(tc.expectedAttempts))
+		})
+	}
+}
+
+func TestReconcileLaunchShape(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	computeClient := mock_compute.NewMockComputeClient(mockCtrl)
+	log := klogr.New()
+	ms := &MachinePoolScope{
+		Logger: &log,
+		OCIMachinePool: &infrav2exp.OCIMachinePool{
+			Spec: infrav2exp.OCIMachinePoolSpec{
+				PlacementDetails: []infrav2exp.PlacementDetails{{AvailabilityDomain: 1}},
+				InstanceConfiguration: infrav2exp.InstanceConfiguration{
+					Shape:                     common.String("VM.Standard.E4.Flex"),
+					PreemptibleInstanceConfig: &infrastructurev1beta2.PreemptibleInstanceConfig{},
+				},
+				LaunchFallbackPolicy: &infrastructurev1beta2.LaunchFallbackPolicy{
+					Shapes:   []infrastructurev1beta2.FallbackShape{{Shape: "VM.Standard.A1.Flex"}},
+					OnDemand: true,
+				},
+			},
+		},
+		ComputeClient:     computeClient,
+		OCIClusterAccesor: OCISelfManagedCluster{OCICluster: fallbackTestCluster()},
+	}
+
+	computeClient.EXPECT().CreateComputeCapacityReport(gomock.Any(), gomock.Any()).
+		Return(core.CreateComputeCapacityReportResponse{ComputeCapacityReport: core.ComputeCapacityReport{
+			ShapeAvailabilities: []core.CapacityReportShapeAvailability{{
+				AvailabilityStatus: core.CapacityReportShapeAvailabilityAvailabilityStatusOutOfHostCapacity,
+			}},
+		}}, nil).Times(2)
+	computeClient.EXPECT().CreateComputeCapacityReport(gomock.Any(), gomock.Eq(core.CreateComputeCapacityReportRequest{
+		CreateComputeCapacityReportDetails: core.CreateComputeCapacityReportDetails{
+			CompartmentId:      common.String("test-compartment"),
+			AvailabilityDomain: common.String("ad-1"),
+			ShapeAvailabilities: []core.CreateCapacityReportShapeAvailabilityDetails{{
+				InstanceShape: common.String("VM.Standard.E4.Flex"),
+			}},
+		},
+	})).Return(core.CreateComputeCapacityReportResponse{ComputeCapacityReport: core.ComputeCapacityReport{
+		ShapeAvailabilities: []core.CapacityReportShapeAvailability{{
+			AvailabilityStatus: core.CapacityReportShapeAvailabilityAvailabilityStatusAvailable,
+		}},
+	}}, nil)
+
+	err := ms.reconcileLaunchShape(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ms.OCIMachinePool.Status.Placement).To(Equal(&infrastructurev1beta2.InstancePlacement{
+		Shape: "VM.Standard.E4.Flex",
+	}))
+	g.Expect(ms.OCIMachinePool.Status.LaunchAttempts).To(HaveLen(2))
+	g.Expect(ms.getLaunchShape()).To(Equal(poolLaunchShape{shape: "VM.Standard.E4.Flex"}))
+}
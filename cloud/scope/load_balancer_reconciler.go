@@ -46,16 +46,24 @@ func (s *ClusterScope) ReconcileApiServerLB(ctx context.Context) error {
 		}
 		networkSpec := s.OCIClusterAccessor.GetNetworkSpec()
 		networkSpec.APIServerLB.LoadBalancerId = lb.Id
+		s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+			status.APIServerLB = newNetworkResourceStatus(lb.Id, string(lb.LifecycleState))
+		})
 		s.OCIClusterAccessor.SetControlPlaneEndpoint(clusterv1.APIEndpoint{
 			Host: *lbIP,
 			Port: s.APIServerPort(),
 		})
+		if err := s.reconcileApiServerLBTags(ctx, lb); err != nil {
+			return err
+		}
 		if s.IsLBEqual(lb, desiredApiServerLb) {
 			s.Logger.Info("No Reconciliation Required for ApiServerLB", "lb", lb.Id)
 			return nil
 		}
 		s.Logger.Info("Reconciliation Required for ApiServerLB", "lb", lb.Id)
-		return s.UpdateLB(ctx, desiredApiServerLb)
+		return s.reconcileDrift(DriftResourceLoadBalancer, desiredApiServerLb.Name, lb.Id, s.lbDrift(lb, desiredApiServerLb), func() error {
+			return s.UpdateLB(ctx, desiredApiServerLb)
+		})
 	}
 	lbID, lbIP, err := s.CreateLB(ctx, desiredApiServerLb)
 	if err != nil {
@@ -63,6 +71,9 @@ func (s *ClusterScope) ReconcileApiServerLB(ctx context.Context) error {
 	}
 	networkSpec := s.OCIClusterAccessor.GetNetworkSpec()
 	networkSpec.APIServerLB.LoadBalancerId = lbID
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		status.APIServerLB = newNetworkResourceStatus(lbID, "")
+	})
 	s.OCIClusterAccessor.SetControlPlaneEndpoint(clusterv1.APIEndpoint{
 		Host: *lbIP,
 		Port: s.APIServerPort(),
@@ -81,6 +92,9 @@ func (s *ClusterScope) DeleteApiServerLB(ctx context.Context) error {
 		s.Logger.Info("loadbalancer is already deleted")
 		return nil
 	}
+	if s.isAPIServerLBRetained() {
+		return s.retainApiServerLB(ctx, lb)
+	}
 	lbResponse, err := s.LoadBalancerClient.DeleteLoadBalancer(ctx, loadbalancer.DeleteLoadBalancerRequest{
 		LoadBalancerId: lb.Id,
 	})
@@ -113,13 +127,11 @@ func (s *ClusterScope) GetControlPlaneLbsLoadBalancerName() string {
 	return fmt.Sprintf("%s-%s", s.OCIClusterAccessor.GetName(), "apiserver")
 }
 
-// UpdateLB updates existing Load Balancer's DisplayName, FreeformTags and DefinedTags
+// UpdateLB updates existing Load Balancer's DisplayName
 func (s *ClusterScope) UpdateLB(ctx context.Context, lb infrastructurev1beta2.LoadBalancer) error {
 	lbId := s.OCIClusterAccessor.GetNetworkSpec().APIServerLB.LoadBalancerId
 	updateLBDetails := loadbalancer.UpdateLoadBalancerDetails{
-		DisplayName:  common.String(lb.Name),
-		FreeformTags: s.GetFreeFormTags(),
-		DefinedTags:  s.GetDefinedTags(),
+		DisplayName: common.String(lb.Name),
 	}
 	lbResponse, err := s.LoadBalancerClient.UpdateLoadBalancer(ctx, loadbalancer.UpdateLoadBalancerRequest{
 		UpdateLoadBalancerDetails: updateLBDetails,
@@ -170,7 +182,7 @@ func (s *ClusterScope) CreateLB(ctx context.Context, lb infrastructurev1beta2.Lo
 	}
 
 	lbDetails := loadbalancer.CreateLoadBalancerDetails{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetLoadBalancerCompartmentId()),
 		DisplayName:   common.String(lb.Name),
 		ShapeName:     common.String("flexible"),
 		ShapeDetails: &loadbalancer.ShapeDetails{MinimumBandwidthInMbps: common.Int(10),
@@ -247,10 +259,13 @@ func (s *ClusterScope) getLoadbalancerIp(lb loadbalancer.LoadBalancer) (*string,
 // IsLBEqual determines if the actual loadbalancer.LoadBalancer is equal to the desired.
 // Equality is determined by DisplayName, FreeformTags and DefinedTags matching.
 func (s *ClusterScope) IsLBEqual(actual *loadbalancer.LoadBalancer, desired infrastructurev1beta2.LoadBalancer) bool {
-	if desired.Name != *actual.DisplayName {
-		return false
-	}
-	return true
+	return len(s.lbDrift(actual, desired)) == 0
+}
+
+func (s *ClusterScope) lbDrift(actual *loadbalancer.LoadBalancer, desired infrastructurev1beta2.LoadBalancer) driftDiff {
+	var drift driftDiff
+	drift.compare("displayName", desired.Name, actual.DisplayName)
+	return drift
 }
 
 // GetLoadBalancers retrieves the Cluster's loadbalancer.LoadBalancer using the one of the following methods
@@ -277,7 +292,7 @@ func (s *ClusterScope) GetLoadBalancers(ctx context.Context) (*loadbalancer.Load
 	var page *string
 	for {
 		lbs, err := s.LoadBalancerClient.ListLoadBalancers(ctx, loadbalancer.ListLoadBalancersRequest{
-			CompartmentId: common.String(s.GetCompartmentId()),
+			CompartmentId: common.String(s.GetLoadBalancerCompartmentId()),
 			DisplayName:   common.String(s.GetControlPlaneLoadBalancerName()),
 			Page:          page,
 		})
@@ -558,9 +558,7 @@ func TestLBReconciliation(t *testing.T) {
 				lbClient.EXPECT().UpdateLoadBalancer(gomock.Any(), gomock.Eq(loadbalancer.UpdateLoadBalancerRequest{
 					LoadBalancerId: common.String("lb-id"),
 					UpdateLoadBalancerDetails: loadbalancer.UpdateLoadBalancerDetails{
-						DisplayName:  common.String(fmt.Sprintf("%s-%s", "cluster", "apiserver")),
-						FreeformTags: tags,
-						DefinedTags:  make(map[string]map[string]interface{}),
+						DisplayName: common.String(fmt.Sprintf("%s-%s", "cluster", "apiserver")),
 					},
 				})).
 					Return(loadbalancer.UpdateLoadBalancerResponse{
@@ -632,9 +630,7 @@ func TestLBReconciliation(t *testing.T) {
 				lbClient.EXPECT().UpdateLoadBalancer(gomock.Any(), gomock.Eq(loadbalancer.UpdateLoadBalancerRequest{
 					LoadBalancerId: common.String("lb-id"),
 					UpdateLoadBalancerDetails: loadbalancer.UpdateLoadBalancerDetails{
-						DisplayName:  common.String(fmt.Sprintf("%s-%s", "cluster", "apiserver")),
-						FreeformTags: tags,
-						DefinedTags:  make(map[string]map[string]interface{}),
+						DisplayName: common.String(fmt.Sprintf("%s-%s", "cluster", "apiserver")),
 					},
 				})).
 					Return(loadbalancer.UpdateLoadBalancerResponse{}, errors.New("request failed"))
@@ -853,6 +849,45 @@ func TestLBDeletion(t *testing.T) {
 					Return(loadbalancer.DeleteLoadBalancerResponse{}, errors.New("request failed"))
 			},
 		},
+		{
+			name:          "lb retained",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, lbClient *mock_lb.MockLoadBalancerClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().APIServerLB.LoadBalancerId = common.String("lb-id")
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().APIServerLB.DeletionPolicy = infrastructurev1beta2.DeletionPolicyRetain
+				lbClient.EXPECT().GetLoadBalancer(gomock.Any(), gomock.Eq(loadbalancer.GetLoadBalancerRequest{
+					LoadBalancerId: common.String("lb-id"),
+				})).
+					Return(loadbalancer.GetLoadBalancerResponse{
+						LoadBalancer: loadbalancer.LoadBalancer{
+							Id:           common.String("lb-id"),
+							FreeformTags: tags,
+							DefinedTags:  make(map[string]map[string]interface{}),
+							IsPrivate:    common.Bool(false),
+							DisplayName:  common.String(fmt.Sprintf("%s-%s", "cluster", "apiserver")),
+						},
+					}, nil)
+				lbClient.EXPECT().UpdateLoadBalancer(gomock.Any(), gomock.Eq(loadbalancer.UpdateLoadBalancerRequest{
+					LoadBalancerId: common.String("lb-id"),
+					UpdateLoadBalancerDetails: loadbalancer.UpdateLoadBalancerDetails{
+						FreeformTags: map[string]string{
+							ociutil.CreatedBy:                 ociutil.OCIClusterAPIProviderRetained,
+							ociutil.ClusterResourceIdentifier: "resource_uid",
+						},
+					},
+				})).
+					Return(loadbalancer.UpdateLoadBalancerResponse{
+						OpcWorkRequestId: common.String("opc-wr-id"),
+					}, nil)
+				lbClient.EXPECT().GetWorkRequest(gomock.Any(), gomock.Eq(loadbalancer.GetWorkRequestRequest{
+					WorkRequestId: common.String("opc-wr-id"),
+				})).Return(loadbalancer.GetWorkRequestResponse{
+					WorkRequest: loadbalancer.WorkRequest{
+						LifecycleState: loadbalancer.WorkRequestLifecycleStateSucceeded,
+					},
+				}, nil)
+			},
+		},
 		{
 			name:                "lb delete work request failed",
 			errorExpected:       true,
@@ -18,19 +18,19 @@ package scope
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/base64"
 	"fmt"
-	"math/big"
 	"net/url"
 	"strconv"
 
 	"github.com/go-logr/logr"
 	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	blockStorageClient "github.com/oracle/cluster-api-provider-oci/cloud/services/blockstorage"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute"
+	computeInstanceAgentClient "github.com/oracle/cluster-api-provider-oci/cloud/services/computeinstanceagent"
 	lb "github.com/oracle/cluster-api-provider-oci/cloud/services/loadbalancer"
 	nlb "github.com/oracle/cluster-api-provider-oci/cloud/services/networkloadbalancer"
+	objectStorageClient "github.com/oracle/cluster-api-provider-oci/cloud/services/objectstorage"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
@@ -38,6 +38,8 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2/klogr"
 	"k8s.io/utils/pointer"
@@ -63,6 +65,9 @@ type MachineScopeParams struct {
 	VCNClient                 vcn.Client
 	NetworkLoadBalancerClient nlb.NetworkLoadBalancerClient
 	LoadBalancerClient        lb.LoadBalancerClient
+	BlockStorageClient        blockStorageClient.Client
+	ObjectStorageClient       objectStorageClient.Client
+	InstanceAgentClient       computeInstanceAgentClient.Client
 }
 
 type MachineScope struct {
@@ -77,6 +82,9 @@ type MachineScope struct {
 	VCNClient                 vcn.Client
 	NetworkLoadBalancerClient nlb.NetworkLoadBalancerClient
 	LoadBalancerClient        lb.LoadBalancerClient
+	BlockStorageClient        blockStorageClient.Client
+	ObjectStorageClient       objectStorageClient.Client
+	InstanceAgentClient       computeInstanceAgentClient.Client
 }
 
 // NewMachineScope creates a MachineScope given the MachineScopeParams
@@ -109,6 +117,9 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 		VCNClient:                 params.VCNClient,
 		NetworkLoadBalancerClient: params.NetworkLoadBalancerClient,
 		LoadBalancerClient:        params.LoadBalancerClient,
+		BlockStorageClient:        params.BlockStorageClient,
+		ObjectStorageClient:       params.ObjectStorageClient,
+		InstanceAgentClient:       params.InstanceAgentClient,
 	}, nil
 }
 
@@ -120,63 +131,28 @@ func (m *MachineScope) GetOrCreateMachine(ctx context.Context) (*core.Instance,
 	}
 	if instance != nil {
 		m.Logger.Info("Found an existing instance")
+		m.recordFailureDomain(instance)
 		return instance, nil
 	}
 	m.Logger.Info("Creating machine with name", "machine-name", m.OCIMachine.GetName())
 
-	cloudInitData, err := m.GetBootstrapData()
+	bootstrapData, bootstrapFormat, err := m.GetBootstrapData()
 	if err != nil {
 		return nil, err
 	}
 
-	shapeConfig := core.LaunchInstanceShapeConfigDetails{}
-	if (m.OCIMachine.Spec.ShapeConfig != infrastructurev1beta2.ShapeConfig{}) {
-		ocpuString := m.OCIMachine.Spec.ShapeConfig.Ocpus
-		if ocpuString != "" {
-			ocpus, err := strconv.ParseFloat(ocpuString, 32)
-			if err != nil {
-				return nil, errors.New(fmt.Sprintf("ocpus provided %s is not a valid floating point",
-					ocpuString))
-			}
-			shapeConfig.Ocpus = common.Float32(float32(ocpus))
-		}
-
-		memoryInGBsString := m.OCIMachine.Spec.ShapeConfig.MemoryInGBs
-		if memoryInGBsString != "" {
-			memoryInGBs, err := strconv.ParseFloat(memoryInGBsString, 32)
-			if err != nil {
-				return nil, errors.New(fmt.Sprintf("memoryInGBs provided %s is not a valid floating point",
-					memoryInGBsString))
-			}
-			shapeConfig.MemoryInGBs = common.Float32(float32(memoryInGBs))
-		}
-		baselineOcpuOptString := m.OCIMachine.Spec.ShapeConfig.BaselineOcpuUtilization
-		if baselineOcpuOptString != "" {
-			value, err := ociutil.GetBaseLineOcpuOptimizationEnum(baselineOcpuOptString)
-			if err != nil {
-				return nil, err
-			}
-			shapeConfig.BaselineOcpuUtilization = value
-		}
-	}
-	sourceDetails := core.InstanceSourceViaImageDetails{
-		ImageId: common.String(m.OCIMachine.Spec.ImageId),
+	shapeConfig, err := buildLaunchShapeConfig(m.OCIMachine.Spec.ShapeConfig)
+	if err != nil {
+		return nil, err
 	}
-	if m.OCIMachine.Spec.BootVolumeSizeInGBs != "" {
-		bootVolumeSizeInGBsString := m.OCIMachine.Spec.BootVolumeSizeInGBs
-		if bootVolumeSizeInGBsString != "" {
-			bootVolumeSizeInGBs, err := strconv.ParseFloat(bootVolumeSizeInGBsString, 64)
-			if err != nil {
-				return nil, errors.New(fmt.Sprintf("bootVolumeSizeInGBs provided %s is not a valid floating point",
-					bootVolumeSizeInGBsString))
-			}
-			sourceDetails.BootVolumeSizeInGBs = common.Int64(int64(bootVolumeSizeInGBs))
+	// the boot volume source is resolved once the availability domain is known
+	var sourceDetails core.InstanceSourceDetails
+	if m.OCIMachine.Spec.InstanceSourceViaBootVolumeDetails == nil {
+		sourceDetails, err = m.getInstanceSourceViaImageDetails(ctx)
+		if err != nil {
+			return nil, err
 		}
 	}
-	if m.OCIMachine.Spec.InstanceSourceViaImageDetails != nil {
-		sourceDetails.KmsKeyId = m.OCIMachine.Spec.InstanceSourceViaImageDetails.KmsKeyId
-		sourceDetails.BootVolumeVpusPerGB = m.OCIMachine.Spec.InstanceSourceViaImageDetails.BootVolumeVpusPerGB
-	}
 
 	subnetId := m.OCIMachine.Spec.NetworkDetails.SubnetId
 	if subnetId == nil {
@@ -202,39 +178,46 @@ func (m *MachineScope) GetOrCreateMachine(ctx context.Context) (*core.Instance,
 		}
 	}
 
-	failureDomain := m.Machine.Spec.FailureDomain
-	var faultDomain string
-	var availabilityDomain string
-	if failureDomain != nil {
-		failureDomainIndex, err := strconv.Atoi(*failureDomain)
+	failureDomain, err := m.getFailureDomain(ctx)
+	if err != nil {
+		return nil, err
+	}
+	failureDomainSpec := m.OCIClusterAccessor.GetFailureDomains()[failureDomain]
+	faultDomain := failureDomainSpec.Attributes[FaultDomain]
+	availabilityDomain := failureDomainSpec.Attributes[AvailabilityDomain]
+
+	if m.OCIMachine.Spec.InstanceSourceViaBootVolumeDetails != nil {
+		bootVolume, err := m.getBootVolumeSource(ctx, availabilityDomain)
 		if err != nil {
-			m.Logger.Error(err, "Failure Domain is not a valid integer")
-			return nil, errors.Wrap(err, "invalid failure domain parameter, must be a valid integer")
-		}
-		m.Logger.Info("Failure Domain being used", "failure-domain", failureDomainIndex)
-		if failureDomainIndex < 1 || failureDomainIndex > 3 {
-			err = errors.New("failure domain should be a value between 1 and 3")
-			m.Logger.Error(err, "Failure domain should be a value between 1 and 3")
 			return nil, err
 		}
-		faultDomain = m.OCIClusterAccessor.GetFailureDomains()[*failureDomain].Attributes[FaultDomain]
-		availabilityDomain = m.OCIClusterAccessor.GetFailureDomains()[*failureDomain].Attributes[AvailabilityDomain]
-	} else {
-		randomFailureDomain, err := rand.Int(rand.Reader, big.NewInt(3))
-		if err != nil {
-			m.Logger.Error(err, "Failed to generate random failure domain")
-			return nil, err
+		// the instance is launched in the availability domain of the boot volume
+		if ociutil.DerefString(bootVolume.AvailabilityDomain) != availabilityDomain {
+			availabilityDomain, faultDomain = ociutil.DerefString(bootVolume.AvailabilityDomain), ""
+		}
+		sourceDetails = core.InstanceSourceViaBootVolumeDetails{
+			BootVolumeId: bootVolume.Id,
 		}
-		// the random number generated is between zero and two, whereas we need a number between one and three
-		failureDomain = common.String(strconv.Itoa(int(randomFailureDomain.Int64()) + 1))
-		availabilityDomain = m.OCIClusterAccessor.GetFailureDomains()[*failureDomain].Attributes[AvailabilityDomain]
+	}
+
+	// the instance of a replaced machine is launched in the availability domain of its persistent volumes
+	persistentVolumeAvailabilityDomain, err := m.getPersistentVolumeAvailabilityDomain(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if persistentVolumeAvailabilityDomain != "" && persistentVolumeAvailabilityDomain != availabilityDomain {
+		availabilityDomain, faultDomain = persistentVolumeAvailabilityDomain, ""
 	}
 
 	metadata := m.OCIMachine.Spec.Metadata
 	if metadata == nil {
 		metadata = make(map[string]string)
 	}
-	metadata["user_data"] = base64.StdEncoding.EncodeToString([]byte(cloudInitData))
+	userData, err := m.getUserData(ctx, bootstrapData, bootstrapFormat, metadata)
+	if err != nil {
+		return nil, err
+	}
+	metadata["user_data"] = userData
 
 	tags := m.getFreeFormTags()
 
@@ -282,14 +265,91 @@ func (m *MachineScope) GetOrCreateMachine(ctx context.Context) (*core.Instance,
 	launchDetails.PreemptibleInstanceConfig = m.getPreemptibleInstanceConfig()
 	launchDetails.PlatformConfig = m.getPlatformConfig()
 	launchDetails.LaunchVolumeAttachments = m.getLaunchVolumeAttachments()
-	req := core.LaunchInstanceRequest{LaunchInstanceDetails: launchDetails,
-		OpcRetryToken: ociutil.GetOPCRetryToken(string(m.OCIMachine.UID))}
-	resp, err := m.ComputeClient.LaunchInstance(ctx, req)
+	if m.OCIMachine.Spec.LaunchFallbackPolicy != nil {
+		pinned := m.Machine.Spec.FailureDomain != nil || m.OCIMachine.Spec.InstanceSourceViaBootVolumeDetails != nil ||
+			persistentVolumeAvailabilityDomain != ""
+		instance, err = m.launchInstanceWithFallback(ctx, launchDetails, pinned)
+	} else {
+		req := core.LaunchInstanceRequest{LaunchInstanceDetails: launchDetails,
+			OpcRetryToken: ociutil.GetOPCRetryToken(string(m.OCIMachine.UID))}
+		var resp core.LaunchInstanceResponse
+		resp, err = m.ComputeClient.LaunchInstance(ctx, req)
+		instance = &resp.Instance
+	}
 	if err != nil {
 		return nil, err
-	} else {
-		return &resp.Instance, nil
 	}
+	m.recordFailureDomain(instance)
+	return instance, nil
+}
+
+// getInstanceSourceViaImageDetails returns the source details of an instance booted from the image of the spec.
+func (m *MachineScope) getInstanceSourceViaImageDetails(ctx context.Context) (core.InstanceSourceViaImageDetails, error) {
+	imageId, err := m.getImageId(ctx)
+	if err != nil {
+		return core.InstanceSourceViaImageDetails{}, err
+	}
+	sourceDetails := core.InstanceSourceViaImageDetails{
+		ImageId: common.String(imageId),
+	}
+	sourceDetails.BootVolumeSizeInGBs, err = m.getBootVolumeSizeInGBs()
+	if err != nil {
+		return sourceDetails, err
+	}
+	if m.OCIMachine.Spec.InstanceSourceViaImageDetails != nil {
+		sourceDetails.KmsKeyId = m.OCIMachine.Spec.InstanceSourceViaImageDetails.KmsKeyId
+		sourceDetails.BootVolumeVpusPerGB = m.OCIMachine.Spec.InstanceSourceViaImageDetails.BootVolumeVpusPerGB
+	}
+	return sourceDetails, nil
+}
+
+// getBootVolumeSizeInGBs returns the size of the boot volume of the spec, if any.
+func (m *MachineScope) getBootVolumeSizeInGBs() (*int64, error) {
+	bootVolumeSizeInGBsString := m.OCIMachine.Spec.BootVolumeSizeInGBs
+	if bootVolumeSizeInGBsString == "" {
+		return nil, nil
+	}
+	bootVolumeSizeInGBs, err := strconv.ParseFloat(bootVolumeSizeInGBsString, 64)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("bootVolumeSizeInGBs provided %s is not a valid floating point",
+			bootVolumeSizeInGBsString))
+	}
+	return common.Int64(int64(bootVolumeSizeInGBs)), nil
+}
+
+// buildLaunchShapeConfig converts the shape config of the spec to the shape config of a launch.
+func buildLaunchShapeConfig(shapeConfigSpec infrastructurev1beta2.ShapeConfig) (core.LaunchInstanceShapeConfigDetails, error) {
+	shapeConfig := core.LaunchInstanceShapeConfigDetails{}
+	if (shapeConfigSpec != infrastructurev1beta2.ShapeConfig{}) {
+		ocpuString := shapeConfigSpec.Ocpus
+		if ocpuString != "" {
+			ocpus, err := strconv.ParseFloat(ocpuString, 32)
+			if err != nil {
+				return shapeConfig, errors.New(fmt.Sprintf("ocpus provided %s is not a valid floating point",
+					ocpuString))
+			}
+			shapeConfig.Ocpus = common.Float32(float32(ocpus))
+		}
+
+		memoryInGBsString := shapeConfigSpec.MemoryInGBs
+		if memoryInGBsString != "" {
+			memoryInGBs, err := strconv.ParseFloat(memoryInGBsString, 32)
+			if err != nil {
+				return shapeConfig, errors.New(fmt.Sprintf("memoryInGBs provided %s is not a valid floating point",
+					memoryInGBsString))
+			}
+			shapeConfig.MemoryInGBs = common.Float32(float32(memoryInGBs))
+		}
+		baselineOcpuOptString := shapeConfigSpec.BaselineOcpuUtilization
+		if baselineOcpuOptString != "" {
+			value, err := ociutil.GetBaseLineOcpuOptimizationEnum(baselineOcpuOptString)
+			if err != nil {
+				return shapeConfig, err
+			}
+			shapeConfig.BaselineOcpuUtilization = value
+		}
+	}
+	return shapeConfig, nil
 }
 
 func (m *MachineScope) getFreeFormTags() map[string]string {
@@ -308,10 +368,139 @@ func (m *MachineScope) getFreeFormTags() map[string]string {
 	return tags
 }
 
-// DeleteMachine terminates the instance using InstanceId from the OCIMachine spec and deletes the boot volume
+// ReconcileTags applies the free form tags and the defined tags of the spec to the instance, its VNICs and its
+// boot volume. The tags which were last applied and removed from the spec since are removed, other tags which are
+// not in the spec are kept. The instance is updated last, so that an instance with the tags of the spec has its VNICs
+// and boot volume updated as well, which avoids looking them up on every reconciliation.
+func (m *MachineScope) ReconcileTags(ctx context.Context, instance *core.Instance) error {
+	desiredDefinedTags := ConvertMachineDefinedTags(m.OCIMachine.Spec.DefinedTags)
+	applied := m.OCIMachine.Status.AppliedTags
+	freeformTags, freeformTagsChanged := ociutil.MergeFreeformTags(instance.FreeformTags, m.getFreeFormTags(), appliedFreeformTags(applied))
+	definedTags, definedTagsChanged := ociutil.MergeDefinedTags(instance.DefinedTags, desiredDefinedTags, appliedDefinedTags(applied))
+	if !freeformTagsChanged && !definedTagsChanged {
+		m.OCIMachine.Status.AppliedTags = NewAppliedTags(m.getFreeFormTags(), m.OCIMachine.Spec.DefinedTags)
+		return nil
+	}
+	if err := m.reconcileVnicTags(ctx, desiredDefinedTags); err != nil {
+		return err
+	}
+	if err := m.reconcileBootVolumeTags(ctx, instance, desiredDefinedTags); err != nil {
+		return err
+	}
+	_, err := m.ComputeClient.UpdateInstance(ctx, core.UpdateInstanceRequest{
+		InstanceId: instance.Id,
+		UpdateInstanceDetails: core.UpdateInstanceDetails{
+			FreeformTags: freeformTags,
+			DefinedTags:  definedTags,
+		},
+	})
+	if err != nil {
+		m.Logger.Error(err, "failed to update the tags of the instance")
+		return errors.Wrap(err, "failed to update the tags of the instance")
+	}
+	m.Logger.Info("Updated the tags of the instance", "instance", instance.Id)
+	m.OCIMachine.Status.AppliedTags = NewAppliedTags(m.getFreeFormTags(), m.OCIMachine.Spec.DefinedTags)
+	return nil
+}
+
+func (m *MachineScope) reconcileVnicTags(ctx context.Context, desiredDefinedTags map[string]map[string]interface{}) error {
+	var page *string
+	for {
+		resp, err := m.ComputeClient.ListVnicAttachments(ctx, core.ListVnicAttachmentsRequest{
+			InstanceId:    m.GetInstanceId(),
+			CompartmentId: common.String(m.getCompartmentId()),
+			Page:          page,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list the vnic attachments")
+		}
+		for _, attachment := range resp.Items {
+			if attachment.LifecycleState != core.VnicAttachmentLifecycleStateAttached || attachment.VnicId == nil {
+				continue
+			}
+			vnic, err := m.VCNClient.GetVnic(ctx, core.GetVnicRequest{
+				VnicId: attachment.VnicId,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to get the vnic")
+			}
+			applied := m.OCIMachine.Status.AppliedTags
+			freeformTags, freeformTagsChanged := ociutil.MergeFreeformTags(vnic.FreeformTags, m.getFreeFormTags(), appliedFreeformTags(applied))
+			definedTags, definedTagsChanged := ociutil.MergeDefinedTags(vnic.DefinedTags, desiredDefinedTags, appliedDefinedTags(applied))
+			if !freeformTagsChanged && !definedTagsChanged {
+				continue
+			}
+			_, err = m.VCNClient.UpdateVnic(ctx, core.UpdateVnicRequest{
+				VnicId: attachment.VnicId,
+				UpdateVnicDetails: core.UpdateVnicDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			if err != nil {
+				m.Logger.Error(err, "failed to update the tags of the vnic")
+				return errors.Wrap(err, "failed to update the tags of the vnic")
+			}
+			m.Logger.Info("Updated the tags of the vnic", "vnic", attachment.VnicId)
+		}
+		if resp.OpcNextPage == nil {
+			return nil
+		}
+		page = resp.OpcNextPage
+	}
+}
+
+func (m *MachineScope) reconcileBootVolumeTags(ctx context.Context, instance *core.Instance, desiredDefinedTags map[string]map[string]interface{}) error {
+	resp, err := m.ComputeClient.ListBootVolumeAttachments(ctx, core.ListBootVolumeAttachmentsRequest{
+		AvailabilityDomain: instance.AvailabilityDomain,
+		CompartmentId:      instance.CompartmentId,
+		InstanceId:         instance.Id,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list the boot volume attachments")
+	}
+	for _, attachment := range resp.Items {
+		if attachment.LifecycleState != core.BootVolumeAttachmentLifecycleStateAttached || attachment.BootVolumeId == nil {
+			continue
+		}
+		bootVolume, err := m.BlockStorageClient.GetBootVolume(ctx, core.GetBootVolumeRequest{
+			BootVolumeId: attachment.BootVolumeId,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get the boot volume")
+		}
+		applied := m.OCIMachine.Status.AppliedTags
+		freeformTags, freeformTagsChanged := ociutil.MergeFreeformTags(bootVolume.FreeformTags, m.getFreeFormTags(), appliedFreeformTags(applied))
+		definedTags, definedTagsChanged := ociutil.MergeDefinedTags(bootVolume.DefinedTags, desiredDefinedTags, appliedDefinedTags(applied))
+		if !freeformTagsChanged && !definedTagsChanged {
+			continue
+		}
+		_, err = m.BlockStorageClient.UpdateBootVolume(ctx, core.UpdateBootVolumeRequest{
+			BootVolumeId: attachment.BootVolumeId,
+			UpdateBootVolumeDetails: core.UpdateBootVolumeDetails{
+				FreeformTags: freeformTags,
+				DefinedTags:  definedTags,
+			},
+		})
+		if err != nil {
+			m.Logger.Error(err, "failed to update the tags of the boot volume")
+			return errors.Wrap(err, "failed to update the tags of the boot volume")
+		}
+		m.Logger.Info("Updated the tags of the boot volume", "bootVolume", attachment.BootVolumeId)
+	}
+	return nil
+}
+
+// DeleteMachine terminates the instance using InstanceId from the OCIMachine spec and deletes the boot volume,
+// unless the boot volume is preserved. An existing boot volume the instance was booted from is always preserved,
+// as it was not created for the machine.
 func (m *MachineScope) DeleteMachine(ctx context.Context, instance *core.Instance) error {
+	preserveBootVolume := m.OCIMachine.Spec.PreserveBootVolume
+	if source := m.OCIMachine.Spec.InstanceSourceViaBootVolumeDetails; source != nil && source.BootVolumeId != nil {
+		preserveBootVolume = true
+	}
 	req := core.TerminateInstanceRequest{InstanceId: instance.Id,
-		PreserveBootVolume:                 common.Bool(m.OCIMachine.Spec.PreserveBootVolume),
+		PreserveBootVolume:                 common.Bool(preserveBootVolume),
 		PreserveDataVolumesCreatedAtLaunch: common.Bool(m.OCIMachine.Spec.PreserveDataVolumesCreatedAtLaunch),
 	}
 	_, err := m.ComputeClient.TerminateInstance(ctx, req)
@@ -381,23 +570,63 @@ func (m *MachineScope) Close(ctx context.Context) error {
 	return m.PatchObject(ctx)
 }
 
-// GetBootstrapData returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName.
-func (m *MachineScope) GetBootstrapData() (string, error) {
+// GetBootstrapData returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName, and the
+// format of the bootstrap data.
+func (m *MachineScope) GetBootstrapData() (string, infrastructurev1beta2.BootstrapFormat, error) {
 	if m.Machine.Spec.Bootstrap.DataSecretName == nil {
-		return "", errors.New("error retrieving bootstrap data: linked Machine's bootstrap.dataSecretName is nil")
+		return "", "", errors.New("error retrieving bootstrap data: linked Machine's bootstrap.dataSecretName is nil")
 	}
 
 	secret := &corev1.Secret{}
 	key := types.NamespacedName{Namespace: m.Machine.Namespace, Name: *m.Machine.Spec.Bootstrap.DataSecretName}
 	if err := m.Client.Get(context.TODO(), key, secret); err != nil {
-		return "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for OCIMachine %s/%s", m.Machine.Namespace, m.Machine.Name)
+		return "", "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for OCIMachine %s/%s", m.Machine.Namespace, m.Machine.Name)
 	}
 
 	value, ok := secret.Data["value"]
 	if !ok {
-		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
+		return "", "", errors.New("error retrieving bootstrap data: secret value key is missing")
 	}
-	return string(value), nil
+	return string(value), getBootstrapFormat(m.OCIMachine.Spec.BootstrapFormat, secret), nil
+}
+
+// writeMachineSecret creates or updates a Secret with the data, the Secret is owned by the OCIMachine and deleted
+// with it.
+func (m *MachineScope) writeMachineSecret(ctx context.Context, name string, data map[string][]byte) error {
+	secret := &corev1.Secret{}
+	err := m.Client.Get(ctx, client.ObjectKey{Namespace: m.OCIMachine.Namespace, Name: name}, secret)
+	if apierrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: m.OCIMachine.Namespace,
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel: m.Cluster.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: infrastructurev1beta2.GroupVersion.String(),
+						Kind:       OCIMachineKind,
+						Name:       m.OCIMachine.Name,
+						UID:        m.OCIMachine.UID,
+					},
+				},
+			},
+			Data: data,
+		}
+		if err := m.Client.Create(ctx, secret); err != nil {
+			return errors.Wrapf(err, "failed to create the secret %s", name)
+		}
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the secret %s", name)
+	}
+	secret.Data = data
+	if err := m.Client.Update(ctx, secret); err != nil {
+		return errors.Wrapf(err, "failed to update the secret %s", name)
+	}
+	return nil
 }
 
 // Name returns the OCIMachine name.
@@ -28,6 +28,7 @@ import (
 	"github.com/go-logr/logr"
 	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/computemanagement"
 	expinfra1 "github.com/oracle/cluster-api-provider-oci/exp/api/v1beta2"
 	infrav2exp "github.com/oracle/cluster-api-provider-oci/exp/api/v1beta2"
@@ -55,6 +56,7 @@ type MachinePoolScopeParams struct {
 	MachinePool             *expclusterv1.MachinePool
 	Client                  client.Client
 	ComputeManagementClient computemanagement.Client
+	ComputeClient           compute.ComputeClient
 	OCIClusterAccessor      OCIClusterAccessor
 	OCIMachinePool          *expinfra1.OCIMachinePool
 }
@@ -66,6 +68,7 @@ type MachinePoolScope struct {
 	Cluster                 *clusterv1.Cluster
 	MachinePool             *expclusterv1.MachinePool
 	ComputeManagementClient computemanagement.Client
+	ComputeClient           compute.ComputeClient
 	OCIClusterAccesor       OCIClusterAccessor
 	OCIMachinePool          *expinfra1.OCIMachinePool
 }
@@ -92,6 +95,7 @@ func NewMachinePoolScope(params MachinePoolScopeParams) (*MachinePoolScope, erro
 		Logger:                  params.Logger,
 		Client:                  params.Client,
 		ComputeManagementClient: params.ComputeManagementClient,
+		ComputeClient:           params.ComputeClient,
 		Cluster:                 params.Cluster,
 		OCIClusterAccesor:       params.OCIClusterAccessor,
 		patchHelper:             helper,
@@ -162,7 +166,7 @@ func (m *MachinePoolScope) ListMachinePoolInstances(ctx context.Context) ([]core
 	}
 
 	req := core.ListInstancePoolInstancesRequest{
-		CompartmentId:  common.String(m.OCIClusterAccesor.GetCompartmentId()),
+		CompartmentId:  common.String(m.getCompartmentId()),
 		InstancePoolId: poolOcid,
 	}
 
@@ -222,23 +226,24 @@ func (m *MachinePoolScope) SetListandSetMachinePoolInstances(ctx context.Context
 	return machines, nil
 }
 
-// GetBootstrapData returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName.
-func (m *MachinePoolScope) GetBootstrapData() (string, error) {
+// GetBootstrapData returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName, and the
+// format of the bootstrap data.
+func (m *MachinePoolScope) GetBootstrapData() (string, infrastructurev1beta2.BootstrapFormat, error) {
 	if m.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
-		return "", errors.New("error retrieving bootstrap data: linked MachinePool's bootstrap.dataSecretName is nil")
+		return "", "", errors.New("error retrieving bootstrap data: linked MachinePool's bootstrap.dataSecretName is nil")
 	}
 
 	secret := &corev1.Secret{}
 	key := types.NamespacedName{Namespace: m.MachinePool.Namespace, Name: *m.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName}
 	if err := m.Client.Get(context.TODO(), key, secret); err != nil {
-		return "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for OCIMachinePool %s/%s", m.MachinePool.Namespace, m.MachinePool.Name)
+		return "", "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for OCIMachinePool %s/%s", m.MachinePool.Namespace, m.MachinePool.Name)
 	}
 
 	value, ok := secret.Data["value"]
 	if !ok {
-		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
+		return "", "", errors.New("error retrieving bootstrap data: secret value key is missing")
 	}
-	return string(value), nil
+	return string(value), getBootstrapFormat(m.OCIMachinePool.Spec.BootstrapFormat, secret), nil
 }
 
 // GetWorkerMachineNSG returns the worker role core.NetworkSecurityGroup id for the cluster
@@ -251,9 +256,8 @@ func (m *MachinePoolScope) GetWorkerMachineNSG() *string {
 	return nil
 }
 
-func (m *MachinePoolScope) buildInstanceConfigurationShapeConfig() (core.InstanceConfigurationLaunchInstanceShapeConfigDetails, error) {
+func (m *MachinePoolScope) buildInstanceConfigurationShapeConfig(shapeConfigSpec *infrav2exp.ShapeConfig) (core.InstanceConfigurationLaunchInstanceShapeConfigDetails, error) {
 	shapeConfig := core.InstanceConfigurationLaunchInstanceShapeConfigDetails{}
-	shapeConfigSpec := m.OCIMachinePool.Spec.InstanceConfiguration.ShapeConfig
 	if shapeConfigSpec != nil {
 		if shapeConfigSpec.Ocpus != nil {
 			ocpus, err := strconv.ParseFloat(*shapeConfigSpec.Ocpus, 32)
@@ -353,14 +357,8 @@ func (m *MachinePoolScope) GetFreeFormTags() map[string]string {
 	return tags
 }
 
-// ReconcileInstanceConfiguration works to try to reconcile the state of the instance configuration for the cluster
-func (m *MachinePoolScope) ReconcileInstanceConfiguration(ctx context.Context) error {
-	var instanceConfiguration *core.InstanceConfiguration
-	instanceConfiguration, err := m.GetInstanceConfiguration(ctx)
-	if err != nil {
-		return err
-	}
-	freeFormTags := m.GetFreeFormTags()
+// getDefinedTags converts the defined tags of the cluster to the format expected by the OCI SDK
+func (m *MachinePoolScope) getDefinedTags() map[string]map[string]interface{} {
 	definedTags := make(map[string]map[string]interface{})
 	if m.OCIClusterAccesor.GetDefinedTags() != nil {
 		for ns, mapNs := range m.OCIClusterAccesor.GetDefinedTags() {
@@ -371,10 +369,28 @@ func (m *MachinePoolScope) ReconcileInstanceConfiguration(ctx context.Context) e
 			definedTags[ns] = mapValues
 		}
 	}
+	return definedTags
+}
+
+// ReconcileInstanceConfiguration works to try to reconcile the state of the instance configuration for the cluster
+func (m *MachinePoolScope) ReconcileInstanceConfiguration(ctx context.Context) error {
+	var instanceConfiguration *core.InstanceConfiguration
+	instanceConfiguration, err := m.GetInstanceConfiguration(ctx)
+	if err != nil {
+		return err
+	}
+	if err := m.reconcileSelectedImage(ctx); err != nil {
+		return err
+	}
+	freeFormTags := m.GetFreeFormTags()
+	definedTags := m.getDefinedTags()
 	instanceConfigurationSpec := m.OCIMachinePool.Spec.InstanceConfiguration
 	if instanceConfiguration == nil {
 		m.Info("Create new instance configuration")
 
+		if err := m.reconcileLaunchShape(ctx); err != nil {
+			return err
+		}
 		launchDetails, err := m.getLaunchInstanceDetails(instanceConfigurationSpec, freeFormTags, definedTags)
 		if err != nil {
 			return err
@@ -392,7 +408,9 @@ func (m *MachinePoolScope) ReconcileInstanceConfiguration(ctx context.Context) e
 			if err != nil {
 				return err
 			}
-			// do not compare defined tags
+			// the defined tags are not compared as tag defaults add tags, a new instance configuration is only
+			// created if the defined tags of the cluster changed
+			_, definedTagsChanged := ociutil.MergeDefinedTags(launchDetailsActual.DefinedTags, definedTags, appliedDefinedTags(m.OCIMachinePool.Status.AppliedTags))
 			launchDetailsSpec.DefinedTags = nil
 			launchDetailsActual.DefinedTags = nil
 
@@ -404,9 +422,12 @@ func (m *MachinePoolScope) ReconcileInstanceConfiguration(ctx context.Context) e
 			}
 			launchDetailsActual.DisplayName = nil
 			launchDetailsSpec.DisplayName = nil
-			if !reflect.DeepEqual(launchDetailsSpec, launchDetailsActual) {
+			if definedTagsChanged || !reflect.DeepEqual(launchDetailsSpec, launchDetailsActual) {
 				m.Logger.Info("Machine pool", "spec", launchDetailsSpec)
 				m.Logger.Info("Machine pool", "actual", launchDetailsActual)
+				if err := m.reconcileLaunchShape(ctx); err != nil {
+					return err
+				}
 				// created the launch details pec again as we may have removed certain fields for comparison purposes
 				launchDetailsSpec, err := m.getLaunchInstanceDetails(instanceConfigurationSpec, freeFormTags, definedTags)
 				if err != nil {
@@ -432,7 +453,7 @@ func (m *MachinePoolScope) createInstanceConfiguration(ctx context.Context, laun
 	}
 	req := core.CreateInstanceConfigurationRequest{
 		CreateInstanceConfiguration: core.CreateInstanceConfigurationDetails{
-			CompartmentId:   common.String(m.OCIClusterAccesor.GetCompartmentId()),
+			CompartmentId:   common.String(m.getCompartmentId()),
 			DisplayName:     common.String(fmt.Sprintf("%s-%s", m.OCIMachinePool.GetName(), m.OCIMachinePool.ResourceVersion)),
 			FreeformTags:    freeFormTags,
 			DefinedTags:     definedTags,
@@ -456,16 +477,28 @@ func (m *MachinePoolScope) getLaunchInstanceDetails(instanceConfigurationSpec in
 	if metadata == nil {
 		metadata = make(map[string]string)
 	}
-	cloudInitData, err := m.GetBootstrapData()
+	bootstrapData, bootstrapFormat, err := m.GetBootstrapData()
 	if err != nil {
 		return nil, err
 	}
-	metadata["user_data"] = base64.StdEncoding.EncodeToString([]byte(cloudInitData))
+	userData := base64.StdEncoding.EncodeToString([]byte(bootstrapData))
+	// the instances of the pool are launched at any time, the bootstrap data can not be behind a short-lived
+	// pre-authenticated request and has to fit in the metadata
+	if bootstrapFormat == infrastructurev1beta2.IgnitionBootstrapFormat {
+		if _, err := getIgnitionVersion(bootstrapData); err != nil {
+			return nil, err
+		}
+		if !fitsInMetadata(metadata, userData) {
+			return nil, errors.Errorf("the Ignition config of %d bytes exceeds the size limit of the instance metadata", len(bootstrapData))
+		}
+	}
+	metadata["user_data"] = userData
+	launchShape := m.getLaunchShape()
 
 	launchDetails := &core.InstanceConfigurationLaunchInstanceDetails{
-		CompartmentId:     common.String(m.OCIClusterAccesor.GetCompartmentId()),
+		CompartmentId:     common.String(m.getCompartmentId()),
 		DisplayName:       common.String(m.OCIMachinePool.GetName()),
-		Shape:             common.String(*m.OCIMachinePool.Spec.InstanceConfiguration.Shape),
+		Shape:             common.String(launchShape.shape),
 		Metadata:          metadata,
 		DedicatedVmHostId: instanceConfigurationSpec.DedicatedVmHostId,
 		FreeformTags:      freeFormTags,
@@ -481,10 +514,12 @@ func (m *MachinePoolScope) getLaunchInstanceDetails(instanceConfigurationSpec in
 	launchDetails.LaunchOptions = m.getLaunchOptions()
 	launchDetails.InstanceOptions = m.getInstanceOptions()
 	launchDetails.AvailabilityConfig = m.getAvailabilityConfig()
-	launchDetails.PreemptibleInstanceConfig = m.getPreemptibleInstanceConfig()
+	if launchShape.preemptible {
+		launchDetails.PreemptibleInstanceConfig = m.getPreemptibleInstanceConfig()
+	}
 	launchDetails.PlatformConfig = m.getPlatformConfig()
 
-	shapeConfig, err := m.buildInstanceConfigurationShapeConfig()
+	shapeConfig, err := m.buildInstanceConfigurationShapeConfig(launchShape.shapeConfig)
 	if err != nil {
 		conditions.MarkFalse(m.MachinePool, infrav2exp.LaunchTemplateReadyCondition, infrav2exp.LaunchTemplateCreateFailedReason, clusterv1.ConditionSeverityError, err.Error())
 		m.Info("failed to create instance configuration due to shape config")
@@ -537,7 +572,7 @@ func (m *MachinePoolScope) FindInstancePool(ctx context.Context) (*core.Instance
 	// We have to first list the pools to get the instance pool.
 	// List returns InstancePoolSummary which lacks some details of InstancePool
 	reqList := core.ListInstancePoolsRequest{
-		CompartmentId: common.String(m.OCIClusterAccesor.GetCompartmentId()),
+		CompartmentId: common.String(m.getCompartmentId()),
 		DisplayName:   common.String(m.OCIMachinePool.GetName()),
 	}
 
@@ -593,11 +628,14 @@ func (m *MachinePoolScope) CreateInstancePool(ctx context.Context) (*core.Instan
 	if m.MachinePool.Spec.Replicas != nil {
 		replicas = int(*m.MachinePool.Spec.Replicas)
 	}
+	if m.isHibernated() {
+		replicas = 0
+	}
 
 	m.Info("Creating Instance Pool")
 	req := core.CreateInstancePoolRequest{
 		CreateInstancePoolDetails: core.CreateInstancePoolDetails{
-			CompartmentId:           common.String(m.OCIClusterAccesor.GetCompartmentId()),
+			CompartmentId:           common.String(m.getCompartmentId()),
 			InstanceConfigurationId: m.GetInstanceConfigurationId(),
 			Size:                    common.Int(replicas),
 			DisplayName:             common.String(m.OCIMachinePool.GetName()),
@@ -615,20 +653,27 @@ func (m *MachinePoolScope) CreateInstancePool(ctx context.Context) (*core.Instan
 	return &instancePool.InstancePool, nil
 }
 
-// UpdatePool attempts to update the instance pool
+// UpdatePool attempts to update the instance pool. Tags set on the cluster are merged into the tags of
+// the instance pool, tags which were added outside of Cluster API are left untouched. The instance pool is
+// scaled to zero while the cluster is hibernated.
 func (m *MachinePoolScope) UpdatePool(ctx context.Context, instancePool *core.InstancePool) (*core.InstancePool, error) {
-	if instancePoolNeedsUpdates(m, instancePool) {
+	m.recordHibernation(instancePool)
+	applied := m.OCIMachinePool.Status.AppliedTags
+	freeformTags, freeformTagsChanged := ociutil.MergeFreeformTags(instancePool.FreeformTags, m.GetFreeFormTags(), appliedFreeformTags(applied))
+	definedTags, definedTagsChanged := ociutil.MergeDefinedTags(instancePool.DefinedTags, m.getDefinedTags(), appliedDefinedTags(applied))
+	tagsChanged := freeformTagsChanged || definedTagsChanged
+	if instancePoolNeedsUpdates(m, instancePool) || tagsChanged {
 		m.Info("Updating instance pool")
-		replicas := 0
-		if m.MachinePool.Spec.Replicas != nil {
-			replicas = int(*m.MachinePool.Spec.Replicas)
-		}
 		req := core.UpdateInstancePoolRequest{InstancePoolId: instancePool.Id,
 			UpdateInstancePoolDetails: core.UpdateInstancePoolDetails{
-				Size:                    common.Int(replicas),
+				Size:                    common.Int(m.desiredSize()),
 				InstanceConfigurationId: m.OCIMachinePool.Spec.InstanceConfiguration.InstanceConfigurationId,
 			},
 		}
+		if tagsChanged {
+			req.UpdateInstancePoolDetails.FreeformTags = freeformTags
+			req.UpdateInstancePoolDetails.DefinedTags = definedTags
+		}
 		resp, err := m.ComputeManagementClient.UpdateInstancePool(ctx, req)
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to update instance pool")
@@ -639,6 +684,52 @@ func (m *MachinePoolScope) UpdatePool(ctx context.Context, instancePool *core.In
 	return instancePool, nil
 }
 
+// ReconcileInstanceTags applies the tags of the cluster to the instances of the instance pool, the tags which were
+// last applied and removed from the cluster since are removed. The instances are only looked up when the tags of
+// the cluster changed, as the instances launched afterwards get the tags of the instance configuration.
+func (m *MachinePoolScope) ReconcileInstanceTags(ctx context.Context) error {
+	desired := NewAppliedTags(m.GetFreeFormTags(), m.OCIClusterAccesor.GetDefinedTags())
+	applied := m.OCIMachinePool.Status.AppliedTags
+	if reflect.DeepEqual(desired, applied) {
+		return nil
+	}
+	instances, err := m.ListMachinePoolInstances(ctx)
+	if err != nil {
+		return err
+	}
+	for _, summary := range instances {
+		if strings.EqualFold(ociutil.DerefString(summary.State), string(core.InstanceLifecycleStateTerminating)) ||
+			strings.EqualFold(ociutil.DerefString(summary.State), string(core.InstanceLifecycleStateTerminated)) {
+			continue
+		}
+		resp, err := m.ComputeClient.GetInstance(ctx, core.GetInstanceRequest{
+			InstanceId: summary.Id,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get the instance")
+		}
+		freeformTags, freeformTagsChanged := ociutil.MergeFreeformTags(resp.FreeformTags, m.GetFreeFormTags(), appliedFreeformTags(applied))
+		definedTags, definedTagsChanged := ociutil.MergeDefinedTags(resp.DefinedTags, m.getDefinedTags(), appliedDefinedTags(applied))
+		if !freeformTagsChanged && !definedTagsChanged {
+			continue
+		}
+		_, err = m.ComputeClient.UpdateInstance(ctx, core.UpdateInstanceRequest{
+			InstanceId: summary.Id,
+			UpdateInstanceDetails: core.UpdateInstanceDetails{
+				FreeformTags: freeformTags,
+				DefinedTags:  definedTags,
+			},
+		})
+		if err != nil {
+			m.Error(err, "failed to update the tags of the instance")
+			return errors.Wrap(err, "failed to update the tags of the instance")
+		}
+		m.Info("Updated the tags of the instance", "instance", summary.Id)
+	}
+	m.OCIMachinePool.Status.AppliedTags = desired
+	return nil
+}
+
 func (m *MachinePoolScope) TerminateInstancePool(ctx context.Context, instancePool *core.InstancePool) error {
 	m.Info("Terminating instance pool", "id", instancePool.Id, "lifecycleState", instancePool.LifecycleState)
 	req := core.TerminateInstancePoolRequest{InstancePoolId: instancePool.Id}
@@ -652,10 +743,7 @@ func (m *MachinePoolScope) TerminateInstancePool(ctx context.Context, instancePo
 // instancePoolNeedsUpdates compares incoming OCIMachinePool and compares against existing instance pool.
 func instancePoolNeedsUpdates(machinePoolScope *MachinePoolScope, instancePool *core.InstancePool) bool {
 	instanePoolSize := 0
-	machinePoolReplicas := 0
-	if machinePoolScope.MachinePool.Spec.Replicas != nil {
-		machinePoolReplicas = int(*machinePoolScope.MachinePool.Spec.Replicas)
-	}
+	machinePoolReplicas := machinePoolScope.desiredSize()
 
 	if instancePool.Size != nil {
 		instanePoolSize = *instancePool.Size
@@ -735,8 +823,12 @@ func (m *MachinePoolScope) getInstanceOptions() *core.InstanceConfigurationInsta
 func (m *MachinePoolScope) getInstanceConfigurationInstanceSourceViaImageDetail() core.InstanceConfigurationInstanceSourceViaImageDetails {
 	sourceConfig := m.OCIMachinePool.Spec.InstanceConfiguration.InstanceSourceViaImageDetails
 	if sourceConfig != nil {
+		imageId := sourceConfig.ImageId
+		if sourceConfig.ImageSelector != nil && m.OCIMachinePool.Status.Image != nil {
+			imageId = common.String(m.OCIMachinePool.Status.Image.ImageId)
+		}
 		return core.InstanceConfigurationInstanceSourceViaImageDetails{
-			ImageId:             sourceConfig.ImageId,
+			ImageId:             imageId,
 			BootVolumeVpusPerGB: sourceConfig.BootVolumeVpusPerGB,
 			BootVolumeSizeInGBs: sourceConfig.BootVolumeSizeInGBs,
 		}
@@ -744,6 +836,27 @@ func (m *MachinePoolScope) getInstanceConfigurationInstanceSourceViaImageDetail(
 	return core.InstanceConfigurationInstanceSourceViaImageDetails{}
 }
 
+// reconcileSelectedImage selects the image of the instance configuration when an image selector is set and records
+// it in the status, the image is only selected again when the selector changes.
+func (m *MachinePoolScope) reconcileSelectedImage(ctx context.Context) error {
+	sourceConfig := m.OCIMachinePool.Spec.InstanceConfiguration.InstanceSourceViaImageDetails
+	if sourceConfig == nil || sourceConfig.ImageSelector == nil {
+		m.OCIMachinePool.Status.Image = nil
+		return nil
+	}
+	if selectedImageId(m.OCIMachinePool.Status.Image, *sourceConfig.ImageSelector) != "" {
+		return nil
+	}
+	image, err := selectImage(ctx, m.ComputeClient, m.getCompartmentId(),
+		pointer.StringDeref(m.OCIMachinePool.Spec.InstanceConfiguration.Shape, ""), *sourceConfig.ImageSelector)
+	if err != nil {
+		return err
+	}
+	m.Info("Selected the image of the instance configuration", "image", image.Id, "displayName", image.DisplayName)
+	m.OCIMachinePool.Status.Image = newSelectedImage(image, *sourceConfig.ImageSelector)
+	return nil
+}
+
 func (m *MachinePoolScope) getAvailabilityConfig() *core.InstanceConfigurationAvailabilityConfig {
 	avalabilityConfigSpec := m.OCIMachinePool.Spec.InstanceConfiguration.AvailabilityConfig
 	if avalabilityConfigSpec != nil {
@@ -876,6 +989,13 @@ func (m *MachinePoolScope) getWorkerMachineNSGs() []string {
 	}
 }
 
+func (m *MachinePoolScope) getCompartmentId() string {
+	if m.OCIMachinePool.Spec.CompartmentId != "" {
+		return m.OCIMachinePool.Spec.CompartmentId
+	}
+	return m.OCIClusterAccesor.GetCompartmentId()
+}
+
 // GetInstanceConfiguration returns the instance configuration associated with the instance pool
 func (m *MachinePoolScope) GetInstanceConfiguration(ctx context.Context) (*core.InstanceConfiguration, error) {
 	instanceConfigurationId := m.GetInstanceConfigurationId()
@@ -929,7 +1049,7 @@ func (m *MachinePoolScope) getInstanceConfigurationsFromDisplayNameSortedTimeCre
 	}
 
 	req := core.ListInstanceConfigurationsRequest{
-		CompartmentId: common.String(m.OCIClusterAccesor.GetCompartmentId()),
+		CompartmentId: common.String(m.getCompartmentId()),
 		SortBy:        core.ListInstanceConfigurationsSortByTimecreated,
 		SortOrder:     core.ListInstanceConfigurationsSortOrderDesc,
 	}
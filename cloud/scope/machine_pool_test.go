@@ -24,10 +24,12 @@ import (
 	. "github.com/onsi/gomega"
 	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute/mock_compute"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/computemanagement/mock_computemanagement"
 	infrav2exp "github.com/oracle/cluster-api-provider-oci/exp/api/v1beta2"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -41,6 +43,7 @@ func TestInstanceConfigCreate(t *testing.T) {
 		ms                      *MachinePoolScope
 		mockCtrl                *gomock.Controller
 		computeManagementClient *mock_computemanagement.MockClient
+		computeClient           *mock_compute.MockComputeClient
 	)
 
 	tags := make(map[string]string)
@@ -80,6 +83,7 @@ func TestInstanceConfigCreate(t *testing.T) {
 			},
 		}
 		computeManagementClient = mock_computemanagement.NewMockClient(mockCtrl)
+		computeClient = mock_compute.NewMockComputeClient(mockCtrl)
 		ociCluster := &infrastructurev1beta2.OCICluster{
 			ObjectMeta: metav1.ObjectMeta{
 				UID: "cluster_uid",
@@ -129,6 +133,7 @@ func TestInstanceConfigCreate(t *testing.T) {
 		client := fake.NewClientBuilder().WithStatusSubresource(machinePool).WithObjects(secret, machinePool).Build()
 		ms, err = NewMachinePoolScope(MachinePoolScopeParams{
 			ComputeManagementClient: computeManagementClient,
+			ComputeClient:           computeClient,
 			OCIMachinePool:          machinePool,
 			OCIClusterAccessor: OCISelfManagedCluster{
 				OCICluster: ociCluster,
@@ -373,6 +378,99 @@ func TestInstanceConfigCreate(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name:          "instance config update when the image selector selects another image",
+			errorExpected: false,
+			testSpecificSetup: func(ms *MachinePoolScope) {
+				ms.OCIMachinePool.Spec.InstanceConfiguration = infrav2exp.InstanceConfiguration{
+					Shape:                   common.String("test-shape"),
+					InstanceConfigurationId: common.String("test"),
+					InstanceSourceViaImageDetails: &infrav2exp.InstanceSourceViaImageConfig{
+						ImageSelector: &infrastructurev1beta2.ImageSelector{
+							FreeformTags: map[string]string{"k8s-version": "v1.29.2"},
+						},
+					},
+				}
+				ms.OCIMachinePool.Status.Image = &infrastructurev1beta2.SelectedImage{
+					ImageId: "old-image",
+					Selector: infrastructurev1beta2.ImageSelector{
+						FreeformTags: map[string]string{"k8s-version": "v1.29.1"},
+					},
+				}
+				computeManagementClient.EXPECT().GetInstanceConfiguration(gomock.Any(), gomock.Eq(core.GetInstanceConfigurationRequest{
+					InstanceConfigurationId: common.String("test"),
+				})).
+					Return(core.GetInstanceConfigurationResponse{
+						InstanceConfiguration: core.InstanceConfiguration{
+							Id: common.String("test"),
+							InstanceDetails: core.ComputeInstanceDetails{
+								LaunchDetails: &core.InstanceConfigurationLaunchInstanceDetails{
+									DefinedTags:   definedTagsInterface,
+									FreeformTags:  tags,
+									CompartmentId: common.String("test-compartment"),
+									Shape:         common.String("test-shape"),
+									CreateVnicDetails: &core.InstanceConfigurationCreateVnicDetails{
+										FreeformTags: tags,
+										NsgIds:       []string{"nsg-id"},
+										SubnetId:     common.String("subnet-id"),
+									},
+									SourceDetails: core.InstanceConfigurationInstanceSourceViaImageDetails{
+										ImageId: common.String("old-image"),
+									},
+									Metadata: map[string]string{"user_data": "dGVzdA=="},
+								},
+							},
+						},
+					}, nil)
+				computeClient.EXPECT().ListImages(gomock.Any(), gomock.Eq(core.ListImagesRequest{
+					CompartmentId:  common.String("test-compartment"),
+					Shape:          common.String("test-shape"),
+					LifecycleState: core.ImageLifecycleStateAvailable,
+					SortBy:         core.ListImagesSortByTimecreated,
+					SortOrder:      core.ListImagesSortOrderDesc,
+				})).
+					Return(core.ListImagesResponse{
+						Items: []core.Image{
+							{
+								Id:           common.String("new-image"),
+								FreeformTags: map[string]string{"k8s-version": "v1.29.2"},
+							},
+						},
+					}, nil)
+				computeManagementClient.EXPECT().CreateInstanceConfiguration(gomock.Any(), gomock.Eq(core.CreateInstanceConfigurationRequest{
+					CreateInstanceConfiguration: core.CreateInstanceConfigurationDetails{
+						DefinedTags:   definedTagsInterface,
+						DisplayName:   common.String("test-20"),
+						FreeformTags:  tags,
+						CompartmentId: common.String("test-compartment"),
+						InstanceDetails: core.ComputeInstanceDetails{
+							LaunchDetails: &core.InstanceConfigurationLaunchInstanceDetails{
+								DefinedTags:   definedTagsInterface,
+								FreeformTags:  tags,
+								DisplayName:   common.String("test"),
+								CompartmentId: common.String("test-compartment"),
+								CreateVnicDetails: &core.InstanceConfigurationCreateVnicDetails{
+									DefinedTags:  definedTagsInterface,
+									FreeformTags: tags,
+									NsgIds:       []string{"nsg-id"},
+									SubnetId:     common.String("subnet-id"),
+								},
+								Metadata: map[string]string{"user_data": "dGVzdA=="},
+								Shape:    common.String("test-shape"),
+								SourceDetails: core.InstanceConfigurationInstanceSourceViaImageDetails{
+									ImageId: common.String("new-image"),
+								},
+							},
+						},
+					},
+				})).
+					Return(core.CreateInstanceConfigurationResponse{
+						InstanceConfiguration: core.InstanceConfiguration{
+							Id: common.String("id"),
+						},
+					}, nil)
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -712,6 +810,7 @@ func TestInstancePoolUpdate(t *testing.T) {
 		errorSubStringMatch bool
 		instancepool        *core.InstancePool
 		testSpecificSetup   func(ms *MachinePoolScope)
+		validate            func(g *WithT, ms *MachinePoolScope)
 	}{
 		{
 			name:          "instance pool no update",
@@ -719,6 +818,8 @@ func TestInstancePoolUpdate(t *testing.T) {
 			instancepool: &core.InstancePool{
 				Size:                    common.Int(3),
 				InstanceConfigurationId: common.String("config_id"),
+				FreeformTags:            tags,
+				DefinedTags:             definedTagsInterface,
 			},
 			testSpecificSetup: func(ms *MachinePoolScope) {
 				ms.OCIMachinePool.Spec.InstanceConfiguration.InstanceConfigurationId = common.String("config_id")
@@ -730,6 +831,8 @@ func TestInstancePoolUpdate(t *testing.T) {
 			instancepool: &core.InstancePool{
 				Size:                    common.Int(3),
 				InstanceConfigurationId: common.String("config_id"),
+				FreeformTags:            tags,
+				DefinedTags:             definedTagsInterface,
 			},
 			testSpecificSetup: func(ms *MachinePoolScope) {
 				ms.OCIMachinePool.Spec.InstanceConfiguration.InstanceConfigurationId = common.String("config_id_new")
@@ -746,6 +849,124 @@ func TestInstancePoolUpdate(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name:          "instance pool tags updated",
+			errorExpected: false,
+			instancepool: &core.InstancePool{
+				Id:                      common.String("id"),
+				Size:                    common.Int(3),
+				InstanceConfigurationId: common.String("config_id"),
+				FreeformTags: map[string]string{
+					ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+					ociutil.ClusterResourceIdentifier: "resource_uid",
+					"foreign":                         "tag",
+				},
+			},
+			testSpecificSetup: func(ms *MachinePoolScope) {
+				ms.OCIMachinePool.Spec.InstanceConfiguration.InstanceConfigurationId = common.String("config_id")
+				computeManagementClient.EXPECT().UpdateInstancePool(gomock.Any(), gomock.Eq(core.UpdateInstancePoolRequest{
+					InstancePoolId: common.String("id"),
+					UpdateInstancePoolDetails: core.UpdateInstancePoolDetails{
+						Size:                    common.Int(3),
+						InstanceConfigurationId: common.String("config_id"),
+						FreeformTags: map[string]string{
+							ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+							ociutil.ClusterResourceIdentifier: "resource_uid",
+							"foreign":                         "tag",
+						},
+						DefinedTags: definedTagsInterface,
+					},
+				})).
+					Return(core.UpdateInstancePoolResponse{
+						InstancePool: core.InstancePool{
+							Id: common.String("id"),
+						},
+					}, nil)
+			},
+		},
+		{
+			name:          "instance pool is scaled to zero when the cluster is hibernated",
+			errorExpected: false,
+			instancepool: &core.InstancePool{
+				Id:                      common.String("id"),
+				Size:                    common.Int(3),
+				InstanceConfigurationId: common.String("config_id"),
+				FreeformTags:            tags,
+				DefinedTags:             definedTagsInterface,
+			},
+			testSpecificSetup: func(ms *MachinePoolScope) {
+				ms.Cluster.Annotations = map[string]string{infrastructurev1beta2.HibernateAnnotation: ""}
+				ms.OCIMachinePool.Spec.InstanceConfiguration.InstanceConfigurationId = common.String("config_id")
+				computeManagementClient.EXPECT().UpdateInstancePool(gomock.Any(), gomock.Eq(core.UpdateInstancePoolRequest{
+					InstancePoolId: common.String("id"),
+					UpdateInstancePoolDetails: core.UpdateInstancePoolDetails{
+						Size:                    common.Int(0),
+						InstanceConfigurationId: common.String("config_id"),
+					},
+				})).
+					Return(core.UpdateInstancePoolResponse{
+						InstancePool: core.InstancePool{
+							Id: common.String("id"),
+						},
+					}, nil)
+			},
+			validate: func(g *WithT, ms *MachinePoolScope) {
+				g.Expect(*ms.OCIMachinePool.Status.HibernatedReplicas).To(Equal(int32(3)))
+			},
+		},
+		{
+			name:          "instance pool stays scaled to zero until the control plane resumes",
+			errorExpected: false,
+			instancepool: &core.InstancePool{
+				Id:                      common.String("id"),
+				Size:                    common.Int(0),
+				InstanceConfigurationId: common.String("config_id"),
+				FreeformTags:            tags,
+				DefinedTags:             definedTagsInterface,
+			},
+			testSpecificSetup: func(ms *MachinePoolScope) {
+				replicas := int32(3)
+				ms.OCIMachinePool.Status.HibernatedReplicas = &replicas
+				ms.OCIClusterAccesor.(OCISelfManagedCluster).OCICluster.Status.Hibernation = infrastructurev1beta2.HibernationStateResuming
+				ms.OCIMachinePool.Spec.InstanceConfiguration.InstanceConfigurationId = common.String("config_id")
+			},
+			validate: func(g *WithT, ms *MachinePoolScope) {
+				g.Expect(ms.IsWaitingForControlPlaneResume()).To(BeTrue())
+				g.Expect(*ms.OCIMachinePool.Status.HibernatedReplicas).To(Equal(int32(3)))
+			},
+		},
+		{
+			name:          "instance pool is scaled back once the control plane has resumed",
+			errorExpected: false,
+			instancepool: &core.InstancePool{
+				Id:                      common.String("id"),
+				Size:                    common.Int(0),
+				InstanceConfigurationId: common.String("config_id"),
+				FreeformTags:            tags,
+				DefinedTags:             definedTagsInterface,
+			},
+			testSpecificSetup: func(ms *MachinePoolScope) {
+				replicas := int32(3)
+				ms.OCIMachinePool.Status.HibernatedReplicas = &replicas
+				ms.OCIMachinePool.Spec.InstanceConfiguration.InstanceConfigurationId = common.String("config_id")
+				computeManagementClient.EXPECT().UpdateInstancePool(gomock.Any(), gomock.Eq(core.UpdateInstancePoolRequest{
+					InstancePoolId: common.String("id"),
+					UpdateInstancePoolDetails: core.UpdateInstancePoolDetails{
+						Size:                    common.Int(3),
+						InstanceConfigurationId: common.String("config_id"),
+					},
+				})).
+					Return(core.UpdateInstancePoolResponse{
+						InstancePool: core.InstancePool{
+							Id: common.String("id"),
+						},
+					}, nil)
+			},
+			validate: func(g *WithT, ms *MachinePoolScope) {
+				g.Expect(ms.IsWaitingForControlPlaneResume()).To(BeFalse())
+				g.Expect(ms.OCIMachinePool.Status.HibernatedReplicas).To(BeNil())
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -760,6 +981,169 @@ func TestInstancePoolUpdate(t *testing.T) {
 			} else {
 				g.Expect(err).To(BeNil())
 			}
+			if tc.validate != nil {
+				tc.validate(g, ms)
+			}
+		})
+	}
+}
+
+func TestReconcileInstanceTags(t *testing.T) {
+	var (
+		ms                      *MachinePoolScope
+		mockCtrl                *gomock.Controller
+		computeManagementClient *mock_computemanagement.MockClient
+		computeClient           *mock_compute.MockComputeClient
+	)
+
+	setup := func(t *testing.T, g *WithT) {
+		var err error
+		mockCtrl = gomock.NewController(t)
+		computeManagementClient = mock_computemanagement.NewMockClient(mockCtrl)
+		computeClient = mock_compute.NewMockComputeClient(mockCtrl)
+		ociCluster := &infrastructurev1beta2.OCICluster{
+			Spec: infrastructurev1beta2.OCIClusterSpec{
+				CompartmentId:         "test-compartment",
+				OCIResourceIdentifier: "resource_uid",
+				FreeformTags:          map[string]string{"cost-center": "2"},
+			},
+		}
+		machinePool := &infrav2exp.OCIMachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: infrav2exp.OCIMachinePoolSpec{
+				OCID: common.String("pool-id"),
+			},
+		}
+		client := fake.NewClientBuilder().WithObjects(machinePool).Build()
+		ms, err = NewMachinePoolScope(MachinePoolScopeParams{
+			ComputeManagementClient: computeManagementClient,
+			ComputeClient:           computeClient,
+			OCIMachinePool:          machinePool,
+			OCIClusterAccessor: OCISelfManagedCluster{
+				OCICluster: ociCluster,
+			},
+			Cluster:     &clusterv1.Cluster{},
+			MachinePool: &expclusterv1.MachinePool{},
+			Client:      client,
+		})
+		g.Expect(err).To(BeNil())
+	}
+	teardown := func(t *testing.T, g *WithT) {
+		mockCtrl.Finish()
+	}
+
+	desiredTags := map[string]string{
+		ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+		ociutil.ClusterResourceIdentifier: "resource_uid",
+		"cost-center":                     "2",
+	}
+
+	tests := []struct {
+		name              string
+		errorExpected     bool
+		matchError        error
+		testSpecificSetup func(ms *MachinePoolScope)
+	}{
+		{
+			name:          "tags already applied",
+			errorExpected: false,
+			testSpecificSetup: func(ms *MachinePoolScope) {
+				ms.OCIMachinePool.Status.AppliedTags = NewAppliedTags(desiredTags, nil)
+			},
+		},
+		{
+			name:          "instances retagged",
+			errorExpected: false,
+			testSpecificSetup: func(ms *MachinePoolScope) {
+				ms.OCIMachinePool.Status.AppliedTags = &infrastructurev1beta2.AppliedTags{
+					FreeformTags: map[string]string{
+						ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+						ociutil.ClusterResourceIdentifier: "resource_uid",
+						"cost-center":                     "1",
+						"team":                            "a",
+					},
+				}
+				computeManagementClient.EXPECT().ListInstancePoolInstances(gomock.Any(), gomock.Eq(core.ListInstancePoolInstancesRequest{
+					CompartmentId:  common.String("test-compartment"),
+					InstancePoolId: common.String("pool-id"),
+				})).Return(core.ListInstancePoolInstancesResponse{
+					Items: []core.InstanceSummary{
+						{
+							Id:    common.String("instance-1"),
+							State: common.String("Running"),
+						},
+						{
+							Id:    common.String("instance-2"),
+							State: common.String("Terminating"),
+						},
+					},
+				}, nil)
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{
+					InstanceId: common.String("instance-1"),
+				})).Return(core.GetInstanceResponse{
+					Instance: core.Instance{
+						Id: common.String("instance-1"),
+						FreeformTags: map[string]string{
+							ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+							ociutil.ClusterResourceIdentifier: "resource_uid",
+							"cost-center":                     "1",
+							"team":                            "a",
+							"foreign":                         "tag",
+						},
+					},
+				}, nil)
+				computeClient.EXPECT().UpdateInstance(gomock.Any(), gomock.Eq(core.UpdateInstanceRequest{
+					InstanceId: common.String("instance-1"),
+					UpdateInstanceDetails: core.UpdateInstanceDetails{
+						FreeformTags: map[string]string{
+							ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+							ociutil.ClusterResourceIdentifier: "resource_uid",
+							"cost-center":                     "2",
+							"foreign":                         "tag",
+						},
+						DefinedTags: map[string]map[string]interface{}{},
+					},
+				})).Return(core.UpdateInstanceResponse{}, nil)
+			},
+		},
+		{
+			name:          "instance update error",
+			errorExpected: true,
+			matchError:    errors.New("failed to update the tags of the instance: could not update instance"),
+			testSpecificSetup: func(ms *MachinePoolScope) {
+				computeManagementClient.EXPECT().ListInstancePoolInstances(gomock.Any(), gomock.Any()).
+					Return(core.ListInstancePoolInstancesResponse{
+						Items: []core.InstanceSummary{
+							{
+								Id:    common.String("instance-1"),
+								State: common.String("Running"),
+							},
+						},
+					}, nil)
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Any()).
+					Return(core.GetInstanceResponse{}, nil)
+				computeClient.EXPECT().UpdateInstance(gomock.Any(), gomock.Any()).
+					Return(core.UpdateInstanceResponse{}, errors.New("could not update instance"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			defer teardown(t, g)
+			setup(t, g)
+			tc.testSpecificSetup(ms)
+			err := ms.ReconcileInstanceTags(context.Background())
+			if tc.errorExpected {
+				g.Expect(err).To(Not(BeNil()))
+				g.Expect(err.Error()).To(Equal(tc.matchError.Error()))
+			} else {
+				g.Expect(err).To(BeNil())
+				g.Expect(ms.OCIMachinePool.Status.AppliedTags).To(Equal(NewAppliedTags(desiredTags, nil)))
+			}
 		})
 	}
 }
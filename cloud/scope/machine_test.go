@@ -33,7 +33,9 @@ import (
 	. "github.com/onsi/gomega"
 	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/blockstorage/mock_blockstorage"
 	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute/mock_compute"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn/mock_vcn"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
 	"github.com/pkg/errors"
@@ -243,10 +245,9 @@ func TestInstanceReconciliation(t *testing.T) {
 			},
 		},
 		{
-			name:                "invalid Failure Domain - 1",
-			errorExpected:       true,
-			errorSubStringMatch: true,
-			matchError:          errors.New("invalid failure domain parameter, must be a valid integer"),
+			name:          "invalid Failure Domain - 1",
+			errorExpected: true,
+			matchError:    errors.New("failure domain invalid is not a failure domain of the cluster"),
 			testSpecificSetup: func(machineScope *MachineScope, computeClient *mock_compute.MockComputeClient) {
 				ms.Machine.Spec.FailureDomain = common.String("invalid")
 				computeClient.EXPECT().ListInstances(gomock.Any(), gomock.Eq(core.ListInstancesRequest{
@@ -258,7 +259,7 @@ func TestInstanceReconciliation(t *testing.T) {
 		{
 			name:          "invalid Failure Domain - 2",
 			errorExpected: true,
-			matchError:    errors.New("failure domain should be a value between 1 and 3"),
+			matchError:    errors.New("failure domain 4 is not a failure domain of the cluster"),
 			testSpecificSetup: func(machineScope *MachineScope, computeClient *mock_compute.MockComputeClient) {
 				ms.Machine.Spec.FailureDomain = common.String("4")
 				computeClient.EXPECT().ListInstances(gomock.Any(), gomock.Eq(core.ListInstancesRequest{
@@ -267,6 +268,63 @@ func TestInstanceReconciliation(t *testing.T) {
 				})).Return(core.ListInstancesResponse{}, nil)
 			},
 		},
+		{
+			name:          "failure domain outside one to three",
+			errorExpected: false,
+			testSpecificSetup: func(machineScope *MachineScope, computeClient *mock_compute.MockComputeClient) {
+				setupAllParams(ms)
+				ociCluster := ms.OCIClusterAccessor.(OCISelfManagedCluster).OCICluster
+				ociCluster.Status.FailureDomains["4"] = clusterv1.FailureDomainSpec{
+					Attributes: map[string]string{
+						"AvailabilityDomain": "ad4",
+					},
+				}
+				ms.Machine.Spec.FailureDomain = common.String("4")
+				computeClient.EXPECT().ListInstances(gomock.Any(), gomock.Eq(core.ListInstancesRequest{
+					DisplayName:   common.String("name"),
+					CompartmentId: common.String("test"),
+				})).Return(core.ListInstancesResponse{}, nil)
+
+				computeClient.EXPECT().LaunchInstance(gomock.Any(), Eq(func(request interface{}) error {
+					return instancePlacementMatcher(request, "ad4", "")
+				})).Return(core.LaunchInstanceResponse{}, nil)
+			},
+		},
+		{
+			name:          "boot from a boot volume",
+			errorExpected: false,
+			testSpecificSetup: func(machineScope *MachineScope, computeClient *mock_compute.MockComputeClient) {
+				setupAllParams(ms)
+				ms.OCIMachine.Spec.ImageId = ""
+				ms.OCIMachine.Spec.BootVolumeSizeInGBs = ""
+				ms.OCIMachine.Spec.InstanceSourceViaBootVolumeDetails = &infrastructurev1beta2.InstanceSourceViaBootVolumeConfig{
+					BootVolumeId: common.String("boot-volume"),
+				}
+				blockStorageClient := mock_blockstorage.NewMockClient(mockCtrl)
+				ms.BlockStorageClient = blockStorageClient
+				computeClient.EXPECT().ListInstances(gomock.Any(), gomock.Eq(core.ListInstancesRequest{
+					DisplayName:   common.String("name"),
+					CompartmentId: common.String("test"),
+				})).Return(core.ListInstancesResponse{}, nil)
+				blockStorageClient.EXPECT().GetBootVolume(gomock.Any(), gomock.Eq(core.GetBootVolumeRequest{
+					BootVolumeId: common.String("boot-volume"),
+				})).Return(core.GetBootVolumeResponse{BootVolume: core.BootVolume{
+					Id:                 common.String("boot-volume"),
+					AvailabilityDomain: common.String("ad3"),
+					LifecycleState:     core.BootVolumeLifecycleStateAvailable,
+				}}, nil)
+
+				computeClient.EXPECT().LaunchInstance(gomock.Any(), Eq(func(request interface{}) error {
+					r := request.(core.LaunchInstanceRequest)
+					if !reflect.DeepEqual(r.LaunchInstanceDetails.SourceDetails, core.InstanceSourceViaBootVolumeDetails{
+						BootVolumeId: common.String("boot-volume"),
+					}) {
+						return errors.New("expecting the boot volume as the source")
+					}
+					return instancePlacementMatcher(request, "ad3", "")
+				})).Return(core.LaunchInstanceResponse{}, nil)
+			},
+		},
 		{
 			name:          "check displayname",
 			errorExpected: false,
@@ -1227,6 +1285,20 @@ func instanceCompartmentIDMatcher(request interface{}, matchStr string) error {
 	return nil
 }
 
+func instancePlacementMatcher(request interface{}, availabilityDomain string, faultDomain string) error {
+	r, ok := request.(core.LaunchInstanceRequest)
+	if !ok {
+		return errors.New("expecting LaunchInstanceRequest type")
+	}
+	if *r.LaunchInstanceDetails.AvailabilityDomain != availabilityDomain {
+		return errors.New(fmt.Sprintf("expecting AvailabilityDomain as %s", availabilityDomain))
+	}
+	if ociutil.DerefString(r.LaunchInstanceDetails.FaultDomain) != faultDomain {
+		return errors.New(fmt.Sprintf("expecting FaultDomain as %s", faultDomain))
+	}
+	return nil
+}
+
 func platformConfigMatcher(actual interface{}, expected core.PlatformConfig) error {
 	r, ok := actual.(core.LaunchInstanceRequest)
 	if !ok {
@@ -2533,6 +2605,24 @@ func TestInstanceDeletion(t *testing.T) {
 				Id: common.String("test"),
 			},
 		},
+		{
+			name:          "delete instance booted from an existing boot volume preserves the boot volume",
+			errorExpected: false,
+			testSpecificSetup: func(machineScope *MachineScope, computeClient *mock_compute.MockComputeClient) {
+				ms.OCIMachine.Spec.InstanceId = common.String("test")
+				ms.OCIMachine.Spec.InstanceSourceViaBootVolumeDetails = &infrastructurev1beta2.InstanceSourceViaBootVolumeConfig{
+					BootVolumeId: common.String("boot-volume"),
+				}
+				computeClient.EXPECT().TerminateInstance(gomock.Any(), gomock.Eq(core.TerminateInstanceRequest{
+					InstanceId:                         common.String("test"),
+					PreserveBootVolume:                 common.Bool(true),
+					PreserveDataVolumesCreatedAtLaunch: common.Bool(false),
+				})).Return(core.TerminateInstanceResponse{}, nil)
+			},
+			instance: &core.Instance{
+				Id: common.String("test"),
+			},
+		},
 		{
 			name:          "delete instance error",
 			errorExpected: true,
@@ -2572,6 +2662,250 @@ func TestInstanceDeletion(t *testing.T) {
 	}
 }
 
+func TestInstanceTagsReconciliation(t *testing.T) {
+	var (
+		ms                 *MachineScope
+		mockCtrl           *gomock.Controller
+		computeClient      *mock_compute.MockComputeClient
+		vcnClient          *mock_vcn.MockClient
+		blockStorageClient *mock_blockstorage.MockClient
+	)
+
+	setup := func(t *testing.T, g *WithT) {
+		var err error
+		mockCtrl = gomock.NewController(t)
+		computeClient = mock_compute.NewMockComputeClient(mockCtrl)
+		vcnClient = mock_vcn.NewMockClient(mockCtrl)
+		blockStorageClient = mock_blockstorage.NewMockClient(mockCtrl)
+		client := fake.NewClientBuilder().Build()
+		ociCluster := infrastructurev1beta2.OCICluster{
+			Spec: infrastructurev1beta2.OCIClusterSpec{
+				OCIResourceIdentifier: "resource_uid",
+			},
+		}
+		ms, err = NewMachineScope(MachineScopeParams{
+			ComputeClient:      computeClient,
+			VCNClient:          vcnClient,
+			BlockStorageClient: blockStorageClient,
+			OCIMachine: &infrastructurev1beta2.OCIMachine{
+				Spec: infrastructurev1beta2.OCIMachineSpec{
+					CompartmentId: "test",
+					InstanceId:    common.String("test"),
+					FreeformTags:  map[string]string{"cost-center": "2"},
+				},
+			},
+			Machine: &clusterv1.Machine{},
+			Cluster: &clusterv1.Cluster{},
+			OCIClusterAccessor: OCISelfManagedCluster{
+				OCICluster: &ociCluster,
+			},
+			Client: client,
+		})
+		g.Expect(err).To(BeNil())
+	}
+	teardown := func(t *testing.T, g *WithT) {
+		mockCtrl.Finish()
+	}
+
+	desiredTags := map[string]string{
+		ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+		ociutil.ClusterResourceIdentifier: "resource_uid",
+		"cost-center":                     "2",
+	}
+	updatedTags := map[string]string{
+		ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+		ociutil.ClusterResourceIdentifier: "resource_uid",
+		"cost-center":                     "2",
+		"foreign":                         "tag",
+	}
+	outdatedTags := map[string]string{
+		ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+		ociutil.ClusterResourceIdentifier: "resource_uid",
+		"cost-center":                     "1",
+		"foreign":                         "tag",
+	}
+
+	tests := []struct {
+		name                string
+		errorExpected       bool
+		matchError          error
+		instance            *core.Instance
+		errorSubStringMatch bool
+		testSpecificSetup   func(machineScope *MachineScope)
+	}{
+		{
+			name:          "tags up to date",
+			errorExpected: false,
+			testSpecificSetup: func(machineScope *MachineScope) {
+			},
+			instance: &core.Instance{
+				Id:           common.String("test"),
+				FreeformTags: desiredTags,
+			},
+		},
+		{
+			name:          "tags updated",
+			errorExpected: false,
+			testSpecificSetup: func(machineScope *MachineScope) {
+				computeClient.EXPECT().ListVnicAttachments(gomock.Any(), gomock.Eq(core.ListVnicAttachmentsRequest{
+					InstanceId:    common.String("test"),
+					CompartmentId: common.String("test"),
+				})).Return(core.ListVnicAttachmentsResponse{
+					Items: []core.VnicAttachment{
+						{
+							LifecycleState: core.VnicAttachmentLifecycleStateAttached,
+							VnicId:         common.String("vnic"),
+						},
+						{
+							LifecycleState: core.VnicAttachmentLifecycleStateDetached,
+							VnicId:         common.String("detached"),
+						},
+					},
+				}, nil)
+				vcnClient.EXPECT().GetVnic(gomock.Any(), gomock.Eq(core.GetVnicRequest{
+					VnicId: common.String("vnic"),
+				})).Return(core.GetVnicResponse{
+					Vnic: core.Vnic{
+						FreeformTags: outdatedTags,
+					},
+				}, nil)
+				vcnClient.EXPECT().UpdateVnic(gomock.Any(), gomock.Eq(core.UpdateVnicRequest{
+					VnicId: common.String("vnic"),
+					UpdateVnicDetails: core.UpdateVnicDetails{
+						FreeformTags: updatedTags,
+						DefinedTags:  map[string]map[string]interface{}{},
+					},
+				})).Return(core.UpdateVnicResponse{}, nil)
+				computeClient.EXPECT().ListBootVolumeAttachments(gomock.Any(), gomock.Eq(core.ListBootVolumeAttachmentsRequest{
+					AvailabilityDomain: common.String("ad1"),
+					CompartmentId:      common.String("test"),
+					InstanceId:         common.String("test"),
+				})).Return(core.ListBootVolumeAttachmentsResponse{
+					Items: []core.BootVolumeAttachment{
+						{
+							LifecycleState: core.BootVolumeAttachmentLifecycleStateAttached,
+							BootVolumeId:   common.String("boot-volume"),
+						},
+					},
+				}, nil)
+				blockStorageClient.EXPECT().GetBootVolume(gomock.Any(), gomock.Eq(core.GetBootVolumeRequest{
+					BootVolumeId: common.String("boot-volume"),
+				})).Return(core.GetBootVolumeResponse{
+					BootVolume: core.BootVolume{
+						FreeformTags: outdatedTags,
+					},
+				}, nil)
+				blockStorageClient.EXPECT().UpdateBootVolume(gomock.Any(), gomock.Eq(core.UpdateBootVolumeRequest{
+					BootVolumeId: common.String("boot-volume"),
+					UpdateBootVolumeDetails: core.UpdateBootVolumeDetails{
+						FreeformTags: updatedTags,
+						DefinedTags:  map[string]map[string]interface{}{},
+					},
+				})).Return(core.UpdateBootVolumeResponse{}, nil)
+				computeClient.EXPECT().UpdateInstance(gomock.Any(), gomock.Eq(core.UpdateInstanceRequest{
+					InstanceId: common.String("test"),
+					UpdateInstanceDetails: core.UpdateInstanceDetails{
+						FreeformTags: updatedTags,
+						DefinedTags:  map[string]map[string]interface{}{},
+					},
+				})).Return(core.UpdateInstanceResponse{}, nil)
+			},
+			instance: &core.Instance{
+				Id:                 common.String("test"),
+				AvailabilityDomain: common.String("ad1"),
+				CompartmentId:      common.String("test"),
+				FreeformTags:       outdatedTags,
+			},
+		},
+		{
+			name:          "tag removed from the spec",
+			errorExpected: false,
+			testSpecificSetup: func(machineScope *MachineScope) {
+				machineScope.OCIMachine.Status.AppliedTags = &infrastructurev1beta2.AppliedTags{
+					FreeformTags: map[string]string{"cost-center": "2", "team": "a"},
+				}
+				computeClient.EXPECT().ListVnicAttachments(gomock.Any(), gomock.Any()).
+					Return(core.ListVnicAttachmentsResponse{
+						Items: []core.VnicAttachment{
+							{
+								LifecycleState: core.VnicAttachmentLifecycleStateAttached,
+								VnicId:         common.String("vnic"),
+							},
+						},
+					}, nil)
+				vcnClient.EXPECT().GetVnic(gomock.Any(), gomock.Any()).
+					Return(core.GetVnicResponse{
+						Vnic: core.Vnic{
+							FreeformTags: desiredTags,
+						},
+					}, nil)
+				computeClient.EXPECT().ListBootVolumeAttachments(gomock.Any(), gomock.Any()).
+					Return(core.ListBootVolumeAttachmentsResponse{}, nil)
+				computeClient.EXPECT().UpdateInstance(gomock.Any(), gomock.Eq(core.UpdateInstanceRequest{
+					InstanceId: common.String("test"),
+					UpdateInstanceDetails: core.UpdateInstanceDetails{
+						FreeformTags: desiredTags,
+						DefinedTags:  map[string]map[string]interface{}{},
+					},
+				})).Return(core.UpdateInstanceResponse{}, nil)
+			},
+			instance: &core.Instance{
+				Id: common.String("test"),
+				FreeformTags: map[string]string{
+					ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+					ociutil.ClusterResourceIdentifier: "resource_uid",
+					"cost-center":                     "2",
+					"team":                            "a",
+				},
+			},
+		},
+		{
+			name:          "vnic update error",
+			errorExpected: true,
+			matchError:    errors.New("failed to update the tags of the vnic: could not update vnic"),
+			testSpecificSetup: func(machineScope *MachineScope) {
+				computeClient.EXPECT().ListVnicAttachments(gomock.Any(), gomock.Any()).
+					Return(core.ListVnicAttachmentsResponse{
+						Items: []core.VnicAttachment{
+							{
+								LifecycleState: core.VnicAttachmentLifecycleStateAttached,
+								VnicId:         common.String("vnic"),
+							},
+						},
+					}, nil)
+				vcnClient.EXPECT().GetVnic(gomock.Any(), gomock.Any()).
+					Return(core.GetVnicResponse{}, nil)
+				vcnClient.EXPECT().UpdateVnic(gomock.Any(), gomock.Any()).
+					Return(core.UpdateVnicResponse{}, errors.New("could not update vnic"))
+			},
+			instance: &core.Instance{
+				Id:           common.String("test"),
+				FreeformTags: outdatedTags,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			defer teardown(t, g)
+			setup(t, g)
+			tc.testSpecificSetup(ms)
+			err := ms.ReconcileTags(context.Background(), tc.instance)
+			if tc.errorExpected {
+				g.Expect(err).To(Not(BeNil()))
+				if tc.errorSubStringMatch {
+					g.Expect(err.Error()).To(ContainSubstring(tc.matchError.Error()))
+				} else {
+					g.Expect(err.Error()).To(Equal(tc.matchError.Error()))
+				}
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
 func setupAllParams(ms *MachineScope) {
 	ms.OCIMachine.Spec.BootVolumeSizeInGBs = "120"
 	ms.OCIMachine.Spec.ImageId = "image"
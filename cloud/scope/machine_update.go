@@ -0,0 +1,228 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsInPlaceUpdateEnabled returns true when the changes to the spec of the machine are applied to the running
+// instance.
+func (m *MachineScope) IsInPlaceUpdateEnabled() bool {
+	return m.OCIMachine.Spec.UpdatePolicy == infrastructurev1beta2.MachineUpdatePolicyInPlace
+}
+
+// GetInstanceUpdate returns the update which applies the spec of the machine to the running instance, or nil when
+// the instance matches the spec. The agent config and the availability config are updated first, the shape config,
+// which reboots the instance, is updated once the instance matches the rest of the spec.
+func (m *MachineScope) GetInstanceUpdate(instance *core.Instance) (*core.UpdateInstanceDetails, error) {
+	details := core.UpdateInstanceDetails{
+		AgentConfig:        m.getAgentConfigUpdate(instance),
+		AvailabilityConfig: m.getAvailabilityConfigUpdate(instance),
+	}
+	if details.AgentConfig != nil || details.AvailabilityConfig != nil {
+		return &details, nil
+	}
+	shapeConfig, err := m.getShapeConfigUpdate(instance)
+	if err != nil || shapeConfig == nil {
+		return nil, err
+	}
+	return &core.UpdateInstanceDetails{
+		ShapeConfig:               shapeConfig,
+		UpdateOperationConstraint: core.UpdateInstanceDetailsUpdateOperationConstraintAllowDowntime,
+	}, nil
+}
+
+// ApplyInstanceUpdate sends the update returned by GetInstanceUpdate to OCI.
+func (m *MachineScope) ApplyInstanceUpdate(ctx context.Context, instance *core.Instance, details core.UpdateInstanceDetails) error {
+	_, err := m.ComputeClient.UpdateInstance(ctx, core.UpdateInstanceRequest{
+		InstanceId:            instance.Id,
+		UpdateInstanceDetails: details,
+	})
+	if err != nil {
+		m.Logger.Error(err, "failed to update the instance")
+		return errors.Wrap(err, "failed to update the instance")
+	}
+	m.Logger.Info("Updated the instance", "instance", instance.Id, "reboot", details.ShapeConfig != nil)
+	return nil
+}
+
+// IsControlPlaneRebootAllowed returns true when the instances of the other control plane machines of the cluster
+// are ready, the instances of the control plane are rebooted one at a time to keep the quorum of etcd.
+func (m *MachineScope) IsControlPlaneRebootAllowed(ctx context.Context) (bool, error) {
+	machines := &infrastructurev1beta2.OCIMachineList{}
+	err := m.Client.List(ctx, machines,
+		client.InNamespace(m.OCIMachine.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: m.Cluster.Name},
+		client.HasLabels{clusterv1.MachineControlPlaneLabel})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to list the control plane machines")
+	}
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		if machine.Name == m.OCIMachine.Name || !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if !machine.Status.Ready ||
+			conditions.GetReason(machine, infrastructurev1beta2.InstanceUpdatedCondition) == infrastructurev1beta2.InstanceRebootingReason {
+			m.Logger.Info("Waiting for the control plane machine to be ready", "machine", machine.Name)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getPlacedShapeConfig returns the shape config of the shape the instance was launched with. The launch fallback
+// policy may launch the instance with a fallback shape, whose shape config replaces the one of the spec. The
+// second value is false when the shape of the instance is neither the shape of the spec nor a fallback shape.
+func (m *MachineScope) getPlacedShapeConfig() (infrastructurev1beta2.ShapeConfig, bool) {
+	placement := m.OCIMachine.Status.Placement
+	if placement == nil || placement.Shape == m.OCIMachine.Spec.Shape {
+		return m.OCIMachine.Spec.ShapeConfig, true
+	}
+	if policy := m.OCIMachine.Spec.LaunchFallbackPolicy; policy != nil {
+		for _, shape := range policy.Shapes {
+			if shape.Shape != placement.Shape {
+				continue
+			}
+			if shape.ShapeConfig == nil {
+				return infrastructurev1beta2.ShapeConfig{}, true
+			}
+			return *shape.ShapeConfig, true
+		}
+	}
+	return infrastructurev1beta2.ShapeConfig{}, false
+}
+
+func (m *MachineScope) getShapeConfigUpdate(instance *core.Instance) (*core.UpdateInstanceShapeConfigDetails, error) {
+	// the shape config of a shape which is no longer in the spec is left as it is
+	spec, ok := m.getPlacedShapeConfig()
+	if !ok {
+		return nil, nil
+	}
+	actual := instance.ShapeConfig
+	if actual == nil {
+		actual = &core.InstanceShapeConfig{}
+	}
+	update := &core.UpdateInstanceShapeConfigDetails{}
+	changed := false
+	if spec.Ocpus != "" {
+		ocpus, err := strconv.ParseFloat(spec.Ocpus, 32)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("ocpus provided %s is not a valid floating point", spec.Ocpus))
+		}
+		update.Ocpus = common.Float32(float32(ocpus))
+		changed = changed || actual.Ocpus == nil || *actual.Ocpus != *update.Ocpus
+	}
+	if spec.MemoryInGBs != "" {
+		memoryInGBs, err := strconv.ParseFloat(spec.MemoryInGBs, 32)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("memoryInGBs provided %s is not a valid floating point", spec.MemoryInGBs))
+		}
+		update.MemoryInGBs = common.Float32(float32(memoryInGBs))
+		changed = changed || actual.MemoryInGBs == nil || *actual.MemoryInGBs != *update.MemoryInGBs
+	}
+	if spec.BaselineOcpuUtilization != "" {
+		value, ok := core.GetMappingUpdateInstanceShapeConfigDetailsBaselineOcpuUtilizationEnum(spec.BaselineOcpuUtilization)
+		if !ok {
+			return nil, errors.New("invalid baseline cpu optimization parameter")
+		}
+		update.BaselineOcpuUtilization = value
+		changed = changed || string(actual.BaselineOcpuUtilization) != string(value)
+	}
+	if !changed {
+		return nil, nil
+	}
+	return update, nil
+}
+
+func (m *MachineScope) getAgentConfigUpdate(instance *core.Instance) *core.UpdateInstanceAgentConfigDetails {
+	desired := m.getAgentConfig()
+	if desired == nil {
+		return nil
+	}
+	actual := instance.AgentConfig
+	if actual == nil {
+		actual = &core.InstanceAgentConfig{}
+	}
+	if !boolChanged(desired.IsMonitoringDisabled, actual.IsMonitoringDisabled) &&
+		!boolChanged(desired.IsManagementDisabled, actual.IsManagementDisabled) &&
+		!boolChanged(desired.AreAllPluginsDisabled, actual.AreAllPluginsDisabled) &&
+		!pluginsConfigChanged(desired.PluginsConfig, actual.PluginsConfig) {
+		return nil
+	}
+	return &core.UpdateInstanceAgentConfigDetails{
+		IsMonitoringDisabled:  desired.IsMonitoringDisabled,
+		IsManagementDisabled:  desired.IsManagementDisabled,
+		AreAllPluginsDisabled: desired.AreAllPluginsDisabled,
+		PluginsConfig:         desired.PluginsConfig,
+	}
+}
+
+func (m *MachineScope) getAvailabilityConfigUpdate(instance *core.Instance) *core.UpdateInstanceAvailabilityConfigDetails {
+	desired := m.OCIMachine.Spec.AvailabilityConfig
+	if desired == nil {
+		return nil
+	}
+	actual := instance.AvailabilityConfig
+	if actual == nil {
+		actual = &core.InstanceAvailabilityConfig{}
+	}
+	recoveryAction, _ := core.GetMappingUpdateInstanceAvailabilityConfigDetailsRecoveryActionEnum(string(desired.RecoveryAction))
+	if !boolChanged(desired.IsLiveMigrationPreferred, actual.IsLiveMigrationPreferred) &&
+		(recoveryAction == "" || string(recoveryAction) == string(actual.RecoveryAction)) {
+		return nil
+	}
+	return &core.UpdateInstanceAvailabilityConfigDetails{
+		IsLiveMigrationPreferred: desired.IsLiveMigrationPreferred,
+		RecoveryAction:           recoveryAction,
+	}
+}
+
+// boolChanged returns true when a field set in the spec differs from the instance, the fields which are not set
+// in the spec are left to OCI.
+func boolChanged(desired *bool, actual *bool) bool {
+	return desired != nil && (actual == nil || *desired != *actual)
+}
+
+func pluginsConfigChanged(desired []core.InstanceAgentPluginConfigDetails, actual []core.InstanceAgentPluginConfigDetails) bool {
+	states := make(map[string]core.InstanceAgentPluginConfigDetailsDesiredStateEnum)
+	for _, plugin := range actual {
+		if plugin.Name != nil {
+			states[*plugin.Name] = plugin.DesiredState
+		}
+	}
+	for _, plugin := range desired {
+		if plugin.Name == nil {
+			continue
+		}
+		if state, ok := states[*plugin.Name]; !ok || state != plugin.DesiredState {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,315 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetInstanceUpdate(t *testing.T) {
+	runningInstance := func() *core.Instance {
+		return &core.Instance{
+			Id: common.String("test"),
+			ShapeConfig: &core.InstanceShapeConfig{
+				Ocpus:                   common.Float32(2),
+				MemoryInGBs:             common.Float32(16),
+				BaselineOcpuUtilization: core.InstanceShapeConfigBaselineOcpuUtilization1,
+			},
+			AgentConfig: &core.InstanceAgentConfig{
+				IsMonitoringDisabled: common.Bool(false),
+				PluginsConfig: []core.InstanceAgentPluginConfigDetails{
+					{
+						Name:         common.String("Bastion"),
+						DesiredState: core.InstanceAgentPluginConfigDetailsDesiredStateDisabled,
+					},
+				},
+			},
+			AvailabilityConfig: &core.InstanceAvailabilityConfig{
+				RecoveryAction: core.InstanceAvailabilityConfigRecoveryActionRestoreInstance,
+			},
+		}
+	}
+	tests := []struct {
+		name          string
+		spec          infrastructurev1beta2.OCIMachineSpec
+		placement     *infrastructurev1beta2.InstancePlacement
+		instance      *core.Instance
+		errorExpected bool
+		expected      *core.UpdateInstanceDetails
+	}{
+		{
+			name: "instance matches the spec",
+			spec: infrastructurev1beta2.OCIMachineSpec{
+				ShapeConfig: infrastructurev1beta2.ShapeConfig{
+					Ocpus:       "2",
+					MemoryInGBs: "16",
+				},
+				AgentConfig: &infrastructurev1beta2.LaunchInstanceAgentConfig{
+					IsMonitoringDisabled: common.Bool(false),
+					PluginsConfig: []infrastructurev1beta2.InstanceAgentPluginConfig{
+						{
+							Name:         common.String("Bastion"),
+							DesiredState: infrastructurev1beta2.InstanceAgentPluginConfigDetailsDesiredStateDisabled,
+						},
+					},
+				},
+				AvailabilityConfig: &infrastructurev1beta2.LaunchInstanceAvailabilityConfig{
+					RecoveryAction: infrastructurev1beta2.LaunchInstanceAvailabilityConfigDetailsRecoveryActionRestoreInstance,
+				},
+			},
+			instance: runningInstance(),
+		},
+		{
+			name:     "fields which are not in the spec are ignored",
+			spec:     infrastructurev1beta2.OCIMachineSpec{},
+			instance: runningInstance(),
+		},
+		{
+			name: "agent config is updated before the shape config",
+			spec: infrastructurev1beta2.OCIMachineSpec{
+				ShapeConfig: infrastructurev1beta2.ShapeConfig{
+					Ocpus: "4",
+				},
+				AgentConfig: &infrastructurev1beta2.LaunchInstanceAgentConfig{
+					PluginsConfig: []infrastructurev1beta2.InstanceAgentPluginConfig{
+						{
+							Name:         common.String("Bastion"),
+							DesiredState: infrastructurev1beta2.InstanceAgentPluginConfigDetailsDesiredStateEnabled,
+						},
+					},
+				},
+			},
+			instance: runningInstance(),
+			expected: &core.UpdateInstanceDetails{
+				AgentConfig: &core.UpdateInstanceAgentConfigDetails{
+					PluginsConfig: []core.InstanceAgentPluginConfigDetails{
+						{
+							Name:         common.String("Bastion"),
+							DesiredState: core.InstanceAgentPluginConfigDetailsDesiredStateEnabled,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "availability config is updated",
+			spec: infrastructurev1beta2.OCIMachineSpec{
+				AvailabilityConfig: &infrastructurev1beta2.LaunchInstanceAvailabilityConfig{
+					IsLiveMigrationPreferred: common.Bool(true),
+					RecoveryAction:           infrastructurev1beta2.LaunchInstanceAvailabilityConfigDetailsRecoveryActionStopInstance,
+				},
+			},
+			instance: runningInstance(),
+			expected: &core.UpdateInstanceDetails{
+				AvailabilityConfig: &core.UpdateInstanceAvailabilityConfigDetails{
+					IsLiveMigrationPreferred: common.Bool(true),
+					RecoveryAction:           core.UpdateInstanceAvailabilityConfigDetailsRecoveryActionStopInstance,
+				},
+			},
+		},
+		{
+			name: "shape config is updated with a reboot",
+			spec: infrastructurev1beta2.OCIMachineSpec{
+				ShapeConfig: infrastructurev1beta2.ShapeConfig{
+					Ocpus:       "4",
+					MemoryInGBs: "16",
+				},
+			},
+			instance: runningInstance(),
+			expected: &core.UpdateInstanceDetails{
+				ShapeConfig: &core.UpdateInstanceShapeConfigDetails{
+					Ocpus:       common.Float32(4),
+					MemoryInGBs: common.Float32(16),
+				},
+				UpdateOperationConstraint: core.UpdateInstanceDetailsUpdateOperationConstraintAllowDowntime,
+			},
+		},
+		{
+			name: "shape config of the fallback shape the instance was launched with is updated",
+			spec: infrastructurev1beta2.OCIMachineSpec{
+				Shape: "VM.Standard.E4.Flex",
+				ShapeConfig: infrastructurev1beta2.ShapeConfig{
+					Ocpus:       "4",
+					MemoryInGBs: "16",
+				},
+				LaunchFallbackPolicy: &infrastructurev1beta2.LaunchFallbackPolicy{
+					Shapes: []infrastructurev1beta2.FallbackShape{
+						{
+							Shape: "VM.Standard3.Flex",
+							ShapeConfig: &infrastructurev1beta2.ShapeConfig{
+								Ocpus:       "2",
+								MemoryInGBs: "32",
+							},
+						},
+					},
+				},
+			},
+			placement: &infrastructurev1beta2.InstancePlacement{Shape: "VM.Standard3.Flex"},
+			instance:  runningInstance(),
+			expected: &core.UpdateInstanceDetails{
+				ShapeConfig: &core.UpdateInstanceShapeConfigDetails{
+					Ocpus:       common.Float32(2),
+					MemoryInGBs: common.Float32(32),
+				},
+				UpdateOperationConstraint: core.UpdateInstanceDetailsUpdateOperationConstraintAllowDowntime,
+			},
+		},
+		{
+			name: "shape config is not updated when the shape of the instance is no longer in the spec",
+			spec: infrastructurev1beta2.OCIMachineSpec{
+				Shape: "VM.Standard.E4.Flex",
+				ShapeConfig: infrastructurev1beta2.ShapeConfig{
+					Ocpus: "4",
+				},
+			},
+			placement: &infrastructurev1beta2.InstancePlacement{Shape: "VM.Standard3.Flex"},
+			instance:  runningInstance(),
+		},
+		{
+			name: "invalid ocpus",
+			spec: infrastructurev1beta2.OCIMachineSpec{
+				ShapeConfig: infrastructurev1beta2.ShapeConfig{
+					Ocpus: "invalid",
+				},
+			},
+			instance:      runningInstance(),
+			errorExpected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ms := &MachineScope{
+				OCIMachine: &infrastructurev1beta2.OCIMachine{
+					Spec: tc.spec,
+					Status: infrastructurev1beta2.OCIMachineStatus{
+						Placement: tc.placement,
+					},
+				},
+			}
+			update, err := ms.GetInstanceUpdate(tc.instance)
+			if tc.errorExpected {
+				g.Expect(err).To(Not(BeNil()))
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(update).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestIsControlPlaneRebootAllowed(t *testing.T) {
+	controlPlaneMachine := func(name string, ready bool, reason string) *infrastructurev1beta2.OCIMachine {
+		machine := &infrastructurev1beta2.OCIMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel:         "cluster",
+					clusterv1.MachineControlPlaneLabel: "",
+				},
+			},
+			Status: infrastructurev1beta2.OCIMachineStatus{
+				Ready: ready,
+			},
+		}
+		if reason != "" {
+			conditions.MarkFalse(machine, infrastructurev1beta2.InstanceUpdatedCondition, reason, clusterv1.ConditionSeverityInfo, "")
+		}
+		return machine
+	}
+	tests := []struct {
+		name     string
+		objects  []client.Object
+		expected bool
+	}{
+		{
+			name: "other control plane machines are ready",
+			objects: []client.Object{
+				controlPlaneMachine("cp-0", false, infrastructurev1beta2.InstanceRebootingReason),
+				controlPlaneMachine("cp-1", true, ""),
+			},
+			expected: true,
+		},
+		{
+			name: "another control plane machine is not ready",
+			objects: []client.Object{
+				controlPlaneMachine("cp-0", true, ""),
+				controlPlaneMachine("cp-1", false, ""),
+			},
+			expected: false,
+		},
+		{
+			name: "another control plane machine reboots",
+			objects: []client.Object{
+				controlPlaneMachine("cp-0", true, ""),
+				controlPlaneMachine("cp-1", true, infrastructurev1beta2.InstanceRebootingReason),
+			},
+			expected: false,
+		},
+		{
+			name: "machines of other clusters are ignored",
+			objects: []client.Object{
+				controlPlaneMachine("cp-0", true, ""),
+				&infrastructurev1beta2.OCIMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other",
+						Namespace: "test",
+						Labels: map[string]string{
+							clusterv1.ClusterNameLabel:         "other",
+							clusterv1.MachineControlPlaneLabel: "",
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ms, err := NewMachineScope(MachineScopeParams{
+				OCIMachine: tc.objects[0].(*infrastructurev1beta2.OCIMachine),
+				Machine:    &clusterv1.Machine{},
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster",
+					},
+				},
+				OCIClusterAccessor: OCISelfManagedCluster{
+					OCICluster: &infrastructurev1beta2.OCICluster{},
+				},
+				Client: fake.NewClientBuilder().WithObjects(tc.objects...).Build(),
+			})
+			g.Expect(err).To(BeNil())
+			allowed, err := ms.IsControlPlaneRebootAllowed(context.Background())
+			g.Expect(err).To(BeNil())
+			g.Expect(allowed).To(Equal(tc.expected))
+		})
+	}
+}
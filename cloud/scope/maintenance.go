@@ -0,0 +1,187 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// defaultMaintenancePollInterval is how often the maintenance events are polled when the poll interval is not set.
+	defaultMaintenancePollInterval = time.Hour
+	// rebootMigrationRequeue is how often a machine is reconciled while its instance is reboot migrated.
+	rebootMigrationRequeue = 30 * time.Second
+)
+
+// ReconcileMaintenance reports the maintenance OCI scheduled for the instance with the
+// InstanceMaintenanceScheduledCondition, marks the Machine for remediation ahead of the maintenance if the
+// maintenance event handling asks for it, and reboot migrates the instance when the OCIMachine has the
+// RebootMigrateAnnotation. It returns when the machine should be reconciled again to poll the maintenance.
+func (m *MachineScope) ReconcileMaintenance(ctx context.Context, instance *core.Instance) (time.Duration, error) {
+	if _, ok := m.OCIMachine.Annotations[infrastructurev1beta2.RebootMigrateAnnotation]; ok {
+		delete(m.OCIMachine.Annotations, infrastructurev1beta2.RebootMigrateAnnotation)
+		if err := m.rebootMigrateInstance(ctx, instance); err != nil {
+			return 0, err
+		}
+		return rebootMigrationRequeue, nil
+	}
+
+	due, maintenance, err := m.getMaintenanceDue(ctx, instance)
+	if err != nil {
+		return 0, err
+	}
+	if due == nil {
+		conditions.Delete(m.OCIMachine, infrastructurev1beta2.InstanceMaintenanceScheduledCondition)
+		return m.getMaintenancePollInterval(), nil
+	}
+	if m.IsRebootMigrating() {
+		// the maintenance is reported until the reboot migration completes
+		return rebootMigrationRequeue, nil
+	}
+
+	reason := infrastructurev1beta2.InstanceMaintenanceDueReason
+	requeueAfter := m.getMaintenancePollInterval()
+	if handling := m.OCIMachine.Spec.MaintenanceEventHandling; handling != nil && handling.RemediateBefore != nil {
+		untilRemediation := time.Until(*due) - handling.RemediateBefore.Duration
+		if untilRemediation <= 0 {
+			if err := m.requestMachineRemediation(ctx); err != nil {
+				return 0, err
+			}
+			reason = infrastructurev1beta2.MachineRemediationRequestedReason
+		} else if untilRemediation < requeueAfter {
+			requeueAfter = untilRemediation
+		}
+	}
+	conditions.Set(m.OCIMachine, &clusterv1.Condition{
+		Type:    infrastructurev1beta2.InstanceMaintenanceScheduledCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  reason,
+		Message: fmt.Sprintf("%s is due at %s", maintenance, due.UTC().Format(time.RFC3339)),
+	})
+	return requeueAfter, nil
+}
+
+// IsRebootMigrating returns true if the instance is being reboot migrated with the RebootMigrateAnnotation.
+func (m *MachineScope) IsRebootMigrating() bool {
+	return conditions.GetReason(m.OCIMachine, infrastructurev1beta2.InstanceMaintenanceScheduledCondition) ==
+		infrastructurev1beta2.InstanceRebootMigratingReason
+}
+
+func (m *MachineScope) getMaintenancePollInterval() time.Duration {
+	handling := m.OCIMachine.Spec.MaintenanceEventHandling
+	if handling == nil {
+		return 0
+	}
+	if handling.PollInterval != nil && handling.PollInterval.Duration > 0 {
+		return handling.PollInterval.Duration
+	}
+	return defaultMaintenancePollInterval
+}
+
+// getMaintenanceDue returns when the earliest maintenance of the instance is due and what it is, or nil if no
+// maintenance is scheduled. The maintenance reboot is reported by the instance, the other maintenance events are
+// only listed when the maintenance event handling is set.
+func (m *MachineScope) getMaintenanceDue(ctx context.Context, instance *core.Instance) (*time.Time, string, error) {
+	var due *time.Time
+	var maintenance string
+	if instance.TimeMaintenanceRebootDue != nil {
+		due = &instance.TimeMaintenanceRebootDue.Time
+		maintenance = "A maintenance reboot"
+	}
+	if m.OCIMachine.Spec.MaintenanceEventHandling == nil {
+		return due, maintenance, nil
+	}
+
+	var page *string
+	for {
+		resp, err := m.ComputeClient.ListInstanceMaintenanceEvents(ctx, core.ListInstanceMaintenanceEventsRequest{
+			CompartmentId: instance.CompartmentId,
+			InstanceId:    instance.Id,
+			Page:          page,
+		})
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "failed to list the maintenance events of the instance %s", *instance.Id)
+		}
+		for _, event := range resp.Items {
+			switch event.LifecycleState {
+			case core.InstanceMaintenanceEventLifecycleStateScheduled,
+				core.InstanceMaintenanceEventLifecycleStateStarted,
+				core.InstanceMaintenanceEventLifecycleStateProcessing:
+			default:
+				continue
+			}
+			if event.TimeWindowStart == nil || (due != nil && !event.TimeWindowStart.Time.Before(*due)) {
+				continue
+			}
+			due = &event.TimeWindowStart.Time
+			maintenance = fmt.Sprintf("A %s maintenance with the %s action", event.MaintenanceCategory, event.InstanceAction)
+		}
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return due, maintenance, nil
+}
+
+// requestMachineRemediation annotates the Machine so that its MachineHealthCheck replaces it, the node is drained
+// before the instance is terminated.
+func (m *MachineScope) requestMachineRemediation(ctx context.Context) error {
+	if _, ok := m.Machine.Annotations[clusterv1.RemediateMachineAnnotation]; ok {
+		return nil
+	}
+	patch := client.MergeFrom(m.Machine.DeepCopy())
+	if m.Machine.Annotations == nil {
+		m.Machine.Annotations = make(map[string]string)
+	}
+	m.Machine.Annotations[clusterv1.RemediateMachineAnnotation] = ""
+	if err := m.Client.Patch(ctx, m.Machine, patch); err != nil {
+		return errors.Wrapf(err, "failed to mark the machine %s for remediation", m.Machine.Name)
+	}
+	m.Logger.Info("Marked the machine for remediation ahead of the maintenance of the instance")
+	return nil
+}
+
+// rebootMigrateInstance moves the instance to a new host now instead of waiting for its maintenance.
+func (m *MachineScope) rebootMigrateInstance(ctx context.Context, instance *core.Instance) error {
+	m.Info("Reboot migrating instance", "InstanceID", *instance.Id)
+	_, err := m.ComputeClient.InstanceAction(ctx, core.InstanceActionRequest{
+		InstanceId:                 instance.Id,
+		Action:                     core.InstanceActionActionRebootmigrate,
+		InstancePowerActionDetails: core.RebootMigrateActionDetails{},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to reboot migrate instance")
+	}
+	conditions.Set(m.OCIMachine, &clusterv1.Condition{
+		Type:    infrastructurev1beta2.InstanceMaintenanceScheduledCondition,
+		Status:  corev1.ConditionTrue,
+		Reason:  infrastructurev1beta2.InstanceRebootMigratingReason,
+		Message: "The instance is reboot migrated",
+	})
+	return nil
+}
@@ -0,0 +1,219 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute/mock_compute"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/klogr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileMaintenance(t *testing.T) {
+	in := func(d time.Duration) *common.SDKTime {
+		return &common.SDKTime{Time: time.Now().Add(d)}
+	}
+	rebootMigrating := clusterv1.Conditions{{
+		Type:   infrastructurev1beta2.InstanceMaintenanceScheduledCondition,
+		Status: corev1.ConditionTrue,
+		Reason: infrastructurev1beta2.InstanceRebootMigratingReason,
+	}}
+
+	tests := []struct {
+		name                   string
+		annotations            map[string]string
+		handling               *infrastructurev1beta2.MaintenanceEventHandling
+		conditions             clusterv1.Conditions
+		rebootDue              *common.SDKTime
+		setup                  func(computeClient *mock_compute.MockComputeClient)
+		expectedReason         string
+		expectedMessage        string
+		expectedRequeueAfter   time.Duration
+		expectedRequeueAtLeast time.Duration
+		expectRemediation      bool
+	}{
+		{
+			name: "no maintenance",
+		},
+		{
+			name:            "maintenance reboot due",
+			rebootDue:       in(48 * time.Hour),
+			expectedReason:  infrastructurev1beta2.InstanceMaintenanceDueReason,
+			expectedMessage: "A maintenance reboot is due at",
+		},
+		{
+			name:     "maintenance events polled",
+			handling: &infrastructurev1beta2.MaintenanceEventHandling{},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().ListInstanceMaintenanceEvents(gomock.Any(), gomock.Eq(core.ListInstanceMaintenanceEventsRequest{
+					CompartmentId: common.String("compartment"),
+					InstanceId:    common.String("instance"),
+				})).Return(core.ListInstanceMaintenanceEventsResponse{
+					Items: []core.InstanceMaintenanceEventSummary{
+						{
+							LifecycleState:  core.InstanceMaintenanceEventLifecycleStateSucceeded,
+							TimeWindowStart: in(-time.Hour),
+						},
+						{
+							LifecycleState:      core.InstanceMaintenanceEventLifecycleStateScheduled,
+							MaintenanceCategory: core.InstanceMaintenanceEventMaintenanceCategoryFlexible,
+							InstanceAction:      core.InstanceMaintenanceEventInstanceActionRebootMigration,
+							TimeWindowStart:     in(48 * time.Hour),
+						},
+					},
+					OpcNextPage: common.String("next"),
+				}, nil)
+				computeClient.EXPECT().ListInstanceMaintenanceEvents(gomock.Any(), gomock.Eq(core.ListInstanceMaintenanceEventsRequest{
+					CompartmentId: common.String("compartment"),
+					InstanceId:    common.String("instance"),
+					Page:          common.String("next"),
+				})).Return(core.ListInstanceMaintenanceEventsResponse{}, nil)
+			},
+			expectedReason:       infrastructurev1beta2.InstanceMaintenanceDueReason,
+			expectedMessage:      "A FLEXIBLE maintenance with the REBOOT_MIGRATION action is due at",
+			expectedRequeueAfter: time.Hour,
+		},
+		{
+			name:     "no maintenance event scheduled",
+			handling: &infrastructurev1beta2.MaintenanceEventHandling{PollInterval: &metav1.Duration{Duration: 10 * time.Minute}},
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().ListInstanceMaintenanceEvents(gomock.Any(), gomock.Any()).
+					Return(core.ListInstanceMaintenanceEventsResponse{}, nil)
+			},
+			expectedRequeueAfter: 10 * time.Minute,
+		},
+		{
+			name:      "machine marked for remediation ahead of the maintenance",
+			handling:  &infrastructurev1beta2.MaintenanceEventHandling{RemediateBefore: &metav1.Duration{Duration: 24 * time.Hour}},
+			rebootDue: in(2 * time.Hour),
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().ListInstanceMaintenanceEvents(gomock.Any(), gomock.Any()).
+					Return(core.ListInstanceMaintenanceEventsResponse{}, nil)
+			},
+			expectedReason:       infrastructurev1beta2.MachineRemediationRequestedReason,
+			expectedMessage:      "A maintenance reboot is due at",
+			expectedRequeueAfter: time.Hour,
+			expectRemediation:    true,
+		},
+		{
+			name:      "requeue when the machine should be remediated",
+			handling:  &infrastructurev1beta2.MaintenanceEventHandling{RemediateBefore: &metav1.Duration{Duration: 24 * time.Hour}},
+			rebootDue: in(24*time.Hour + 30*time.Minute),
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().ListInstanceMaintenanceEvents(gomock.Any(), gomock.Any()).
+					Return(core.ListInstanceMaintenanceEventsResponse{}, nil)
+			},
+			expectedReason:         infrastructurev1beta2.InstanceMaintenanceDueReason,
+			expectedMessage:        "A maintenance reboot is due at",
+			expectedRequeueAtLeast: 29 * time.Minute,
+		},
+		{
+			name:        "reboot migration requested with the annotation",
+			annotations: map[string]string{infrastructurev1beta2.RebootMigrateAnnotation: ""},
+			rebootDue:   in(48 * time.Hour),
+			setup: func(computeClient *mock_compute.MockComputeClient) {
+				computeClient.EXPECT().InstanceAction(gomock.Any(), gomock.Eq(core.InstanceActionRequest{
+					InstanceId:                 common.String("instance"),
+					Action:                     core.InstanceActionActionRebootmigrate,
+					InstancePowerActionDetails: core.RebootMigrateActionDetails{},
+				})).Return(core.InstanceActionResponse{}, nil)
+			},
+			expectedReason:       infrastructurev1beta2.InstanceRebootMigratingReason,
+			expectedMessage:      "The instance is reboot migrated",
+			expectedRequeueAfter: 30 * time.Second,
+		},
+		{
+			name:                 "instance being reboot migrated",
+			conditions:           rebootMigrating,
+			rebootDue:            in(48 * time.Hour),
+			expectedReason:       infrastructurev1beta2.InstanceRebootMigratingReason,
+			expectedRequeueAfter: 30 * time.Second,
+		},
+		{
+			name:       "instance reboot migrated",
+			conditions: rebootMigrating,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			computeClient := mock_compute.NewMockComputeClient(mockCtrl)
+			if tc.setup != nil {
+				tc.setup(computeClient)
+			}
+			machine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"}}
+			log := klogr.New()
+			ms := &MachineScope{
+				Logger:  &log,
+				Client:  fake.NewClientBuilder().WithObjects(machine.DeepCopy()).Build(),
+				Machine: machine,
+				OCIMachine: &infrastructurev1beta2.OCIMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default", Annotations: tc.annotations},
+					Spec:       infrastructurev1beta2.OCIMachineSpec{MaintenanceEventHandling: tc.handling},
+					Status:     infrastructurev1beta2.OCIMachineStatus{Conditions: tc.conditions.DeepCopy()},
+				},
+				ComputeClient: computeClient,
+			}
+			instance := &core.Instance{
+				Id:                       common.String("instance"),
+				CompartmentId:            common.String("compartment"),
+				TimeMaintenanceRebootDue: tc.rebootDue,
+			}
+
+			requeueAfter, err := ms.ReconcileMaintenance(context.Background(), instance)
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expectedRequeueAtLeast > 0 {
+				g.Expect(requeueAfter).To(BeNumerically(">=", tc.expectedRequeueAtLeast))
+				g.Expect(requeueAfter).To(BeNumerically("<=", 30*time.Minute))
+			} else {
+				g.Expect(requeueAfter).To(Equal(tc.expectedRequeueAfter))
+			}
+			g.Expect(ms.OCIMachine.Annotations).NotTo(HaveKey(infrastructurev1beta2.RebootMigrateAnnotation))
+			condition := conditions.Get(ms.OCIMachine, infrastructurev1beta2.InstanceMaintenanceScheduledCondition)
+			if tc.expectedReason == "" {
+				g.Expect(condition).To(BeNil())
+			} else {
+				g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+				g.Expect(condition.Reason).To(Equal(tc.expectedReason))
+				g.Expect(condition.Message).To(HavePrefix(tc.expectedMessage))
+			}
+
+			updated := &clusterv1.Machine{}
+			g.Expect(ms.Client.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "machine"}, updated)).To(Succeed())
+			if tc.expectRemediation {
+				g.Expect(updated.Annotations).To(HaveKey(clusterv1.RemediateMachineAnnotation))
+			} else {
+				g.Expect(updated.Annotations).NotTo(HaveKey(clusterv1.RemediateMachineAnnotation))
+			}
+		})
+	}
+}
@@ -39,7 +39,6 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	capierrors "sigs.k8s.io/cluster-api/errors"
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
-	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -588,19 +587,34 @@ func (m *ManagedMachinePoolScope) getWorkerMachineSubnet(name *string) *string {
 	return nil
 }
 
-// UpdateNodePool updates a node pool, if needed, based on updated spec
+// UpdateNodePool updates a node pool, if needed, based on updated spec. The node pool is scaled to zero while
+// the cluster is hibernated.
 func (m *ManagedMachinePoolScope) UpdateNodePool(ctx context.Context, pool *oke.NodePool) (bool, error) {
 	spec := m.OCIManagedMachinePool.Spec.DeepCopy()
 	setMachinePoolSpecDefaults(spec)
+	m.recordHibernation(pool)
 	nodePoolSizeUpdateRequired := false
 	// if replicas is not managed by cluster autoscaler and if the number of nodes in the spec is not equal to number set in the node pool
 	// update the node pool
-	if !annotations.ReplicasManagedByExternalAutoscaler(m.MachinePool) && (*m.MachinePool.Spec.Replicas != int32(*pool.NodeConfigDetails.Size)) {
+	nodePoolSize, sizeManaged := m.nodePoolSize()
+	if sizeManaged && nodePoolSize != *pool.NodeConfigDetails.Size {
 		nodePoolSizeUpdateRequired = true
 	}
 	actual := m.getSpecFromAPIObject(pool)
+	// tags which are not set by Cluster API, for example tags added by tag defaults, are preserved
+	applied := m.OCIManagedMachinePool.Status.AppliedTags
+	freeformTags, freeformTagsChanged := ociutil.MergeFreeformTags(pool.FreeformTags, m.getFreeFormTags(), appliedFreeformTags(applied))
+	definedTags, definedTagsChanged := ociutil.MergeDefinedTags(pool.DefinedTags, m.getDefinedTags(), appliedDefinedTags(applied))
+	var nodeFreeformTags map[string]string
+	var nodeDefinedTags map[string]map[string]interface{}
+	nodeFreeformTagsChanged, nodeDefinedTagsChanged := false, false
+	if pool.NodeConfigDetails != nil {
+		nodeFreeformTags, nodeFreeformTagsChanged = ociutil.MergeFreeformTags(pool.NodeConfigDetails.FreeformTags, m.getFreeFormTags(), appliedFreeformTags(applied))
+		nodeDefinedTags, nodeDefinedTagsChanged = ociutil.MergeDefinedTags(pool.NodeConfigDetails.DefinedTags, m.getDefinedTags(), appliedDefinedTags(applied))
+	}
+	tagsChanged := freeformTagsChanged || definedTagsChanged || nodeFreeformTagsChanged || nodeDefinedTagsChanged
 	if !reflect.DeepEqual(spec, actual) ||
-		m.getNodePoolName() != *pool.Name || nodePoolSizeUpdateRequired {
+		m.getNodePoolName() != *pool.Name || nodePoolSizeUpdateRequired || tagsChanged {
 		m.Logger.Info("Updating node pool")
 		// printing json specs will help debug problems when there are spurious/unwanted updates
 		jsonSpec, err := json.Marshal(*spec)
@@ -629,7 +643,7 @@ func (m *ManagedMachinePoolScope) UpdateNodePool(ctx context.Context, pool *oke.
 			nodeConfigDetails.PlacementConfigs = placementConfig
 		}
 		if nodePoolSizeUpdateRequired {
-			nodeConfigDetails.Size = common.Int(int(*m.MachinePool.Spec.Replicas))
+			nodeConfigDetails.Size = common.Int(nodePoolSize)
 		}
 		nodeShapeConfig := oke.UpdateNodeShapeConfigDetails{}
 		if spec.NodeShapeConfig != nil {
@@ -708,6 +722,14 @@ func (m *ManagedMachinePoolScope) UpdateNodePool(ctx context.Context, pool *oke.
 				IsForceDeleteAfterGraceDuration: spec.NodeEvictionNodePoolSettings.IsForceDeleteAfterGraceDuration,
 			}
 		}
+		if freeformTagsChanged || definedTagsChanged {
+			nodePoolDetails.FreeformTags = freeformTags
+			nodePoolDetails.DefinedTags = definedTags
+		}
+		if nodeFreeformTagsChanged || nodeDefinedTagsChanged {
+			nodeConfigDetails.FreeformTags = nodeFreeformTags
+			nodeConfigDetails.DefinedTags = nodeDefinedTags
+		}
 		nodePoolDetails.InitialNodeLabels = m.getInitialNodeKeyValuePairs()
 		req := oke.UpdateNodePoolRequest{
 			NodePoolId:            pool.Id,
@@ -719,10 +741,18 @@ func (m *ManagedMachinePoolScope) UpdateNodePool(ctx context.Context, pool *oke.
 		}
 
 		m.Info("Updated node pool")
+		if !IsHibernated(m.Cluster) {
+			m.OCIManagedMachinePool.Status.HibernatedReplicas = nil
+		}
+		m.OCIManagedMachinePool.Status.AppliedTags = NewAppliedTags(m.getFreeFormTags(), m.OCIManagedCluster.Spec.DefinedTags)
 		return true, nil
 	} else {
 		m.Info("No reconciliation needed for node pool")
 	}
+	if !IsHibernated(m.Cluster) {
+		m.OCIManagedMachinePool.Status.HibernatedReplicas = nil
+	}
+	m.OCIManagedMachinePool.Status.AppliedTags = NewAppliedTags(m.getFreeFormTags(), m.OCIManagedCluster.Spec.DefinedTags)
 	return false, nil
 }
 
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	plan "github.com/oracle/cluster-api-provider-oci/cloud/plan"
 	scope "github.com/oracle/cluster-api-provider-oci/cloud/scope"
 )
 
@@ -105,6 +106,20 @@ func (mr *MockClusterScopeClientMockRecorder) DeleteDRGVCNAttachment(arg0 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDRGVCNAttachment", reflect.TypeOf((*MockClusterScopeClient)(nil).DeleteDRGVCNAttachment), arg0)
 }
 
+// DeleteFlowLogs mocks base method.
+func (m *MockClusterScopeClient) DeleteFlowLogs(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlowLogs", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFlowLogs indicates an expected call of DeleteFlowLogs.
+func (mr *MockClusterScopeClientMockRecorder) DeleteFlowLogs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlowLogs", reflect.TypeOf((*MockClusterScopeClient)(nil).DeleteFlowLogs), arg0)
+}
+
 // DeleteInternetGateway mocks base method.
 func (m *MockClusterScopeClient) DeleteInternetGateway(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -217,6 +232,34 @@ func (mr *MockClusterScopeClientMockRecorder) DeleteVCN(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVCN", reflect.TypeOf((*MockClusterScopeClient)(nil).DeleteVCN), arg0)
 }
 
+// DeleteVPN mocks base method.
+func (m *MockClusterScopeClient) DeleteVPN(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVPN", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVPN indicates an expected call of DeleteVPN.
+func (mr *MockClusterScopeClientMockRecorder) DeleteVPN(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPN", reflect.TypeOf((*MockClusterScopeClient)(nil).DeleteVPN), arg0)
+}
+
+// DeleteWorkloadResources mocks base method.
+func (m *MockClusterScopeClient) DeleteWorkloadResources(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkloadResources", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkloadResources indicates an expected call of DeleteWorkloadResources.
+func (mr *MockClusterScopeClientMockRecorder) DeleteWorkloadResources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkloadResources", reflect.TypeOf((*MockClusterScopeClient)(nil).DeleteWorkloadResources), arg0)
+}
+
 // GetOCIClusterAccessor mocks base method.
 func (m *MockClusterScopeClient) GetOCIClusterAccessor() scope.OCIClusterAccessor {
 	m.ctrl.T.Helper()
@@ -231,6 +274,49 @@ func (mr *MockClusterScopeClientMockRecorder) GetOCIClusterAccessor() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOCIClusterAccessor", reflect.TypeOf((*MockClusterScopeClient)(nil).GetOCIClusterAccessor))
 }
 
+// GetPlanRecorder mocks base method.
+func (m *MockClusterScopeClient) GetPlanRecorder() *plan.Recorder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlanRecorder")
+	ret0, _ := ret[0].(*plan.Recorder)
+	return ret0
+}
+
+// GetPlanRecorder indicates an expected call of GetPlanRecorder.
+func (mr *MockClusterScopeClientMockRecorder) GetPlanRecorder() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlanRecorder", reflect.TypeOf((*MockClusterScopeClient)(nil).GetPlanRecorder))
+}
+
+// IsAPIServerLBHealthy mocks base method.
+func (m *MockClusterScopeClient) IsAPIServerLBHealthy(arg0 context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAPIServerLBHealthy", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAPIServerLBHealthy indicates an expected call of IsAPIServerLBHealthy.
+func (mr *MockClusterScopeClientMockRecorder) IsAPIServerLBHealthy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAPIServerLBHealthy", reflect.TypeOf((*MockClusterScopeClient)(nil).IsAPIServerLBHealthy), arg0)
+}
+
+// IsHibernated mocks base method.
+func (m *MockClusterScopeClient) IsHibernated() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsHibernated")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsHibernated indicates an expected call of IsHibernated.
+func (mr *MockClusterScopeClientMockRecorder) IsHibernated() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHibernated", reflect.TypeOf((*MockClusterScopeClient)(nil).IsHibernated))
+}
+
 // ReconcileApiServerLB mocks base method.
 func (m *MockClusterScopeClient) ReconcileApiServerLB(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -315,6 +401,20 @@ func (mr *MockClusterScopeClientMockRecorder) ReconcileFailureDomains(arg0 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileFailureDomains", reflect.TypeOf((*MockClusterScopeClient)(nil).ReconcileFailureDomains), arg0)
 }
 
+// ReconcileFlowLogs mocks base method.
+func (m *MockClusterScopeClient) ReconcileFlowLogs(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileFlowLogs", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileFlowLogs indicates an expected call of ReconcileFlowLogs.
+func (mr *MockClusterScopeClientMockRecorder) ReconcileFlowLogs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileFlowLogs", reflect.TypeOf((*MockClusterScopeClient)(nil).ReconcileFlowLogs), arg0)
+}
+
 // ReconcileInternetGateway mocks base method.
 func (m *MockClusterScopeClient) ReconcileInternetGateway(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -413,6 +513,34 @@ func (mr *MockClusterScopeClientMockRecorder) ReconcileVCN(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileVCN", reflect.TypeOf((*MockClusterScopeClient)(nil).ReconcileVCN), arg0)
 }
 
+// ReconcileVPN mocks base method.
+func (m *MockClusterScopeClient) ReconcileVPN(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileVPN", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileVPN indicates an expected call of ReconcileVPN.
+func (mr *MockClusterScopeClientMockRecorder) ReconcileVPN(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileVPN", reflect.TypeOf((*MockClusterScopeClient)(nil).ReconcileVPN), arg0)
+}
+
+// ReleaseSharedNetwork mocks base method.
+func (m *MockClusterScopeClient) ReleaseSharedNetwork(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSharedNetwork", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseSharedNetwork indicates an expected call of ReleaseSharedNetwork.
+func (mr *MockClusterScopeClientMockRecorder) ReleaseSharedNetwork(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSharedNetwork", reflect.TypeOf((*MockClusterScopeClient)(nil).ReleaseSharedNetwork), arg0)
+}
+
 // SetRegionKey mocks base method.
 func (m *MockClusterScopeClient) SetRegionKey(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -19,6 +19,7 @@ package scope
 import (
 	"context"
 
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
@@ -42,11 +43,39 @@ func (s *ClusterScope) ReconcileNatGateway(ctx context.Context) error {
 	}
 	if ngw != nil {
 		s.OCIClusterAccessor.GetNetworkSpec().Vcn.NATGateway.Id = ngw.Id
-		s.Logger.Info("No Reconciliation Required for Nat Gateway", "nat_gateway", ngw.Id)
-		return nil
+		s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+			status.NATGateway = newNetworkResourceStatus(ngw.Id, string(ngw.LifecycleState))
+		})
+		if err := s.reconcileNatGatewayTags(ctx, ngw); err != nil {
+			return err
+		}
+		var drift driftDiff
+		if ngw.BlockTraffic != nil && *ngw.BlockTraffic {
+			drift.compare("blockTraffic", "false", common.String("true"))
+		}
+		if len(drift) == 0 {
+			s.Logger.Info("No Reconciliation Required for Nat Gateway", "nat_gateway", ngw.Id)
+			return nil
+		}
+		return s.reconcileDrift(DriftResourceNATGateway, NatGatewayName, ngw.Id, drift, func() error {
+			_, err := s.VCNClient.UpdateNatGateway(ctx, core.UpdateNatGatewayRequest{
+				NatGatewayId: ngw.Id,
+				UpdateNatGatewayDetails: core.UpdateNatGatewayDetails{
+					BlockTraffic: common.Bool(false),
+				},
+			})
+			if err != nil {
+				s.Logger.Error(err, "failed to reconcile the nat gateway, failed to update")
+				return errors.Wrap(err, "failed to reconcile the nat gateway, failed to update")
+			}
+			return nil
+		})
 	}
 	natGateway, err := s.CreateNatGateway(ctx)
 	s.OCIClusterAccessor.GetNetworkSpec().Vcn.NATGateway.Id = natGateway
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		status.NATGateway = newNetworkResourceStatus(natGateway, "")
+	})
 	return err
 }
 
@@ -65,14 +94,14 @@ func (s *ClusterScope) GetNatGateway(ctx context.Context) (*core.NatGateway, err
 			return nil, err
 		}
 		ngw := resp.NatGateway
-		if s.IsResourceCreatedByClusterAPI(ngw.FreeformTags) {
+		if s.IsNetworkResourceCreatedByClusterAPI(ngw.FreeformTags) {
 			return &ngw, err
 		} else {
 			return nil, errors.New("cluster api tags have been modified out of context")
 		}
 	}
 	ngws, err := s.VCNClient.ListNatGateways(ctx, core.ListNatGatewaysRequest{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNetworkCompartmentId()),
 		VcnId:         s.getVcnId(),
 		DisplayName:   common.String(NatGatewayName),
 	})
@@ -81,7 +110,7 @@ func (s *ClusterScope) GetNatGateway(ctx context.Context) (*core.NatGateway, err
 		return nil, errors.Wrap(err, "failed to list NAT gateways")
 	}
 	for _, ngw := range ngws.Items {
-		if s.IsResourceCreatedByClusterAPI(ngw.FreeformTags) {
+		if s.IsNetworkResourceCreatedByClusterAPI(ngw.FreeformTags) {
 			return &ngw, nil
 		}
 	}
@@ -91,7 +120,7 @@ func (s *ClusterScope) GetNatGateway(ctx context.Context) (*core.NatGateway, err
 // UpdateNatGateway updates the FreeFormTags and DefinedTags
 func (s *ClusterScope) UpdateNatGateway(ctx context.Context) error {
 	updateNGWDetails := core.UpdateNatGatewayDetails{
-		FreeformTags: s.GetFreeFormTags(),
+		FreeformTags: s.GetNetworkFreeFormTags(),
 		DefinedTags:  s.GetDefinedTags(),
 	}
 	igwResponse, err := s.VCNClient.UpdateNatGateway(ctx, core.UpdateNatGatewayRequest{
@@ -109,10 +138,10 @@ func (s *ClusterScope) UpdateNatGateway(ctx context.Context) error {
 // CreateNatGateway creates the NAT Gateway for the cluster based on the ClusterScope
 func (s *ClusterScope) CreateNatGateway(ctx context.Context) (*string, error) {
 	ngwDetails := core.CreateNatGatewayDetails{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNetworkCompartmentId()),
 		DisplayName:   common.String(NatGatewayName),
 		VcnId:         s.getVcnId(),
-		FreeformTags:  s.GetFreeFormTags(),
+		FreeformTags:  s.GetNetworkFreeFormTags(),
 		DefinedTags:   s.GetDefinedTags(),
 	}
 	ngwResponse, err := s.VCNClient.CreateNatGateway(ctx, core.CreateNatGatewayRequest{
@@ -132,6 +161,14 @@ func (s *ClusterScope) DeleteNatGateway(ctx context.Context) error {
 		s.Logger.Info("Skipping NAT Gateway reconciliation as per spec")
 		return nil
 	}
+	inUse, err := s.IsSharedNetworkInUse(ctx)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		s.Logger.Info("Shared VCN is used by other clusters, skipping deletion of NAT Gateway")
+		return nil
+	}
 	ngw, err := s.GetNatGateway(ctx)
 	if err != nil && !ociutil.IsNotFound(err) {
 		return err
@@ -140,6 +177,9 @@ func (s *ClusterScope) DeleteNatGateway(ctx context.Context) error {
 		s.Logger.Info("NAT Gateway is already deleted")
 		return nil
 	}
+	if s.isVCNRetained() {
+		return s.retainNatGateway(ctx, ngw)
+	}
 	_, err = s.VCNClient.DeleteNatGateway(ctx, core.DeleteNatGatewayRequest{
 		NatGatewayId: ngw.Id,
 	})
@@ -77,6 +77,15 @@ func TestClusterScope_ReconcileNatGateway(t *testing.T) {
 		updatedTags[k] = v
 	}
 	updatedTags["foo"] = "bar"
+	vcnClient.EXPECT().UpdateNatGateway(gomock.Any(), gomock.Eq(core.UpdateNatGatewayRequest{
+		NatGatewayId: common.String("foo"),
+		UpdateNatGatewayDetails: core.UpdateNatGatewayDetails{
+			FreeformTags: updatedTags,
+			DefinedTags:  definedTagsInterface,
+		},
+	})).
+		Return(core.UpdateNatGatewayResponse{}, nil)
+
 	vcnClient.EXPECT().ListNatGateways(gomock.Any(), gomock.Eq(core.ListNatGatewaysRequest{
 		CompartmentId: common.String("foo"),
 		DisplayName:   common.String("nat-gateway"),
@@ -86,7 +95,8 @@ func TestClusterScope_ReconcileNatGateway(t *testing.T) {
 			Items: []core.NatGateway{
 				{
 					Id:           common.String("ngw_id"),
-					FreeformTags: tags,
+					FreeformTags: updatedTags,
+					DefinedTags:  definedTagsInterface,
 				},
 			}}, nil)
 
@@ -169,7 +179,7 @@ func TestClusterScope_ReconcileNatGateway(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "no update needed",
+			name: "tags updated",
 			spec: infrastructurev1beta2.OCIClusterSpec{
 				DefinedTags: definedTags,
 				FreeformTags: map[string]string{
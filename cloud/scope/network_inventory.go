@@ -0,0 +1,180 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+)
+
+// updateNetworkStatus applies update to the inventory of the network resources in the status of the cluster.
+// The network resources are reconciled concurrently, hence the updates are serialized.
+func (s *ClusterScope) updateNetworkStatus(update func(status *infrastructurev1beta2.NetworkStatus)) {
+	s.networkStatusLock.Lock()
+	defer s.networkStatusLock.Unlock()
+	update(s.OCIClusterAccessor.GetNetworkStatus())
+}
+
+// newNetworkResourceStatus returns the status of a network resource, the lifecycle state is empty for a
+// resource which has just been created.
+func newNetworkResourceStatus(id *string, lifecycleState string) *infrastructurev1beta2.NetworkResourceStatus {
+	return &infrastructurev1beta2.NetworkResourceStatus{
+		ID:             id,
+		LifecycleState: lifecycleState,
+	}
+}
+
+// setVCNStatus records the VCN in the inventory.
+func (s *ClusterScope) setVCNStatus(id *string, lifecycleState string, cidrs []string) {
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		status.VCN = &infrastructurev1beta2.VCNStatus{
+			NetworkResourceStatus: *newNetworkResourceStatus(id, lifecycleState),
+			CIDRs:                 cidrs,
+		}
+	})
+}
+
+// setSubnetStatus adds the subnet to the inventory, or replaces the subnet with the same name.
+func (s *ClusterScope) setSubnetStatus(subnet infrastructurev1beta2.Subnet, securityListID *string, cidr string, lifecycleState string) {
+	subnetStatus := infrastructurev1beta2.SubnetStatus{
+		NetworkResourceStatus: *newNetworkResourceStatus(subnet.ID, lifecycleState),
+		Name:                  subnet.Name,
+		Role:                  subnet.Role,
+		CIDR:                  cidr,
+		SecurityListID:        securityListID,
+	}
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		for i := range status.Subnets {
+			if status.Subnets[i].Name == subnet.Name {
+				status.Subnets[i] = subnetStatus
+				return
+			}
+		}
+		status.Subnets = append(status.Subnets, subnetStatus)
+	})
+}
+
+// setNSGStatus adds the network security group to the inventory, or replaces the network security group with
+// the same name.
+func (s *ClusterScope) setNSGStatus(nsg infrastructurev1beta2.NSG, lifecycleState string) {
+	nsgStatus := infrastructurev1beta2.NSGStatus{
+		NetworkResourceStatus: *newNetworkResourceStatus(nsg.ID, lifecycleState),
+		Name:                  nsg.Name,
+		Role:                  nsg.Role,
+	}
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		for i := range status.NetworkSecurityGroups {
+			if status.NetworkSecurityGroups[i].Name == nsg.Name {
+				status.NetworkSecurityGroups[i] = nsgStatus
+				return
+			}
+		}
+		status.NetworkSecurityGroups = append(status.NetworkSecurityGroups, nsgStatus)
+	})
+}
+
+// setFlowLogStatus adds the flow log to the inventory, or replaces the flow log of the same subnet in the same log
+// group.
+func (s *ClusterScope) setFlowLogStatus(flowLogStatus infrastructurev1beta2.FlowLogStatus) {
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		for i := range status.FlowLogs {
+			if status.FlowLogs[i].SubnetName == flowLogStatus.SubnetName &&
+				status.FlowLogs[i].LogGroupId == flowLogStatus.LogGroupId {
+				status.FlowLogs[i] = flowLogStatus
+				return
+			}
+		}
+		status.FlowLogs = append(status.FlowLogs, flowLogStatus)
+	})
+}
+
+// removeFlowLogStatus removes the flow log of the subnet in the log group from the inventory.
+func (s *ClusterScope) removeFlowLogStatus(subnetName string, logGroupId string) {
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		for i := range status.FlowLogs {
+			if status.FlowLogs[i].SubnetName == subnetName && status.FlowLogs[i].LogGroupId == logGroupId {
+				status.FlowLogs = append(status.FlowLogs[:i], status.FlowLogs[i+1:]...)
+				return
+			}
+		}
+	})
+}
+
+// getFlowLogStatuses returns a copy of the flow logs of the inventory.
+func (s *ClusterScope) getFlowLogStatuses() []infrastructurev1beta2.FlowLogStatus {
+	var flowLogs []infrastructurev1beta2.FlowLogStatus
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		flowLogs = append(flowLogs, status.FlowLogs...)
+	})
+	return flowLogs
+}
+
+// LoadNetworkInventory sets the OCIDs of the network resources which are not set in the spec from the inventory
+// in the status of the cluster. The OCIDs are only meant to be set in memory, so that the reconcilers and the
+// lookups find the resources of the cluster without the OCIDs being persisted in the spec. The OCIDs set in the
+// spec, either by the user or by earlier versions of the controller, take precedence over the inventory.
+func LoadNetworkInventory(spec *infrastructurev1beta2.NetworkSpec, status *infrastructurev1beta2.NetworkStatus) {
+	if status == nil {
+		return
+	}
+	vcn := &spec.Vcn
+	if status.VCN != nil {
+		vcn.ID = loadID(vcn.ID, &status.VCN.NetworkResourceStatus)
+	}
+	vcn.InternetGateway.Id = loadID(vcn.InternetGateway.Id, status.InternetGateway)
+	vcn.NATGateway.Id = loadID(vcn.NATGateway.Id, status.NATGateway)
+	vcn.ServiceGateway.Id = loadID(vcn.ServiceGateway.Id, status.ServiceGateway)
+	vcn.RouteTable.PrivateRouteTableId = loadID(vcn.RouteTable.PrivateRouteTableId, status.PrivateRouteTable)
+	vcn.RouteTable.PublicRouteTableId = loadID(vcn.RouteTable.PublicRouteTableId, status.PublicRouteTable)
+	for _, subnet := range vcn.Subnets {
+		for _, subnetStatus := range status.Subnets {
+			if subnet == nil || subnet.Name != subnetStatus.Name {
+				continue
+			}
+			subnet.ID = loadID(subnet.ID, &subnetStatus.NetworkResourceStatus)
+			if subnet.SecurityList != nil && subnet.SecurityList.ID == nil {
+				subnet.SecurityList.ID = subnetStatus.SecurityListID
+			}
+		}
+	}
+	for _, nsg := range vcn.NetworkSecurityGroup.List {
+		for _, nsgStatus := range status.NetworkSecurityGroups {
+			if nsg == nil || nsg.Name != nsgStatus.Name {
+				continue
+			}
+			nsg.ID = loadID(nsg.ID, &nsgStatus.NetworkResourceStatus)
+		}
+	}
+	if spec.VCNPeering != nil && spec.VCNPeering.DRG != nil {
+		drg := spec.VCNPeering.DRG
+		drg.ID = loadID(drg.ID, status.DRG)
+		drg.VcnAttachmentId = loadID(drg.VcnAttachmentId, status.DRGVCNAttachment)
+	}
+	if spec.VCNPeering != nil && spec.VCNPeering.VPN != nil {
+		vpn := spec.VCNPeering.VPN
+		vpn.ID = loadID(vpn.ID, status.IPSecConnection)
+		vpn.CPE.ID = loadID(vpn.CPE.ID, status.CPE)
+	}
+	spec.APIServerLB.LoadBalancerId = loadID(spec.APIServerLB.LoadBalancerId, status.APIServerLB)
+}
+
+// loadID returns the OCID of the spec if it is set, otherwise the OCID of the inventory.
+func loadID(specID *string, status *infrastructurev1beta2.NetworkResourceStatus) *string {
+	if specID != nil || status == nil {
+		return specID
+	}
+	return status.ID
+}
@@ -0,0 +1,236 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn/mock_vcn"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestLoadNetworkInventory(t *testing.T) {
+	status := &infrastructurev1beta2.NetworkStatus{
+		VCN: &infrastructurev1beta2.VCNStatus{
+			NetworkResourceStatus: infrastructurev1beta2.NetworkResourceStatus{ID: common.String("vcn")},
+		},
+		InternetGateway:   &infrastructurev1beta2.NetworkResourceStatus{ID: common.String("igw")},
+		NATGateway:        &infrastructurev1beta2.NetworkResourceStatus{ID: common.String("ngw")},
+		ServiceGateway:    &infrastructurev1beta2.NetworkResourceStatus{ID: common.String("sgw")},
+		PrivateRouteTable: &infrastructurev1beta2.NetworkResourceStatus{ID: common.String("private-rt")},
+		PublicRouteTable:  &infrastructurev1beta2.NetworkResourceStatus{ID: common.String("public-rt")},
+		Subnets: []infrastructurev1beta2.SubnetStatus{
+			{
+				NetworkResourceStatus: infrastructurev1beta2.NetworkResourceStatus{ID: common.String("subnet")},
+				Name:                  "worker",
+				SecurityListID:        common.String("seclist"),
+			},
+		},
+		NetworkSecurityGroups: []infrastructurev1beta2.NSGStatus{
+			{
+				NetworkResourceStatus: infrastructurev1beta2.NetworkResourceStatus{ID: common.String("nsg")},
+				Name:                  "worker",
+			},
+		},
+		DRG:              &infrastructurev1beta2.NetworkResourceStatus{ID: common.String("drg")},
+		DRGVCNAttachment: &infrastructurev1beta2.NetworkResourceStatus{ID: common.String("attachment")},
+		CPE:              &infrastructurev1beta2.NetworkResourceStatus{ID: common.String("cpe")},
+		IPSecConnection:  &infrastructurev1beta2.NetworkResourceStatus{ID: common.String("ipsec")},
+		APIServerLB:      &infrastructurev1beta2.NetworkResourceStatus{ID: common.String("lb")},
+	}
+	tests := []struct {
+		name     string
+		spec     infrastructurev1beta2.NetworkSpec
+		status   *infrastructurev1beta2.NetworkStatus
+		expected infrastructurev1beta2.NetworkSpec
+	}{
+		{
+			name: "no inventory",
+			spec: infrastructurev1beta2.NetworkSpec{
+				Vcn: infrastructurev1beta2.VCN{Name: "vcn"},
+			},
+			expected: infrastructurev1beta2.NetworkSpec{
+				Vcn: infrastructurev1beta2.VCN{Name: "vcn"},
+			},
+		},
+		{
+			name: "ids loaded from inventory",
+			spec: infrastructurev1beta2.NetworkSpec{
+				Vcn: infrastructurev1beta2.VCN{
+					Subnets: []*infrastructurev1beta2.Subnet{
+						{Name: "worker", SecurityList: &infrastructurev1beta2.SecurityList{Name: "worker"}},
+						{Name: "control-plane"},
+					},
+					NetworkSecurityGroup: infrastructurev1beta2.NetworkSecurityGroup{
+						List: []*infrastructurev1beta2.NSG{{Name: "worker"}},
+					},
+				},
+				VCNPeering: &infrastructurev1beta2.VCNPeering{
+					DRG: &infrastructurev1beta2.DRG{Manage: true},
+					VPN: &infrastructurev1beta2.VPN{},
+				},
+			},
+			status: status,
+			expected: infrastructurev1beta2.NetworkSpec{
+				Vcn: infrastructurev1beta2.VCN{
+					ID:              common.String("vcn"),
+					InternetGateway: infrastructurev1beta2.InternetGateway{Id: common.String("igw")},
+					NATGateway:      infrastructurev1beta2.NATGateway{Id: common.String("ngw")},
+					ServiceGateway:  infrastructurev1beta2.ServiceGateway{Id: common.String("sgw")},
+					RouteTable: infrastructurev1beta2.RouteTable{
+						PrivateRouteTableId: common.String("private-rt"),
+						PublicRouteTableId:  common.String("public-rt"),
+					},
+					Subnets: []*infrastructurev1beta2.Subnet{
+						{
+							ID:           common.String("subnet"),
+							Name:         "worker",
+							SecurityList: &infrastructurev1beta2.SecurityList{ID: common.String("seclist"), Name: "worker"},
+						},
+						{Name: "control-plane"},
+					},
+					NetworkSecurityGroup: infrastructurev1beta2.NetworkSecurityGroup{
+						List: []*infrastructurev1beta2.NSG{{ID: common.String("nsg"), Name: "worker"}},
+					},
+				},
+				VCNPeering: &infrastructurev1beta2.VCNPeering{
+					DRG: &infrastructurev1beta2.DRG{Manage: true, ID: common.String("drg"), VcnAttachmentId: common.String("attachment")},
+					VPN: &infrastructurev1beta2.VPN{ID: common.String("ipsec"), CPE: infrastructurev1beta2.CPE{ID: common.String("cpe")}},
+				},
+				APIServerLB: infrastructurev1beta2.LoadBalancer{LoadBalancerId: common.String("lb")},
+			},
+		},
+		{
+			name: "ids of the spec take precedence",
+			spec: infrastructurev1beta2.NetworkSpec{
+				Vcn: infrastructurev1beta2.VCN{
+					ID: common.String("byo-vcn"),
+					Subnets: []*infrastructurev1beta2.Subnet{
+						{ID: common.String("byo-subnet"), Name: "worker"},
+					},
+				},
+				APIServerLB: infrastructurev1beta2.LoadBalancer{LoadBalancerId: common.String("byo-lb")},
+			},
+			status: status,
+			expected: infrastructurev1beta2.NetworkSpec{
+				Vcn: infrastructurev1beta2.VCN{
+					ID:              common.String("byo-vcn"),
+					InternetGateway: infrastructurev1beta2.InternetGateway{Id: common.String("igw")},
+					NATGateway:      infrastructurev1beta2.NATGateway{Id: common.String("ngw")},
+					ServiceGateway:  infrastructurev1beta2.ServiceGateway{Id: common.String("sgw")},
+					RouteTable: infrastructurev1beta2.RouteTable{
+						PrivateRouteTableId: common.String("private-rt"),
+						PublicRouteTableId:  common.String("public-rt"),
+					},
+					Subnets: []*infrastructurev1beta2.Subnet{
+						{ID: common.String("byo-subnet"), Name: "worker"},
+					},
+				},
+				APIServerLB: infrastructurev1beta2.LoadBalancer{LoadBalancerId: common.String("byo-lb")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			LoadNetworkInventory(&tt.spec, tt.status)
+			if !reflect.DeepEqual(tt.spec, tt.expected) {
+				t.Errorf("LoadNetworkInventory() = %+v, expected %+v", tt.spec, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClusterScope_NetworkInventory(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	vcnClient := mock_vcn.NewMockClient(mockCtrl)
+	vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{
+		VcnId: common.String("vcn"),
+	})).
+		Return(core.GetVcnResponse{
+			Vcn: core.Vcn{
+				Id:          common.String("vcn"),
+				DisplayName: common.String("foo"),
+				CidrBlocks:  []string{"10.0.0.0/16"},
+				FreeformTags: map[string]string{
+					ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+					ociutil.ClusterResourceIdentifier: "resource_uid",
+				},
+				LifecycleState: core.VcnLifecycleStateAvailable,
+			},
+		}, nil)
+
+	l := log.FromContext(context.Background())
+	ociCluster := &infrastructurev1beta2.OCICluster{
+		Spec: infrastructurev1beta2.OCIClusterSpec{
+			OCIResourceIdentifier: "resource_uid",
+			NetworkSpec: infrastructurev1beta2.NetworkSpec{
+				Vcn: infrastructurev1beta2.VCN{
+					ID:   common.String("vcn"),
+					Name: "foo",
+				},
+			},
+		},
+	}
+	s := &ClusterScope{
+		VCNClient:          vcnClient,
+		OCIClusterAccessor: OCISelfManagedCluster{ociCluster},
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				UID: "cluster_uid",
+			},
+		},
+		Logger: &l,
+	}
+	if err := s.ReconcileVCN(context.Background()); err != nil {
+		t.Fatalf("ReconcileVCN() error = %v", err)
+	}
+	s.setSubnetStatus(infrastructurev1beta2.Subnet{ID: common.String("subnet"), Name: "worker"}, nil, "10.0.64.0/20", "PROVISIONING")
+	s.setSubnetStatus(infrastructurev1beta2.Subnet{ID: common.String("subnet"), Name: "worker"}, nil, "10.0.64.0/20", "AVAILABLE")
+
+	expected := &infrastructurev1beta2.NetworkStatus{
+		VCN: &infrastructurev1beta2.VCNStatus{
+			NetworkResourceStatus: infrastructurev1beta2.NetworkResourceStatus{
+				ID:             common.String("vcn"),
+				LifecycleState: "AVAILABLE",
+			},
+			CIDRs: []string{"10.0.0.0/16"},
+		},
+		Subnets: []infrastructurev1beta2.SubnetStatus{
+			{
+				NetworkResourceStatus: infrastructurev1beta2.NetworkResourceStatus{
+					ID:             common.String("subnet"),
+					LifecycleState: "AVAILABLE",
+				},
+				Name: "worker",
+				CIDR: "10.0.64.0/20",
+			},
+		},
+	}
+	if !reflect.DeepEqual(ociCluster.Status.Network, expected) {
+		t.Errorf("network status = %+v, expected %+v", ociCluster.Status.Network, expected)
+	}
+}
@@ -45,16 +45,24 @@ func (s *ClusterScope) ReconcileApiServerNLB(ctx context.Context) error {
 		}
 		networkSpec := s.OCIClusterAccessor.GetNetworkSpec()
 		networkSpec.APIServerLB.LoadBalancerId = nlb.Id
+		s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+			status.APIServerLB = newNetworkResourceStatus(nlb.Id, string(nlb.LifecycleState))
+		})
 		s.OCIClusterAccessor.SetControlPlaneEndpoint(clusterv1.APIEndpoint{
 			Host: *lbIP,
 			Port: s.APIServerPort(),
 		})
+		if err := s.reconcileApiServerNLBTags(ctx, nlb); err != nil {
+			return err
+		}
 		if s.IsNLBEqual(nlb, desiredApiServerNLB) {
 			s.Logger.Info("No Reconciliation Required for ApiServerLB", "nlb", nlb.Id)
 			return nil
 		}
 		s.Logger.Info("Reconciliation Required for ApiServerLB", "nlb", nlb.Id)
-		return s.UpdateNLB(ctx, desiredApiServerNLB)
+		return s.reconcileDrift(DriftResourceNetworkLoadBalancer, desiredApiServerNLB.Name, nlb.Id, s.nlbDrift(nlb, desiredApiServerNLB), func() error {
+			return s.UpdateNLB(ctx, desiredApiServerNLB)
+		})
 	}
 	nlbID, nlbIP, err := s.CreateNLB(ctx, desiredApiServerNLB)
 	if err != nil {
@@ -62,6 +70,9 @@ func (s *ClusterScope) ReconcileApiServerNLB(ctx context.Context) error {
 	}
 	networkSpec := s.OCIClusterAccessor.GetNetworkSpec()
 	networkSpec.APIServerLB.LoadBalancerId = nlbID
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		status.APIServerLB = newNetworkResourceStatus(nlbID, "")
+	})
 	s.OCIClusterAccessor.SetControlPlaneEndpoint(clusterv1.APIEndpoint{
 		Host: *nlbIP,
 		Port: s.APIServerPort(),
@@ -80,6 +91,9 @@ func (s *ClusterScope) DeleteApiServerNLB(ctx context.Context) error {
 		s.Logger.Info("network loadbalancer is already deleted")
 		return nil
 	}
+	if s.isAPIServerLBRetained() {
+		return s.retainApiServerNLB(ctx, nlb)
+	}
 	lbResponse, err := s.NetworkLoadBalancerClient.DeleteNetworkLoadBalancer(ctx, networkloadbalancer.DeleteNetworkLoadBalancerRequest{
 		NetworkLoadBalancerId: nlb.Id,
 	})
@@ -113,7 +127,7 @@ func (s *ClusterScope) GetControlPlaneLoadBalancerName() string {
 	return fmt.Sprintf("%s-%s", s.OCIClusterAccessor.GetName(), "apiserver")
 }
 
-// UpdateLB updates existing Load Balancer's DisplayName, FreeformTags and DefinedTags
+// UpdateNLB updates existing Network Load Balancer's DisplayName
 func (s *ClusterScope) UpdateNLB(ctx context.Context, nlb infrastructurev1beta2.LoadBalancer) error {
 	nlbId := s.OCIClusterAccessor.GetNetworkSpec().APIServerLB.LoadBalancerId
 	updateLBDetails := networkloadbalancer.UpdateNetworkLoadBalancerDetails{
@@ -189,7 +203,7 @@ func (s *ClusterScope) CreateNLB(ctx context.Context, lb infrastructurev1beta2.L
 		return nil, nil, errors.New("cannot have more than 1 control plane endpoint subnet")
 	}
 	nlbDetails := networkloadbalancer.CreateNetworkLoadBalancerDetails{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetLoadBalancerCompartmentId()),
 		DisplayName:   common.String(lb.Name),
 		SubnetId:      common.String(controlPlaneEndpointSubnets[0]),
 		IsPrivate:     common.Bool(s.isControlPlaneEndpointSubnetPrivate()),
@@ -262,10 +276,13 @@ func (s *ClusterScope) getNetworkLoadbalancerIp(nlb networkloadbalancer.NetworkL
 // IsNLBEqual determines if the actual networkloadbalancer.NetworkLoadBalancer is equal to the desired.
 // Equality is determined by DisplayName
 func (s *ClusterScope) IsNLBEqual(actual *networkloadbalancer.NetworkLoadBalancer, desired infrastructurev1beta2.LoadBalancer) bool {
-	if desired.Name != *actual.DisplayName {
-		return false
-	}
-	return true
+	return len(s.nlbDrift(actual, desired)) == 0
+}
+
+func (s *ClusterScope) nlbDrift(actual *networkloadbalancer.NetworkLoadBalancer, desired infrastructurev1beta2.LoadBalancer) driftDiff {
+	var drift driftDiff
+	drift.compare("displayName", desired.Name, actual.DisplayName)
+	return drift
 }
 
 // GetNetworkLoadBalancers retrieves the Cluster's networkloadbalancer.NetworkLoadBalancer using the one of the following methods
@@ -290,7 +307,7 @@ func (s *ClusterScope) GetNetworkLoadBalancers(ctx context.Context) (*networkloa
 		}
 	}
 	nlbs, err := s.NetworkLoadBalancerClient.ListNetworkLoadBalancers(ctx, networkloadbalancer.ListNetworkLoadBalancersRequest{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetLoadBalancerCompartmentId()),
 		DisplayName:   common.String(s.GetControlPlaneLoadBalancerName()),
 	})
 	if err != nil {
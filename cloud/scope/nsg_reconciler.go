@@ -40,12 +40,15 @@ func (s *ClusterScope) ReconcileNSG(ctx context.Context) error {
 		if nsg != nil {
 			nsgOCID := nsg.Id
 			desiredNSG.ID = nsgOCID
-			if !s.IsNSGEqual(nsg, *desiredNSG) {
-				err = s.UpdateNSG(ctx, *desiredNSG)
-				if err != nil {
-					return err
-				}
-				s.Logger.Info("Successfully updated network security list", "nsg", nsgOCID)
+			s.setNSGStatus(*desiredNSG, string(nsg.LifecycleState))
+			if err := s.reconcileNSGTags(ctx, nsg); err != nil {
+				return err
+			}
+			err = s.reconcileDrift(DriftResourceNSG, desiredNSG.Name, nsgOCID, s.nsgDrift(nsg, *desiredNSG), func() error {
+				return s.UpdateNSG(ctx, *desiredNSG)
+			})
+			if err != nil {
+				return err
 			}
 			continue
 		}
@@ -56,7 +59,7 @@ func (s *ClusterScope) ReconcileNSG(ctx context.Context) error {
 		}
 		s.Logger.Info("Created the nsg", "nsg", nsgID)
 		desiredNSG.ID = nsgID
-
+		s.setNSGStatus(*desiredNSG, "")
 	}
 	for _, desiredNSG := range desiredNSGs.List {
 		s.adjustNSGRulesSpec(desiredNSG, desiredNSGs.List)
@@ -107,7 +110,7 @@ func (s *ClusterScope) GetNSG(ctx context.Context, spec infrastructurev1beta2.NS
 		}
 	}
 	nsgs, err := s.VCNClient.ListNetworkSecurityGroups(ctx, core.ListNetworkSecurityGroupsRequest{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNSGCompartmentId(spec)),
 		VcnId:         s.getVcnId(),
 		DisplayName:   common.String(spec.Name),
 	})
@@ -134,6 +137,12 @@ func (s *ClusterScope) DeleteNSGs(ctx context.Context) error {
 			s.Logger.Info("nsg is already deleted", "nsg", desiredNSG.Name)
 			continue
 		}
+		if s.isNSGRetained(*desiredNSG) {
+			if err := s.retainNSG(ctx, nsg); err != nil {
+				return err
+			}
+			continue
+		}
 		_, err = s.VCNClient.DeleteNetworkSecurityGroup(ctx, core.DeleteNetworkSecurityGroupRequest{
 			NetworkSecurityGroupId: nsg.Id,
 		})
@@ -161,19 +170,26 @@ func (s *ClusterScope) IsNSGExitsByRole(role infrastructurev1beta2.Role) bool {
 
 // IsNSGEqual compares the actual and desired NSG using name.
 func (s *ClusterScope) IsNSGEqual(actual *core.NetworkSecurityGroup, desired infrastructurev1beta2.NSG) bool {
-	if *actual.DisplayName != desired.Name {
-		return false
-	}
-	return true
+	return len(s.nsgDrift(actual, desired)) == 0
+}
+
+// nsgDrift returns the fields of the NSG which differ from the spec, the security rules are compared by
+// UpdateNSGSecurityRulesIfNeeded.
+func (s *ClusterScope) nsgDrift(actual *core.NetworkSecurityGroup, desired infrastructurev1beta2.NSG) driftDiff {
+	var drift driftDiff
+	drift.compare("displayName", desired.Name, actual.DisplayName)
+	return drift
 }
 
-// UpdateNSGSecurityRulesIfNeeded updates NSG rules if required by comparing actual and desired.
+// UpdateNSGSecurityRulesIfNeeded updates NSG rules if required by comparing actual and desired. The rules are
+// only reported as drift if the drift policy of the cluster is Report.
 func (s *ClusterScope) UpdateNSGSecurityRulesIfNeeded(ctx context.Context, desired infrastructurev1beta2.NSG,
 	nsgId *string) (bool, error) {
 	var ingressRulesToAdd []infrastructurev1beta2.IngressSecurityRuleForNSG
 	var egressRulesToAdd []infrastructurev1beta2.EgressSecurityRuleForNSG
 	var securityRulesToRemove []string
 	var isNSGUpdated bool
+	var drift driftDiff
 	listSecurityRulesResponse, err := s.VCNClient.ListNetworkSecurityGroupSecurityRules(ctx, core.ListNetworkSecurityGroupSecurityRulesRequest{
 		NetworkSecurityGroupId: nsgId,
 	})
@@ -204,6 +220,7 @@ func (s *ClusterScope) UpdateNSGSecurityRulesIfNeeded(ctx context.Context, desir
 		}
 		if !found {
 			ingressRulesToAdd = append(ingressRulesToAdd, desiredRule)
+			drift.missing("ingressRules", desiredRule)
 		}
 	}
 
@@ -217,6 +234,7 @@ func (s *ClusterScope) UpdateNSGSecurityRulesIfNeeded(ctx context.Context, desir
 		}
 		if !found {
 			securityRulesToRemove = append(securityRulesToRemove, id)
+			drift.unexpected("ingressRules", actualRule)
 		}
 	}
 
@@ -230,6 +248,7 @@ func (s *ClusterScope) UpdateNSGSecurityRulesIfNeeded(ctx context.Context, desir
 		}
 		if !found {
 			egressRulesToAdd = append(egressRulesToAdd, desiredRule)
+			drift.missing("egressRules", desiredRule)
 		}
 	}
 
@@ -243,33 +262,37 @@ func (s *ClusterScope) UpdateNSGSecurityRulesIfNeeded(ctx context.Context, desir
 		}
 		if !found {
 			securityRulesToRemove = append(securityRulesToRemove, id)
+			drift.unexpected("egressRules", actualRule)
 		}
 	}
 
-	if len(ingressRulesToAdd) > 0 || len(egressRulesToAdd) > 0 {
-		isNSGUpdated = true
-		err := s.AddNSGSecurityRules(ctx, desired.ID, ingressRulesToAdd, egressRulesToAdd)
-		if err != nil {
-			s.Logger.Error(err, "failed to reconcile the network security group, failed to add security rules")
-			return isNSGUpdated, err
-		}
-		s.Logger.Info("Successfully added missing rules in NSG", "nsg", *nsgId)
-	}
-	if len(securityRulesToRemove) > 0 {
-		isNSGUpdated = true
-		_, err = s.VCNClient.RemoveNetworkSecurityGroupSecurityRules(ctx, core.RemoveNetworkSecurityGroupSecurityRulesRequest{
-			NetworkSecurityGroupId: desired.ID,
-			RemoveNetworkSecurityGroupSecurityRulesDetails: core.RemoveNetworkSecurityGroupSecurityRulesDetails{
-				SecurityRuleIds: securityRulesToRemove,
-			},
-		})
-		if err != nil {
-			s.Logger.Error(err, "failed to reconcile the network security group, failed to remove security rules")
-			return isNSGUpdated, err
+	err = s.reconcileDrift(DriftResourceNSGRules, desired.Name, nsgId, drift, func() error {
+		if len(ingressRulesToAdd) > 0 || len(egressRulesToAdd) > 0 {
+			isNSGUpdated = true
+			err := s.AddNSGSecurityRules(ctx, desired.ID, ingressRulesToAdd, egressRulesToAdd)
+			if err != nil {
+				s.Logger.Error(err, "failed to reconcile the network security group, failed to add security rules")
+				return err
+			}
+			s.Logger.Info("Successfully added missing rules in NSG", "nsg", *nsgId)
+		}
+		if len(securityRulesToRemove) > 0 {
+			isNSGUpdated = true
+			_, err := s.VCNClient.RemoveNetworkSecurityGroupSecurityRules(ctx, core.RemoveNetworkSecurityGroupSecurityRulesRequest{
+				NetworkSecurityGroupId: desired.ID,
+				RemoveNetworkSecurityGroupSecurityRulesDetails: core.RemoveNetworkSecurityGroupSecurityRulesDetails{
+					SecurityRuleIds: securityRulesToRemove,
+				},
+			})
+			if err != nil {
+				s.Logger.Error(err, "failed to reconcile the network security group, failed to remove security rules")
+				return err
+			}
+			s.Logger.Info("Successfully deleted rules in NSG", "nsg", *nsgId)
 		}
-		s.Logger.Info("Successfully deleted rules in NSG", "nsg", *nsgId)
-	}
-	return isNSGUpdated, nil
+		return nil
+	})
+	return isNSGUpdated, err
 }
 
 func (s *ClusterScope) UpdateNSG(ctx context.Context, nsgSpec infrastructurev1beta2.NSG) error {
@@ -415,7 +438,7 @@ func (s *ClusterScope) AddNSGSecurityRules(ctx context.Context, nsgId *string, i
 
 func (s *ClusterScope) CreateNSG(ctx context.Context, nsg infrastructurev1beta2.NSG) (*string, error) {
 	createNetworkSecurityGroupDetails := core.CreateNetworkSecurityGroupDetails{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNSGCompartmentId(nsg)),
 		VcnId:         s.getVcnId(),
 		DefinedTags:   s.GetDefinedTags(),
 		DisplayName:   common.String(nsg.Name),
@@ -40,11 +40,43 @@ func (c OCIManagedCluster) GetClientOverrides() *infrastructurev1beta2.ClientOve
 	return c.OCIManagedCluster.Spec.ClientOverrides
 }
 
+func (c OCIManagedCluster) GetWorkloadResourceCleanup() *infrastructurev1beta2.WorkloadResourceCleanup {
+	return c.OCIManagedCluster.Spec.WorkloadResourceCleanup
+}
+
+func (c OCIManagedCluster) GetDriftPolicy() infrastructurev1beta2.DriftPolicy {
+	return c.OCIManagedCluster.Spec.DriftPolicy
+}
+
+func (c OCIManagedCluster) AddResourceDrift(drift infrastructurev1beta2.ResourceDrift) {
+	c.OCIManagedCluster.Status.Drift = append(c.OCIManagedCluster.Status.Drift, drift)
+}
+
+func (c OCIManagedCluster) GetAppliedTags() *infrastructurev1beta2.AppliedTags {
+	return c.OCIManagedCluster.Status.AppliedTags
+}
+
+func (c OCIManagedCluster) GetNetworkStatus() *infrastructurev1beta2.NetworkStatus {
+	if c.OCIManagedCluster.Status.Network == nil {
+		c.OCIManagedCluster.Status.Network = &infrastructurev1beta2.NetworkStatus{}
+	}
+	return c.OCIManagedCluster.Status.Network
+}
+
+// GetHibernationState returns an empty state, as the control plane of an OKE cluster is not hibernated.
+func (c OCIManagedCluster) GetHibernationState() infrastructurev1beta2.HibernationState {
+	return ""
+}
+
 func (c OCIManagedCluster) MarkConditionFalse(t clusterv1.ConditionType, reason string, severity clusterv1.ConditionSeverity, messageFormat string, messageArgs ...interface{}) {
-	conditions.MarkFalse(c.OCIManagedCluster, infrastructurev1beta2.ClusterReadyCondition, reason, severity, messageFormat, messageArgs...)
+	conditions.MarkFalse(c.OCIManagedCluster, t, reason, severity, messageFormat, messageArgs...)
 
 }
 
+func (c OCIManagedCluster) MarkConditionTrue(t clusterv1.ConditionType) {
+	conditions.MarkTrue(c.OCIManagedCluster, t)
+}
+
 func (c OCIManagedCluster) GetIdentityRef() *corev1.ObjectReference {
 	return c.OCIManagedCluster.Spec.IdentityRef
 }
@@ -109,3 +141,13 @@ func (c OCIManagedCluster) SetAvailabilityDomains(ads map[string]infrastructurev
 func (c OCIManagedCluster) GetProviderID(instanceId string) string {
 	return instanceId
 }
+
+func (c OCIManagedCluster) SetRemotePeeringConnectionStatus(status infrastructurev1beta2.RemotePeeringConnectionStatus) {
+	for i, rpcStatus := range c.OCIManagedCluster.Status.RemotePeeringConnections {
+		if rpcStatus.Name == status.Name {
+			c.OCIManagedCluster.Status.RemotePeeringConnections[i] = status
+			return
+		}
+	}
+	c.OCIManagedCluster.Status.RemotePeeringConnections = append(c.OCIManagedCluster.Status.RemotePeeringConnections, status)
+}
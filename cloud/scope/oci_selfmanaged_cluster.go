@@ -41,12 +41,43 @@ func (c OCISelfManagedCluster) GetClientOverrides() *infrastructurev1beta2.Clien
 	return c.OCICluster.Spec.ClientOverrides
 }
 
+func (c OCISelfManagedCluster) GetWorkloadResourceCleanup() *infrastructurev1beta2.WorkloadResourceCleanup {
+	return c.OCICluster.Spec.WorkloadResourceCleanup
+}
+
+func (c OCISelfManagedCluster) GetDriftPolicy() infrastructurev1beta2.DriftPolicy {
+	return c.OCICluster.Spec.DriftPolicy
+}
+
+func (c OCISelfManagedCluster) AddResourceDrift(drift infrastructurev1beta2.ResourceDrift) {
+	c.OCICluster.Status.Drift = append(c.OCICluster.Status.Drift, drift)
+}
+
+func (c OCISelfManagedCluster) GetAppliedTags() *infrastructurev1beta2.AppliedTags {
+	return c.OCICluster.Status.AppliedTags
+}
+
+func (c OCISelfManagedCluster) GetNetworkStatus() *infrastructurev1beta2.NetworkStatus {
+	if c.OCICluster.Status.Network == nil {
+		c.OCICluster.Status.Network = &infrastructurev1beta2.NetworkStatus{}
+	}
+	return c.OCICluster.Status.Network
+}
+
+func (c OCISelfManagedCluster) GetHibernationState() infrastructurev1beta2.HibernationState {
+	return c.OCICluster.Status.Hibernation
+}
+
 func (c OCISelfManagedCluster) GetIdentityRef() *corev1.ObjectReference {
 	return c.OCICluster.Spec.IdentityRef
 }
 
 func (c OCISelfManagedCluster) MarkConditionFalse(t clusterv1.ConditionType, reason string, severity clusterv1.ConditionSeverity, messageFormat string, messageArgs ...interface{}) {
-	conditions.MarkFalse(c.OCICluster, infrastructurev1beta2.ClusterReadyCondition, reason, severity, messageFormat, messageArgs...)
+	conditions.MarkFalse(c.OCICluster, t, reason, severity, messageFormat, messageArgs...)
+}
+
+func (c OCISelfManagedCluster) MarkConditionTrue(t clusterv1.ConditionType) {
+	conditions.MarkTrue(c.OCICluster, t)
 }
 
 func (c OCISelfManagedCluster) GetOCIResourceIdentifier() string {
@@ -110,3 +141,13 @@ func (c OCISelfManagedCluster) GetControlPlaneEndpoint() clusterv1.APIEndpoint {
 func (c OCISelfManagedCluster) GetProviderID(instanceId string) string {
 	return fmt.Sprintf("oci://%s", instanceId)
 }
+
+func (c OCISelfManagedCluster) SetRemotePeeringConnectionStatus(status infrastructurev1beta2.RemotePeeringConnectionStatus) {
+	for i, rpcStatus := range c.OCICluster.Status.RemotePeeringConnections {
+		if rpcStatus.Name == status.Name {
+			c.OCICluster.Status.RemotePeeringConnections[i] = status
+			return
+		}
+	}
+	c.OCICluster.Status.RemotePeeringConnections = append(c.OCICluster.Status.RemotePeeringConnections, status)
+}
@@ -0,0 +1,460 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// PersistentVolumeTag is the freeform tag with the name of the persistent volume a volume was created for.
+	PersistentVolumeTag = "PersistentVolume"
+	// PersistentVolumeKeyTag is the freeform tag with the machine name or the index a volume is keyed by.
+	PersistentVolumeKeyTag = "PersistentVolumeKey"
+)
+
+// ErrPersistentVolumeNotAvailable is returned while a persistent volume is being created, detached from the
+// instance of a replaced machine or attached to the instance.
+var ErrPersistentVolumeNotAvailable = errors.New("the persistent volume is not available")
+
+// ReconcilePersistentVolumes claims a volume for each persistent volume of the spec and attaches it to the
+// instance, and detaches the volumes of the persistent volumes which have been removed from the spec.
+func (m *MachineScope) ReconcilePersistentVolumes(ctx context.Context, instance *core.Instance) error {
+	if len(m.OCIMachine.Spec.PersistentVolumes) == 0 && len(m.OCIMachine.Status.PersistentVolumes) == 0 {
+		return nil
+	}
+	attachments, err := m.listVolumeAttachments(ctx, core.ListVolumeAttachmentsRequest{
+		CompartmentId: instance.CompartmentId,
+		InstanceId:    instance.Id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var claims []infrastructurev1beta2.PersistentVolumeStatus
+	for _, claim := range m.OCIMachine.Status.PersistentVolumes {
+		if m.getPersistentVolume(claim.Name) != nil {
+			claims = append(claims, claim)
+			continue
+		}
+		for _, attachment := range attachments {
+			if ociutil.DerefString(attachment.GetVolumeId()) == ociutil.DerefString(claim.VolumeId) {
+				if err := m.detachVolume(ctx, attachment); err != nil {
+					return err
+				}
+			}
+		}
+		m.Logger.Info("Released the persistent volume", "name", claim.Name, "volume", ociutil.DerefString(claim.VolumeId))
+	}
+	m.OCIMachine.Status.PersistentVolumes = claims
+	if len(m.OCIMachine.Spec.PersistentVolumes) == 0 {
+		return nil
+	}
+
+	otherClaims, otherInstances, err := m.listOtherPersistentVolumeClaims(ctx)
+	if err != nil {
+		return err
+	}
+	var pending []string
+	for _, persistentVolume := range m.OCIMachine.Spec.PersistentVolumes {
+		volume, err := m.claimPersistentVolume(ctx, persistentVolume, instance, otherClaims, otherInstances)
+		if errors.Is(err, ErrPersistentVolumeNotAvailable) {
+			pending = append(pending, persistentVolume.Name)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		attached, err := m.attachVolume(ctx, persistentVolume, volume, instance, attachments)
+		if err != nil {
+			return err
+		}
+		if !attached {
+			pending = append(pending, persistentVolume.Name)
+		}
+	}
+	if len(pending) > 0 {
+		return errors.Wrapf(ErrPersistentVolumeNotAvailable, "persistent volumes %v are not attached yet", pending)
+	}
+	return nil
+}
+
+// getPersistentVolumeAvailabilityDomain returns the availability domain of the volumes of the persistent volumes
+// keyed by the name of the machine, the instance of a replaced machine is launched where its volumes are.
+func (m *MachineScope) getPersistentVolumeAvailabilityDomain(ctx context.Context) (string, error) {
+	for _, persistentVolume := range m.OCIMachine.Spec.PersistentVolumes {
+		if persistentVolume.KeyBy != infrastructurev1beta2.PersistentVolumeKeyMachineName {
+			continue
+		}
+		volumes, err := m.listPersistentVolumes(ctx, persistentVolume.Name)
+		if err != nil {
+			return "", err
+		}
+		for _, volume := range volumes {
+			if volume.FreeformTags[PersistentVolumeKeyTag] == m.Machine.Name {
+				return ociutil.DerefString(volume.AvailabilityDomain), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// claimPersistentVolume returns the volume claimed by the machine for the persistent volume once the volume is
+// available and not attached to another instance. A volume which is still attached to the instance of a replaced
+// machine, that is an instance of the cluster whose OCIMachine is gone or being deleted, is detached from it. A
+// volume attached to the instance of another machine, or to an instance which is not part of the cluster, is
+// left attached and the machine waits for the volume.
+func (m *MachineScope) claimPersistentVolume(ctx context.Context, persistentVolume infrastructurev1beta2.PersistentVolume,
+	instance *core.Instance, otherClaims map[string]string, otherInstances map[string]string) (*core.Volume, error) {
+	claim := m.getPersistentVolumeStatus(persistentVolume.Name)
+	if claim == nil {
+		key, volumeId, err := m.choosePersistentVolume(ctx, persistentVolume, ociutil.DerefString(instance.AvailabilityDomain), otherClaims)
+		if err != nil {
+			return nil, err
+		}
+		m.OCIMachine.Status.PersistentVolumes = append(m.OCIMachine.Status.PersistentVolumes, infrastructurev1beta2.PersistentVolumeStatus{
+			Name:     persistentVolume.Name,
+			Key:      key,
+			VolumeId: volumeId,
+		})
+		m.Logger.Info("Claimed the persistent volume", "name", persistentVolume.Name, "key", key, "volume", *volumeId)
+		claim = m.getPersistentVolumeStatus(persistentVolume.Name)
+	}
+
+	resp, err := m.BlockStorageClient.GetVolume(ctx, core.GetVolumeRequest{VolumeId: claim.VolumeId})
+	if err != nil {
+		if ociutil.IsNotFound(err) {
+			m.releasePersistentVolumeClaim(persistentVolume.Name)
+			return nil, errors.Wrapf(ErrPersistentVolumeNotAvailable, "volume %s was deleted", *claim.VolumeId)
+		}
+		return nil, errors.Wrapf(err, "failed to get the volume %s", *claim.VolumeId)
+	}
+	volume := resp.Volume
+	if volume.LifecycleState != core.VolumeLifecycleStateAvailable {
+		return nil, errors.Wrapf(ErrPersistentVolumeNotAvailable, "volume %s is %s", *claim.VolumeId, volume.LifecycleState)
+	}
+	if ociutil.DerefString(volume.AvailabilityDomain) != ociutil.DerefString(instance.AvailabilityDomain) {
+		return nil, errors.New(fmt.Sprintf("volume %s of the persistent volume %s is in the availability domain %s, not in the availability domain of the instance",
+			*claim.VolumeId, persistentVolume.Name, ociutil.DerefString(volume.AvailabilityDomain)))
+	}
+
+	attachments, err := m.listVolumeAttachments(ctx, core.ListVolumeAttachmentsRequest{
+		CompartmentId: volume.CompartmentId,
+		VolumeId:      volume.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	detaching := false
+	for _, attachment := range attachments {
+		if ociutil.DerefString(attachment.GetInstanceId()) == ociutil.DerefString(instance.Id) {
+			continue
+		}
+		if owner, ok := otherClaims[*volume.Id]; ok {
+			// another machine claimed the volume at the same time, the volume is left to it
+			m.releasePersistentVolumeClaim(persistentVolume.Name)
+			return nil, errors.Wrapf(ErrPersistentVolumeNotAvailable, "volume %s is claimed by %s", *volume.Id, owner)
+		}
+		instanceId := ociutil.DerefString(attachment.GetInstanceId())
+		if owner, ok := otherInstances[instanceId]; ok {
+			return nil, errors.Wrapf(ErrPersistentVolumeNotAvailable, "volume %s is attached to the instance of %s", *volume.Id, owner)
+		}
+		replaced, err := m.isInstanceOfReplacedMachine(ctx, instanceId)
+		if err != nil {
+			return nil, err
+		}
+		if !replaced {
+			return nil, errors.Wrapf(ErrPersistentVolumeNotAvailable, "volume %s is attached to the instance %s, which is not a machine of the cluster",
+				*volume.Id, instanceId)
+		}
+		if err := m.detachVolume(ctx, attachment); err != nil {
+			return nil, err
+		}
+		detaching = true
+	}
+	if detaching {
+		return nil, errors.Wrapf(ErrPersistentVolumeNotAvailable, "volume %s is being detached from the instance of a replaced machine", *volume.Id)
+	}
+	return &volume, nil
+}
+
+// isInstanceOfReplacedMachine returns true when an instance which is not the instance of an OCIMachine of the
+// cluster was launched for the cluster, or is gone.
+func (m *MachineScope) isInstanceOfReplacedMachine(ctx context.Context, instanceId string) (bool, error) {
+	resp, err := m.ComputeClient.GetInstance(ctx, core.GetInstanceRequest{InstanceId: common.String(instanceId)})
+	if err != nil {
+		if ociutil.IsNotFound(err) {
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "failed to get the instance %s", instanceId)
+	}
+	return resp.FreeformTags[ociutil.ClusterResourceIdentifier] == m.OCIClusterAccessor.GetOCIResourceIdentifier(), nil
+}
+
+// choosePersistentVolume returns the key and the volume for a persistent volume which the machine has not claimed
+// yet, the volume is created when there is no volume to reuse.
+func (m *MachineScope) choosePersistentVolume(ctx context.Context, persistentVolume infrastructurev1beta2.PersistentVolume,
+	availabilityDomain string, otherClaims map[string]string) (string, *string, error) {
+	volumes, err := m.listPersistentVolumes(ctx, persistentVolume.Name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if persistentVolume.KeyBy == infrastructurev1beta2.PersistentVolumeKeyMachineName {
+		key := m.Machine.Name
+		for _, volume := range volumes {
+			if volume.FreeformTags[PersistentVolumeKeyTag] == key {
+				return key, volume.Id, nil
+			}
+		}
+		volumeId, err := m.createPersistentVolume(ctx, persistentVolume, key, availabilityDomain)
+		return key, volumeId, err
+	}
+
+	// the lowest numbered volume in the availability domain which is not claimed by another machine is reused,
+	// otherwise a volume is created with the lowest number which is not in use
+	usedIndexes := make(map[int]bool)
+	var free []core.Volume
+	for _, volume := range volumes {
+		index, err := strconv.Atoi(volume.FreeformTags[PersistentVolumeKeyTag])
+		if err != nil {
+			continue
+		}
+		usedIndexes[index] = true
+		if _, claimed := otherClaims[ociutil.DerefString(volume.Id)]; claimed {
+			continue
+		}
+		if ociutil.DerefString(volume.AvailabilityDomain) == availabilityDomain {
+			free = append(free, volume)
+		}
+	}
+	if len(free) > 0 {
+		sort.Slice(free, func(i, j int) bool {
+			left, _ := strconv.Atoi(free[i].FreeformTags[PersistentVolumeKeyTag])
+			right, _ := strconv.Atoi(free[j].FreeformTags[PersistentVolumeKeyTag])
+			return left < right
+		})
+		return free[0].FreeformTags[PersistentVolumeKeyTag], free[0].Id, nil
+	}
+	index := 0
+	for usedIndexes[index] {
+		index++
+	}
+	key := strconv.Itoa(index)
+	volumeId, err := m.createPersistentVolume(ctx, persistentVolume, key, availabilityDomain)
+	return key, volumeId, err
+}
+
+// createPersistentVolume creates the volume of a persistent volume with the key in the availability domain.
+func (m *MachineScope) createPersistentVolume(ctx context.Context, persistentVolume infrastructurev1beta2.PersistentVolume,
+	key string, availabilityDomain string) (*string, error) {
+	tags := m.getFreeFormTags()
+	tags[PersistentVolumeTag] = persistentVolume.Name
+	tags[PersistentVolumeKeyTag] = key
+	resp, err := m.BlockStorageClient.CreateVolume(ctx, core.CreateVolumeRequest{
+		CreateVolumeDetails: core.CreateVolumeDetails{
+			CompartmentId:      common.String(m.getCompartmentId()),
+			AvailabilityDomain: common.String(availabilityDomain),
+			DisplayName:        common.String(fmt.Sprintf("%s-%s-%s", m.Cluster.Name, persistentVolume.Name, key)),
+			SizeInGBs:          persistentVolume.SizeInGBs,
+			VpusPerGB:          persistentVolume.VpusPerGB,
+			KmsKeyId:           persistentVolume.KmsKeyId,
+			FreeformTags:       tags,
+			DefinedTags:        ConvertMachineDefinedTags(m.OCIMachine.Spec.DefinedTags),
+		},
+		// the retry token is scoped to the machine, so that machines which choose the same key at the same time
+		// do not share the created volume
+		OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", string(m.OCIMachine.UID), persistentVolume.Name, key),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create the volume of the persistent volume %s", persistentVolume.Name)
+	}
+	m.Logger.Info("Created the volume of the persistent volume", "name", persistentVolume.Name, "key", key, "volume", *resp.Id)
+	return resp.Id, nil
+}
+
+// attachVolume attaches the volume to the instance and returns whether the volume is attached.
+func (m *MachineScope) attachVolume(ctx context.Context, persistentVolume infrastructurev1beta2.PersistentVolume, volume *core.Volume,
+	instance *core.Instance, attachments []core.VolumeAttachment) (bool, error) {
+	for _, attachment := range attachments {
+		if ociutil.DerefString(attachment.GetVolumeId()) != ociutil.DerefString(volume.Id) {
+			continue
+		}
+		switch attachment.GetLifecycleState() {
+		case core.VolumeAttachmentLifecycleStateAttached:
+			return true, nil
+		case core.VolumeAttachmentLifecycleStateAttaching:
+			return false, nil
+		}
+	}
+
+	var details core.AttachVolumeDetails
+	if persistentVolume.AttachmentType == infrastructurev1beta2.IscsiType {
+		details = core.AttachIScsiVolumeDetails{
+			InstanceId:                   instance.Id,
+			VolumeId:                     volume.Id,
+			Device:                       persistentVolume.Device,
+			DisplayName:                  common.String(persistentVolume.Name),
+			IsAgentAutoIscsiLoginEnabled: common.Bool(true),
+		}
+	} else {
+		details = core.AttachParavirtualizedVolumeDetails{
+			InstanceId:                     instance.Id,
+			VolumeId:                       volume.Id,
+			Device:                         persistentVolume.Device,
+			DisplayName:                    common.String(persistentVolume.Name),
+			IsPvEncryptionInTransitEnabled: common.Bool(m.OCIMachine.Spec.IsPvEncryptionInTransitEnabled),
+		}
+	}
+	_, err := m.ComputeClient.AttachVolume(ctx, core.AttachVolumeRequest{
+		AttachVolumeDetails: details,
+		OpcRetryToken:       ociutil.GetOPCRetryToken("%s-%s", ociutil.DerefString(instance.Id), ociutil.DerefString(volume.Id)),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to attach the volume of the persistent volume %s", persistentVolume.Name)
+	}
+	m.Logger.Info("Attaching the volume of the persistent volume", "name", persistentVolume.Name, "volume", *volume.Id)
+	return false, nil
+}
+
+// detachVolume detaches the volume of the attachment, unless it is already being detached.
+func (m *MachineScope) detachVolume(ctx context.Context, attachment core.VolumeAttachment) error {
+	state := attachment.GetLifecycleState()
+	if state != core.VolumeAttachmentLifecycleStateAttached && state != core.VolumeAttachmentLifecycleStateAttaching {
+		return nil
+	}
+	_, err := m.ComputeClient.DetachVolume(ctx, core.DetachVolumeRequest{
+		VolumeAttachmentId: attachment.GetId(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to detach the volume %s", ociutil.DerefString(attachment.GetVolumeId()))
+	}
+	m.Logger.Info("Detaching the volume", "volume", ociutil.DerefString(attachment.GetVolumeId()),
+		"instance", ociutil.DerefString(attachment.GetInstanceId()))
+	return nil
+}
+
+// listVolumeAttachments returns the volume attachments matching the request which are not detached.
+func (m *MachineScope) listVolumeAttachments(ctx context.Context, req core.ListVolumeAttachmentsRequest) ([]core.VolumeAttachment, error) {
+	var attachments []core.VolumeAttachment
+	for {
+		resp, err := m.ComputeClient.ListVolumeAttachments(ctx, req)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list the volume attachments")
+		}
+		for _, attachment := range resp.Items {
+			if attachment.GetLifecycleState() != core.VolumeAttachmentLifecycleStateDetached {
+				attachments = append(attachments, attachment)
+			}
+		}
+		if resp.OpcNextPage == nil {
+			return attachments, nil
+		}
+		req.Page = resp.OpcNextPage
+	}
+}
+
+// listPersistentVolumes returns the volumes of the cluster created for the persistent volume.
+func (m *MachineScope) listPersistentVolumes(ctx context.Context, name string) ([]core.Volume, error) {
+	var volumes []core.Volume
+	req := core.ListVolumesRequest{CompartmentId: common.String(m.getCompartmentId())}
+	for {
+		resp, err := m.BlockStorageClient.ListVolumes(ctx, req)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list the volumes")
+		}
+		for _, volume := range resp.Items {
+			if volume.LifecycleState == core.VolumeLifecycleStateTerminating || volume.LifecycleState == core.VolumeLifecycleStateTerminated {
+				continue
+			}
+			if volume.FreeformTags[PersistentVolumeTag] != name ||
+				volume.FreeformTags[ociutil.ClusterResourceIdentifier] != m.OCIClusterAccessor.GetOCIResourceIdentifier() {
+				continue
+			}
+			volumes = append(volumes, volume)
+		}
+		if resp.OpcNextPage == nil {
+			return volumes, nil
+		}
+		req.Page = resp.OpcNextPage
+	}
+}
+
+// listOtherPersistentVolumeClaims returns the volumes claimed by the other OCIMachines of the cluster which are
+// not being deleted and the instances of these OCIMachines, both mapped to the name of the OCIMachine.
+func (m *MachineScope) listOtherPersistentVolumeClaims(ctx context.Context) (map[string]string, map[string]string, error) {
+	ociMachines := &infrastructurev1beta2.OCIMachineList{}
+	if err := m.Client.List(ctx, ociMachines, client.InNamespace(m.OCIMachine.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: m.Cluster.Name}); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to list the OCIMachines")
+	}
+	claims := make(map[string]string)
+	instances := make(map[string]string)
+	for _, ociMachine := range ociMachines.Items {
+		if ociMachine.Name == m.OCIMachine.Name || !ociMachine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		for _, claim := range ociMachine.Status.PersistentVolumes {
+			if claim.VolumeId != nil {
+				claims[*claim.VolumeId] = ociMachine.Name
+			}
+		}
+		if ociMachine.Spec.InstanceId != nil {
+			instances[*ociMachine.Spec.InstanceId] = ociMachine.Name
+		}
+	}
+	return claims, instances, nil
+}
+
+func (m *MachineScope) getPersistentVolume(name string) *infrastructurev1beta2.PersistentVolume {
+	for i := range m.OCIMachine.Spec.PersistentVolumes {
+		if m.OCIMachine.Spec.PersistentVolumes[i].Name == name {
+			return &m.OCIMachine.Spec.PersistentVolumes[i]
+		}
+	}
+	return nil
+}
+
+func (m *MachineScope) getPersistentVolumeStatus(name string) *infrastructurev1beta2.PersistentVolumeStatus {
+	for i := range m.OCIMachine.Status.PersistentVolumes {
+		if m.OCIMachine.Status.PersistentVolumes[i].Name == name {
+			return &m.OCIMachine.Status.PersistentVolumes[i]
+		}
+	}
+	return nil
+}
+
+func (m *MachineScope) releasePersistentVolumeClaim(name string) {
+	var claims []infrastructurev1beta2.PersistentVolumeStatus
+	for _, claim := range m.OCIMachine.Status.PersistentVolumes {
+		if claim.Name != name {
+			claims = append(claims, claim)
+		}
+	}
+	m.OCIMachine.Status.PersistentVolumes = claims
+}
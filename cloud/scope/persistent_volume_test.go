@@ -0,0 +1,433 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/blockstorage/mock_blockstorage"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute/mock_compute"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/klogr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func persistentVolumeTestOCIMachine(name string, claims ...infrastructurev1beta2.PersistentVolumeStatus) *infrastructurev1beta2.OCIMachine {
+	return &infrastructurev1beta2.OCIMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster"},
+		},
+		Status: infrastructurev1beta2.OCIMachineStatus{PersistentVolumes: claims},
+	}
+}
+
+func persistentVolumeTestVolume(id string, key string, ad string) core.Volume {
+	return core.Volume{
+		Id:                 common.String(id),
+		AvailabilityDomain: common.String(ad),
+		LifecycleState:     core.VolumeLifecycleStateAvailable,
+		FreeformTags: map[string]string{
+			ociutil.ClusterResourceIdentifier: "resource-uid",
+			PersistentVolumeTag:               "data",
+			PersistentVolumeKeyTag:            key,
+		},
+	}
+}
+
+func persistentVolumeTestAttachment(volumeId string, instanceId string, state core.VolumeAttachmentLifecycleStateEnum) core.VolumeAttachment {
+	return core.ParavirtualizedVolumeAttachment{
+		Id:             common.String(volumeId + "-attachment"),
+		VolumeId:       common.String(volumeId),
+		InstanceId:     common.String(instanceId),
+		LifecycleState: state,
+	}
+}
+
+func TestReconcilePersistentVolumes(t *testing.T) {
+	instance := &core.Instance{
+		Id:                 common.String("instance"),
+		CompartmentId:      common.String("test-compartment"),
+		AvailabilityDomain: common.String("ad-1"),
+	}
+	instanceAttachments := core.ListVolumeAttachmentsRequest{
+		CompartmentId: common.String("test-compartment"),
+		InstanceId:    common.String("instance"),
+	}
+	volumeAttachments := func(volumeId string) core.ListVolumeAttachmentsRequest {
+		return core.ListVolumeAttachmentsRequest{
+			CompartmentId: common.String("test-compartment"),
+			VolumeId:      common.String(volumeId),
+		}
+	}
+	listVolumes := core.ListVolumesRequest{CompartmentId: common.String("test-compartment")}
+
+	tests := []struct {
+		name              string
+		persistentVolumes []infrastructurev1beta2.PersistentVolume
+		claims            []infrastructurev1beta2.PersistentVolumeStatus
+		objects           []client.Object
+		setup             func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient)
+		errorExpected     bool
+		notAvailable      bool
+		expectedClaims    []infrastructurev1beta2.PersistentVolumeStatus
+	}{
+		{
+			name: "volume created for the machine name",
+			persistentVolumes: []infrastructurev1beta2.PersistentVolume{{
+				Name: "data", KeyBy: infrastructurev1beta2.PersistentVolumeKeyMachineName, SizeInGBs: common.Int64(100),
+			}},
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(instanceAttachments)).
+					Return(core.ListVolumeAttachmentsResponse{}, nil)
+				blockStorageClient.EXPECT().ListVolumes(gomock.Any(), gomock.Eq(listVolumes)).
+					Return(core.ListVolumesResponse{Items: []core.Volume{persistentVolumeTestVolume("other", "other-machine", "ad-1")}}, nil)
+				blockStorageClient.EXPECT().CreateVolume(gomock.Any(), gomock.Eq(core.CreateVolumeRequest{
+					CreateVolumeDetails: core.CreateVolumeDetails{
+						CompartmentId:      common.String("test-compartment"),
+						AvailabilityDomain: common.String("ad-1"),
+						DisplayName:        common.String("cluster-data-machine"),
+						SizeInGBs:          common.Int64(100),
+						FreeformTags: map[string]string{
+							ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+							ociutil.ClusterResourceIdentifier: "resource-uid",
+							PersistentVolumeTag:               "data",
+							PersistentVolumeKeyTag:            "machine",
+						},
+						DefinedTags: map[string]map[string]interface{}{},
+					},
+					OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", "machine-uid", "data", "machine"),
+				})).Return(core.CreateVolumeResponse{Volume: core.Volume{Id: common.String("volume")}}, nil)
+				blockStorageClient.EXPECT().GetVolume(gomock.Any(), gomock.Eq(core.GetVolumeRequest{VolumeId: common.String("volume")})).
+					Return(core.GetVolumeResponse{Volume: core.Volume{
+						Id:             common.String("volume"),
+						LifecycleState: core.VolumeLifecycleStateProvisioning,
+					}}, nil)
+			},
+			errorExpected: true,
+			notAvailable:  true,
+			expectedClaims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "machine", VolumeId: common.String("volume")},
+			},
+		},
+		{
+			name: "volume of the machine name detached from the instance of the replaced machine",
+			persistentVolumes: []infrastructurev1beta2.PersistentVolume{{
+				Name: "data", KeyBy: infrastructurev1beta2.PersistentVolumeKeyMachineName,
+			}},
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				volume := persistentVolumeTestVolume("volume", "machine", "ad-1")
+				volume.CompartmentId = common.String("test-compartment")
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(instanceAttachments)).
+					Return(core.ListVolumeAttachmentsResponse{}, nil)
+				blockStorageClient.EXPECT().ListVolumes(gomock.Any(), gomock.Eq(listVolumes)).
+					Return(core.ListVolumesResponse{Items: []core.Volume{volume}}, nil)
+				blockStorageClient.EXPECT().GetVolume(gomock.Any(), gomock.Eq(core.GetVolumeRequest{VolumeId: common.String("volume")})).
+					Return(core.GetVolumeResponse{Volume: volume}, nil)
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(volumeAttachments("volume"))).
+					Return(core.ListVolumeAttachmentsResponse{Items: []core.VolumeAttachment{
+						persistentVolumeTestAttachment("volume", "old-instance", core.VolumeAttachmentLifecycleStateAttached),
+					}}, nil)
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{InstanceId: common.String("old-instance")})).
+					Return(core.GetInstanceResponse{Instance: core.Instance{
+						Id:           common.String("old-instance"),
+						FreeformTags: map[string]string{ociutil.ClusterResourceIdentifier: "resource-uid"},
+					}}, nil)
+				computeClient.EXPECT().DetachVolume(gomock.Any(), gomock.Eq(core.DetachVolumeRequest{
+					VolumeAttachmentId: common.String("volume-attachment"),
+				})).Return(core.DetachVolumeResponse{}, nil)
+			},
+			errorExpected: true,
+			notAvailable:  true,
+			expectedClaims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "machine", VolumeId: common.String("volume")},
+			},
+		},
+		{
+			name: "volume attached to the instance of another machine is not detached",
+			persistentVolumes: []infrastructurev1beta2.PersistentVolume{{
+				Name: "data", KeyBy: infrastructurev1beta2.PersistentVolumeKeyMachineName,
+			}},
+			claims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "machine", VolumeId: common.String("volume")},
+			},
+			objects: []client.Object{
+				func() client.Object {
+					ociMachine := persistentVolumeTestOCIMachine("machine-1")
+					ociMachine.Spec.InstanceId = common.String("other-instance")
+					return ociMachine
+				}(),
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				volume := persistentVolumeTestVolume("volume", "machine", "ad-1")
+				volume.CompartmentId = common.String("test-compartment")
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(instanceAttachments)).
+					Return(core.ListVolumeAttachmentsResponse{}, nil)
+				blockStorageClient.EXPECT().GetVolume(gomock.Any(), gomock.Any()).
+					Return(core.GetVolumeResponse{Volume: volume}, nil)
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(volumeAttachments("volume"))).
+					Return(core.ListVolumeAttachmentsResponse{Items: []core.VolumeAttachment{
+						persistentVolumeTestAttachment("volume", "other-instance", core.VolumeAttachmentLifecycleStateAttached),
+					}}, nil)
+			},
+			errorExpected: true,
+			notAvailable:  true,
+			expectedClaims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "machine", VolumeId: common.String("volume")},
+			},
+		},
+		{
+			name: "volume attached to an instance which is not part of the cluster is not detached",
+			persistentVolumes: []infrastructurev1beta2.PersistentVolume{{
+				Name: "data", KeyBy: infrastructurev1beta2.PersistentVolumeKeyMachineName,
+			}},
+			claims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "machine", VolumeId: common.String("volume")},
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				volume := persistentVolumeTestVolume("volume", "machine", "ad-1")
+				volume.CompartmentId = common.String("test-compartment")
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(instanceAttachments)).
+					Return(core.ListVolumeAttachmentsResponse{}, nil)
+				blockStorageClient.EXPECT().GetVolume(gomock.Any(), gomock.Any()).
+					Return(core.GetVolumeResponse{Volume: volume}, nil)
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(volumeAttachments("volume"))).
+					Return(core.ListVolumeAttachmentsResponse{Items: []core.VolumeAttachment{
+						persistentVolumeTestAttachment("volume", "foreign-instance", core.VolumeAttachmentLifecycleStateAttached),
+					}}, nil)
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{InstanceId: common.String("foreign-instance")})).
+					Return(core.GetInstanceResponse{Instance: core.Instance{Id: common.String("foreign-instance")}}, nil)
+			},
+			errorExpected: true,
+			notAvailable:  true,
+			expectedClaims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "machine", VolumeId: common.String("volume")},
+			},
+		},
+		{
+			name: "lowest free index in the availability domain attached",
+			persistentVolumes: []infrastructurev1beta2.PersistentVolume{{
+				Name: "data", KeyBy: infrastructurev1beta2.PersistentVolumeKeyMachineIndex,
+				AttachmentType: infrastructurev1beta2.ParavirtualizedType, Device: common.String("/dev/oracleoci/oraclevdb"),
+			}},
+			objects: []client.Object{
+				persistentVolumeTestOCIMachine("machine-1", infrastructurev1beta2.PersistentVolumeStatus{
+					Name: "data", Key: "0", VolumeId: common.String("volume-0"),
+				}),
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				volume := persistentVolumeTestVolume("volume-2", "2", "ad-1")
+				volume.CompartmentId = common.String("test-compartment")
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(instanceAttachments)).
+					Return(core.ListVolumeAttachmentsResponse{}, nil)
+				blockStorageClient.EXPECT().ListVolumes(gomock.Any(), gomock.Eq(listVolumes)).
+					Return(core.ListVolumesResponse{Items: []core.Volume{
+						persistentVolumeTestVolume("volume-0", "0", "ad-1"),
+						persistentVolumeTestVolume("volume-1", "1", "ad-2"),
+						volume,
+					}}, nil)
+				blockStorageClient.EXPECT().GetVolume(gomock.Any(), gomock.Eq(core.GetVolumeRequest{VolumeId: common.String("volume-2")})).
+					Return(core.GetVolumeResponse{Volume: volume}, nil)
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(volumeAttachments("volume-2"))).
+					Return(core.ListVolumeAttachmentsResponse{}, nil)
+				computeClient.EXPECT().AttachVolume(gomock.Any(), gomock.Eq(core.AttachVolumeRequest{
+					AttachVolumeDetails: core.AttachParavirtualizedVolumeDetails{
+						InstanceId:                     common.String("instance"),
+						VolumeId:                       common.String("volume-2"),
+						Device:                         common.String("/dev/oracleoci/oraclevdb"),
+						DisplayName:                    common.String("data"),
+						IsPvEncryptionInTransitEnabled: common.Bool(false),
+					},
+					OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s", "instance", "volume-2"),
+				})).Return(core.AttachVolumeResponse{}, nil)
+			},
+			errorExpected: true,
+			notAvailable:  true,
+			expectedClaims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "2", VolumeId: common.String("volume-2")},
+			},
+		},
+		{
+			name: "volume created with the next index",
+			persistentVolumes: []infrastructurev1beta2.PersistentVolume{{
+				Name: "data", KeyBy: infrastructurev1beta2.PersistentVolumeKeyMachineIndex,
+			}},
+			objects: []client.Object{
+				persistentVolumeTestOCIMachine("machine-1", infrastructurev1beta2.PersistentVolumeStatus{
+					Name: "data", Key: "0", VolumeId: common.String("volume-0"),
+				}),
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(instanceAttachments)).
+					Return(core.ListVolumeAttachmentsResponse{}, nil)
+				blockStorageClient.EXPECT().ListVolumes(gomock.Any(), gomock.Eq(listVolumes)).
+					Return(core.ListVolumesResponse{Items: []core.Volume{
+						persistentVolumeTestVolume("volume-0", "0", "ad-1"),
+						persistentVolumeTestVolume("volume-2", "2", "ad-2"),
+					}}, nil)
+				blockStorageClient.EXPECT().CreateVolume(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req core.CreateVolumeRequest) (core.CreateVolumeResponse, error) {
+						if req.FreeformTags[PersistentVolumeKeyTag] != "1" {
+							return core.CreateVolumeResponse{}, errors.New("unexpected key")
+						}
+						return core.CreateVolumeResponse{Volume: core.Volume{Id: common.String("volume-1")}}, nil
+					})
+				blockStorageClient.EXPECT().GetVolume(gomock.Any(), gomock.Eq(core.GetVolumeRequest{VolumeId: common.String("volume-1")})).
+					Return(core.GetVolumeResponse{Volume: core.Volume{
+						Id:             common.String("volume-1"),
+						LifecycleState: core.VolumeLifecycleStateProvisioning,
+					}}, nil)
+			},
+			errorExpected: true,
+			notAvailable:  true,
+			expectedClaims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "1", VolumeId: common.String("volume-1")},
+			},
+		},
+		{
+			name: "volume claimed by another machine is released",
+			persistentVolumes: []infrastructurev1beta2.PersistentVolume{{
+				Name: "data", KeyBy: infrastructurev1beta2.PersistentVolumeKeyMachineIndex,
+			}},
+			claims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "0", VolumeId: common.String("volume-0")},
+			},
+			objects: []client.Object{
+				persistentVolumeTestOCIMachine("machine-1", infrastructurev1beta2.PersistentVolumeStatus{
+					Name: "data", Key: "0", VolumeId: common.String("volume-0"),
+				}),
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				volume := persistentVolumeTestVolume("volume-0", "0", "ad-1")
+				volume.CompartmentId = common.String("test-compartment")
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(instanceAttachments)).
+					Return(core.ListVolumeAttachmentsResponse{}, nil)
+				blockStorageClient.EXPECT().GetVolume(gomock.Any(), gomock.Any()).
+					Return(core.GetVolumeResponse{Volume: volume}, nil)
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(volumeAttachments("volume-0"))).
+					Return(core.ListVolumeAttachmentsResponse{Items: []core.VolumeAttachment{
+						persistentVolumeTestAttachment("volume-0", "other-instance", core.VolumeAttachmentLifecycleStateAttached),
+					}}, nil)
+			},
+			errorExpected: true,
+			notAvailable:  true,
+		},
+		{
+			name: "volume attached",
+			persistentVolumes: []infrastructurev1beta2.PersistentVolume{{
+				Name: "data", KeyBy: infrastructurev1beta2.PersistentVolumeKeyMachineName,
+			}},
+			claims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "machine", VolumeId: common.String("volume")},
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				volume := persistentVolumeTestVolume("volume", "machine", "ad-1")
+				volume.CompartmentId = common.String("test-compartment")
+				attachments := core.ListVolumeAttachmentsResponse{Items: []core.VolumeAttachment{
+					persistentVolumeTestAttachment("volume", "instance", core.VolumeAttachmentLifecycleStateAttached),
+				}}
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(instanceAttachments)).Return(attachments, nil)
+				blockStorageClient.EXPECT().GetVolume(gomock.Any(), gomock.Any()).
+					Return(core.GetVolumeResponse{Volume: volume}, nil)
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(volumeAttachments("volume"))).Return(attachments, nil)
+			},
+			expectedClaims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "machine", VolumeId: common.String("volume")},
+			},
+		},
+		{
+			name: "volume of a removed persistent volume detached",
+			claims: []infrastructurev1beta2.PersistentVolumeStatus{
+				{Name: "data", Key: "machine", VolumeId: common.String("volume")},
+			},
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(instanceAttachments)).
+					Return(core.ListVolumeAttachmentsResponse{Items: []core.VolumeAttachment{
+						persistentVolumeTestAttachment("volume", "instance", core.VolumeAttachmentLifecycleStateAttached),
+					}}, nil)
+				computeClient.EXPECT().DetachVolume(gomock.Any(), gomock.Eq(core.DetachVolumeRequest{
+					VolumeAttachmentId: common.String("volume-attachment"),
+				})).Return(core.DetachVolumeResponse{}, nil)
+			},
+		},
+		{
+			name: "volume can not be created",
+			persistentVolumes: []infrastructurev1beta2.PersistentVolume{{
+				Name: "data", KeyBy: infrastructurev1beta2.PersistentVolumeKeyMachineName,
+			}},
+			setup: func(computeClient *mock_compute.MockComputeClient, blockStorageClient *mock_blockstorage.MockClient) {
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Any()).
+					Return(core.ListVolumeAttachmentsResponse{}, nil)
+				blockStorageClient.EXPECT().ListVolumes(gomock.Any(), gomock.Any()).
+					Return(core.ListVolumesResponse{}, nil)
+				blockStorageClient.EXPECT().CreateVolume(gomock.Any(), gomock.Any()).
+					Return(core.CreateVolumeResponse{}, errors.New("request failed"))
+			},
+			errorExpected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			computeClient := mock_compute.NewMockComputeClient(mockCtrl)
+			blockStorageClient := mock_blockstorage.NewMockClient(mockCtrl)
+			tc.setup(computeClient, blockStorageClient)
+			log := klogr.New()
+			ms := &MachineScope{
+				Logger:  &log,
+				Client:  fake.NewClientBuilder().WithObjects(tc.objects...).Build(),
+				Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}},
+				Machine: &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"}},
+				OCIMachine: &infrastructurev1beta2.OCIMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default", UID: "machine-uid"},
+					Spec: infrastructurev1beta2.OCIMachineSpec{
+						CompartmentId:     "test-compartment",
+						PersistentVolumes: tc.persistentVolumes,
+					},
+					Status: infrastructurev1beta2.OCIMachineStatus{PersistentVolumes: tc.claims},
+				},
+				ComputeClient:      computeClient,
+				BlockStorageClient: blockStorageClient,
+				OCIClusterAccessor: OCISelfManagedCluster{OCICluster: &infrastructurev1beta2.OCICluster{
+					Spec: infrastructurev1beta2.OCIClusterSpec{OCIResourceIdentifier: "resource-uid"},
+				}},
+			}
+
+			err := ms.ReconcilePersistentVolumes(context.Background(), instance)
+			if tc.errorExpected {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(errors.Is(err, ErrPersistentVolumeNotAvailable)).To(Equal(tc.notAvailable))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(ms.OCIMachine.Status.PersistentVolumes).To(Equal(tc.expectedClaims))
+		})
+	}
+}
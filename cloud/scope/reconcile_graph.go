@@ -0,0 +1,183 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/plan"
+	"github.com/pkg/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// GraphNode is a step of a ReconcileGraph. The node is run once all the nodes it depends on have completed
+// successfully.
+type GraphNode struct {
+	// Name identifies the node in the graph.
+	Name string
+	// DependsOn are the names of the nodes which have to complete before this node is run.
+	DependsOn []string
+	// Run reconciles or deletes the resources of the node.
+	Run func(ctx context.Context) error
+	// Condition is marked true on the cluster when the node completes successfully and false when it fails.
+	// No condition is marked if it is empty.
+	Condition clusterv1.ConditionType
+	// FailedReason is the reason of the condition when the node fails.
+	FailedReason string
+}
+
+// GraphNodeError is returned by ReconcileGraph.Execute when a node of the graph fails.
+type GraphNodeError struct {
+	Node GraphNode
+	Err  error
+}
+
+func (e *GraphNodeError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Node.Name, e.Err.Error())
+}
+
+func (e *GraphNodeError) Unwrap() error {
+	return e.Err
+}
+
+// ReconcileGraph runs independent reconciliation steps concurrently while respecting the dependencies between
+// them.
+type ReconcileGraph struct {
+	nodes          []GraphNode
+	dependents     [][]int
+	maxConcurrency int
+}
+
+// NewReconcileGraph creates a ReconcileGraph which runs at most maxConcurrency nodes at the same time. A node
+// must be declared after the nodes it depends on, which rules out cycles and makes the graph run the nodes in
+// the declared order if maxConcurrency is 1.
+func NewReconcileGraph(maxConcurrency int, nodes ...GraphNode) (*ReconcileGraph, error) {
+	if maxConcurrency < 1 {
+		maxConcurrency = 1
+	}
+	index := make(map[string]int)
+	dependents := make([][]int, len(nodes))
+	for i, node := range nodes {
+		if _, ok := index[node.Name]; ok {
+			return nil, errors.Errorf("duplicate node %s", node.Name)
+		}
+		for _, dependency := range node.DependsOn {
+			j, ok := index[dependency]
+			if !ok {
+				return nil, errors.Errorf("node %s depends on %s, which is not declared before it", node.Name, dependency)
+			}
+			dependents[j] = append(dependents[j], i)
+		}
+		index[node.Name] = i
+	}
+	return &ReconcileGraph{
+		nodes:          nodes,
+		dependents:     dependents,
+		maxConcurrency: maxConcurrency,
+	}, nil
+}
+
+type graphNodeResult struct {
+	index int
+	err   error
+}
+
+// Execute runs the nodes of the graph. Once a node fails no further node is started, the running nodes are
+// waited for, and the error of the failed node declared first is returned as a GraphNodeError. The
+// conditions of the nodes which have run are marked on the setter after all the nodes have stopped, as
+// the nodes may update the same object concurrently. In plan mode, a node which stops at a planned operation
+// does not fail the graph, the nodes which depend on it are not run and its condition is left as it is.
+func (g *ReconcileGraph) Execute(ctx context.Context, setter conditions.Setter) error {
+	remaining := make([]int, len(g.nodes))
+	var ready []int
+	for i, node := range g.nodes {
+		remaining[i] = len(node.DependsOn)
+		if remaining[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	results := make(map[int]error)
+	done := make(chan graphNodeResult)
+	running := 0
+	failed := false
+	for {
+		for !failed && running < g.maxConcurrency && len(ready) > 0 {
+			i := ready[0]
+			ready = ready[1:]
+			running++
+			go func(i int) {
+				done <- graphNodeResult{index: i, err: g.run(ctx, g.nodes[i])}
+			}(i)
+		}
+		if running == 0 {
+			break
+		}
+		result := <-done
+		running--
+		results[result.index] = result.err
+		if result.err != nil {
+			if !plan.IsPlanned(result.err) {
+				failed = true
+			}
+			continue
+		}
+		for _, dependent := range g.dependents[result.index] {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+		sort.Ints(ready)
+	}
+
+	var nodeErr error
+	for i, node := range g.nodes {
+		err, ok := results[i]
+		if !ok || plan.IsPlanned(err) {
+			continue
+		}
+		if err != nil && nodeErr == nil {
+			nodeErr = &GraphNodeError{Node: node, Err: err}
+		}
+		if node.Condition == "" {
+			continue
+		}
+		if err != nil {
+			conditions.MarkFalse(setter, node.Condition, node.FailedReason, clusterv1.ConditionSeverityError, "%s",
+				ociutil.ConditionMessage(err))
+		} else {
+			conditions.MarkTrue(setter, node.Condition)
+		}
+	}
+	return nodeErr
+}
+
+// run runs a node and turns a panic of the node into an error, as a panic in a goroutine other than the one
+// of the reconciler would not be recovered by controller-runtime.
+func (g *ReconcileGraph) run(ctx context.Context, node GraphNode) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic: %v", r)
+		}
+	}()
+	return node.Run(ctx)
+}
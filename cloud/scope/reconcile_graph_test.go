@@ -0,0 +1,226 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/plan"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileGraph(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		ran []string
+	)
+	record := func(name string, err error) func(context.Context) error {
+		return func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			ran = append(ran, name)
+			return err
+		}
+	}
+
+	tests := []struct {
+		name              string
+		maxConcurrency    int
+		nodes             func() []GraphNode
+		graphErrorMatch   string
+		errorMatch        string
+		failedNode        string
+		expectedRan       []string
+		expectedTrue      []clusterv1.ConditionType
+		expectedFalse     []clusterv1.ConditionType
+		expectedUntouched []clusterv1.ConditionType
+		expectedMessage   string
+	}{
+		{
+			name:           "nodes run in the declared order without concurrency",
+			maxConcurrency: 1,
+			nodes: func() []GraphNode {
+				return []GraphNode{
+					{Name: "drg", Run: record("drg", nil), Condition: infrastructurev1beta2.DRGReadyCondition},
+					{Name: "vcn", Run: record("vcn", nil), Condition: infrastructurev1beta2.VCNReadyCondition},
+					{Name: "igw", Run: record("igw", nil), DependsOn: []string{"vcn"}},
+					{Name: "subnet", Run: record("subnet", nil), DependsOn: []string{"igw"}, Condition: infrastructurev1beta2.SubnetsReadyCondition},
+				}
+			},
+			expectedRan:  []string{"drg", "vcn", "igw", "subnet"},
+			expectedTrue: []clusterv1.ConditionType{infrastructurev1beta2.DRGReadyCondition, infrastructurev1beta2.VCNReadyCondition, infrastructurev1beta2.SubnetsReadyCondition},
+		},
+		{
+			name:           "failed node stops the dependent nodes",
+			maxConcurrency: 1,
+			nodes: func() []GraphNode {
+				return []GraphNode{
+					{Name: "vcn", Run: record("vcn", nil), Condition: infrastructurev1beta2.VCNReadyCondition},
+					{Name: "nsg", Run: record("nsg", errors.New("some error")), DependsOn: []string{"vcn"},
+						Condition: infrastructurev1beta2.NSGsReadyCondition, FailedReason: infrastructurev1beta2.NSGReconciliationFailedReason},
+					{Name: "subnet", Run: record("subnet", nil), DependsOn: []string{"vcn"}, Condition: infrastructurev1beta2.SubnetsReadyCondition},
+				}
+			},
+			errorMatch:        "nsg: some error",
+			failedNode:        "nsg",
+			expectedRan:       []string{"vcn", "nsg"},
+			expectedTrue:      []clusterv1.ConditionType{infrastructurev1beta2.VCNReadyCondition},
+			expectedFalse:     []clusterv1.ConditionType{infrastructurev1beta2.NSGsReadyCondition},
+			expectedUntouched: []clusterv1.ConditionType{infrastructurev1beta2.SubnetsReadyCondition},
+			expectedMessage:   "some error",
+		},
+		{
+			name:           "planned node stops the dependent nodes without failing the graph",
+			maxConcurrency: 1,
+			nodes: func() []GraphNode {
+				return []GraphNode{
+					{Name: "vcn", Run: record("vcn", &plan.PlannedError{}), Condition: infrastructurev1beta2.VCNReadyCondition},
+					{Name: "drg", Run: record("drg", nil), Condition: infrastructurev1beta2.DRGReadyCondition},
+					{Name: "subnet", Run: record("subnet", nil), DependsOn: []string{"vcn"}, Condition: infrastructurev1beta2.SubnetsReadyCondition},
+				}
+			},
+			expectedRan:       []string{"vcn", "drg"},
+			expectedTrue:      []clusterv1.ConditionType{infrastructurev1beta2.DRGReadyCondition},
+			expectedUntouched: []clusterv1.ConditionType{infrastructurev1beta2.VCNReadyCondition, infrastructurev1beta2.SubnetsReadyCondition},
+		},
+		{
+			name:           "panic is returned as an error",
+			maxConcurrency: 2,
+			nodes: func() []GraphNode {
+				return []GraphNode{
+					{Name: "vcn", Run: func(ctx context.Context) error {
+						panic("unexpected")
+					}, Condition: infrastructurev1beta2.VCNReadyCondition, FailedReason: infrastructurev1beta2.VcnReconciliationFailedReason},
+				}
+			},
+			errorMatch:    "vcn: panic: unexpected",
+			failedNode:    "vcn",
+			expectedFalse: []clusterv1.ConditionType{infrastructurev1beta2.VCNReadyCondition},
+		},
+		{
+			name:           "unknown dependency",
+			maxConcurrency: 1,
+			nodes: func() []GraphNode {
+				return []GraphNode{
+					{Name: "subnet", Run: record("subnet", nil), DependsOn: []string{"vcn"}},
+					{Name: "vcn", Run: record("vcn", nil)},
+				}
+			},
+			graphErrorMatch: "node subnet depends on vcn, which is not declared before it",
+		},
+		{
+			name:           "duplicate node",
+			maxConcurrency: 1,
+			nodes: func() []GraphNode {
+				return []GraphNode{
+					{Name: "vcn", Run: record("vcn", nil)},
+					{Name: "vcn", Run: record("vcn", nil)},
+				}
+			},
+			graphErrorMatch: "duplicate node vcn",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ran = nil
+			cluster := &infrastructurev1beta2.OCICluster{}
+			graph, err := NewReconcileGraph(tc.maxConcurrency, tc.nodes()...)
+			if tc.graphErrorMatch != "" {
+				g.Expect(err).To(Not(BeNil()))
+				g.Expect(err.Error()).To(Equal(tc.graphErrorMatch))
+				return
+			}
+			g.Expect(err).To(BeNil())
+
+			err = graph.Execute(context.Background(), cluster)
+			if tc.errorMatch != "" {
+				g.Expect(err).To(Not(BeNil()))
+				g.Expect(err.Error()).To(Equal(tc.errorMatch))
+				var nodeErr *GraphNodeError
+				g.Expect(errors.As(err, &nodeErr)).To(BeTrue())
+				g.Expect(nodeErr.Node.Name).To(Equal(tc.failedNode))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(ran).To(Equal(tc.expectedRan))
+			for _, condition := range tc.expectedTrue {
+				g.Expect(conditions.IsTrue(cluster, condition)).To(BeTrue())
+			}
+			for _, condition := range tc.expectedFalse {
+				actual := conditions.Get(cluster, condition)
+				g.Expect(actual).To(Not(BeNil()))
+				g.Expect(actual.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(actual.Severity).To(Equal(clusterv1.ConditionSeverityError))
+				if tc.expectedMessage != "" {
+					g.Expect(actual.Message).To(Equal(tc.expectedMessage))
+				}
+			}
+			for _, condition := range tc.expectedUntouched {
+				g.Expect(conditions.Get(cluster, condition)).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestReconcileGraph_Concurrency(t *testing.T) {
+	g := NewWithT(t)
+	vcnDone := false
+	// the gateways only complete once all of them are running, which requires them to run concurrently
+	var started sync.WaitGroup
+	started.Add(3)
+	gateway := func(ctx context.Context) error {
+		started.Done()
+		waited := make(chan struct{})
+		go func() {
+			started.Wait()
+			close(waited)
+		}()
+		select {
+		case <-waited:
+			return nil
+		case <-time.After(10 * time.Second):
+			return errors.New("gateways did not run concurrently")
+		}
+	}
+	graph, err := NewReconcileGraph(3,
+		GraphNode{Name: "vcn", Run: func(ctx context.Context) error {
+			vcnDone = true
+			return nil
+		}},
+		GraphNode{Name: "igw", Run: gateway, DependsOn: []string{"vcn"}},
+		GraphNode{Name: "nat", Run: gateway, DependsOn: []string{"vcn"}},
+		GraphNode{Name: "sgw", Run: gateway, DependsOn: []string{"vcn"}},
+		GraphNode{Name: "route table", Run: func(ctx context.Context) error {
+			if !vcnDone {
+				return errors.New("vcn is not ready")
+			}
+			return nil
+		}, DependsOn: []string{"igw", "nat", "sgw"}},
+	)
+	g.Expect(err).To(BeNil())
+	g.Expect(graph.Execute(context.Background(), &infrastructurev1beta2.OCICluster{})).To(BeNil())
+}
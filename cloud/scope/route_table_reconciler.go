@@ -28,6 +28,16 @@ import (
 	"github.com/pkg/errors"
 )
 
+// The descriptions of the route rules added by Cluster API. The route rules with another description were added
+// outside of Cluster API and are kept when the drift of the route table is corrected.
+const (
+	routeRuleDescriptionNATGateway      = "traffic to the internet"
+	routeRuleDescriptionServiceGateway  = "traffic to OCI services"
+	routeRuleDescriptionInternetGateway = "traffic to/from internet"
+	routeRuleDescriptionPeerDRG         = "traffic to peer DRG"
+	routeRuleDescriptionVPN             = "traffic to on-premises network"
+)
+
 func (s *ClusterScope) ReconcileRouteTable(ctx context.Context) error {
 	if s.OCIClusterAccessor.GetNetworkSpec().Vcn.RouteTable.Skip {
 		s.Logger.Info("Skipping Route table reconciliation as per spec")
@@ -41,8 +51,21 @@ func (s *ClusterScope) ReconcileRouteTable(ctx context.Context) error {
 		}
 		if routeTable != nil {
 			routeTableOCID := routeTable.Id
-			s.setRTStatus(routeTableOCID, rt)
-			s.Logger.Info("No Reconciliation Required for Route Table", "route-table", routeTableOCID)
+			s.setRTStatus(routeTableOCID, rt, string(routeTable.LifecycleState))
+			if err := s.reconcileRouteTableTags(ctx, routeTable); err != nil {
+				return err
+			}
+			drift := s.routeTableDrift(routeTable, rt)
+			if len(drift) == 0 {
+				s.Logger.Info("No Reconciliation Required for Route Table", "route-table", routeTableOCID)
+				continue
+			}
+			err = s.reconcileDrift(DriftResourceRouteTable, getRouteTableName(rt), routeTableOCID, drift, func() error {
+				return s.updateRouteTable(ctx, routeTable, rt)
+			})
+			if err != nil {
+				return err
+			}
 			continue
 		}
 
@@ -52,7 +75,7 @@ func (s *ClusterScope) ReconcileRouteTable(ctx context.Context) error {
 			return err
 		}
 		s.Logger.Info("Created the route table", "route-table", rtId)
-		s.setRTStatus(rtId, rt)
+		s.setRTStatus(rtId, rt, "")
 	}
 	return nil
 }
@@ -82,7 +105,7 @@ func (s *ClusterScope) getRouteTable(ctx context.Context, routeTableType string)
 			return nil, err
 		}
 		rt := resp.RouteTable
-		if s.IsResourceCreatedByClusterAPI(rt.FreeformTags) {
+		if s.IsNetworkResourceCreatedByClusterAPI(rt.FreeformTags) {
 			return &rt, nil
 		} else {
 			return nil, errors.New("cluster api tags have been modified out of context")
@@ -95,7 +118,7 @@ func (s *ClusterScope) getRouteTable(ctx context.Context, routeTableType string)
 		routeTableName = PrivateRouteTableName
 	}
 	rts, err := s.VCNClient.ListRouteTables(ctx, core.ListRouteTablesRequest{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNetworkCompartmentId()),
 		VcnId:         vcId,
 		DisplayName:   common.String(routeTableName),
 	})
@@ -104,7 +127,7 @@ func (s *ClusterScope) getRouteTable(ctx context.Context, routeTableType string)
 		return nil, errors.Wrap(err, "failed to list route tables")
 	}
 	for _, rt := range rts.Items {
-		if s.IsResourceCreatedByClusterAPI(rt.FreeformTags) {
+		if s.IsNetworkResourceCreatedByClusterAPI(rt.FreeformTags) {
 			return &rt, nil
 		}
 	}
@@ -112,73 +135,171 @@ func (s *ClusterScope) getRouteTable(ctx context.Context, routeTableType string)
 }
 
 func (s *ClusterScope) CreateRouteTable(ctx context.Context, routeTableType string) (*string, error) {
+	vcnId := s.getVcnId()
+	routeTableDetails := core.CreateRouteTableDetails{
+		VcnId:         vcnId,
+		CompartmentId: common.String(s.GetNetworkCompartmentId()),
+		DisplayName:   common.String(getRouteTableName(routeTableType)),
+		RouteRules:    s.getRouteRules(routeTableType),
+		FreeformTags:  s.GetNetworkFreeFormTags(),
+		DefinedTags:   s.GetDefinedTags(),
+	}
+	routeTableResponse, err := s.VCNClient.CreateRouteTable(ctx, core.CreateRouteTableRequest{
+		CreateRouteTableDetails: routeTableDetails,
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed create route table")
+		return nil, errors.Wrap(err, "failed create route table")
+	}
+	s.Logger.Info("successfully created the route table", "route-table", *routeTableResponse.Id)
+	return routeTableResponse.Id, nil
+}
+
+// updateRouteTable replaces the route rules owned by Cluster API with the route rules of the spec, the route rules
+// added outside of Cluster API are kept.
+func (s *ClusterScope) updateRouteTable(ctx context.Context, routeTable *core.RouteTable, routeTableType string) error {
+	desiredRules := s.getRouteRules(routeTableType)
+	var routeRules []core.RouteRule
+	for _, rule := range routeTable.RouteRules {
+		if !isRouteRuleOwned(rule, desiredRules) {
+			routeRules = append(routeRules, rule)
+		}
+	}
+	routeRules = append(routeRules, desiredRules...)
+	_, err := s.VCNClient.UpdateRouteTable(ctx, core.UpdateRouteTableRequest{
+		RtId: routeTable.Id,
+		UpdateRouteTableDetails: core.UpdateRouteTableDetails{
+			RouteRules: routeRules,
+		},
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to reconcile the route table, failed to update")
+		return errors.Wrap(err, "failed to reconcile the route table, failed to update")
+	}
+	s.Logger.Info("successfully updated the route table", "route-table", *routeTable.Id)
+	return nil
+}
+
+// routeTableDrift returns the route rules missing from the route table and the route rules owned by Cluster API
+// which are not in the spec. The route rules are compared by destination and target, as OCI fills in other fields.
+func (s *ClusterScope) routeTableDrift(actual *core.RouteTable, routeTableType string) driftDiff {
+	var drift driftDiff
+	desiredRules := s.getRouteRules(routeTableType)
+	for _, rule := range desiredRules {
+		if !containsRouteRule(actual.RouteRules, rule) {
+			drift.missing("routeRules", routeRuleString(rule))
+		}
+	}
+	for _, rule := range actual.RouteRules {
+		if isRouteRuleOwned(rule, desiredRules) && !containsRouteRule(desiredRules, rule) {
+			drift.unexpected("routeRules", routeRuleString(rule))
+		}
+	}
+	return drift
+}
+
+// isRouteRuleOwned returns true if the route rule was added by Cluster API, or if it has the destination of a route
+// rule of the spec and hence replaced it.
+func isRouteRuleOwned(rule core.RouteRule, desiredRules []core.RouteRule) bool {
+	switch ociutil.DerefString(rule.Description) {
+	case routeRuleDescriptionNATGateway, routeRuleDescriptionServiceGateway, routeRuleDescriptionInternetGateway,
+		routeRuleDescriptionPeerDRG, routeRuleDescriptionVPN:
+		return true
+	}
+	for _, desired := range desiredRules {
+		if ociutil.DerefString(desired.Destination) == ociutil.DerefString(rule.Destination) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsRouteRule(rules []core.RouteRule, rule core.RouteRule) bool {
+	for _, r := range rules {
+		if routeRuleString(r) == routeRuleString(rule) {
+			return true
+		}
+	}
+	return false
+}
+
+func routeRuleString(rule core.RouteRule) string {
+	return fmt.Sprintf("%s (%s) via %s", ociutil.DerefString(rule.Destination), rule.DestinationType,
+		ociutil.DerefString(rule.NetworkEntityId))
+}
+
+func getRouteTableName(routeTableType string) string {
+	if routeTableType == infrastructurev1beta2.Private {
+		return PrivateRouteTableName
+	}
+	return PublicRouteTableName
+}
+
+// getRouteRules returns the route rules of the route table in the spec.
+func (s *ClusterScope) getRouteRules(routeTableType string) []core.RouteRule {
 	var routeRules []core.RouteRule
-	var routeTableName string
 	if routeTableType == infrastructurev1beta2.Private {
 		routeRules = []core.RouteRule{
 			{
 				DestinationType: core.RouteRuleDestinationTypeCidrBlock,
 				Destination:     common.String("0.0.0.0/0"),
 				NetworkEntityId: s.OCIClusterAccessor.GetNetworkSpec().Vcn.NATGateway.Id,
-				Description:     common.String("traffic to the internet"),
+				Description:     common.String(routeRuleDescriptionNATGateway),
 			},
 			{
 				DestinationType: core.RouteRuleDestinationTypeServiceCidrBlock,
 				Destination:     common.String(fmt.Sprintf("all-%s-services-in-oracle-services-network", strings.ToLower(s.RegionKey))),
 				NetworkEntityId: s.OCIClusterAccessor.GetNetworkSpec().Vcn.ServiceGateway.Id,
-				Description:     common.String("traffic to OCI services"),
+				Description:     common.String(routeRuleDescriptionServiceGateway),
 			},
 		}
+		// the route tables of a shared network do not route to the DRG of a single cluster
 		vcnPeering := s.OCIClusterAccessor.GetNetworkSpec().VCNPeering
-		if vcnPeering != nil {
+		if vcnPeering != nil && !s.IsNetworkShared() {
 			for _, routeRule := range vcnPeering.PeerRouteRules {
 				routeRules = append(routeRules, core.RouteRule{
 					DestinationType: core.RouteRuleDestinationTypeCidrBlock,
 					Destination:     common.String(routeRule.VCNCIDRRange),
 					NetworkEntityId: s.getDrgID(),
-					Description:     common.String("traffic to peer DRG"),
+					Description:     common.String(routeRuleDescriptionPeerDRG),
 				})
 			}
+			if vcnPeering.VPN != nil {
+				for _, staticRoute := range vcnPeering.VPN.StaticRoutes {
+					routeRules = append(routeRules, core.RouteRule{
+						DestinationType: core.RouteRuleDestinationTypeCidrBlock,
+						Destination:     common.String(staticRoute),
+						NetworkEntityId: s.getDrgID(),
+						Description:     common.String(routeRuleDescriptionVPN),
+					})
+				}
+			}
 		}
-
-		routeTableName = PrivateRouteTableName
 	} else {
 		routeRules = []core.RouteRule{
 			{
 				DestinationType: core.RouteRuleDestinationTypeCidrBlock,
 				Destination:     common.String("0.0.0.0/0"),
 				NetworkEntityId: s.OCIClusterAccessor.GetNetworkSpec().Vcn.InternetGateway.Id,
-				Description:     common.String("traffic to/from internet"),
+				Description:     common.String(routeRuleDescriptionInternetGateway),
 			},
 		}
-		routeTableName = PublicRouteTableName
 	}
-	vcnId := s.getVcnId()
-	routeTableDetails := core.CreateRouteTableDetails{
-		VcnId:         vcnId,
-		CompartmentId: common.String(s.GetCompartmentId()),
-		DisplayName:   common.String(routeTableName),
-		RouteRules:    routeRules,
-		FreeformTags:  s.GetFreeFormTags(),
-		DefinedTags:   s.GetDefinedTags(),
-	}
-	routeTableResponse, err := s.VCNClient.CreateRouteTable(ctx, core.CreateRouteTableRequest{
-		CreateRouteTableDetails: routeTableDetails,
-	})
-	if err != nil {
-		s.Logger.Error(err, "failed create route table")
-		return nil, errors.Wrap(err, "failed create route table")
-	}
-	s.Logger.Info("successfully created the route table", "route-table", *routeTableResponse.Id)
-	return routeTableResponse.Id, nil
+	return routeRules
 }
 
-func (s *ClusterScope) setRTStatus(id *string, routeTableType string) {
+func (s *ClusterScope) setRTStatus(id *string, routeTableType string, lifecycleState string) {
 	if routeTableType == infrastructurev1beta2.Private {
 		s.OCIClusterAccessor.GetNetworkSpec().Vcn.RouteTable.PrivateRouteTableId = id
+		s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+			status.PrivateRouteTable = newNetworkResourceStatus(id, lifecycleState)
+		})
 		return
 	}
 	s.OCIClusterAccessor.GetNetworkSpec().Vcn.RouteTable.PublicRouteTableId = id
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		status.PublicRouteTable = newNetworkResourceStatus(id, lifecycleState)
+	})
 }
 
 func (s *ClusterScope) DeleteRouteTables(ctx context.Context) error {
@@ -186,6 +307,14 @@ func (s *ClusterScope) DeleteRouteTables(ctx context.Context) error {
 		s.Logger.Info("Skipping Route table reconciliation as per spec")
 		return nil
 	}
+	inUse, err := s.IsSharedNetworkInUse(ctx)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		s.Logger.Info("Shared VCN is used by other clusters, skipping deletion of Route Tables")
+		return nil
+	}
 	desiredRouteTables := s.GetDesiredRouteTables()
 	for _, routeTable := range desiredRouteTables {
 		rt, err := s.getRouteTable(ctx, routeTable)
@@ -196,6 +325,12 @@ func (s *ClusterScope) DeleteRouteTables(ctx context.Context) error {
 			s.Logger.Info("Route Table is already deleted", "rt", routeTable)
 			continue
 		}
+		if s.isVCNRetained() {
+			if err := s.retainRouteTable(ctx, rt); err != nil {
+				return err
+			}
+			continue
+		}
 		_, err = s.VCNClient.DeleteRouteTable(ctx, core.DeleteRouteTableRequest{
 			RtId: rt.Id,
 		})
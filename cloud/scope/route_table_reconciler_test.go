@@ -96,6 +96,7 @@ func TestClusterScope_ReconcileRouteTable(t *testing.T) {
 				Id:           common.String("private"),
 				FreeformTags: tags,
 				DefinedTags:  definedTagsInterface,
+				RouteRules:   privateRouteRules,
 			},
 		}, nil).Times(2)
 
@@ -107,6 +108,7 @@ func TestClusterScope_ReconcileRouteTable(t *testing.T) {
 				Id:           common.String("public"),
 				FreeformTags: tags,
 				DefinedTags:  definedTagsInterface,
+				RouteRules:   publicRoutingRules,
 			},
 		}, nil)
 
@@ -115,6 +117,15 @@ func TestClusterScope_ReconcileRouteTable(t *testing.T) {
 		updatedTags[k] = v
 	}
 	updatedTags["foo"] = "bar"
+	vcnClient.EXPECT().UpdateRouteTable(gomock.Any(), gomock.Eq(core.UpdateRouteTableRequest{
+		RtId: common.String("private"),
+		UpdateRouteTableDetails: core.UpdateRouteTableDetails{
+			FreeformTags: updatedTags,
+			DefinedTags:  definedTagsInterface,
+		},
+	})).
+		Return(core.UpdateRouteTableResponse{}, nil)
+
 	vcnClient.EXPECT().ListRouteTables(gomock.Any(), gomock.Eq(core.ListRouteTablesRequest{
 		CompartmentId: common.String("foo"),
 		DisplayName:   common.String("private-route-table"),
@@ -124,7 +135,9 @@ func TestClusterScope_ReconcileRouteTable(t *testing.T) {
 			Items: []core.RouteTable{
 				{
 					Id:           common.String("rt_id"),
-					FreeformTags: tags,
+					FreeformTags: updatedTags,
+					DefinedTags:  definedTagsInterface,
+					RouteRules:   privateRouteRules,
 				},
 			}}, nil)
 
@@ -285,6 +298,15 @@ func TestClusterScope_ReconcileRouteTable(t *testing.T) {
 				DefinedTags: definedTags,
 				NetworkSpec: infrastructurev1beta2.NetworkSpec{
 					Vcn: infrastructurev1beta2.VCN{
+						InternetGateway: infrastructurev1beta2.InternetGateway{
+							Id: common.String("igw"),
+						},
+						ServiceGateway: infrastructurev1beta2.ServiceGateway{
+							Id: common.String("sgw"),
+						},
+						NATGateway: infrastructurev1beta2.NATGateway{
+							Id: common.String("ngw"),
+						},
 						RouteTable: infrastructurev1beta2.RouteTable{
 							PrivateRouteTableId: common.String("private"),
 							PublicRouteTableId:  common.String("public"),
@@ -316,6 +338,12 @@ func TestClusterScope_ReconcileRouteTable(t *testing.T) {
 						RouteTable: infrastructurev1beta2.RouteTable{
 							PrivateRouteTableId: common.String("private"),
 						},
+						ServiceGateway: infrastructurev1beta2.ServiceGateway{
+							Id: common.String("sgw"),
+						},
+						NATGateway: infrastructurev1beta2.NATGateway{
+							Id: common.String("ngw"),
+						},
 					},
 				},
 			},
@@ -332,6 +360,12 @@ func TestClusterScope_ReconcileRouteTable(t *testing.T) {
 				NetworkSpec: infrastructurev1beta2.NetworkSpec{
 					Vcn: infrastructurev1beta2.VCN{
 						ID: common.String("vcn"),
+						ServiceGateway: infrastructurev1beta2.ServiceGateway{
+							Id: common.String("sgw"),
+						},
+						NATGateway: infrastructurev1beta2.NATGateway{
+							Id: common.String("ngw"),
+						},
 						Subnets: []*infrastructurev1beta2.Subnet{
 							{
 								Type: infrastructurev1beta2.Private,
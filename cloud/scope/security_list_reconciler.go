@@ -40,6 +40,12 @@ func (s *ClusterScope) DeleteSecurityLists(ctx context.Context) error {
 				s.Logger.Info("security list is already deleted", "securityList", desiredSubnet.SecurityList.Name)
 				continue
 			}
+			if s.isVCNRetained() {
+				if err := s.retainSecurityList(ctx, securityList); err != nil {
+					return err
+				}
+				continue
+			}
 			_, err = s.VCNClient.DeleteSecurityList(ctx, core.DeleteSecurityListRequest{
 				SecurityListId: securityList.Id,
 			})
@@ -70,7 +76,7 @@ func (s *ClusterScope) CreateSecurityList(ctx context.Context, secList infrastru
 	}
 	securityListDetails := core.CreateSecurityListDetails{
 		VcnId:                s.getVcnId(),
-		CompartmentId:        common.String(s.GetCompartmentId()),
+		CompartmentId:        common.String(s.GetNetworkCompartmentId()),
 		DisplayName:          common.String(secList.Name),
 		EgressSecurityRules:  egressRules,
 		IngressSecurityRules: ingressRules,
@@ -89,41 +95,63 @@ func (s *ClusterScope) CreateSecurityList(ctx context.Context, secList infrastru
 }
 
 func (s *ClusterScope) IsSecurityListEqual(actual core.SecurityList, desired infrastructurev1beta2.SecurityList) bool {
-	if *actual.DisplayName != desired.Name {
-		return false
+	return len(s.securityListDrift(actual, desired)) == 0
+}
+
+// securityListDrift returns the fields of the security list which differ from the spec, the rules missing from
+// the security list and the rules which are not in the spec.
+func (s *ClusterScope) securityListDrift(actual core.SecurityList, desired infrastructurev1beta2.SecurityList) driftDiff {
+	var drift driftDiff
+	drift.compare("displayName", desired.Name, actual.DisplayName)
+
+	var desiredIngressRules []core.IngressSecurityRule
+	for _, rule := range desired.IngressRules {
+		desiredIngressRules = append(desiredIngressRules, convertSecurityListIngressRule(rule))
 	}
-	if len(actual.IngressSecurityRules) != len(desired.IngressRules) {
-		return false
+	for _, rule := range desiredIngressRules {
+		if !containsIngressSecurityRule(actual.IngressSecurityRules, rule) {
+			drift.missing("ingressSecurityRules", rule)
+		}
 	}
-	if len(actual.EgressSecurityRules) != len(desired.EgressRules) {
-		return false
+	for _, rule := range actual.IngressSecurityRules {
+		if !containsIngressSecurityRule(desiredIngressRules, rule) {
+			drift.unexpected("ingressSecurityRules", rule)
+		}
 	}
-	for _, rule := range desired.IngressRules {
-		found := false
-		for _, existingRule := range actual.IngressSecurityRules {
-			if reflect.DeepEqual(existingRule, convertSecurityListIngressRule(rule)) {
-				found = true
-				break
-			}
+
+	var desiredEgressRules []core.EgressSecurityRule
+	for _, rule := range desired.EgressRules {
+		desiredEgressRules = append(desiredEgressRules, convertSecurityListEgressRule(rule))
+	}
+	for _, rule := range desiredEgressRules {
+		if !containsEgressSecurityRule(actual.EgressSecurityRules, rule) {
+			drift.missing("egressSecurityRules", rule)
 		}
-		if !found {
-			return false
+	}
+	for _, rule := range actual.EgressSecurityRules {
+		if !containsEgressSecurityRule(desiredEgressRules, rule) {
+			drift.unexpected("egressSecurityRules", rule)
 		}
 	}
+	return drift
+}
 
-	for _, rule := range desired.EgressRules {
-		found := false
-		for _, existingRule := range actual.EgressSecurityRules {
-			if reflect.DeepEqual(existingRule, convertSecurityListEgressRule(rule)) {
-				found = true
-				break
-			}
+func containsIngressSecurityRule(rules []core.IngressSecurityRule, rule core.IngressSecurityRule) bool {
+	for _, r := range rules {
+		if reflect.DeepEqual(r, rule) {
+			return true
 		}
-		if !found {
-			return false
+	}
+	return false
+}
+
+func containsEgressSecurityRule(rules []core.EgressSecurityRule, rule core.EgressSecurityRule) bool {
+	for _, r := range rules {
+		if reflect.DeepEqual(r, rule) {
+			return true
 		}
 	}
-	return true
+	return false
 }
 
 func (s *ClusterScope) UpdateSecurityList(ctx context.Context, securityListSpec infrastructurev1beta2.SecurityList) error {
@@ -272,7 +300,7 @@ func (s *ClusterScope) GetSecurityList(ctx context.Context, spec infrastructurev
 		}
 	}
 	securityLists, err := s.VCNClient.ListSecurityLists(ctx, core.ListSecurityListsRequest{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNetworkCompartmentId()),
 		VcnId:         s.getVcnId(),
 		DisplayName:   common.String(spec.Name),
 	})
@@ -20,6 +20,7 @@ import (
 	"context"
 	"strings"
 
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/core"
@@ -38,11 +39,39 @@ func (s *ClusterScope) ReconcileServiceGateway(ctx context.Context) error {
 	}
 	if sgw != nil {
 		s.OCIClusterAccessor.GetNetworkSpec().Vcn.ServiceGateway.Id = sgw.Id
-		s.Logger.Info("No Reconciliation Required for Service Gateway", "service_gateway", sgw.Id)
-		return nil
+		s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+			status.ServiceGateway = newNetworkResourceStatus(sgw.Id, string(sgw.LifecycleState))
+		})
+		if err := s.reconcileServiceGatewayTags(ctx, sgw); err != nil {
+			return err
+		}
+		var drift driftDiff
+		if sgw.BlockTraffic != nil && *sgw.BlockTraffic {
+			drift.compare("blockTraffic", "false", common.String("true"))
+		}
+		if len(drift) == 0 {
+			s.Logger.Info("No Reconciliation Required for Service Gateway", "service_gateway", sgw.Id)
+			return nil
+		}
+		return s.reconcileDrift(DriftResourceServiceGateway, ServiceGatewayName, sgw.Id, drift, func() error {
+			_, err := s.VCNClient.UpdateServiceGateway(ctx, core.UpdateServiceGatewayRequest{
+				ServiceGatewayId: sgw.Id,
+				UpdateServiceGatewayDetails: core.UpdateServiceGatewayDetails{
+					BlockTraffic: common.Bool(false),
+				},
+			})
+			if err != nil {
+				s.Logger.Error(err, "failed to reconcile the service gateway, failed to update")
+				return errors.Wrap(err, "failed to reconcile the service gateway, failed to update")
+			}
+			return nil
+		})
 	}
 	serviceGateway, err := s.CreateServiceGateway(ctx)
 	s.OCIClusterAccessor.GetNetworkSpec().Vcn.ServiceGateway.Id = serviceGateway
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		status.ServiceGateway = newNetworkResourceStatus(serviceGateway, "")
+	})
 	return err
 }
 
@@ -67,11 +96,11 @@ func (s *ClusterScope) CreateServiceGateway(ctx context.Context) (*string, error
 	}
 
 	sgwDetails := core.CreateServiceGatewayDetails{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNetworkCompartmentId()),
 		DisplayName:   common.String(ServiceGatewayName),
 		VcnId:         s.getVcnId(),
 		Services:      []core.ServiceIdRequestDetails{{ServiceId: common.String(serviceOcid)}},
-		FreeformTags:  s.GetFreeFormTags(),
+		FreeformTags:  s.GetNetworkFreeFormTags(),
 		DefinedTags:   s.GetDefinedTags(),
 	}
 	sgwResponse, err := s.VCNClient.CreateServiceGateway(ctx, core.CreateServiceGatewayRequest{
@@ -86,6 +115,14 @@ func (s *ClusterScope) CreateServiceGateway(ctx context.Context) (*string, error
 }
 
 func (s *ClusterScope) DeleteServiceGateway(ctx context.Context) error {
+	inUse, err := s.IsSharedNetworkInUse(ctx)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		s.Logger.Info("Shared VCN is used by other clusters, skipping deletion of Service Gateway")
+		return nil
+	}
 	sgw, err := s.GetServiceGateway(ctx)
 	if err != nil && !ociutil.IsNotFound(err) {
 		return err
@@ -94,6 +131,9 @@ func (s *ClusterScope) DeleteServiceGateway(ctx context.Context) error {
 		s.Logger.Info("Service Gateway is already deleted")
 		return nil
 	}
+	if s.isVCNRetained() {
+		return s.retainServiceGateway(ctx, sgw)
+	}
 	_, err = s.VCNClient.DeleteServiceGateway(ctx, core.DeleteServiceGatewayRequest{
 		ServiceGatewayId: sgw.Id,
 	})
@@ -115,14 +155,14 @@ func (s *ClusterScope) GetServiceGateway(ctx context.Context) (*core.ServiceGate
 			return nil, err
 		}
 		sgw := resp.ServiceGateway
-		if s.IsResourceCreatedByClusterAPI(sgw.FreeformTags) {
+		if s.IsNetworkResourceCreatedByClusterAPI(sgw.FreeformTags) {
 			return &sgw, err
 		} else {
 			return nil, errors.New("cluster api tags have been modified out of context")
 		}
 	}
 	sgws, err := s.VCNClient.ListServiceGateways(ctx, core.ListServiceGatewaysRequest{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNetworkCompartmentId()),
 		VcnId:         s.getVcnId(),
 	})
 	if err != nil {
@@ -131,7 +171,7 @@ func (s *ClusterScope) GetServiceGateway(ctx context.Context) (*core.ServiceGate
 	}
 	for _, sgw := range sgws.Items {
 		if *sgw.DisplayName == ServiceGatewayName {
-			if s.IsResourceCreatedByClusterAPI(sgw.FreeformTags) {
+			if s.IsNetworkResourceCreatedByClusterAPI(sgw.FreeformTags) {
 				return &sgw, nil
 			}
 		}
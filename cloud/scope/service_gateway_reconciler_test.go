@@ -86,7 +86,8 @@ func TestClusterScope_ReconcileServiceGateway(t *testing.T) {
 			Items: []core.ServiceGateway{
 				{
 					Id:           common.String("sgw_id"),
-					FreeformTags: tags,
+					FreeformTags: updatedTags,
+					DefinedTags:  definedTagsInterface,
 					DisplayName:  common.String("service-gateway"),
 				},
 			}}, nil)
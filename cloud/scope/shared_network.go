@@ -0,0 +1,192 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+)
+
+// IsNetworkShared returns true if the VCN, gateways and route tables are shared with other clusters.
+func (s *ClusterScope) IsNetworkShared() bool {
+	return s.OCIClusterAccessor.GetNetworkSpec().Vcn.Shared != nil
+}
+
+// GetNetworkResourceIdentifier returns the identifier used to tag the VCN, gateways and route tables. For a shared
+// network this is the shared network identifier, otherwise it is the resource identifier of the cluster.
+func (s *ClusterScope) GetNetworkResourceIdentifier() string {
+	if s.IsNetworkShared() {
+		return s.OCIClusterAccessor.GetNetworkSpec().Vcn.Shared.Identifier
+	}
+	return s.OCIClusterAccessor.GetOCIResourceIdentifier()
+}
+
+// IsNetworkResourceCreatedByClusterAPI checks if a VCN, gateway or route table has been created by Cluster API
+// for this cluster, or for the shared network the cluster uses.
+func (s *ClusterScope) IsNetworkResourceCreatedByClusterAPI(resourceFreeFormTags map[string]string) bool {
+	return ociutil.IsClusterResource(resourceFreeFormTags, s.GetNetworkResourceIdentifier())
+}
+
+// GetNetworkFreeFormTags returns the free form tags to be applied to the VCN, gateways and route tables.
+func (s *ClusterScope) GetNetworkFreeFormTags() map[string]string {
+	completeTags := s.GetFreeFormTags()
+	for k, v := range ociutil.BuildClusterTags(s.GetNetworkResourceIdentifier()) {
+		completeTags[k] = v
+	}
+	return completeTags
+}
+
+// getSharedNetworkConsumerTagKey returns the tag key which registers this cluster as a consumer of the shared network.
+func (s *ClusterScope) getSharedNetworkConsumerTagKey() string {
+	return ociutil.BuildSharedNetworkConsumerTagKey(s.OCIClusterAccessor.GetOCIResourceIdentifier())
+}
+
+// getSharedNetworkConsumers returns the consumer registration tag keys present on the VCN.
+func getSharedNetworkConsumers(freeformTags map[string]string) []string {
+	var consumers []string
+	for k := range freeformTags {
+		if strings.HasPrefix(k, ociutil.SharedNetworkConsumerPrefix) {
+			consumers = append(consumers, k)
+		}
+	}
+	return consumers
+}
+
+// ReconcileSharedNetworkConsumer registers the cluster as a consumer of the shared VCN, if it is not registered
+// already. The registration is a free form tag on the VCN and is used as the reference count of the shared network.
+func (s *ClusterScope) ReconcileSharedNetworkConsumer(ctx context.Context, vcnId *string) error {
+	if !s.IsNetworkShared() {
+		return nil
+	}
+	resp, err := s.VCNClient.GetVcn(ctx, core.GetVcnRequest{
+		VcnId: vcnId,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get shared vcn")
+	}
+	key := s.getSharedNetworkConsumerTagKey()
+	if _, ok := resp.Vcn.FreeformTags[key]; ok {
+		return nil
+	}
+	tags := make(map[string]string)
+	for k, v := range resp.Vcn.FreeformTags {
+		tags[k] = v
+	}
+	tags[key] = s.OCIClusterAccessor.GetName()
+	_, err = s.VCNClient.UpdateVcn(ctx, core.UpdateVcnRequest{
+		VcnId: vcnId,
+		UpdateVcnDetails: core.UpdateVcnDetails{
+			FreeformTags: tags,
+		},
+		IfMatch: resp.Etag,
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to register the cluster as a consumer of the shared vcn")
+		return errors.Wrap(err, "failed to register the cluster as a consumer of the shared vcn")
+	}
+	s.Logger.Info("Registered the cluster as a consumer of the shared vcn", "vcn", *vcnId)
+	return nil
+}
+
+// sharedNetworkReleaseAttempts is how many times the release of the shared VCN is retried when it is updated
+// concurrently by another cluster.
+const sharedNetworkReleaseAttempts = 5
+
+// ReleaseSharedNetwork removes the consumer registration of this cluster from the shared VCN. It is only called
+// while the cluster is deleted, once the resources of the cluster in the VCN are deleted and before the shared
+// resources are. The registration is released before the other consumers are looked up by IsSharedNetworkInUse, so
+// that of the clusters which are deleted concurrently, the last one to release the network never sees the others
+// and deletes the shared resources.
+func (s *ClusterScope) ReleaseSharedNetwork(ctx context.Context) error {
+	if !s.IsNetworkShared() {
+		return nil
+	}
+	vcn, err := s.GetVCN(ctx)
+	if err != nil && !ociutil.IsNotFound(err) {
+		return err
+	}
+	if vcn == nil {
+		return nil
+	}
+	return s.releaseSharedNetwork(ctx, vcn.Id)
+}
+
+// IsSharedNetworkInUse returns true if clusters other than this cluster are registered as consumers of the shared
+// VCN, in which case the shared resources must not be deleted.
+func (s *ClusterScope) IsSharedNetworkInUse(ctx context.Context) (bool, error) {
+	if !s.IsNetworkShared() {
+		return false, nil
+	}
+	vcn, err := s.GetVCN(ctx)
+	if err != nil && !ociutil.IsNotFound(err) {
+		return false, err
+	}
+	if vcn == nil {
+		return false, nil
+	}
+	key := s.getSharedNetworkConsumerTagKey()
+	for _, consumer := range getSharedNetworkConsumers(vcn.FreeformTags) {
+		if consumer != key {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// releaseSharedNetwork removes the consumer registration of this cluster from the shared VCN. The update uses the etag of the VCN so that concurrent releases by
+// different clusters do not overwrite each other, a release which lost the race is retried with the new tags.
+func (s *ClusterScope) releaseSharedNetwork(ctx context.Context, vcnId *string) error {
+	key := s.getSharedNetworkConsumerTagKey()
+	for attempt := 1; ; attempt++ {
+		resp, err := s.VCNClient.GetVcn(ctx, core.GetVcnRequest{
+			VcnId: vcnId,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get shared vcn")
+		}
+		if _, ok := resp.Vcn.FreeformTags[key]; !ok {
+			return nil
+		}
+		tags := make(map[string]string)
+		for k, v := range resp.Vcn.FreeformTags {
+			if k != key {
+				tags[k] = v
+			}
+		}
+		_, err = s.VCNClient.UpdateVcn(ctx, core.UpdateVcnRequest{
+			VcnId: vcnId,
+			UpdateVcnDetails: core.UpdateVcnDetails{
+				FreeformTags: tags,
+			},
+			IfMatch: resp.Etag,
+		})
+		if ociutil.IsPreconditionFailed(err) && attempt < sharedNetworkReleaseAttempts {
+			s.Logger.Info("Shared vcn was updated concurrently, retrying the release", "vcn", *vcnId)
+			continue
+		}
+		if err != nil {
+			s.Logger.Error(err, "failed to release the shared vcn")
+			return errors.Wrap(err, "failed to release the shared vcn")
+		}
+		s.Logger.Info("Released the shared vcn", "vcn", *vcnId)
+		return nil
+	}
+}
@@ -0,0 +1,298 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn/mock_vcn"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func sharedNetworkTags(consumers ...string) map[string]string {
+	tags := make(map[string]string)
+	tags[ociutil.CreatedBy] = ociutil.OCIClusterAPIProvider
+	tags[ociutil.ClusterResourceIdentifier] = "shared_id"
+	for _, consumer := range consumers {
+		tags[ociutil.BuildSharedNetworkConsumerTagKey(consumer)] = consumer
+	}
+	return tags
+}
+
+func TestClusterScope_ReconcileSharedNetworkConsumer(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	vcnClient := mock_vcn.NewMockClient(mockCtrl)
+
+	vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{
+		VcnId: common.String("registered"),
+	})).
+		Return(core.GetVcnResponse{
+			Vcn: core.Vcn{
+				Id:           common.String("registered"),
+				FreeformTags: sharedNetworkTags("resource_uid"),
+			},
+		}, nil)
+	vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{
+		VcnId: common.String("not_registered"),
+	})).
+		Return(core.GetVcnResponse{
+			Vcn: core.Vcn{
+				Id:           common.String("not_registered"),
+				FreeformTags: sharedNetworkTags("other_uid"),
+			},
+			Etag: common.String("etag"),
+		}, nil)
+	updatedTags := sharedNetworkTags("other_uid")
+	updatedTags[ociutil.BuildSharedNetworkConsumerTagKey("resource_uid")] = "cluster"
+	vcnClient.EXPECT().UpdateVcn(gomock.Any(), gomock.Eq(core.UpdateVcnRequest{
+		VcnId: common.String("not_registered"),
+		UpdateVcnDetails: core.UpdateVcnDetails{
+			FreeformTags: updatedTags,
+		},
+		IfMatch: common.String("etag"),
+	})).
+		Return(core.UpdateVcnResponse{}, nil)
+	vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{
+		VcnId: common.String("error"),
+	})).
+		Return(core.GetVcnResponse{}, errors.New("some error in GetVcn"))
+
+	tests := []struct {
+		name          string
+		vcnId         string
+		shared        *infrastructurev1beta2.SharedNetwork
+		wantErr       bool
+		expectedError string
+	}{
+		{
+			name:  "network not shared",
+			vcnId: "not_shared",
+		},
+		{
+			name:   "cluster already registered",
+			vcnId:  "registered",
+			shared: &infrastructurev1beta2.SharedNetwork{Identifier: "shared_id"},
+		},
+		{
+			name:   "cluster registered as consumer",
+			vcnId:  "not_registered",
+			shared: &infrastructurev1beta2.SharedNetwork{Identifier: "shared_id"},
+		},
+		{
+			name:          "get vcn error",
+			vcnId:         "error",
+			shared:        &infrastructurev1beta2.SharedNetwork{Identifier: "shared_id"},
+			wantErr:       true,
+			expectedError: "failed to get shared vcn: some error in GetVcn",
+		},
+	}
+	l := log.FromContext(context.Background())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ociClusterAccessor := OCISelfManagedCluster{
+				&infrastructurev1beta2.OCICluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster",
+						UID:  "cluster_uid",
+					},
+					Spec: infrastructurev1beta2.OCIClusterSpec{
+						OCIResourceIdentifier: "resource_uid",
+						NetworkSpec: infrastructurev1beta2.NetworkSpec{
+							Vcn: infrastructurev1beta2.VCN{
+								Shared: tt.shared,
+							},
+						},
+					},
+				},
+			}
+			s := &ClusterScope{
+				VCNClient:          vcnClient,
+				OCIClusterAccessor: ociClusterAccessor,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						UID: "cluster_uid",
+					},
+				},
+				Logger: &l,
+			}
+			err := s.ReconcileSharedNetworkConsumer(context.Background(), common.String(tt.vcnId))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReconcileSharedNetworkConsumer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if err.Error() != tt.expectedError {
+					t.Errorf("ReconcileSharedNetworkConsumer() expected error = %s, actual error %s", tt.expectedError, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestClusterScope_DeleteSharedVCN(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	vcnClient := mock_vcn.NewMockClient(mockCtrl)
+
+	vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{
+		VcnId: common.String("in_use"),
+	})).
+		Return(core.GetVcnResponse{
+			Vcn: core.Vcn{
+				Id:           common.String("in_use"),
+				FreeformTags: sharedNetworkTags("resource_uid", "other_uid"),
+			},
+			Etag: common.String("etag"),
+		}, nil).Times(4)
+	vcnClient.EXPECT().UpdateVcn(gomock.Any(), gomock.Eq(core.UpdateVcnRequest{
+		VcnId: common.String("in_use"),
+		UpdateVcnDetails: core.UpdateVcnDetails{
+			FreeformTags: sharedNetworkTags("other_uid"),
+		},
+		IfMatch: common.String("etag"),
+	})).
+		Return(core.UpdateVcnResponse{}, nil)
+	vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{
+		VcnId: common.String("last_consumer"),
+	})).
+		Return(core.GetVcnResponse{
+			Vcn: core.Vcn{
+				Id:           common.String("last_consumer"),
+				FreeformTags: sharedNetworkTags("resource_uid"),
+			},
+		}, nil).Times(4)
+	vcnClient.EXPECT().UpdateVcn(gomock.Any(), gomock.Eq(core.UpdateVcnRequest{
+		VcnId: common.String("last_consumer"),
+		UpdateVcnDetails: core.UpdateVcnDetails{
+			FreeformTags: sharedNetworkTags(),
+		},
+	})).
+		Return(core.UpdateVcnResponse{}, nil)
+	vcnClient.EXPECT().DeleteVcn(gomock.Any(), gomock.Eq(core.DeleteVcnRequest{
+		VcnId: common.String("last_consumer"),
+	})).
+		Return(core.DeleteVcnResponse{}, nil)
+
+	// the other consumer releases the vcn between the lookup and the release of this cluster, the release is
+	// retried and this cluster is then the last consumer
+	concurrentVcn := func(etag string, consumers ...string) core.GetVcnResponse {
+		return core.GetVcnResponse{
+			Vcn: core.Vcn{
+				Id:           common.String("concurrent"),
+				FreeformTags: sharedNetworkTags(consumers...),
+			},
+			Etag: common.String(etag),
+		}
+	}
+	getConcurrentVcn := core.GetVcnRequest{VcnId: common.String("concurrent")}
+	gomock.InOrder(
+		vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(getConcurrentVcn)).
+			Return(concurrentVcn("etag1", "resource_uid", "other_uid"), nil).Times(2),
+		vcnClient.EXPECT().UpdateVcn(gomock.Any(), gomock.Eq(core.UpdateVcnRequest{
+			VcnId: common.String("concurrent"),
+			UpdateVcnDetails: core.UpdateVcnDetails{
+				FreeformTags: sharedNetworkTags("other_uid"),
+			},
+			IfMatch: common.String("etag1"),
+		})).
+			Return(core.UpdateVcnResponse{}, ociutil.ErrPreconditionFailed),
+		vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(getConcurrentVcn)).
+			Return(concurrentVcn("etag2", "resource_uid"), nil),
+		vcnClient.EXPECT().UpdateVcn(gomock.Any(), gomock.Eq(core.UpdateVcnRequest{
+			VcnId: common.String("concurrent"),
+			UpdateVcnDetails: core.UpdateVcnDetails{
+				FreeformTags: sharedNetworkTags(),
+			},
+			IfMatch: common.String("etag2"),
+		})).
+			Return(core.UpdateVcnResponse{}, nil),
+		vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(getConcurrentVcn)).
+			Return(concurrentVcn("etag3"), nil).Times(2),
+		vcnClient.EXPECT().DeleteVcn(gomock.Any(), gomock.Eq(core.DeleteVcnRequest{
+			VcnId: common.String("concurrent"),
+		})).
+			Return(core.DeleteVcnResponse{}, nil),
+	)
+
+	tests := []struct {
+		name    string
+		vcnId   string
+		wantErr bool
+	}{
+		{
+			name:  "shared vcn used by other clusters is released",
+			vcnId: "in_use",
+		},
+		{
+			name:  "shared vcn is deleted by the last consumer",
+			vcnId: "last_consumer",
+		},
+		{
+			name:  "shared vcn is deleted by the last consumer of concurrent releases",
+			vcnId: "concurrent",
+		},
+	}
+	l := log.FromContext(context.Background())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ociClusterAccessor := OCISelfManagedCluster{
+				&infrastructurev1beta2.OCICluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster",
+						UID:  "cluster_uid",
+					},
+					Spec: infrastructurev1beta2.OCIClusterSpec{
+						OCIResourceIdentifier: "resource_uid",
+						NetworkSpec: infrastructurev1beta2.NetworkSpec{
+							Vcn: infrastructurev1beta2.VCN{
+								ID:     common.String(tt.vcnId),
+								Shared: &infrastructurev1beta2.SharedNetwork{Identifier: "shared_id"},
+							},
+						},
+					},
+				},
+			}
+			s := &ClusterScope{
+				VCNClient:          vcnClient,
+				OCIClusterAccessor: ociClusterAccessor,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						UID: "cluster_uid",
+					},
+				},
+				Logger: &l,
+			}
+			err := s.ReleaseSharedNetwork(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReleaseSharedNetwork() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			err = s.DeleteVCN(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteVCN() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -36,6 +36,7 @@ func (s *ClusterScope) ReconcileSubnet(ctx context.Context) error {
 		if subnet != nil {
 			subnetOCID := subnet.Id
 			desiredSubnet.ID = subnetOCID
+			var securityListID *string
 			if desiredSubnet.SecurityList != nil {
 				securityList, err := s.GetSecurityList(ctx, *desiredSubnet.SecurityList)
 				if err != nil {
@@ -48,26 +49,39 @@ func (s *ClusterScope) ReconcileSubnet(ctx context.Context) error {
 					}
 					s.Logger.Info("Created the security list", "ocid", seclistId)
 					desiredSubnet.SecurityList.ID = seclistId
+					securityListID = seclistId
 				} else {
-					if s.IsSecurityListEqual(*securityList, *desiredSubnet.SecurityList) {
+					securityListID = securityList.Id
+					if err := s.reconcileSecurityListTags(ctx, securityList); err != nil {
+						return err
+					}
+					securityListDrift := s.securityListDrift(*securityList, *desiredSubnet.SecurityList)
+					if len(securityListDrift) == 0 {
 						s.Logger.Info("No Reconciliation Required for Security List", "securitylist", securityList.Id)
 					} else {
-						err = s.UpdateSecurityList(ctx, *desiredSubnet.SecurityList)
+						err = s.reconcileDrift(DriftResourceSecurityList, desiredSubnet.SecurityList.Name, securityList.Id, securityListDrift, func() error {
+							return s.UpdateSecurityList(ctx, *desiredSubnet.SecurityList)
+						})
 						if err != nil {
 							return err
 						}
-						s.Logger.Info("Successfully updated security list", "securitylist", securityList)
 					}
 				}
 			}
-			if s.IsSubnetsEqual(subnet, *desiredSubnet) {
+			s.setSubnetStatus(*desiredSubnet, securityListID, ociutil.DerefString(subnet.CidrBlock), string(subnet.LifecycleState))
+			if err := s.reconcileSubnetTags(ctx, subnet); err != nil {
+				return err
+			}
+			subnetDrift := s.subnetDrift(subnet, *desiredSubnet)
+			if len(subnetDrift) == 0 {
 				s.Logger.Info("No Reconciliation Required for Subnet", "subnet", subnetOCID)
 			} else {
-				err = s.UpdateSubnet(ctx, *desiredSubnet)
+				err = s.reconcileDrift(DriftResourceSubnet, desiredSubnet.Name, subnetOCID, subnetDrift, func() error {
+					return s.UpdateSubnet(ctx, *desiredSubnet, subnet.SecurityListIds)
+				})
 				if err != nil {
 					return err
 				}
-				s.Logger.Info("Successfully updated subnet", "subnet", subnetOCID)
 			}
 			continue
 		}
@@ -87,6 +101,11 @@ func (s *ClusterScope) ReconcileSubnet(ctx context.Context) error {
 		}
 		s.Logger.Info("Created the subnet", "ocid", subnetId)
 		desiredSubnet.ID = subnetId
+		var securityListID *string
+		if desiredSubnet.SecurityList != nil {
+			securityListID = desiredSubnet.SecurityList.ID
+		}
+		s.setSubnetStatus(*desiredSubnet, securityListID, desiredSubnet.CIDR, "")
 	}
 	return nil
 }
@@ -102,7 +121,7 @@ func (s *ClusterScope) CreateSubnet(ctx context.Context, spec infrastructurev1be
 		routeTable = s.getRouteTableId(infrastructurev1beta2.Public)
 	}
 	createSubnetDetails := core.CreateSubnetDetails{
-		CompartmentId:           common.String(s.GetCompartmentId()),
+		CompartmentId:           common.String(s.GetSubnetCompartmentId(spec)),
 		CidrBlock:               common.String(spec.CIDR),
 		VcnId:                   s.getVcnId(),
 		DisplayName:             common.String(spec.Name),
@@ -127,13 +146,15 @@ func (s *ClusterScope) CreateSubnet(ctx context.Context, spec infrastructurev1be
 	return subnetResponse.Subnet.Id, nil
 }
 
-func (s *ClusterScope) UpdateSubnet(ctx context.Context, spec infrastructurev1beta2.Subnet) error {
+// UpdateSubnet updates the subnet to match the spec. The security list of the spec is added to the security lists
+// of the subnet, the security lists which were added out of Cluster API are kept.
+func (s *ClusterScope) UpdateSubnet(ctx context.Context, spec infrastructurev1beta2.Subnet, securityListIds []string) error {
 	updateSubnetDetails := core.UpdateSubnetDetails{
 		DisplayName: common.String(spec.Name),
 		CidrBlock:   common.String(spec.CIDR),
 	}
-	if spec.SecurityList != nil {
-		updateSubnetDetails.SecurityListIds = []string{*spec.SecurityList.ID}
+	if spec.SecurityList != nil && !containsString(securityListIds, *spec.SecurityList.ID) {
+		updateSubnetDetails.SecurityListIds = append(append([]string{}, securityListIds...), *spec.SecurityList.ID)
 	}
 	subnetResponse, err := s.VCNClient.UpdateSubnet(ctx, core.UpdateSubnetRequest{
 		UpdateSubnetDetails: updateSubnetDetails,
@@ -158,6 +179,12 @@ func (s *ClusterScope) DeleteSubnets(ctx context.Context) error {
 			s.Logger.Info("subnet is already deleted", "subnet", desiredSubnet.Name)
 			continue
 		}
+		if s.isSubnetRetained(*desiredSubnet) {
+			if err := s.retainSubnet(ctx, subnet); err != nil {
+				return err
+			}
+			continue
+		}
 		_, err = s.VCNClient.DeleteSubnet(ctx, core.DeleteSubnetRequest{
 			SubnetId: subnet.Id,
 		})
@@ -187,7 +214,7 @@ func (s *ClusterScope) GetSubnet(ctx context.Context, spec infrastructurev1beta2
 		}
 	}
 	subnets, err := s.VCNClient.ListSubnets(ctx, core.ListSubnetsRequest{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetSubnetCompartmentId(spec)),
 		VcnId:         s.getVcnId(),
 		DisplayName:   common.String(spec.Name),
 	})
@@ -245,18 +272,19 @@ func (s *ClusterScope) GetSubnetsSpec() []*infrastructurev1beta2.Subnet {
 }
 
 func (s *ClusterScope) IsSubnetsEqual(actual *core.Subnet, desired infrastructurev1beta2.Subnet) bool {
-	if desired.Name != *actual.DisplayName {
-		return false
-	}
-	if desired.CIDR != *actual.CidrBlock {
-		return false
-	}
-	if desired.SecurityList != nil {
-		if *desired.SecurityList.ID != actual.SecurityListIds[0] {
-			return false
-		}
+	return len(s.subnetDrift(actual, desired)) == 0
+}
+
+// subnetDrift returns the fields of the subnet which differ from the spec.
+func (s *ClusterScope) subnetDrift(actual *core.Subnet, desired infrastructurev1beta2.Subnet) driftDiff {
+	var drift driftDiff
+	drift.compare("displayName", desired.Name, actual.DisplayName)
+	drift.compare("cidrBlock", desired.CIDR, actual.CidrBlock)
+	// the subnet may have other security lists, only the security list of the spec is required
+	if desired.SecurityList != nil && !containsString(actual.SecurityListIds, *desired.SecurityList.ID) {
+		drift.missing("securityListIds", *desired.SecurityList.ID)
 	}
-	return true
+	return drift
 }
 
 func (s *ClusterScope) isControlPlaneEndpointSubnetPrivate() bool {
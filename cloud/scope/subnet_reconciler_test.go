@@ -287,7 +287,7 @@ func TestClusterScope_ReconcileSubnet(t *testing.T) {
 					UpdateSubnetDetails: core.UpdateSubnetDetails{
 						DisplayName:     common.String("sec_list_added"),
 						CidrBlock:       common.String(WorkerSubnetDefaultCIDR),
-						SecurityListIds: []string{"sec_list_id"},
+						SecurityListIds: []string{"foo", "sec_list_id"},
 					},
 				})).
 					Return(core.UpdateSubnetResponse{
@@ -369,6 +369,7 @@ func TestClusterScope_ReconcileSubnet(t *testing.T) {
 							DisplayName:  common.String("bar"),
 							Id:           common.String("seclist_id"),
 							FreeformTags: tags,
+							DefinedTags:  definedTagsInterface,
 						},
 					}, nil)
 
@@ -443,6 +444,7 @@ func TestClusterScope_ReconcileSubnet(t *testing.T) {
 							Id:           common.String("update_subnet_error"),
 							DisplayName:  common.String("name"),
 							FreeformTags: tags,
+							DefinedTags:  definedTagsInterface,
 						},
 					}, nil)
 
@@ -644,6 +646,7 @@ func TestClusterScope_ReconcileSubnet(t *testing.T) {
 							DisplayName:  common.String("bar"),
 							Id:           common.String("seclist_id"),
 							FreeformTags: tags,
+							DefinedTags:  definedTagsInterface,
 						},
 					}, nil)
 				vcnClient.EXPECT().UpdateSecurityList(gomock.Any(), gomock.Eq(core.UpdateSecurityListRequest{
@@ -777,6 +780,38 @@ func TestClusterScope_DeleteSubnets(t *testing.T) {
 		SubnetId: common.String("cp_endpoint_id_error_delete"),
 	})).
 		Return(core.DeleteSubnetResponse{}, errors.New("some error in subnet delete"))
+	vcnClient.EXPECT().GetSubnet(gomock.Any(), gomock.Eq(core.GetSubnetRequest{
+		SubnetId: common.String("retain_id"),
+	})).
+		Return(core.GetSubnetResponse{
+			Subnet: core.Subnet{
+				Id:           common.String("retain_id"),
+				FreeformTags: tags,
+			},
+		}, nil)
+	vcnClient.EXPECT().GetSubnet(gomock.Any(), gomock.Eq(core.GetSubnetRequest{
+		SubnetId: common.String("delete_id"),
+	})).
+		Return(core.GetSubnetResponse{
+			Subnet: core.Subnet{
+				Id:           common.String("delete_id"),
+				FreeformTags: tags,
+			},
+		}, nil)
+	vcnClient.EXPECT().UpdateSubnet(gomock.Any(), gomock.Eq(core.UpdateSubnetRequest{
+		SubnetId: common.String("retain_id"),
+		UpdateSubnetDetails: core.UpdateSubnetDetails{
+			FreeformTags: map[string]string{
+				ociutil.CreatedBy:                 ociutil.OCIClusterAPIProviderRetained,
+				ociutil.ClusterResourceIdentifier: "resource_uid",
+			},
+		},
+	})).
+		Return(core.UpdateSubnetResponse{}, nil)
+	vcnClient.EXPECT().DeleteSubnet(gomock.Any(), gomock.Eq(core.DeleteSubnetRequest{
+		SubnetId: common.String("delete_id"),
+	})).
+		Return(core.DeleteSubnetResponse{}, nil)
 
 	tests := []struct {
 		name          string
@@ -802,6 +837,26 @@ func TestClusterScope_DeleteSubnets(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "subnet inherits the deletion policy of the vcn",
+			spec: infrastructurev1beta2.OCIClusterSpec{
+				NetworkSpec: infrastructurev1beta2.NetworkSpec{
+					Vcn: infrastructurev1beta2.VCN{
+						DeletionPolicy: infrastructurev1beta2.DeletionPolicyRetain,
+						Subnets: []*infrastructurev1beta2.Subnet{
+							{
+								ID: common.String("retain_id"),
+							},
+							{
+								ID:             common.String("delete_id"),
+								DeletionPolicy: infrastructurev1beta2.DeletionPolicyDelete,
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "subnet already deleted",
 			spec: infrastructurev1beta2.OCIClusterSpec{
@@ -0,0 +1,232 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/plan"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/pkg/errors"
+)
+
+// updateTagsFunc updates the tags of a resource, the tags are the complete set of tags of the resource.
+type updateTagsFunc func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error
+
+// reconcileTags applies the free form tags and the defined tags of the spec to an existing resource. The tags which
+// were applied by the previous reconciliation and removed from the spec since are removed. Other tags, such as tags
+// added by other tools or by tag defaults, are kept. The tags are not applied if the drift policy of the cluster is
+// Report.
+func (s *ClusterScope) reconcileTags(resource string, id *string, actualFreeformTags map[string]string,
+	actualDefinedTags map[string]map[string]interface{}, desiredFreeformTags map[string]string, update updateTagsFunc) error {
+	applied := s.OCIClusterAccessor.GetAppliedTags()
+	freeformTags, freeformTagsChanged := ociutil.MergeFreeformTags(actualFreeformTags, desiredFreeformTags, appliedFreeformTags(applied))
+	definedTags, definedTagsChanged := ociutil.MergeDefinedTags(actualDefinedTags, s.GetDefinedTags(), appliedDefinedTags(applied))
+	if !freeformTagsChanged && !definedTagsChanged {
+		return nil
+	}
+	if s.OCIClusterAccessor.GetDriftPolicy() == infrastructurev1beta2.DriftPolicyReport {
+		// the resources are not updated as per the drift policy
+		s.Logger.Info("The tags differ from the spec, the resource is not updated as per the drift policy", "resource", resource, "id", id)
+		return nil
+	}
+	if err := update(freeformTags, definedTags); err != nil {
+		if plan.IsPlanned(err) {
+			return nil
+		}
+		s.Logger.Error(err, "failed to update the tags", "resource", resource, "id", id)
+		return errors.Wrapf(err, "failed to update the tags of the %s", resource)
+	}
+	s.Logger.Info("Updated the tags", "resource", resource, "id", id)
+	return nil
+}
+
+// reconcileNetworkTags applies the tags of the spec to the VCN, a gateway or a route table. The tags of a shared
+// network are left as they are, as the clusters sharing it may have different tags.
+func (s *ClusterScope) reconcileNetworkTags(resource string, id *string, actualFreeformTags map[string]string,
+	actualDefinedTags map[string]map[string]interface{}, update updateTagsFunc) error {
+	if s.IsNetworkShared() {
+		return nil
+	}
+	return s.reconcileTags(resource, id, actualFreeformTags, actualDefinedTags, s.GetNetworkFreeFormTags(), update)
+}
+
+func (s *ClusterScope) reconcileVCNTags(ctx context.Context, vcn *core.Vcn) error {
+	return s.reconcileNetworkTags("vcn", vcn.Id, vcn.FreeformTags, vcn.DefinedTags,
+		func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			_, err := s.VCNClient.UpdateVcn(ctx, core.UpdateVcnRequest{
+				VcnId: vcn.Id,
+				UpdateVcnDetails: core.UpdateVcnDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			return err
+		})
+}
+
+func (s *ClusterScope) reconcileInternetGatewayTags(ctx context.Context, igw *core.InternetGateway) error {
+	return s.reconcileNetworkTags("internet gateway", igw.Id, igw.FreeformTags, igw.DefinedTags,
+		func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			_, err := s.VCNClient.UpdateInternetGateway(ctx, core.UpdateInternetGatewayRequest{
+				IgId: igw.Id,
+				UpdateInternetGatewayDetails: core.UpdateInternetGatewayDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			return err
+		})
+}
+
+func (s *ClusterScope) reconcileNatGatewayTags(ctx context.Context, ngw *core.NatGateway) error {
+	return s.reconcileNetworkTags("nat gateway", ngw.Id, ngw.FreeformTags, ngw.DefinedTags,
+		func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			_, err := s.VCNClient.UpdateNatGateway(ctx, core.UpdateNatGatewayRequest{
+				NatGatewayId: ngw.Id,
+				UpdateNatGatewayDetails: core.UpdateNatGatewayDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			return err
+		})
+}
+
+func (s *ClusterScope) reconcileServiceGatewayTags(ctx context.Context, sgw *core.ServiceGateway) error {
+	return s.reconcileNetworkTags("service gateway", sgw.Id, sgw.FreeformTags, sgw.DefinedTags,
+		func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			_, err := s.VCNClient.UpdateServiceGateway(ctx, core.UpdateServiceGatewayRequest{
+				ServiceGatewayId: sgw.Id,
+				UpdateServiceGatewayDetails: core.UpdateServiceGatewayDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			return err
+		})
+}
+
+func (s *ClusterScope) reconcileRouteTableTags(ctx context.Context, rt *core.RouteTable) error {
+	return s.reconcileNetworkTags("route table", rt.Id, rt.FreeformTags, rt.DefinedTags,
+		func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			_, err := s.VCNClient.UpdateRouteTable(ctx, core.UpdateRouteTableRequest{
+				RtId: rt.Id,
+				UpdateRouteTableDetails: core.UpdateRouteTableDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			return err
+		})
+}
+
+func (s *ClusterScope) reconcileSubnetTags(ctx context.Context, subnet *core.Subnet) error {
+	return s.reconcileTags("subnet", subnet.Id, subnet.FreeformTags, subnet.DefinedTags, s.GetFreeFormTags(),
+		func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			_, err := s.VCNClient.UpdateSubnet(ctx, core.UpdateSubnetRequest{
+				SubnetId: subnet.Id,
+				UpdateSubnetDetails: core.UpdateSubnetDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			return err
+		})
+}
+
+func (s *ClusterScope) reconcileSecurityListTags(ctx context.Context, securityList *core.SecurityList) error {
+	return s.reconcileTags("security list", securityList.Id, securityList.FreeformTags, securityList.DefinedTags, s.GetFreeFormTags(),
+		func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			_, err := s.VCNClient.UpdateSecurityList(ctx, core.UpdateSecurityListRequest{
+				SecurityListId: securityList.Id,
+				UpdateSecurityListDetails: core.UpdateSecurityListDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			return err
+		})
+}
+
+func (s *ClusterScope) reconcileNSGTags(ctx context.Context, nsg *core.NetworkSecurityGroup) error {
+	return s.reconcileTags("nsg", nsg.Id, nsg.FreeformTags, nsg.DefinedTags, s.GetFreeFormTags(),
+		func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			_, err := s.VCNClient.UpdateNetworkSecurityGroup(ctx, core.UpdateNetworkSecurityGroupRequest{
+				NetworkSecurityGroupId: nsg.Id,
+				UpdateNetworkSecurityGroupDetails: core.UpdateNetworkSecurityGroupDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			return err
+		})
+}
+
+func (s *ClusterScope) reconcileDRGTags(ctx context.Context, drg *core.Drg) error {
+	return s.reconcileTags("drg", drg.Id, drg.FreeformTags, drg.DefinedTags, s.GetFreeFormTags(),
+		func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			_, err := s.VCNClient.UpdateDrg(ctx, core.UpdateDrgRequest{
+				DrgId: drg.Id,
+				UpdateDrgDetails: core.UpdateDrgDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			return err
+		})
+}
+
+func (s *ClusterScope) reconcileApiServerLBTags(ctx context.Context, lb *loadbalancer.LoadBalancer) error {
+	return s.reconcileTags("apiserver lb", lb.Id, lb.FreeformTags, lb.DefinedTags, s.GetFreeFormTags(),
+		func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			lbResponse, err := s.LoadBalancerClient.UpdateLoadBalancer(ctx, loadbalancer.UpdateLoadBalancerRequest{
+				LoadBalancerId: lb.Id,
+				UpdateLoadBalancerDetails: loadbalancer.UpdateLoadBalancerDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			if err != nil {
+				return err
+			}
+			_, err = ociutil.AwaitLBWorkRequest(ctx, s.LoadBalancerClient, lbResponse.OpcWorkRequestId)
+			return err
+		})
+}
+
+func (s *ClusterScope) reconcileApiServerNLBTags(ctx context.Context, nlb *networkloadbalancer.NetworkLoadBalancer) error {
+	return s.reconcileTags("apiserver nlb", nlb.Id, nlb.FreeformTags, nlb.DefinedTags, s.GetFreeFormTags(),
+		func(freeformTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			nlbResponse, err := s.NetworkLoadBalancerClient.UpdateNetworkLoadBalancer(ctx, networkloadbalancer.UpdateNetworkLoadBalancerRequest{
+				NetworkLoadBalancerId: nlb.Id,
+				UpdateNetworkLoadBalancerDetails: networkloadbalancer.UpdateNetworkLoadBalancerDetails{
+					FreeformTags: freeformTags,
+					DefinedTags:  definedTags,
+				},
+			})
+			if err != nil {
+				return err
+			}
+			_, err = ociutil.AwaitNLBWorkRequest(ctx, s.NetworkLoadBalancerClient, nlbResponse.OpcWorkRequestId)
+			return err
+		})
+}
@@ -0,0 +1,163 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn/mock_vcn"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestClusterScope_ReconcileVCNTags(t *testing.T) {
+	tests := []struct {
+		name              string
+		spec              infrastructurev1beta2.OCIClusterSpec
+		vcn               core.Vcn
+		testSpecificSetup func(vcnClient *mock_vcn.MockClient)
+		wantErr           bool
+	}{
+		{
+			name: "tags up to date",
+			spec: infrastructurev1beta2.OCIClusterSpec{
+				FreeformTags: map[string]string{"cost-center": "1"},
+			},
+			vcn: core.Vcn{
+				Id: common.String("vcn"),
+				FreeformTags: map[string]string{
+					ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+					ociutil.ClusterResourceIdentifier: "resource_uid",
+					"cost-center":                     "1",
+				},
+			},
+		},
+		{
+			name: "tags updated, foreign tags kept",
+			spec: infrastructurev1beta2.OCIClusterSpec{
+				FreeformTags: map[string]string{"cost-center": "2"},
+				DefinedTags:  map[string]map[string]string{"ns": {"tag": "value"}},
+			},
+			vcn: core.Vcn{
+				Id: common.String("vcn"),
+				FreeformTags: map[string]string{
+					ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+					ociutil.ClusterResourceIdentifier: "resource_uid",
+					"cost-center":                     "1",
+					"foreign":                         "tag",
+				},
+				DefinedTags: map[string]map[string]interface{}{
+					"Oracle-Tags": {"CreatedBy": "user"},
+				},
+			},
+			testSpecificSetup: func(vcnClient *mock_vcn.MockClient) {
+				vcnClient.EXPECT().UpdateVcn(gomock.Any(), gomock.Eq(core.UpdateVcnRequest{
+					VcnId: common.String("vcn"),
+					UpdateVcnDetails: core.UpdateVcnDetails{
+						FreeformTags: map[string]string{
+							ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+							ociutil.ClusterResourceIdentifier: "resource_uid",
+							"cost-center":                     "2",
+							"foreign":                         "tag",
+						},
+						DefinedTags: map[string]map[string]interface{}{
+							"Oracle-Tags": {"CreatedBy": "user"},
+							"ns":          {"tag": "value"},
+						},
+					},
+				})).
+					Return(core.UpdateVcnResponse{}, nil)
+			},
+		},
+		{
+			name: "update failed",
+			spec: infrastructurev1beta2.OCIClusterSpec{
+				FreeformTags: map[string]string{"cost-center": "2"},
+			},
+			vcn: core.Vcn{
+				Id: common.String("vcn"),
+			},
+			testSpecificSetup: func(vcnClient *mock_vcn.MockClient) {
+				vcnClient.EXPECT().UpdateVcn(gomock.Any(), gomock.Any()).
+					Return(core.UpdateVcnResponse{}, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "tags are not updated with the report drift policy",
+			spec: infrastructurev1beta2.OCIClusterSpec{
+				FreeformTags: map[string]string{"cost-center": "2"},
+				DriftPolicy:  infrastructurev1beta2.DriftPolicyReport,
+			},
+			vcn: core.Vcn{
+				Id: common.String("vcn"),
+			},
+		},
+		{
+			name: "shared network is not updated",
+			spec: infrastructurev1beta2.OCIClusterSpec{
+				FreeformTags: map[string]string{"cost-center": "2"},
+				NetworkSpec: infrastructurev1beta2.NetworkSpec{
+					Vcn: infrastructurev1beta2.VCN{
+						Shared: &infrastructurev1beta2.SharedNetwork{Identifier: "shared"},
+					},
+				},
+			},
+			vcn: core.Vcn{
+				Id: common.String("vcn"),
+			},
+		},
+	}
+	l := log.FromContext(context.Background())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			vcnClient := mock_vcn.NewMockClient(mockCtrl)
+			if tt.testSpecificSetup != nil {
+				tt.testSpecificSetup(vcnClient)
+			}
+			tt.spec.OCIResourceIdentifier = "resource_uid"
+			s := &ClusterScope{
+				VCNClient: vcnClient,
+				OCIClusterAccessor: OCISelfManagedCluster{
+					&infrastructurev1beta2.OCICluster{
+						Spec: tt.spec,
+					},
+				},
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						UID: "cluster_uid",
+					},
+				},
+				Logger: &l,
+			}
+			err := s.reconcileVCNTags(context.Background(), &tt.vcn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("reconcileVCNTags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -79,3 +79,41 @@ func ConvertMachineDefinedTags(machineDefinedTags map[string]map[string]string)
 
 	return definedTags
 }
+
+// NewAppliedTags returns a copy of the tags of the spec, to record them in the status once they are applied to the
+// resources.
+func NewAppliedTags(freeformTags map[string]string, definedTags map[string]map[string]string) *infrastructurev1beta2.AppliedTags {
+	applied := &infrastructurev1beta2.AppliedTags{}
+	if len(freeformTags) > 0 {
+		applied.FreeformTags = make(map[string]string)
+		for k, v := range freeformTags {
+			applied.FreeformTags[k] = v
+		}
+	}
+	if len(definedTags) > 0 {
+		applied.DefinedTags = make(map[string]map[string]string)
+		for ns, mapNs := range definedTags {
+			applied.DefinedTags[ns] = make(map[string]string)
+			for k, v := range mapNs {
+				applied.DefinedTags[ns][k] = v
+			}
+		}
+	}
+	return applied
+}
+
+// appliedFreeformTags returns the free form tags which were last applied, if any.
+func appliedFreeformTags(applied *infrastructurev1beta2.AppliedTags) map[string]string {
+	if applied == nil {
+		return nil
+	}
+	return applied.FreeformTags
+}
+
+// appliedDefinedTags returns the defined tags which were last applied, if any.
+func appliedDefinedTags(applied *infrastructurev1beta2.AppliedTags) map[string]map[string]string {
+	if applied == nil {
+		return nil
+	}
+	return applied.DefinedTags
+}
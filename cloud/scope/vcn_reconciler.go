@@ -37,28 +37,53 @@ func (s *ClusterScope) ReconcileVCN(ctx context.Context) error {
 	}
 	if vcn != nil {
 		s.OCIClusterAccessor.GetNetworkSpec().Vcn.ID = vcn.Id
-		if s.IsVcnEquals(vcn) {
+		s.setVCNStatus(vcn.Id, string(vcn.LifecycleState), vcn.CidrBlocks)
+		if err := s.ReconcileSharedNetworkConsumer(ctx, vcn.Id); err != nil {
+			return err
+		}
+		if err := s.reconcileVCNTags(ctx, vcn); err != nil {
+			return err
+		}
+		drift := s.vcnDrift(vcn)
+		if len(drift) == 0 {
 			s.Logger.Info("No Reconciliation Required for VCN", "vcn", s.getVcnId())
 			return nil
 		}
-		return s.UpdateVCN(ctx, spec)
+		// the CIDR blocks and the DNS label of the VCN are reported, they are not updated in place
+		return s.reconcileDriftOfMutableFields(DriftResourceVCN, s.GetVcnName(), vcn.Id, drift, []string{"cidrBlocks", "dnsLabel"}, func() error {
+			return s.UpdateVCN(ctx, spec)
+		})
 	}
 	vcnId, err := s.CreateVCN(ctx, spec)
 	s.OCIClusterAccessor.GetNetworkSpec().Vcn.ID = vcnId
+	if vcnId != nil {
+		s.setVCNStatus(vcnId, "", s.GetVcnCidrs())
+	}
 	return err
 }
 
 func (s *ClusterScope) IsVcnEquals(actual *core.Vcn) bool {
-	if *actual.DisplayName != s.GetVcnName() {
-		return false
+	return len(s.vcnDrift(actual)) == 0
+}
+
+// vcnDrift returns the fields of the VCN which differ from the spec.
+func (s *ClusterScope) vcnDrift(actual *core.Vcn) driftDiff {
+	var drift driftDiff
+	drift.compare("displayName", s.GetVcnName(), actual.DisplayName)
+	drift.compare("cidrBlocks", joinIds(s.GetVcnCidrs()), common.String(joinIds(actual.CidrBlocks)))
+	if dnsLabel := s.OCIClusterAccessor.GetNetworkSpec().Vcn.DnsLabel; dnsLabel != nil {
+		drift.compare("dnsLabel", *dnsLabel, actual.DnsLabel)
 	}
-	return true
+	return drift
 }
 
 func (s *ClusterScope) GetVcnName() string {
 	if s.OCIClusterAccessor.GetNetworkSpec().Vcn.Name != "" {
 		return s.OCIClusterAccessor.GetNetworkSpec().Vcn.Name
 	}
+	if s.IsNetworkShared() {
+		return s.GetNetworkResourceIdentifier()
+	}
 	return fmt.Sprintf("%s", s.OCIClusterAccessor.GetName())
 }
 
@@ -81,14 +106,14 @@ func (s *ClusterScope) GetVCN(ctx context.Context) (*core.Vcn, error) {
 			return nil, err
 		}
 		vcn := resp.Vcn
-		if s.IsResourceCreatedByClusterAPI(vcn.FreeformTags) {
+		if s.IsNetworkResourceCreatedByClusterAPI(vcn.FreeformTags) {
 			return &vcn, nil
 		} else {
 			return nil, errors.New("cluster api tags have been modified out of context")
 		}
 	}
 	vcns, err := s.VCNClient.ListVcns(ctx, core.ListVcnsRequest{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNetworkCompartmentId()),
 		DisplayName:   common.String(s.GetVcnName()),
 	})
 	if err != nil {
@@ -97,7 +122,7 @@ func (s *ClusterScope) GetVCN(ctx context.Context) (*core.Vcn, error) {
 	}
 
 	for _, vcn := range vcns.Items {
-		if s.IsResourceCreatedByClusterAPI(vcn.FreeformTags) {
+		if s.IsNetworkResourceCreatedByClusterAPI(vcn.FreeformTags) {
 			return &vcn, nil
 		}
 	}
@@ -121,17 +146,21 @@ func (s *ClusterScope) UpdateVCN(ctx context.Context, vcn infrastructurev1beta2.
 }
 
 func (s *ClusterScope) CreateVCN(ctx context.Context, spec infrastructurev1beta2.VCN) (*string, error) {
+	freeformTags := s.GetNetworkFreeFormTags()
+	if s.IsNetworkShared() {
+		freeformTags[s.getSharedNetworkConsumerTagKey()] = s.OCIClusterAccessor.GetName()
+	}
 	vcnDetails := core.CreateVcnDetails{
-		CompartmentId: common.String(s.GetCompartmentId()),
+		CompartmentId: common.String(s.GetNetworkCompartmentId()),
 		DisplayName:   common.String(s.GetVcnName()),
 		CidrBlocks:    s.GetVcnCidrs(),
-		FreeformTags:  s.GetFreeFormTags(),
+		FreeformTags:  freeformTags,
 		DefinedTags:   s.GetDefinedTags(),
 		DnsLabel:      spec.DnsLabel,
 	}
 	vcnResponse, err := s.VCNClient.CreateVcn(ctx, core.CreateVcnRequest{
 		CreateVcnDetails: vcnDetails,
-		OpcRetryToken:    ociutil.GetOPCRetryToken("%s-%s", "create-vcn", string(s.OCIClusterAccessor.GetOCIResourceIdentifier())),
+		OpcRetryToken:    ociutil.GetOPCRetryToken("%s-%s", "create-vcn", s.GetNetworkResourceIdentifier()),
 	})
 	if err != nil {
 		s.Logger.Error(err, "failed create vcn")
@@ -151,6 +180,17 @@ func (s *ClusterScope) DeleteVCN(ctx context.Context) error {
 		s.Logger.Info("VCN is already deleted")
 		return nil
 	}
+	inUse, err := s.IsSharedNetworkInUse(ctx)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		s.Logger.Info("Shared VCN is used by other clusters, skipping deletion of VCN")
+		return nil
+	}
+	if s.isVCNRetained() {
+		return s.retainVCN(ctx, vcn)
+	}
 	_, err = s.VCNClient.DeleteVcn(ctx, core.DeleteVcnRequest{
 		VcnId: vcn.Id,
 	})
@@ -168,6 +168,39 @@ func TestClusterScope_DeleteVCN(t *testing.T) {
 	vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{VcnId: common.String("error")})).
 		Return(core.GetVcnResponse{}, errors.New("some error in GetVcn"))
 
+	retainedTags := map[string]string{
+		ociutil.CreatedBy:                 ociutil.OCIClusterAPIProviderRetained,
+		ociutil.ClusterResourceIdentifier: "resource_uid",
+	}
+	vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{VcnId: common.String("retain_id")})).
+		Return(core.GetVcnResponse{
+			Vcn: core.Vcn{
+				Id:           common.String("retain_id"),
+				FreeformTags: tags,
+			},
+		}, nil)
+	vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{VcnId: common.String("retained_id")})).
+		Return(core.GetVcnResponse{
+			Vcn: core.Vcn{
+				Id:           common.String("retained_id"),
+				FreeformTags: retainedTags,
+			},
+		}, nil)
+	vcnClient.EXPECT().UpdateVcn(gomock.Any(), gomock.Eq(core.UpdateVcnRequest{
+		VcnId: common.String("retain_id"),
+		UpdateVcnDetails: core.UpdateVcnDetails{
+			FreeformTags: retainedTags,
+		},
+	})).
+		Return(core.UpdateVcnResponse{}, nil)
+	vcnClient.EXPECT().UpdateVcn(gomock.Any(), gomock.Eq(core.UpdateVcnRequest{
+		VcnId: common.String("retained_id"),
+		UpdateVcnDetails: core.UpdateVcnDetails{
+			FreeformTags: retainedTags,
+		},
+	})).
+		Return(core.UpdateVcnResponse{}, nil)
+
 	vcnClient.EXPECT().GetVcn(gomock.Any(), gomock.Eq(core.GetVcnRequest{VcnId: common.String("vcn_deleted")})).
 		Return(core.GetVcnResponse{}, errors.New("not found"))
 	vcnClient.EXPECT().DeleteVcn(gomock.Any(), gomock.Eq(core.DeleteVcnRequest{
@@ -196,6 +229,30 @@ func TestClusterScope_DeleteVCN(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "vcn is retained",
+			spec: infrastructurev1beta2.OCIClusterSpec{
+				NetworkSpec: infrastructurev1beta2.NetworkSpec{
+					Vcn: infrastructurev1beta2.VCN{
+						ID:             common.String("retain_id"),
+						DeletionPolicy: infrastructurev1beta2.DeletionPolicyRetain,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "vcn is already retained",
+			spec: infrastructurev1beta2.OCIClusterSpec{
+				NetworkSpec: infrastructurev1beta2.NetworkSpec{
+					Vcn: infrastructurev1beta2.VCN{
+						ID:             common.String("retained_id"),
+						DeletionPolicy: infrastructurev1beta2.DeletionPolicyRetain,
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "vcn already deleted",
 			spec: infrastructurev1beta2.OCIClusterSpec{
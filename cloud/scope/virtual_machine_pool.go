@@ -488,8 +488,13 @@ func (m *VirtualMachinePoolScope) UpdateVirtualNodePool(ctx context.Context, poo
 		nodePoolSizeUpdateRequired = true
 	}
 	actual := m.getSpecFromAPIObject(pool)
+	// tags which are not set by Cluster API, for example tags added by tag defaults, are preserved
+	applied := m.OCIVirtualMachinePool.Status.AppliedTags
+	freeformTags, freeformTagsChanged := ociutil.MergeFreeformTags(pool.FreeformTags, m.getFreeFormTags(), appliedFreeformTags(applied))
+	definedTags, definedTagsChanged := ociutil.MergeDefinedTags(pool.DefinedTags, m.getDefinedTags(), appliedDefinedTags(applied))
+	tagsChanged := freeformTagsChanged || definedTagsChanged
 	if !reflect.DeepEqual(spec, actual) ||
-		m.getNodePoolName() != *pool.DisplayName || nodePoolSizeUpdateRequired {
+		m.getNodePoolName() != *pool.DisplayName || nodePoolSizeUpdateRequired || tagsChanged {
 		m.Logger.Info("Updating virtual node pool")
 		// printing json specs will help debug problems when there are spurious/unwanted updates
 		jsonSpec, err := json.Marshal(*spec)
@@ -522,6 +527,10 @@ func (m *VirtualMachinePoolScope) UpdateVirtualNodePool(ctx context.Context, poo
 		}
 
 		nodePoolDetails.InitialVirtualNodeLabels = m.getInitialNodeKeyValuePairs()
+		if tagsChanged {
+			nodePoolDetails.FreeformTags = freeformTags
+			nodePoolDetails.DefinedTags = definedTags
+		}
 
 		req := oke.UpdateVirtualNodePoolRequest{
 			VirtualNodePoolId:            pool.Id,
@@ -533,10 +542,12 @@ func (m *VirtualMachinePoolScope) UpdateVirtualNodePool(ctx context.Context, poo
 		}
 
 		m.Info("Updated virtual node pool")
+		m.OCIVirtualMachinePool.Status.AppliedTags = NewAppliedTags(m.getFreeFormTags(), m.OCIManagedCluster.Spec.DefinedTags)
 		return true, nil
 	} else {
 		m.Info("No reconciliation needed for virtual node pool")
 	}
+	m.OCIVirtualMachinePool.Status.AppliedTags = NewAppliedTags(m.getFreeFormTags(), m.OCIManagedCluster.Spec.DefinedTags)
 	return false, nil
 }
 
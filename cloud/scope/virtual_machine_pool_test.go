@@ -585,6 +585,7 @@ func TestVirtualMachinePoolUpdate(t *testing.T) {
 				},
 				Size:         common.Int(3),
 				FreeformTags: tags,
+				DefinedTags:  definedTagsInterface,
 			},
 		},
 		{
@@ -683,6 +684,7 @@ func TestVirtualMachinePoolUpdate(t *testing.T) {
 				},
 				Size:         common.Int(3),
 				FreeformTags: tags,
+				DefinedTags:  definedTagsInterface,
 			},
 		},
 		{
@@ -751,6 +753,7 @@ func TestVirtualMachinePoolUpdate(t *testing.T) {
 				},
 				Size:         common.Int(3),
 				FreeformTags: tags,
+				DefinedTags:  definedTagsInterface,
 			},
 		},
 		{
@@ -847,6 +850,7 @@ func TestVirtualMachinePoolUpdate(t *testing.T) {
 				},
 				Size:         common.Int(3),
 				FreeformTags: tags,
+				DefinedTags:  definedTagsInterface,
 			},
 		},
 		{
@@ -944,6 +948,7 @@ func TestVirtualMachinePoolUpdate(t *testing.T) {
 				},
 				Size:         common.Int(3),
 				FreeformTags: tags,
+				DefinedTags:  definedTagsInterface,
 			},
 		},
 	}
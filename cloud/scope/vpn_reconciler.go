@@ -0,0 +1,512 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// ReconcileVPN reconciles the Site-to-Site IPSec VPN connection of the DRG
+func (s *ClusterScope) ReconcileVPN(ctx context.Context) error {
+	if !s.isVPNEnabled() {
+		s.Logger.Info("VPN is not enabled, ignoring reconciliation")
+		return nil
+	}
+	if s.getDRG() == nil {
+		return errors.New("DRG has not been specified")
+	}
+	if s.getDrgID() == nil {
+		return errors.New("DRG ID has not been set")
+	}
+	vpn := s.getVPN()
+
+	cpe, err := s.GetCPE(ctx)
+	if err != nil {
+		return err
+	}
+	if cpe != nil && cpe.IpAddress != nil && *cpe.IpAddress != vpn.CPE.IPAddress {
+		// the IP address of a CPE cannot be updated, the CPE is replaced along with the IPSec connection
+		s.Logger.Info("IP address of the CPE has changed, replacing the CPE", "cpe", cpe.Id)
+		err = s.deleteIPSecConnection(ctx)
+		if err != nil {
+			return err
+		}
+		err = s.deleteCPE(ctx, cpe)
+		if err != nil {
+			return err
+		}
+		cpe = nil
+		vpn.ID = nil
+		vpn.CPE.ID = nil
+	}
+	if cpe == nil {
+		cpe, err = s.CreateCPE(ctx)
+		if err != nil {
+			return err
+		}
+	} else {
+		s.Logger.Info("No Reconciliation Required for CPE", "cpe", cpe.Id)
+	}
+	vpn.CPE.ID = cpe.Id
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		// CPE does not have a lifecycle state
+		status.CPE = newNetworkResourceStatus(cpe.Id, "")
+	})
+
+	ipSecConnection, err := s.GetIPSecConnection(ctx)
+	if err != nil {
+		return err
+	}
+	created := false
+	if ipSecConnection == nil {
+		ipSecConnection, err = s.CreateIPSecConnection(ctx)
+		if err != nil {
+			return err
+		}
+		created = true
+	} else if !isStaticRoutesEqual(ipSecConnection.StaticRoutes, vpn.StaticRoutes) {
+		_, err = s.VCNClient.UpdateIPSecConnection(ctx, core.UpdateIPSecConnectionRequest{
+			IpscId: ipSecConnection.Id,
+			UpdateIpSecConnectionDetails: core.UpdateIpSecConnectionDetails{
+				StaticRoutes: vpn.StaticRoutes,
+			},
+		})
+		if err != nil {
+			s.Logger.Error(err, "failed to update IPSec connection")
+			return errors.Wrap(err, "failed to update IPSec connection")
+		}
+		s.Logger.Info("Successfully updated IPSec connection", "ipsec", ipSecConnection.Id)
+	} else {
+		s.Logger.Info("No Reconciliation Required for IPSec connection", "ipsec", ipSecConnection.Id)
+	}
+	vpn.ID = ipSecConnection.Id
+	s.updateNetworkStatus(func(status *infrastructurev1beta2.NetworkStatus) {
+		status.IPSecConnection = newNetworkResourceStatus(ipSecConnection.Id, string(ipSecConnection.LifecycleState))
+	})
+
+	resp, err := s.VCNClient.ListIPSecConnectionTunnels(ctx, core.ListIPSecConnectionTunnelsRequest{
+		IpscId: ipSecConnection.Id,
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to list IPSec tunnels")
+		return errors.Wrap(err, "failed to list IPSec tunnels")
+	}
+	// the tunnels of a new IPSec connection are created with the desired configuration
+	if !created {
+		err = s.reconcileIPSecTunnels(ctx, ipSecConnection.Id, resp.Items)
+		if err != nil {
+			return err
+		}
+	}
+	s.reconcileVPNTunnelStatus(resp.Items)
+	return nil
+}
+
+// GetCPE returns the CPE of the VPN created by Cluster API, or nil if it does not exist.
+func (s *ClusterScope) GetCPE(ctx context.Context) (*core.Cpe, error) {
+	cpeId := s.getVPN().CPE.ID
+	if cpeId != nil {
+		resp, err := s.VCNClient.GetCpe(ctx, core.GetCpeRequest{
+			CpeId: cpeId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		cpe := resp.Cpe
+		if s.IsResourceCreatedByClusterAPI(cpe.FreeformTags) {
+			return &cpe, nil
+		} else {
+			return nil, errors.New("cluster api tags have been modified out of context")
+		}
+	}
+	var page *string
+	for {
+		resp, err := s.VCNClient.ListCpes(ctx, core.ListCpesRequest{
+			CompartmentId: common.String(s.GetNetworkCompartmentId()),
+			Page:          page,
+		})
+		if err != nil {
+			s.Logger.Error(err, "failed to list CPEs")
+			return nil, errors.Wrap(err, "failed to list CPEs")
+		}
+		for _, cpe := range resp.Items {
+			if *cpe.DisplayName == s.OCIClusterAccessor.GetName() && s.IsResourceCreatedByClusterAPI(cpe.FreeformTags) {
+				return &cpe, nil
+			}
+		}
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return nil, nil
+}
+
+// CreateCPE creates the CPE which represents the on-premises end of the VPN.
+func (s *ClusterScope) CreateCPE(ctx context.Context) (*core.Cpe, error) {
+	vpn := s.getVPN()
+	resp, err := s.VCNClient.CreateCpe(ctx, core.CreateCpeRequest{
+		CreateCpeDetails: core.CreateCpeDetails{
+			CompartmentId:    common.String(s.GetNetworkCompartmentId()),
+			IpAddress:        common.String(vpn.CPE.IPAddress),
+			DisplayName:      common.String(s.OCIClusterAccessor.GetName()),
+			CpeDeviceShapeId: vpn.CPE.DeviceShapeId,
+			FreeformTags:     s.GetFreeFormTags(),
+			DefinedTags:      s.GetDefinedTags(),
+		},
+		OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", "create-cpe", s.OCIClusterAccessor.GetOCIResourceIdentifier(), vpn.CPE.IPAddress),
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to create CPE")
+		return nil, errors.Wrap(err, "failed to create CPE")
+	}
+	s.Logger.Info("Created the CPE", "cpe", resp.Id)
+	return &resp.Cpe, nil
+}
+
+// GetIPSecConnection returns the IPSec connection of the VPN created by Cluster API, or nil if it does not exist.
+func (s *ClusterScope) GetIPSecConnection(ctx context.Context) (*core.IpSecConnection, error) {
+	vpn := s.getVPN()
+	if vpn.ID != nil {
+		resp, err := s.VCNClient.GetIPSecConnection(ctx, core.GetIPSecConnectionRequest{
+			IpscId: vpn.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		ipSecConnection := resp.IpSecConnection
+		if s.IsResourceCreatedByClusterAPI(ipSecConnection.FreeformTags) {
+			return &ipSecConnection, nil
+		} else {
+			return nil, errors.New("cluster api tags have been modified out of context")
+		}
+	}
+	var page *string
+	for {
+		resp, err := s.VCNClient.ListIPSecConnections(ctx, core.ListIPSecConnectionsRequest{
+			CompartmentId: common.String(s.GetNetworkCompartmentId()),
+			DrgId:         s.getDrgID(),
+			CpeId:         vpn.CPE.ID,
+			Page:          page,
+		})
+		if err != nil {
+			s.Logger.Error(err, "failed to list IPSec connections")
+			return nil, errors.Wrap(err, "failed to list IPSec connections")
+		}
+		for _, ipSecConnection := range resp.Items {
+			if ipSecConnection.LifecycleState == core.IpSecConnectionLifecycleStateTerminated {
+				continue
+			}
+			if s.IsResourceCreatedByClusterAPI(ipSecConnection.FreeformTags) {
+				return &ipSecConnection, nil
+			}
+		}
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return nil, nil
+}
+
+// CreateIPSecConnection creates the IPSec connection between the DRG and the CPE.
+func (s *ClusterScope) CreateIPSecConnection(ctx context.Context) (*core.IpSecConnection, error) {
+	vpn := s.getVPN()
+	tunnels, err := s.getIPSecTunnelDetails(ctx, *vpn)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.VCNClient.CreateIPSecConnection(ctx, core.CreateIPSecConnectionRequest{
+		CreateIpSecConnectionDetails: core.CreateIpSecConnectionDetails{
+			CompartmentId:       common.String(s.GetNetworkCompartmentId()),
+			CpeId:               vpn.CPE.ID,
+			DrgId:               s.getDrgID(),
+			StaticRoutes:        vpn.StaticRoutes,
+			DisplayName:         common.String(s.OCIClusterAccessor.GetName()),
+			TunnelConfiguration: tunnels,
+			FreeformTags:        s.GetFreeFormTags(),
+			DefinedTags:         s.GetDefinedTags(),
+		},
+		OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", "create-ipsec", s.OCIClusterAccessor.GetOCIResourceIdentifier(), *vpn.CPE.ID),
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to create IPSec connection")
+		return nil, errors.Wrap(err, "failed to create IPSec connection")
+	}
+	s.Logger.Info("Created the IPSec connection", "ipsec", resp.Id)
+	return &resp.IpSecConnection, nil
+}
+
+// getIPSecTunnelDetails builds the configuration of the tunnels, reading the pre-shared keys from
+// the Secret referred to by the VPN spec, if any.
+func (s *ClusterScope) getIPSecTunnelDetails(ctx context.Context, vpn infrastructurev1beta2.VPN) ([]core.CreateIpSecConnectionTunnelDetails, error) {
+	var secret *corev1.Secret
+	if vpn.SharedSecretName != "" {
+		secret = &corev1.Secret{}
+		key := types.NamespacedName{
+			Namespace: s.OCIClusterAccessor.GetNameSpace(),
+			Name:      vpn.SharedSecretName,
+		}
+		if err := s.client.Get(ctx, key, secret); err != nil {
+			return nil, errors.Wrapf(err, "failed to get VPN shared secret %s", vpn.SharedSecretName)
+		}
+	}
+	var tunnels []core.CreateIpSecConnectionTunnelDetails
+	for i := 0; i < 2; i++ {
+		tunnelName := fmt.Sprintf("tunnel%d", i+1)
+		tunnel := core.CreateIpSecConnectionTunnelDetails{
+			DisplayName: common.String(fmt.Sprintf("%s-%s", s.OCIClusterAccessor.GetName(), tunnelName)),
+			Routing:     core.CreateIpSecConnectionTunnelDetailsRoutingStatic,
+		}
+		if i < len(vpn.Tunnels) && vpn.Tunnels[i].Routing == infrastructurev1beta2.IPSecTunnelRoutingBGP {
+			bgpSession := vpn.Tunnels[i].BGPSession
+			if bgpSession == nil {
+				return nil, errors.Errorf("BGP session has not been specified for %s", tunnelName)
+			}
+			tunnel.Routing = core.CreateIpSecConnectionTunnelDetailsRoutingBgp
+			tunnel.BgpSessionConfig = &core.CreateIpSecTunnelBgpSessionDetails{
+				CustomerBgpAsn:      common.String(bgpSession.CustomerBgpAsn),
+				CustomerInterfaceIp: common.String(bgpSession.CustomerInterfaceIp),
+				OracleInterfaceIp:   common.String(bgpSession.OracleInterfaceIp),
+			}
+		}
+		if secret != nil {
+			sharedSecret, ok := secret.Data[tunnelName]
+			if !ok {
+				return nil, errors.Errorf("VPN shared secret %s does not contain the key %s", vpn.SharedSecretName, tunnelName)
+			}
+			tunnel.SharedSecret = common.String(string(sharedSecret))
+		}
+		tunnels = append(tunnels, tunnel)
+	}
+	return tunnels, nil
+}
+
+// reconcileIPSecTunnels updates the routing and the pre-shared keys of the tunnels of an existing IPSec
+// connection which differ from the spec. The pre-shared keys are only reconciled if they are read from a Secret,
+// so that rotating the keys in the Secret rotates the keys of the tunnels.
+func (s *ClusterScope) reconcileIPSecTunnels(ctx context.Context, ipSecConnectionId *string, tunnels []core.IpSecConnectionTunnel) error {
+	desiredTunnels, err := s.getIPSecTunnelDetails(ctx, *s.getVPN())
+	if err != nil {
+		return err
+	}
+	for _, desiredTunnel := range desiredTunnels {
+		tunnel := findIPSecTunnel(tunnels, *desiredTunnel.DisplayName)
+		if tunnel == nil {
+			s.Logger.Info("IPSec tunnel not found, skipping its reconciliation", "tunnel", *desiredTunnel.DisplayName)
+			continue
+		}
+		if !isIPSecTunnelRoutingEqual(*tunnel, desiredTunnel) {
+			details := core.UpdateIpSecConnectionTunnelDetails{
+				Routing: core.UpdateIpSecConnectionTunnelDetailsRoutingEnum(desiredTunnel.Routing),
+			}
+			if desiredTunnel.BgpSessionConfig != nil {
+				details.BgpSessionConfig = &core.UpdateIpSecTunnelBgpSessionDetails{
+					CustomerBgpAsn:      desiredTunnel.BgpSessionConfig.CustomerBgpAsn,
+					CustomerInterfaceIp: desiredTunnel.BgpSessionConfig.CustomerInterfaceIp,
+					OracleInterfaceIp:   desiredTunnel.BgpSessionConfig.OracleInterfaceIp,
+				}
+			}
+			_, err = s.VCNClient.UpdateIPSecConnectionTunnel(ctx, core.UpdateIPSecConnectionTunnelRequest{
+				IpscId:                             ipSecConnectionId,
+				TunnelId:                           tunnel.Id,
+				UpdateIpSecConnectionTunnelDetails: details,
+			})
+			if err != nil {
+				s.Logger.Error(err, "failed to update IPSec tunnel")
+				return errors.Wrap(err, "failed to update IPSec tunnel")
+			}
+			s.Logger.Info("Successfully updated IPSec tunnel", "tunnel", tunnel.Id)
+		}
+		if desiredTunnel.SharedSecret == nil {
+			continue
+		}
+		resp, err := s.VCNClient.GetIPSecConnectionTunnelSharedSecret(ctx, core.GetIPSecConnectionTunnelSharedSecretRequest{
+			IpscId:   ipSecConnectionId,
+			TunnelId: tunnel.Id,
+		})
+		if err != nil {
+			s.Logger.Error(err, "failed to get IPSec tunnel shared secret")
+			return errors.Wrap(err, "failed to get IPSec tunnel shared secret")
+		}
+		if reflect.DeepEqual(resp.SharedSecret, desiredTunnel.SharedSecret) {
+			continue
+		}
+		_, err = s.VCNClient.UpdateIPSecConnectionTunnelSharedSecret(ctx, core.UpdateIPSecConnectionTunnelSharedSecretRequest{
+			IpscId:   ipSecConnectionId,
+			TunnelId: tunnel.Id,
+			UpdateIpSecConnectionTunnelSharedSecretDetails: core.UpdateIpSecConnectionTunnelSharedSecretDetails{
+				SharedSecret: desiredTunnel.SharedSecret,
+			},
+		})
+		if err != nil {
+			s.Logger.Error(err, "failed to update IPSec tunnel shared secret")
+			return errors.Wrap(err, "failed to update IPSec tunnel shared secret")
+		}
+		s.Logger.Info("Successfully rotated IPSec tunnel shared secret", "tunnel", tunnel.Id)
+	}
+	return nil
+}
+
+// reconcileVPNTunnelStatus reports the status of the tunnels of the IPSec connection as a condition.
+// A tunnel which is not up does not fail the reconciliation, as it depends on the on-premises device.
+func (s *ClusterScope) reconcileVPNTunnelStatus(tunnels []core.IpSecConnectionTunnel) {
+	if len(tunnels) == 0 {
+		s.OCIClusterAccessor.MarkConditionFalse(infrastructurev1beta2.VPNTunnelsReadyCondition, infrastructurev1beta2.VPNTunnelNotReadyReason,
+			clusterv1.ConditionSeverityInfo, "IPSec tunnels are being provisioned")
+		return
+	}
+	for _, tunnel := range tunnels {
+		if tunnel.Status != core.IpSecConnectionTunnelStatusUp {
+			s.Logger.Info("IPSec tunnel is not up", "tunnel", tunnel.Id, "status", tunnel.Status)
+			s.OCIClusterAccessor.MarkConditionFalse(infrastructurev1beta2.VPNTunnelsReadyCondition, infrastructurev1beta2.VPNTunnelNotReadyReason,
+				clusterv1.ConditionSeverityWarning, "IPSec tunnel %s is %s", *tunnel.Id, tunnel.Status)
+			return
+		}
+	}
+	s.OCIClusterAccessor.MarkConditionTrue(infrastructurev1beta2.VPNTunnelsReadyCondition)
+}
+
+// DeleteVPN deletes the IPSec connection and the CPE of the Site-to-Site VPN
+func (s *ClusterScope) DeleteVPN(ctx context.Context) error {
+	if !s.isVPNEnabled() {
+		s.Logger.Info("VPN is not enabled, ignoring deletion")
+		return nil
+	}
+	if s.getDRG() == nil || s.getDrgID() == nil {
+		s.Logger.Info("DRG ID has not been set, ignoring deletion of VPN")
+		return nil
+	}
+	err := s.deleteIPSecConnection(ctx)
+	if err != nil {
+		return err
+	}
+	cpe, err := s.GetCPE(ctx)
+	if err != nil && !ociutil.IsNotFound(err) {
+		return err
+	}
+	if cpe == nil {
+		s.Logger.Info("CPE is already deleted")
+		return nil
+	}
+	return s.deleteCPE(ctx, cpe)
+}
+
+// deleteIPSecConnection deletes the IPSec connection of the VPN and waits for it to be terminated.
+func (s *ClusterScope) deleteIPSecConnection(ctx context.Context) error {
+	ipSecConnection, err := s.GetIPSecConnection(ctx)
+	if err != nil && !ociutil.IsNotFound(err) {
+		return err
+	}
+	if ipSecConnection == nil || ipSecConnection.LifecycleState == core.IpSecConnectionLifecycleStateTerminated {
+		s.Logger.Info("IPSec connection is already deleted")
+		return nil
+	}
+	_, err = s.VCNClient.DeleteIPSecConnection(ctx, core.DeleteIPSecConnectionRequest{
+		IpscId: ipSecConnection.Id,
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to delete IPSec connection")
+		return errors.Wrap(err, "failed to delete IPSec connection")
+	}
+	err = s.waitForIPSecConnectionToBeDeleted(ctx, ipSecConnection.Id)
+	if err != nil {
+		return err
+	}
+	s.Logger.Info("Successfully deleted IPSec connection", "ipsec", ipSecConnection.Id)
+	return nil
+}
+
+func (s *ClusterScope) deleteCPE(ctx context.Context, cpe *core.Cpe) error {
+	_, err := s.VCNClient.DeleteCpe(ctx, core.DeleteCpeRequest{
+		CpeId: cpe.Id,
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to delete CPE")
+		return errors.Wrap(err, "failed to delete CPE")
+	}
+	s.Logger.Info("Successfully deleted CPE", "cpe", cpe.Id)
+	return nil
+}
+
+func (s *ClusterScope) waitForIPSecConnectionToBeDeleted(ctx context.Context, ipSecConnectionId *string) error {
+	return wait.PollWithContext(ctx, PollInterval, RequestTimeout, func(ctx context.Context) (done bool, err error) {
+		resp, err := s.VCNClient.GetIPSecConnection(ctx, core.GetIPSecConnectionRequest{
+			IpscId: ipSecConnectionId,
+		})
+		if err != nil {
+			if ociutil.IsNotFound(err) {
+				return true, nil
+			}
+			return true, err
+		}
+		return resp.LifecycleState == core.IpSecConnectionLifecycleStateTerminated, nil
+	})
+}
+
+func (s *ClusterScope) getVPN() *infrastructurev1beta2.VPN {
+	return s.OCIClusterAccessor.GetNetworkSpec().VCNPeering.VPN
+}
+
+func (s *ClusterScope) isVPNEnabled() bool {
+	return s.isPeeringEnabled() && s.getVPN() != nil
+}
+
+func isStaticRoutesEqual(actual []string, desired []string) bool {
+	actualRoutes := append([]string{}, actual...)
+	desiredRoutes := append([]string{}, desired...)
+	sort.Strings(actualRoutes)
+	sort.Strings(desiredRoutes)
+	return reflect.DeepEqual(actualRoutes, desiredRoutes)
+}
+
+func findIPSecTunnel(tunnels []core.IpSecConnectionTunnel, displayName string) *core.IpSecConnectionTunnel {
+	for i, tunnel := range tunnels {
+		if tunnel.DisplayName != nil && *tunnel.DisplayName == displayName {
+			return &tunnels[i]
+		}
+	}
+	return nil
+}
+
+func isIPSecTunnelRoutingEqual(actual core.IpSecConnectionTunnel, desired core.CreateIpSecConnectionTunnelDetails) bool {
+	if string(actual.Routing) != string(desired.Routing) {
+		return false
+	}
+	if desired.BgpSessionConfig == nil {
+		return true
+	}
+	if actual.BgpSessionInfo == nil {
+		return false
+	}
+	return reflect.DeepEqual(actual.BgpSessionInfo.CustomerBgpAsn, desired.BgpSessionConfig.CustomerBgpAsn) &&
+		reflect.DeepEqual(actual.BgpSessionInfo.CustomerInterfaceIp, desired.BgpSessionConfig.CustomerInterfaceIp) &&
+		reflect.DeepEqual(actual.BgpSessionInfo.OracleInterfaceIp, desired.BgpSessionConfig.OracleInterfaceIp)
+}
@@ -0,0 +1,621 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn/mock_vcn"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestVPNReconciliation(t *testing.T) {
+	var (
+		cs                 *ClusterScope
+		mockCtrl           *gomock.Controller
+		vcnClient          *mock_vcn.MockClient
+		ociClusterAccessor OCISelfManagedCluster
+		tags               map[string]string
+	)
+
+	setup := func(t *testing.T, g *WithT) {
+		var err error
+		mockCtrl = gomock.NewController(t)
+		vcnClient = mock_vcn.NewMockClient(mockCtrl)
+		client := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vpn-secret",
+				Namespace: "test",
+			},
+			Data: map[string][]byte{
+				"tunnel1": []byte("secret1"),
+				"tunnel2": []byte("secret2"),
+			},
+		}).Build()
+		ociClusterAccessor = OCISelfManagedCluster{
+			&infrastructurev1beta2.OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					UID:       "cluster_uid",
+					Name:      "cluster",
+					Namespace: "test",
+				},
+				Spec: infrastructurev1beta2.OCIClusterSpec{
+					CompartmentId:         "compartment-id",
+					OCIResourceIdentifier: "resource_uid",
+					NetworkSpec: infrastructurev1beta2.NetworkSpec{
+						VCNPeering: &infrastructurev1beta2.VCNPeering{
+							DRG: &infrastructurev1beta2.DRG{
+								Manage: true,
+								ID:     common.String("drg-id"),
+							},
+						},
+					},
+				},
+			},
+		}
+		cs, err = NewClusterScope(ClusterScopeParams{
+			VCNClient:          vcnClient,
+			Cluster:            &clusterv1.Cluster{},
+			OCIClusterAccessor: ociClusterAccessor,
+			Client:             client,
+		})
+		tags = make(map[string]string)
+		tags[ociutil.CreatedBy] = ociutil.OCIClusterAPIProvider
+		tags[ociutil.ClusterResourceIdentifier] = "resource_uid"
+		g.Expect(err).To(BeNil())
+	}
+	teardown := func(t *testing.T, g *WithT) {
+		mockCtrl.Finish()
+	}
+
+	tests := []struct {
+		name              string
+		errorExpected     bool
+		matchError        error
+		tunnelsReady      corev1.ConditionStatus
+		testSpecificSetup func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient)
+	}{
+		{
+			name:          "vpn disabled",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+			},
+		},
+		{
+			name:          "drg id not set",
+			errorExpected: true,
+			matchError:    errors.New("DRG ID has not been set"),
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+				vcnPeering := clusterScope.OCIClusterAccessor.GetNetworkSpec().VCNPeering
+				vcnPeering.DRG.ID = nil
+				vcnPeering.VPN = &infrastructurev1beta2.VPN{}
+			},
+		},
+		{
+			name:          "cpe and ipsec connection create",
+			errorExpected: false,
+			tunnelsReady:  corev1.ConditionTrue,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().VCNPeering.VPN = &infrastructurev1beta2.VPN{
+					CPE:          infrastructurev1beta2.CPE{IPAddress: "203.0.113.10"},
+					StaticRoutes: []string{"192.168.0.0/16"},
+					Tunnels: []infrastructurev1beta2.IPSecTunnel{
+						{
+							Routing: infrastructurev1beta2.IPSecTunnelRoutingBGP,
+							BGPSession: &infrastructurev1beta2.BGPSession{
+								CustomerBgpAsn:      "65000",
+								CustomerInterfaceIp: "10.255.0.1/30",
+								OracleInterfaceIp:   "10.255.0.2/30",
+							},
+						},
+					},
+					SharedSecretName: "vpn-secret",
+				}
+				vcnClient.EXPECT().ListCpes(gomock.Any(), gomock.Eq(core.ListCpesRequest{
+					CompartmentId: common.String("compartment-id"),
+				})).
+					Return(core.ListCpesResponse{}, nil)
+				vcnClient.EXPECT().CreateCpe(gomock.Any(), gomock.Eq(core.CreateCpeRequest{
+					CreateCpeDetails: core.CreateCpeDetails{
+						CompartmentId: common.String("compartment-id"),
+						IpAddress:     common.String("203.0.113.10"),
+						DisplayName:   common.String("cluster"),
+						FreeformTags:  tags,
+						DefinedTags:   make(map[string]map[string]interface{}),
+					},
+					OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", "create-cpe", "resource_uid", "203.0.113.10"),
+				})).
+					Return(core.CreateCpeResponse{
+						Cpe: core.Cpe{
+							Id: common.String("cpe-id"),
+						},
+					}, nil)
+				vcnClient.EXPECT().ListIPSecConnections(gomock.Any(), gomock.Eq(core.ListIPSecConnectionsRequest{
+					CompartmentId: common.String("compartment-id"),
+					DrgId:         common.String("drg-id"),
+					CpeId:         common.String("cpe-id"),
+				})).
+					Return(core.ListIPSecConnectionsResponse{}, nil)
+				vcnClient.EXPECT().CreateIPSecConnection(gomock.Any(), gomock.Eq(core.CreateIPSecConnectionRequest{
+					CreateIpSecConnectionDetails: core.CreateIpSecConnectionDetails{
+						CompartmentId: common.String("compartment-id"),
+						CpeId:         common.String("cpe-id"),
+						DrgId:         common.String("drg-id"),
+						StaticRoutes:  []string{"192.168.0.0/16"},
+						DisplayName:   common.String("cluster"),
+						TunnelConfiguration: []core.CreateIpSecConnectionTunnelDetails{
+							{
+								DisplayName:  common.String("cluster-tunnel1"),
+								Routing:      core.CreateIpSecConnectionTunnelDetailsRoutingBgp,
+								SharedSecret: common.String("secret1"),
+								BgpSessionConfig: &core.CreateIpSecTunnelBgpSessionDetails{
+									CustomerBgpAsn:      common.String("65000"),
+									CustomerInterfaceIp: common.String("10.255.0.1/30"),
+									OracleInterfaceIp:   common.String("10.255.0.2/30"),
+								},
+							},
+							{
+								DisplayName:  common.String("cluster-tunnel2"),
+								Routing:      core.CreateIpSecConnectionTunnelDetailsRoutingStatic,
+								SharedSecret: common.String("secret2"),
+							},
+						},
+						FreeformTags: tags,
+						DefinedTags:  make(map[string]map[string]interface{}),
+					},
+					OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", "create-ipsec", "resource_uid", "cpe-id"),
+				})).
+					Return(core.CreateIPSecConnectionResponse{
+						IpSecConnection: core.IpSecConnection{
+							Id: common.String("ipsec-id"),
+						},
+					}, nil)
+				vcnClient.EXPECT().ListIPSecConnectionTunnels(gomock.Any(), gomock.Eq(core.ListIPSecConnectionTunnelsRequest{
+					IpscId: common.String("ipsec-id"),
+				})).
+					Return(core.ListIPSecConnectionTunnelsResponse{
+						Items: []core.IpSecConnectionTunnel{
+							{Id: common.String("tunnel1"), Status: core.IpSecConnectionTunnelStatusUp},
+							{Id: common.String("tunnel2"), Status: core.IpSecConnectionTunnelStatusUp},
+						},
+					}, nil)
+			},
+		},
+		{
+			name:          "missing shared secret",
+			errorExpected: true,
+			matchError:    errors.New("failed to get VPN shared secret missing-secret: secrets \"missing-secret\" not found"),
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().VCNPeering.VPN = &infrastructurev1beta2.VPN{
+					CPE: infrastructurev1beta2.CPE{
+						IPAddress: "203.0.113.10",
+						ID:        common.String("cpe-id"),
+					},
+					StaticRoutes:     []string{"192.168.0.0/16"},
+					SharedSecretName: "missing-secret",
+				}
+				vcnClient.EXPECT().GetCpe(gomock.Any(), gomock.Eq(core.GetCpeRequest{
+					CpeId: common.String("cpe-id"),
+				})).
+					Return(core.GetCpeResponse{
+						Cpe: core.Cpe{
+							Id:           common.String("cpe-id"),
+							IpAddress:    common.String("203.0.113.10"),
+							FreeformTags: tags,
+						},
+					}, nil)
+				vcnClient.EXPECT().ListIPSecConnections(gomock.Any(), gomock.Any()).
+					Return(core.ListIPSecConnectionsResponse{}, nil)
+			},
+		},
+		{
+			name:          "static routes update and tunnel down",
+			errorExpected: false,
+			tunnelsReady:  corev1.ConditionFalse,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().VCNPeering.VPN = &infrastructurev1beta2.VPN{
+					CPE: infrastructurev1beta2.CPE{
+						IPAddress: "203.0.113.10",
+						ID:        common.String("cpe-id"),
+					},
+					StaticRoutes: []string{"192.168.0.0/16", "172.16.0.0/12"},
+					ID:           common.String("ipsec-id"),
+				}
+				vcnClient.EXPECT().GetCpe(gomock.Any(), gomock.Any()).
+					Return(core.GetCpeResponse{
+						Cpe: core.Cpe{
+							Id:           common.String("cpe-id"),
+							IpAddress:    common.String("203.0.113.10"),
+							FreeformTags: tags,
+						},
+					}, nil)
+				vcnClient.EXPECT().GetIPSecConnection(gomock.Any(), gomock.Eq(core.GetIPSecConnectionRequest{
+					IpscId: common.String("ipsec-id"),
+				})).
+					Return(core.GetIPSecConnectionResponse{
+						IpSecConnection: core.IpSecConnection{
+							Id:           common.String("ipsec-id"),
+							StaticRoutes: []string{"192.168.0.0/16"},
+							FreeformTags: tags,
+						},
+					}, nil)
+				vcnClient.EXPECT().UpdateIPSecConnection(gomock.Any(), gomock.Eq(core.UpdateIPSecConnectionRequest{
+					IpscId: common.String("ipsec-id"),
+					UpdateIpSecConnectionDetails: core.UpdateIpSecConnectionDetails{
+						StaticRoutes: []string{"192.168.0.0/16", "172.16.0.0/12"},
+					},
+				})).
+					Return(core.UpdateIPSecConnectionResponse{}, nil)
+				vcnClient.EXPECT().ListIPSecConnectionTunnels(gomock.Any(), gomock.Any()).
+					Return(core.ListIPSecConnectionTunnelsResponse{
+						Items: []core.IpSecConnectionTunnel{
+							{Id: common.String("tunnel1"), Status: core.IpSecConnectionTunnelStatusUp},
+							{Id: common.String("tunnel2"), Status: core.IpSecConnectionTunnelStatusDown},
+						},
+					}, nil)
+			},
+		},
+		{
+			name:          "cpe ip address change replaces the cpe and the ipsec connection",
+			errorExpected: false,
+			tunnelsReady:  corev1.ConditionFalse,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().VCNPeering.VPN = &infrastructurev1beta2.VPN{
+					CPE: infrastructurev1beta2.CPE{
+						IPAddress: "203.0.113.20",
+						ID:        common.String("cpe-id"),
+					},
+					StaticRoutes: []string{"192.168.0.0/16"},
+					ID:           common.String("ipsec-id"),
+				}
+				vcnClient.EXPECT().GetCpe(gomock.Any(), gomock.Any()).
+					Return(core.GetCpeResponse{
+						Cpe: core.Cpe{
+							Id:           common.String("cpe-id"),
+							IpAddress:    common.String("203.0.113.10"),
+							FreeformTags: tags,
+						},
+					}, nil)
+				vcnClient.EXPECT().GetIPSecConnection(gomock.Any(), gomock.Eq(core.GetIPSecConnectionRequest{
+					IpscId: common.String("ipsec-id"),
+				})).
+					Return(core.GetIPSecConnectionResponse{
+						IpSecConnection: core.IpSecConnection{
+							Id:             common.String("ipsec-id"),
+							LifecycleState: core.IpSecConnectionLifecycleStateAvailable,
+							FreeformTags:   tags,
+						},
+					}, nil)
+				vcnClient.EXPECT().DeleteIPSecConnection(gomock.Any(), gomock.Eq(core.DeleteIPSecConnectionRequest{
+					IpscId: common.String("ipsec-id"),
+				})).
+					Return(core.DeleteIPSecConnectionResponse{}, nil)
+				vcnClient.EXPECT().GetIPSecConnection(gomock.Any(), gomock.Eq(core.GetIPSecConnectionRequest{
+					IpscId: common.String("ipsec-id"),
+				})).
+					Return(core.GetIPSecConnectionResponse{}, ociutil.ErrNotFound)
+				vcnClient.EXPECT().DeleteCpe(gomock.Any(), gomock.Eq(core.DeleteCpeRequest{
+					CpeId: common.String("cpe-id"),
+				})).
+					Return(core.DeleteCpeResponse{}, nil)
+				vcnClient.EXPECT().CreateCpe(gomock.Any(), gomock.Eq(core.CreateCpeRequest{
+					CreateCpeDetails: core.CreateCpeDetails{
+						CompartmentId: common.String("compartment-id"),
+						IpAddress:     common.String("203.0.113.20"),
+						DisplayName:   common.String("cluster"),
+						FreeformTags:  tags,
+						DefinedTags:   make(map[string]map[string]interface{}),
+					},
+					OpcRetryToken: ociutil.GetOPCRetryToken("%s-%s-%s", "create-cpe", "resource_uid", "203.0.113.20"),
+				})).
+					Return(core.CreateCpeResponse{
+						Cpe: core.Cpe{
+							Id: common.String("new-cpe-id"),
+						},
+					}, nil)
+				vcnClient.EXPECT().ListIPSecConnections(gomock.Any(), gomock.Eq(core.ListIPSecConnectionsRequest{
+					CompartmentId: common.String("compartment-id"),
+					DrgId:         common.String("drg-id"),
+					CpeId:         common.String("new-cpe-id"),
+				})).
+					Return(core.ListIPSecConnectionsResponse{}, nil)
+				vcnClient.EXPECT().CreateIPSecConnection(gomock.Any(), gomock.Any()).
+					Return(core.CreateIPSecConnectionResponse{
+						IpSecConnection: core.IpSecConnection{
+							Id: common.String("new-ipsec-id"),
+						},
+					}, nil)
+				vcnClient.EXPECT().ListIPSecConnectionTunnels(gomock.Any(), gomock.Eq(core.ListIPSecConnectionTunnelsRequest{
+					IpscId: common.String("new-ipsec-id"),
+				})).
+					Return(core.ListIPSecConnectionTunnelsResponse{}, nil)
+			},
+		},
+		{
+			name:          "tunnel routing and shared secret update",
+			errorExpected: false,
+			tunnelsReady:  corev1.ConditionTrue,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+				clusterScope.OCIClusterAccessor.GetNetworkSpec().VCNPeering.VPN = &infrastructurev1beta2.VPN{
+					CPE: infrastructurev1beta2.CPE{
+						IPAddress: "203.0.113.10",
+						ID:        common.String("cpe-id"),
+					},
+					StaticRoutes: []string{"192.168.0.0/16"},
+					Tunnels: []infrastructurev1beta2.IPSecTunnel{
+						{
+							Routing: infrastructurev1beta2.IPSecTunnelRoutingBGP,
+							BGPSession: &infrastructurev1beta2.BGPSession{
+								CustomerBgpAsn:      "65000",
+								CustomerInterfaceIp: "10.255.0.1/30",
+								OracleInterfaceIp:   "10.255.0.2/30",
+							},
+						},
+					},
+					SharedSecretName: "vpn-secret",
+					ID:               common.String("ipsec-id"),
+				}
+				vcnClient.EXPECT().GetCpe(gomock.Any(), gomock.Any()).
+					Return(core.GetCpeResponse{
+						Cpe: core.Cpe{
+							Id:           common.String("cpe-id"),
+							IpAddress:    common.String("203.0.113.10"),
+							FreeformTags: tags,
+						},
+					}, nil)
+				vcnClient.EXPECT().GetIPSecConnection(gomock.Any(), gomock.Any()).
+					Return(core.GetIPSecConnectionResponse{
+						IpSecConnection: core.IpSecConnection{
+							Id:           common.String("ipsec-id"),
+							StaticRoutes: []string{"192.168.0.0/16"},
+							FreeformTags: tags,
+						},
+					}, nil)
+				vcnClient.EXPECT().ListIPSecConnectionTunnels(gomock.Any(), gomock.Any()).
+					Return(core.ListIPSecConnectionTunnelsResponse{
+						Items: []core.IpSecConnectionTunnel{
+							{
+								Id:          common.String("tunnel1"),
+								DisplayName: common.String("cluster-tunnel1"),
+								Status:      core.IpSecConnectionTunnelStatusUp,
+								Routing:     core.IpSecConnectionTunnelRoutingStatic,
+							},
+							{
+								Id:          common.String("tunnel2"),
+								DisplayName: common.String("cluster-tunnel2"),
+								Status:      core.IpSecConnectionTunnelStatusUp,
+								Routing:     core.IpSecConnectionTunnelRoutingStatic,
+							},
+						},
+					}, nil)
+				vcnClient.EXPECT().UpdateIPSecConnectionTunnel(gomock.Any(), gomock.Eq(core.UpdateIPSecConnectionTunnelRequest{
+					IpscId:   common.String("ipsec-id"),
+					TunnelId: common.String("tunnel1"),
+					UpdateIpSecConnectionTunnelDetails: core.UpdateIpSecConnectionTunnelDetails{
+						Routing: core.UpdateIpSecConnectionTunnelDetailsRoutingBgp,
+						BgpSessionConfig: &core.UpdateIpSecTunnelBgpSessionDetails{
+							CustomerBgpAsn:      common.String("65000"),
+							CustomerInterfaceIp: common.String("10.255.0.1/30"),
+							OracleInterfaceIp:   common.String("10.255.0.2/30"),
+						},
+					},
+				})).
+					Return(core.UpdateIPSecConnectionTunnelResponse{}, nil)
+				vcnClient.EXPECT().GetIPSecConnectionTunnelSharedSecret(gomock.Any(), gomock.Eq(core.GetIPSecConnectionTunnelSharedSecretRequest{
+					IpscId:   common.String("ipsec-id"),
+					TunnelId: common.String("tunnel1"),
+				})).
+					Return(core.GetIPSecConnectionTunnelSharedSecretResponse{
+						IpSecConnectionTunnelSharedSecret: core.IpSecConnectionTunnelSharedSecret{
+							SharedSecret: common.String("secret1"),
+						},
+					}, nil)
+				vcnClient.EXPECT().GetIPSecConnectionTunnelSharedSecret(gomock.Any(), gomock.Eq(core.GetIPSecConnectionTunnelSharedSecretRequest{
+					IpscId:   common.String("ipsec-id"),
+					TunnelId: common.String("tunnel2"),
+				})).
+					Return(core.GetIPSecConnectionTunnelSharedSecretResponse{
+						IpSecConnectionTunnelSharedSecret: core.IpSecConnectionTunnelSharedSecret{
+							SharedSecret: common.String("old-secret2"),
+						},
+					}, nil)
+				vcnClient.EXPECT().UpdateIPSecConnectionTunnelSharedSecret(gomock.Any(), gomock.Eq(core.UpdateIPSecConnectionTunnelSharedSecretRequest{
+					IpscId:   common.String("ipsec-id"),
+					TunnelId: common.String("tunnel2"),
+					UpdateIpSecConnectionTunnelSharedSecretDetails: core.UpdateIpSecConnectionTunnelSharedSecretDetails{
+						SharedSecret: common.String("secret2"),
+					},
+				})).
+					Return(core.UpdateIPSecConnectionTunnelSharedSecretResponse{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			defer teardown(t, g)
+			setup(t, g)
+			tc.testSpecificSetup(cs, vcnClient)
+			err := cs.ReconcileVPN(context.Background())
+			if tc.errorExpected {
+				g.Expect(err).To(Not(BeNil()))
+				g.Expect(err.Error()).To(Equal(tc.matchError.Error()))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			if tc.tunnelsReady != "" {
+				condition := conditions.Get(ociClusterAccessor.OCICluster, infrastructurev1beta2.VPNTunnelsReadyCondition)
+				g.Expect(condition).To(Not(BeNil()))
+				g.Expect(condition.Status).To(Equal(tc.tunnelsReady))
+			}
+		})
+	}
+}
+
+func TestVPNDeletion(t *testing.T) {
+	var (
+		cs                 *ClusterScope
+		mockCtrl           *gomock.Controller
+		vcnClient          *mock_vcn.MockClient
+		ociClusterAccessor OCISelfManagedCluster
+		tags               map[string]string
+	)
+
+	setup := func(t *testing.T, g *WithT) {
+		var err error
+		mockCtrl = gomock.NewController(t)
+		vcnClient = mock_vcn.NewMockClient(mockCtrl)
+		client := fake.NewClientBuilder().Build()
+		ociClusterAccessor = OCISelfManagedCluster{
+			&infrastructurev1beta2.OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					UID:  "cluster_uid",
+					Name: "cluster",
+				},
+				Spec: infrastructurev1beta2.OCIClusterSpec{
+					CompartmentId:         "compartment-id",
+					OCIResourceIdentifier: "resource_uid",
+					NetworkSpec: infrastructurev1beta2.NetworkSpec{
+						VCNPeering: &infrastructurev1beta2.VCNPeering{
+							DRG: &infrastructurev1beta2.DRG{
+								Manage: true,
+								ID:     common.String("drg-id"),
+							},
+							VPN: &infrastructurev1beta2.VPN{
+								CPE: infrastructurev1beta2.CPE{
+									IPAddress: "203.0.113.10",
+									ID:        common.String("cpe-id"),
+								},
+								StaticRoutes: []string{"192.168.0.0/16"},
+								ID:           common.String("ipsec-id"),
+							},
+						},
+					},
+				},
+			},
+		}
+		cs, err = NewClusterScope(ClusterScopeParams{
+			VCNClient:          vcnClient,
+			Cluster:            &clusterv1.Cluster{},
+			OCIClusterAccessor: ociClusterAccessor,
+			Client:             client,
+		})
+		tags = make(map[string]string)
+		tags[ociutil.CreatedBy] = ociutil.OCIClusterAPIProvider
+		tags[ociutil.ClusterResourceIdentifier] = "resource_uid"
+		g.Expect(err).To(BeNil())
+	}
+	teardown := func(t *testing.T, g *WithT) {
+		mockCtrl.Finish()
+	}
+
+	tests := []struct {
+		name              string
+		errorExpected     bool
+		matchError        error
+		testSpecificSetup func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient)
+	}{
+		{
+			name:          "already deleted",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+				vcnClient.EXPECT().GetIPSecConnection(gomock.Any(), gomock.Any()).
+					Return(core.GetIPSecConnectionResponse{}, ociutil.ErrNotFound)
+				vcnClient.EXPECT().GetCpe(gomock.Any(), gomock.Any()).
+					Return(core.GetCpeResponse{}, ociutil.ErrNotFound)
+			},
+		},
+		{
+			name:          "ipsec connection and cpe delete",
+			errorExpected: false,
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+				vcnClient.EXPECT().GetIPSecConnection(gomock.Any(), gomock.Eq(core.GetIPSecConnectionRequest{
+					IpscId: common.String("ipsec-id"),
+				})).
+					Return(core.GetIPSecConnectionResponse{
+						IpSecConnection: core.IpSecConnection{
+							Id:           common.String("ipsec-id"),
+							FreeformTags: tags,
+						},
+					}, nil)
+				vcnClient.EXPECT().DeleteIPSecConnection(gomock.Any(), gomock.Eq(core.DeleteIPSecConnectionRequest{
+					IpscId: common.String("ipsec-id"),
+				})).
+					Return(core.DeleteIPSecConnectionResponse{}, nil)
+				vcnClient.EXPECT().GetIPSecConnection(gomock.Any(), gomock.Any()).
+					Return(core.GetIPSecConnectionResponse{}, ociutil.ErrNotFound)
+				vcnClient.EXPECT().GetCpe(gomock.Any(), gomock.Any()).
+					Return(core.GetCpeResponse{
+						Cpe: core.Cpe{
+							Id:           common.String("cpe-id"),
+							IpAddress:    common.String("203.0.113.10"),
+							FreeformTags: tags,
+						},
+					}, nil)
+				vcnClient.EXPECT().DeleteCpe(gomock.Any(), gomock.Eq(core.DeleteCpeRequest{
+					CpeId: common.String("cpe-id"),
+				})).
+					Return(core.DeleteCpeResponse{}, nil)
+			},
+		},
+		{
+			name:          "ipsec connection delete error",
+			errorExpected: true,
+			matchError:    errors.New("failed to delete IPSec connection: request failed"),
+			testSpecificSetup: func(clusterScope *ClusterScope, vcnClient *mock_vcn.MockClient) {
+				vcnClient.EXPECT().GetIPSecConnection(gomock.Any(), gomock.Any()).
+					Return(core.GetIPSecConnectionResponse{
+						IpSecConnection: core.IpSecConnection{
+							Id:           common.String("ipsec-id"),
+							FreeformTags: tags,
+						},
+					}, nil)
+				vcnClient.EXPECT().DeleteIPSecConnection(gomock.Any(), gomock.Any()).
+					Return(core.DeleteIPSecConnectionResponse{}, errors.New("request failed"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			defer teardown(t, g)
+			setup(t, g)
+			tc.testSpecificSetup(cs, vcnClient)
+			err := cs.DeleteVPN(context.Background())
+			if tc.errorExpected {
+				g.Expect(err).To(Not(BeNil()))
+				g.Expect(err.Error()).To(Equal(tc.matchError.Error()))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
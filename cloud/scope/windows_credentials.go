@@ -0,0 +1,343 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// WindowsCredentialsUsernameKey is the key of the username in the Secret of the Windows credentials.
+	WindowsCredentialsUsernameKey = "username"
+	// WindowsCredentialsPasswordKey is the key of the password in the Secret of the Windows credentials.
+	WindowsCredentialsPasswordKey = "password"
+	// windowsCredentialsRotationKeyKey is the key of the private key the password being set on the instance is
+	// encrypted with.
+	windowsCredentialsRotationKeyKey = "rotationKey"
+	// windowsPasswordRotationKeyBits is the size of the key the password being set on the instance is encrypted with.
+	windowsPasswordRotationKeyBits = 2048
+	// windowsPasswordLength is the length of the generated passwords.
+	windowsPasswordLength = 24
+	// windowsPasswordRotationTimeoutInSeconds is how long the Run Command which sets the password may run.
+	windowsPasswordRotationTimeoutInSeconds = 300
+	// windowsPasswordRotationDeadline is how long the Run Command which sets the password may take to be delivered to
+	// the instance and to run before the rotation is abandoned.
+	windowsPasswordRotationDeadline = 15 * time.Minute
+)
+
+// windowsPasswordRotationScript generates a password of the given length from letters and digits, sets it as the
+// password of the user and prints it encrypted with the public key. The random bytes are rejected above the largest
+// multiple of the alphabet length so that every character is equally likely.
+const windowsPasswordRotationScript = `$ErrorActionPreference = 'Stop'
+$alphabet = 'abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789'
+$random = [System.Security.Cryptography.RandomNumberGenerator]::Create()
+$byte = New-Object byte[] 1
+do {
+  $password = ''
+  while ($password.Length -lt %d) {
+    $random.GetBytes($byte)
+    if ($byte[0] -lt 248) { $password += $alphabet[$byte[0] %% 62] }
+  }
+} until ($password -cmatch '[a-z]' -and $password -cmatch '[A-Z]' -and $password -match '[0-9]')
+Set-LocalUser -Name '%s' -Password (ConvertTo-SecureString $password -AsPlainText -Force)
+$rsa = New-Object System.Security.Cryptography.RSACryptoServiceProvider
+$rsa.FromXmlString('%s')
+[Convert]::ToBase64String($rsa.Encrypt([System.Text.Encoding]::UTF8.GetBytes($password), $true))
+`
+
+// ErrWindowsCredentialsNotAvailable is returned while the initial credentials of the instance are not available yet
+// or the password is being rotated.
+var ErrWindowsCredentialsNotAvailable = errors.New("the Windows credentials are not available")
+
+// ReconcileWindowsCredentials stores the initial credentials of the Windows instance in a Secret owned by the
+// OCIMachine, and rotates the password when the OCIMachine has the RotateWindowsPasswordAnnotation. The new password
+// is set on the instance with a Run Command, which requires the Compute Instance Run Command plugin of the Oracle
+// Cloud Agent.
+func (m *MachineScope) ReconcileWindowsCredentials(ctx context.Context, instance *core.Instance) error {
+	if !m.OCIMachine.Spec.StoreWindowsCredentials {
+		return nil
+	}
+	status := m.OCIMachine.Status.WindowsCredentials
+	if status == nil || ociutil.DerefString(status.InstanceId) != ociutil.DerefString(instance.Id) {
+		return m.storeInitialWindowsCredentials(ctx, instance)
+	}
+	if status.RotationCommandId != nil {
+		return m.completeWindowsPasswordRotation(ctx, instance, status)
+	}
+	if status.RotationStartTime != nil {
+		// the rotation was persisted but the command which was created for it, if any, was not
+		return m.createWindowsPasswordRotationCommand(ctx, instance, status)
+	}
+	if _, ok := m.OCIMachine.Annotations[infrastructurev1beta2.RotateWindowsPasswordAnnotation]; ok {
+		return m.rotateWindowsPassword(ctx, instance, status)
+	}
+	return nil
+}
+
+func (m *MachineScope) getWindowsCredentialsSecretName() string {
+	return fmt.Sprintf("%s-windows-credentials", m.OCIMachine.Name)
+}
+
+// storeInitialWindowsCredentials stores the credentials OCI generated for the instance at launch.
+func (m *MachineScope) storeInitialWindowsCredentials(ctx context.Context, instance *core.Instance) error {
+	resp, err := m.ComputeClient.GetWindowsInstanceInitialCredentials(ctx, core.GetWindowsInstanceInitialCredentialsRequest{
+		InstanceId: instance.Id,
+	})
+	if err != nil {
+		if ociutil.IsNotFound(err) {
+			return errors.Wrapf(ErrWindowsCredentialsNotAvailable, "the initial credentials of the instance %s are not available yet", *instance.Id)
+		}
+		return errors.Wrapf(err, "failed to get the initial credentials of the instance %s", *instance.Id)
+	}
+	secretName := m.getWindowsCredentialsSecretName()
+	if err := m.writeMachineSecret(ctx, secretName, map[string][]byte{
+		WindowsCredentialsUsernameKey: []byte(ociutil.DerefString(resp.Username)),
+		WindowsCredentialsPasswordKey: []byte(ociutil.DerefString(resp.Password)),
+	}); err != nil {
+		return err
+	}
+	now := metav1.Now()
+	m.OCIMachine.Status.WindowsCredentials = &infrastructurev1beta2.WindowsCredentialsStatus{
+		SecretName: secretName,
+		InstanceId: instance.Id,
+		UpdateTime: &now,
+	}
+	m.Logger.Info("Stored the initial Windows credentials of the instance", "secret", secretName)
+	return nil
+}
+
+// rotateWindowsPassword sets a new password on the instance with a Run Command. The password is generated on the
+// instance and returned encrypted with a key generated for the rotation, so that neither the command nor its output,
+// which OCI keeps, reveal it. The private key is kept in the Secret until the command completes. The rotation is
+// persisted before the command is created, so that a rotation which is requested again before the command is
+// recorded does not replace the key the output of the command is encrypted with.
+func (m *MachineScope) rotateWindowsPassword(ctx context.Context, instance *core.Instance, status *infrastructurev1beta2.WindowsCredentialsStatus) error {
+	// the request is consumed whether the rotation succeeds or not, a failed rotation is not retried
+	delete(m.OCIMachine.Annotations, infrastructurev1beta2.RotateWindowsPasswordAnnotation)
+	secret, err := m.getWindowsCredentialsSecret(ctx, status)
+	if err != nil {
+		return err
+	}
+	key, err := rsa.GenerateKey(rand.Reader, windowsPasswordRotationKeyBits)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate the key of the password rotation")
+	}
+	data := secret.Data
+	data[windowsCredentialsRotationKeyKey] = pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+	if err := m.writeMachineSecret(ctx, status.SecretName, data); err != nil {
+		return err
+	}
+	now := metav1.Now()
+	status.RotationStartTime = &now
+	if err := m.PatchObject(ctx); err != nil {
+		return errors.Wrap(err, "failed to persist the rotation of the password")
+	}
+	return m.createWindowsPasswordRotationCommand(ctx, instance, status)
+}
+
+// createWindowsPasswordRotationCommand creates the Run Command of the persisted rotation. The retry token is derived
+// from the rotation so that the command is only created once, even when its id was not recorded. The rotation is
+// dropped when the command cannot be created.
+func (m *MachineScope) createWindowsPasswordRotationCommand(ctx context.Context, instance *core.Instance, status *infrastructurev1beta2.WindowsCredentialsStatus) error {
+	secret, err := m.getWindowsCredentialsSecret(ctx, status)
+	if err != nil {
+		return err
+	}
+	data := secret.Data
+	username := string(data[WindowsCredentialsUsernameKey])
+	var resp computeinstanceagent.CreateInstanceAgentCommandResponse
+	key, err := parseWindowsPasswordRotationKey(data[windowsCredentialsRotationKeyKey])
+	if err == nil {
+		resp, err = m.InstanceAgentClient.CreateInstanceAgentCommand(ctx, computeinstanceagent.CreateInstanceAgentCommandRequest{
+			CreateInstanceAgentCommandDetails: computeinstanceagent.CreateInstanceAgentCommandDetails{
+				CompartmentId:             instance.CompartmentId,
+				DisplayName:               common.String(fmt.Sprintf("%s-rotate-windows-password", m.OCIMachine.Name)),
+				ExecutionTimeOutInSeconds: common.Int(windowsPasswordRotationTimeoutInSeconds),
+				Target:                    &computeinstanceagent.InstanceAgentCommandTarget{InstanceId: instance.Id},
+				Content: &computeinstanceagent.InstanceAgentCommandContent{
+					Source: computeinstanceagent.InstanceAgentCommandSourceViaTextDetails{
+						Text: common.String(getWindowsPasswordRotationCommand(username, &key.PublicKey)),
+					},
+					Output: computeinstanceagent.InstanceAgentCommandOutputViaTextDetails{},
+				},
+			},
+			OpcRetryToken: ociutil.GetOPCRetryToken("%s-rotate-windows-password-%d", string(m.OCIMachine.UID),
+				status.RotationStartTime.Unix()),
+		})
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "failed to create the command which rotates the password of the instance %s", *instance.Id)
+		delete(data, windowsCredentialsRotationKeyKey)
+		if writeErr := m.writeMachineSecret(ctx, status.SecretName, data); writeErr != nil {
+			return errors.Wrapf(err, "failed to delete the key of the password rotation: %s", writeErr.Error())
+		}
+		status.RotationStartTime = nil
+		return err
+	}
+	status.RotationCommandId = resp.Id
+	m.Logger.Info("Rotating the Windows password of the instance", "command", *resp.Id)
+	return errors.Wrap(ErrWindowsCredentialsNotAvailable, "the password is being rotated")
+}
+
+// completeWindowsPasswordRotation stores the password the Run Command has set once it succeeds, the key of the
+// rotation is only dropped along with the storage of the password, so that a password which could not be read yet
+// is read again on the next reconcile. The rotation is dropped when the command fails or does not complete before
+// the deadline, the command is then canceled.
+func (m *MachineScope) completeWindowsPasswordRotation(ctx context.Context, instance *core.Instance, status *infrastructurev1beta2.WindowsCredentialsStatus) error {
+	var execution *computeinstanceagent.InstanceAgentCommandExecution
+	resp, err := m.InstanceAgentClient.GetInstanceAgentCommandExecution(ctx, computeinstanceagent.GetInstanceAgentCommandExecutionRequest{
+		InstanceAgentCommandId: status.RotationCommandId,
+		InstanceId:             instance.Id,
+	})
+	if err == nil {
+		execution = &resp.InstanceAgentCommandExecution
+	} else if !ociutil.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to get the execution of the command %s", *status.RotationCommandId)
+	}
+
+	var rotationErr error
+	if execution == nil || execution.LifecycleState == computeinstanceagent.InstanceAgentCommandExecutionLifecycleStateAccepted ||
+		execution.LifecycleState == computeinstanceagent.InstanceAgentCommandExecutionLifecycleStateInProgress {
+		if status.RotationStartTime != nil && time.Since(status.RotationStartTime.Time) < windowsPasswordRotationDeadline {
+			return errors.Wrap(ErrWindowsCredentialsNotAvailable, "the password is being rotated")
+		}
+		_, err := m.InstanceAgentClient.CancelInstanceAgentCommand(ctx, computeinstanceagent.CancelInstanceAgentCommandRequest{
+			InstanceAgentCommandId: status.RotationCommandId,
+		})
+		if err != nil && !ociutil.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to cancel the command %s", *status.RotationCommandId)
+		}
+		rotationErr = errors.Errorf("the command %s which rotates the password did not complete in %s",
+			*status.RotationCommandId, windowsPasswordRotationDeadline)
+	} else if execution.LifecycleState != computeinstanceagent.InstanceAgentCommandExecutionLifecycleStateSucceeded {
+		rotationErr = errors.Errorf("the command %s which rotates the password is %s", *status.RotationCommandId, execution.LifecycleState)
+	}
+
+	secret, err := m.getWindowsCredentialsSecret(ctx, status)
+	if err != nil {
+		return err
+	}
+	data := secret.Data
+	if rotationErr == nil {
+		// the command has set the password on the instance, the rotation is kept until the password is stored
+		password, err := decryptWindowsPassword(data[windowsCredentialsRotationKeyKey], execution.Content)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read the password set by the command %s", *status.RotationCommandId)
+		}
+		data[WindowsCredentialsPasswordKey] = password
+	}
+	delete(data, windowsCredentialsRotationKeyKey)
+	if err := m.writeMachineSecret(ctx, status.SecretName, data); err != nil {
+		return err
+	}
+	status.RotationCommandId = nil
+	status.RotationStartTime = nil
+	if rotationErr != nil {
+		return rotationErr
+	}
+	now := metav1.Now()
+	status.UpdateTime = &now
+	m.Logger.Info("Rotated the Windows password of the instance", "secret", status.SecretName)
+	return nil
+}
+
+func (m *MachineScope) getWindowsCredentialsSecret(ctx context.Context, status *infrastructurev1beta2.WindowsCredentialsStatus) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: m.OCIMachine.Namespace, Name: status.SecretName}
+	if err := m.Client.Get(ctx, key, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to get the Windows credentials secret %s", status.SecretName)
+	}
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	return secret, nil
+}
+
+// getWindowsPasswordRotationCommand returns the command which generates a password which meets the complexity
+// requirements of Windows, sets it as the password of the user and prints it encrypted with the public key. The
+// script is passed encoded to PowerShell so that it does not need to be quoted in the command.
+func getWindowsPasswordRotationCommand(username string, key *rsa.PublicKey) string {
+	publicKey := fmt.Sprintf("<RSAKeyValue><Modulus>%s</Modulus><Exponent>%s</Exponent></RSAKeyValue>",
+		base64.StdEncoding.EncodeToString(key.N.Bytes()),
+		base64.StdEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+	script := fmt.Sprintf(windowsPasswordRotationScript, windowsPasswordLength,
+		strings.ReplaceAll(username, "'", "''"), publicKey)
+	encoded := make([]byte, 0, 2*len(script))
+	for _, c := range utf16.Encode([]rune(script)) {
+		encoded = append(encoded, byte(c), byte(c>>8))
+	}
+	return fmt.Sprintf("powershell.exe -NoProfile -NonInteractive -EncodedCommand %s", base64.StdEncoding.EncodeToString(encoded))
+}
+
+// decryptWindowsPassword decrypts the password printed by the command which rotated it with the private key of the
+// rotation.
+func decryptWindowsPassword(privateKey []byte, content computeinstanceagent.InstanceAgentCommandExecutionOutputContent) ([]byte, error) {
+	key, err := parseWindowsPasswordRotationKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	output, ok := content.(computeinstanceagent.InstanceAgentCommandExecutionOutputViaTextDetails)
+	if !ok || output.Text == nil {
+		return nil, errors.New("the command has no output")
+	}
+	encrypted, err := base64.StdEncoding.DecodeString(strings.TrimSpace(*output.Text))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode the output of the command")
+	}
+	password, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, key, encrypted, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt the output of the command")
+	}
+	return password, nil
+}
+
+// parseWindowsPasswordRotationKey parses the private key of the password rotation stored in the Secret.
+func parseWindowsPasswordRotationKey(privateKey []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(privateKey)
+	if block == nil {
+		return nil, errors.New("the key of the password rotation is missing")
+	}
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the key of the password rotation")
+	}
+	return key, nil
+}
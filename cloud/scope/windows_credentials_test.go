@@ -0,0 +1,421 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute/mock_compute"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/computeinstanceagent/mock_computeinstanceagent"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/klogr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileWindowsCredentials(t *testing.T) {
+	credentialsSecret := func(data map[string]string) *corev1.Secret {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-windows-credentials", Namespace: "default"},
+			Data:       map[string][]byte{},
+		}
+		for k, v := range data {
+			secret.Data[k] = []byte(v)
+		}
+		return secret
+	}
+	storedStatus := func() *infrastructurev1beta2.WindowsCredentialsStatus {
+		return &infrastructurev1beta2.WindowsCredentialsStatus{
+			SecretName: "machine-windows-credentials",
+			InstanceId: common.String("instance"),
+		}
+	}
+	rotatingStatus := func(started time.Time) *infrastructurev1beta2.WindowsCredentialsStatus {
+		status := storedStatus()
+		status.RotationCommandId = common.String("command")
+		status.RotationStartTime = &metav1.Time{Time: started}
+		return status
+	}
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotationKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	encrypted, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, &key.PublicKey, []byte("rotated"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotatedOutput := computeinstanceagent.InstanceAgentCommandExecutionOutputViaTextDetails{
+		ExitCode: common.Int(0),
+		Text:     common.String(base64.StdEncoding.EncodeToString(encrypted) + "\r\n"),
+	}
+
+	tests := []struct {
+		name                    string
+		store                   bool
+		annotations             map[string]string
+		status                  *infrastructurev1beta2.WindowsCredentialsStatus
+		objects                 []client.Object
+		setup                   func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient)
+		errorExpected           bool
+		notAvailable            bool
+		expectedCommandId       *string
+		expectedUsername        string
+		expectedPassword        string
+		expectRotationKey       bool
+		expectPersistedRotation bool
+	}{
+		{
+			name: "credentials not stored",
+		},
+		{
+			name:  "initial credentials not available yet",
+			store: true,
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				computeClient.EXPECT().GetWindowsInstanceInitialCredentials(gomock.Any(), gomock.Any()).
+					Return(core.GetWindowsInstanceInitialCredentialsResponse{}, ociutil.ErrNotFound)
+			},
+			errorExpected: true,
+			notAvailable:  true,
+		},
+		{
+			name:  "initial credentials stored",
+			store: true,
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				computeClient.EXPECT().GetWindowsInstanceInitialCredentials(gomock.Any(), gomock.Eq(core.GetWindowsInstanceInitialCredentialsRequest{
+					InstanceId: common.String("instance"),
+				})).Return(core.GetWindowsInstanceInitialCredentialsResponse{InstanceCredentials: core.InstanceCredentials{
+					Username: common.String("opc"),
+					Password: common.String("initial"),
+				}}, nil)
+			},
+			expectedUsername: "opc",
+			expectedPassword: "initial",
+		},
+		{
+			name:  "initial credentials of a replaced instance stored",
+			store: true,
+			status: &infrastructurev1beta2.WindowsCredentialsStatus{
+				SecretName: "machine-windows-credentials",
+				InstanceId: common.String("old"),
+			},
+			objects: []client.Object{credentialsSecret(map[string]string{"username": "opc", "password": "old"})},
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				computeClient.EXPECT().GetWindowsInstanceInitialCredentials(gomock.Any(), gomock.Any()).
+					Return(core.GetWindowsInstanceInitialCredentialsResponse{InstanceCredentials: core.InstanceCredentials{
+						Username: common.String("opc"),
+						Password: common.String("initial"),
+					}}, nil)
+			},
+			expectedUsername: "opc",
+			expectedPassword: "initial",
+		},
+		{
+			name:        "password rotation requested",
+			store:       true,
+			annotations: map[string]string{infrastructurev1beta2.RotateWindowsPasswordAnnotation: ""},
+			status:      storedStatus(),
+			objects:     []client.Object{credentialsSecret(map[string]string{"username": "opc", "password": "initial"})},
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				agentClient.EXPECT().CreateInstanceAgentCommand(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req computeinstanceagent.CreateInstanceAgentCommandRequest) (computeinstanceagent.CreateInstanceAgentCommandResponse, error) {
+						source := req.Content.Source.(computeinstanceagent.InstanceAgentCommandSourceViaTextDetails)
+						if *req.CompartmentId != "compartment" || *req.Target.InstanceId != "instance" ||
+							!strings.HasPrefix(*source.Text, "powershell.exe -NoProfile -NonInteractive -EncodedCommand ") {
+							return computeinstanceagent.CreateInstanceAgentCommandResponse{}, errors.New("unexpected request")
+						}
+						return computeinstanceagent.CreateInstanceAgentCommandResponse{
+							InstanceAgentCommand: computeinstanceagent.InstanceAgentCommand{Id: common.String("command")},
+						}, nil
+					})
+			},
+			errorExpected:           true,
+			notAvailable:            true,
+			expectedCommandId:       common.String("command"),
+			expectedUsername:        "opc",
+			expectedPassword:        "initial",
+			expectRotationKey:       true,
+			expectPersistedRotation: true,
+		},
+		{
+			name:  "password rotation resumed when its command was not recorded",
+			store: true,
+			status: &infrastructurev1beta2.WindowsCredentialsStatus{
+				SecretName:        "machine-windows-credentials",
+				InstanceId:        common.String("instance"),
+				RotationStartTime: &metav1.Time{Time: time.Unix(1700000000, 0)},
+			},
+			objects: []client.Object{credentialsSecret(map[string]string{"username": "opc", "password": "initial", "rotationKey": rotationKey})},
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				agentClient.EXPECT().CreateInstanceAgentCommand(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req computeinstanceagent.CreateInstanceAgentCommandRequest) (computeinstanceagent.CreateInstanceAgentCommandResponse, error) {
+						if *req.OpcRetryToken != "machine-uid-rotate-windows-password-1700000000" {
+							return computeinstanceagent.CreateInstanceAgentCommandResponse{}, errors.New("unexpected request")
+						}
+						return computeinstanceagent.CreateInstanceAgentCommandResponse{
+							InstanceAgentCommand: computeinstanceagent.InstanceAgentCommand{Id: common.String("command")},
+						}, nil
+					})
+			},
+			errorExpected:     true,
+			notAvailable:      true,
+			expectedCommandId: common.String("command"),
+			expectedUsername:  "opc",
+			expectedPassword:  "initial",
+			expectRotationKey: true,
+		},
+		{
+			name:        "password rotation which could not be requested",
+			store:       true,
+			annotations: map[string]string{infrastructurev1beta2.RotateWindowsPasswordAnnotation: ""},
+			status:      storedStatus(),
+			objects:     []client.Object{credentialsSecret(map[string]string{"username": "opc", "password": "initial"})},
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				agentClient.EXPECT().CreateInstanceAgentCommand(gomock.Any(), gomock.Any()).
+					Return(computeinstanceagent.CreateInstanceAgentCommandResponse{}, errors.New("request failed"))
+			},
+			errorExpected:    true,
+			expectedUsername: "opc",
+			expectedPassword: "initial",
+		},
+		{
+			name:    "password being rotated",
+			store:   true,
+			status:  rotatingStatus(time.Now()),
+			objects: []client.Object{credentialsSecret(map[string]string{"username": "opc", "password": "initial", "rotationKey": rotationKey})},
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				agentClient.EXPECT().GetInstanceAgentCommandExecution(gomock.Any(), gomock.Eq(computeinstanceagent.GetInstanceAgentCommandExecutionRequest{
+					InstanceAgentCommandId: common.String("command"),
+					InstanceId:             common.String("instance"),
+				})).Return(computeinstanceagent.GetInstanceAgentCommandExecutionResponse{
+					InstanceAgentCommandExecution: computeinstanceagent.InstanceAgentCommandExecution{
+						LifecycleState: computeinstanceagent.InstanceAgentCommandExecutionLifecycleStateInProgress,
+					},
+				}, nil)
+			},
+			errorExpected:     true,
+			notAvailable:      true,
+			expectedCommandId: common.String("command"),
+			expectedUsername:  "opc",
+			expectedPassword:  "initial",
+			expectRotationKey: true,
+		},
+		{
+			name:    "password rotated",
+			store:   true,
+			status:  rotatingStatus(time.Now()),
+			objects: []client.Object{credentialsSecret(map[string]string{"username": "opc", "password": "initial", "rotationKey": rotationKey})},
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				agentClient.EXPECT().GetInstanceAgentCommandExecution(gomock.Any(), gomock.Any()).
+					Return(computeinstanceagent.GetInstanceAgentCommandExecutionResponse{
+						InstanceAgentCommandExecution: computeinstanceagent.InstanceAgentCommandExecution{
+							LifecycleState: computeinstanceagent.InstanceAgentCommandExecutionLifecycleStateSucceeded,
+							Content:        rotatedOutput,
+						},
+					}, nil)
+			},
+			expectedUsername: "opc",
+			expectedPassword: "rotated",
+		},
+		{
+			name:    "password rotation not delivered yet",
+			store:   true,
+			status:  rotatingStatus(time.Now()),
+			objects: []client.Object{credentialsSecret(map[string]string{"username": "opc", "password": "initial", "rotationKey": rotationKey})},
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				agentClient.EXPECT().GetInstanceAgentCommandExecution(gomock.Any(), gomock.Any()).
+					Return(computeinstanceagent.GetInstanceAgentCommandExecutionResponse{}, ociutil.ErrNotFound)
+			},
+			errorExpected:     true,
+			notAvailable:      true,
+			expectedCommandId: common.String("command"),
+			expectedUsername:  "opc",
+			expectedPassword:  "initial",
+			expectRotationKey: true,
+		},
+		{
+			name:    "password rotation past the deadline",
+			store:   true,
+			status:  rotatingStatus(time.Now().Add(-time.Hour)),
+			objects: []client.Object{credentialsSecret(map[string]string{"username": "opc", "password": "initial", "rotationKey": rotationKey})},
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				agentClient.EXPECT().GetInstanceAgentCommandExecution(gomock.Any(), gomock.Any()).
+					Return(computeinstanceagent.GetInstanceAgentCommandExecutionResponse{}, ociutil.ErrNotFound)
+				agentClient.EXPECT().CancelInstanceAgentCommand(gomock.Any(), gomock.Eq(computeinstanceagent.CancelInstanceAgentCommandRequest{
+					InstanceAgentCommandId: common.String("command"),
+				})).Return(computeinstanceagent.CancelInstanceAgentCommandResponse{}, nil)
+			},
+			errorExpected:    true,
+			expectedUsername: "opc",
+			expectedPassword: "initial",
+		},
+		{
+			name:    "password rotated without a readable output",
+			store:   true,
+			status:  rotatingStatus(time.Now().Add(-time.Hour)),
+			objects: []client.Object{credentialsSecret(map[string]string{"username": "opc", "password": "initial", "rotationKey": rotationKey})},
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				agentClient.EXPECT().GetInstanceAgentCommandExecution(gomock.Any(), gomock.Any()).
+					Return(computeinstanceagent.GetInstanceAgentCommandExecutionResponse{
+						InstanceAgentCommandExecution: computeinstanceagent.InstanceAgentCommandExecution{
+							LifecycleState: computeinstanceagent.InstanceAgentCommandExecutionLifecycleStateSucceeded,
+							Content: computeinstanceagent.InstanceAgentCommandExecutionOutputViaTextDetails{
+								ExitCode: common.Int(0),
+								Text:     common.String("not encrypted"),
+							},
+						},
+					}, nil)
+			},
+			errorExpected:     true,
+			expectedCommandId: common.String("command"),
+			expectedUsername:  "opc",
+			expectedPassword:  "initial",
+			expectRotationKey: true,
+		},
+		{
+			name:    "password rotation failed",
+			store:   true,
+			status:  rotatingStatus(time.Now()),
+			objects: []client.Object{credentialsSecret(map[string]string{"username": "opc", "password": "initial", "rotationKey": rotationKey})},
+			setup: func(computeClient *mock_compute.MockComputeClient, agentClient *mock_computeinstanceagent.MockClient) {
+				agentClient.EXPECT().GetInstanceAgentCommandExecution(gomock.Any(), gomock.Any()).
+					Return(computeinstanceagent.GetInstanceAgentCommandExecutionResponse{
+						InstanceAgentCommandExecution: computeinstanceagent.InstanceAgentCommandExecution{
+							LifecycleState: computeinstanceagent.InstanceAgentCommandExecutionLifecycleStateFailed,
+						},
+					}, nil)
+			},
+			errorExpected:    true,
+			expectedUsername: "opc",
+			expectedPassword: "initial",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			computeClient := mock_compute.NewMockComputeClient(mockCtrl)
+			agentClient := mock_computeinstanceagent.NewMockClient(mockCtrl)
+			if tc.setup != nil {
+				tc.setup(computeClient, agentClient)
+			}
+			log := klogr.New()
+			ociMachine := &infrastructurev1beta2.OCIMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default", UID: "machine-uid", Annotations: tc.annotations},
+				Spec:       infrastructurev1beta2.OCIMachineSpec{StoreWindowsCredentials: tc.store},
+				Status:     infrastructurev1beta2.OCIMachineStatus{WindowsCredentials: tc.status},
+			}
+			fakeClient := fake.NewClientBuilder().WithObjects(append(tc.objects, ociMachine.DeepCopy())...).
+				WithStatusSubresource(ociMachine).Build()
+			patchHelper, err := patch.NewHelper(ociMachine, fakeClient)
+			g.Expect(err).NotTo(HaveOccurred())
+			ms := &MachineScope{
+				Logger:              &log,
+				Client:              fakeClient,
+				patchHelper:         patchHelper,
+				Cluster:             &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}},
+				Machine:             &clusterv1.Machine{},
+				OCIMachine:          ociMachine,
+				ComputeClient:       computeClient,
+				InstanceAgentClient: agentClient,
+			}
+			instance := &core.Instance{
+				Id:            common.String("instance"),
+				CompartmentId: common.String("compartment"),
+			}
+
+			err = ms.ReconcileWindowsCredentials(context.Background(), instance)
+			if tc.errorExpected {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(errors.Is(err, ErrWindowsCredentialsNotAvailable)).To(Equal(tc.notAvailable))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(ms.OCIMachine.Annotations).NotTo(HaveKey(infrastructurev1beta2.RotateWindowsPasswordAnnotation))
+			if tc.expectPersistedRotation {
+				persisted := &infrastructurev1beta2.OCIMachine{}
+				g.Expect(fakeClient.Get(context.Background(), client.ObjectKeyFromObject(ociMachine), persisted)).To(Succeed())
+				g.Expect(persisted.Annotations).NotTo(HaveKey(infrastructurev1beta2.RotateWindowsPasswordAnnotation))
+				g.Expect(persisted.Status.WindowsCredentials.RotationStartTime).NotTo(BeNil())
+			}
+			if tc.expectedUsername == "" {
+				g.Expect(ms.OCIMachine.Status.WindowsCredentials).To(BeNil())
+				return
+			}
+			status := ms.OCIMachine.Status.WindowsCredentials
+			g.Expect(status.SecretName).To(Equal("machine-windows-credentials"))
+			g.Expect(status.InstanceId).To(Equal(common.String("instance")))
+			g.Expect(status.RotationCommandId).To(Equal(tc.expectedCommandId))
+			g.Expect(status.RotationStartTime != nil).To(Equal(tc.expectedCommandId != nil))
+
+			secret := &corev1.Secret{}
+			g.Expect(ms.Client.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "machine-windows-credentials"}, secret)).To(Succeed())
+			g.Expect(string(secret.Data[WindowsCredentialsUsernameKey])).To(Equal(tc.expectedUsername))
+			g.Expect(string(secret.Data[WindowsCredentialsPasswordKey])).To(Equal(tc.expectedPassword))
+			if tc.expectRotationKey {
+				g.Expect(secret.Data).To(HaveKey("rotationKey"))
+			} else {
+				g.Expect(secret.Data).NotTo(HaveKey("rotationKey"))
+			}
+		})
+	}
+}
+
+func TestGetWindowsPasswordRotationCommand(t *testing.T) {
+	g := NewWithT(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	command := getWindowsPasswordRotationCommand("o'pc", &key.PublicKey)
+	prefix := "powershell.exe -NoProfile -NonInteractive -EncodedCommand "
+	g.Expect(command).To(HavePrefix(prefix))
+	encoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(command, prefix))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(len(encoded) % 2).To(Equal(0))
+	units := make([]uint16, 0, len(encoded)/2)
+	for i := 0; i < len(encoded); i += 2 {
+		units = append(units, uint16(encoded[i])|uint16(encoded[i+1])<<8)
+	}
+	script := string(utf16.Decode(units))
+	g.Expect(script).To(ContainSubstring("-lt 24)"))
+	g.Expect(script).To(ContainSubstring("% 62"))
+	g.Expect(script).To(ContainSubstring("Set-LocalUser -Name 'o''pc'"))
+	g.Expect(script).To(ContainSubstring("<Modulus>" + base64.StdEncoding.EncodeToString(key.N.Bytes()) + "</Modulus>"))
+	g.Expect(script).To(ContainSubstring("<Exponent>AQAB</Exponent>"))
+}
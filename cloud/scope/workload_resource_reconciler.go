@@ -0,0 +1,389 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/pkg/errors"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeleteWorkloadResources deletes the load balancers, network load balancers and block volumes created by
+// the cloud controller manager and the CSI driver of the workload cluster, if the cleanup policy is Delete.
+// Only the load balancers in the subnets of the cluster and the volumes which are not attached are deleted. The
+// load balancers left in the subnets of the cluster block the deletion of the subnets, hence they are reported
+// in the WorkloadResourcesDeleted condition and an error is returned until they are deleted.
+func (s *ClusterScope) DeleteWorkloadResources(ctx context.Context) error {
+	cleanup := s.OCIClusterAccessor.GetWorkloadResourceCleanup()
+	if cleanup == nil || cleanup.Policy != infrastructurev1beta2.WorkloadResourceCleanupPolicyDelete {
+		s.Logger.Info("Workload resource cleanup is not enabled, skipping deletion of workload cluster resources")
+		return nil
+	}
+	clusterSubnetIds := s.getClusterSubnetIds()
+	if len(clusterSubnetIds) == 0 {
+		s.Logger.Info("Subnets have not been created, skipping deletion of workload cluster load balancers")
+	}
+	subnetIds := s.getWorkloadResourceSubnetIds()
+	attachmentCompartmentIds, err := s.getVolumeAttachmentCompartmentIds(ctx)
+	if err != nil {
+		return err
+	}
+	var blocking []string
+
+	for _, compartmentId := range s.getWorkloadResourceCompartmentIds() {
+		if len(clusterSubnetIds) > 0 {
+			lbs, err := s.listWorkloadLoadBalancers(ctx, compartmentId)
+			if err != nil {
+				return err
+			}
+			for _, lb := range lbs {
+				if !isInSubnets(lb.SubnetIds, clusterSubnetIds) {
+					continue
+				}
+				if isWorkloadResource(cleanup, lb.FreeformTags, lb.DefinedTags) {
+					err = s.deleteWorkloadLoadBalancer(ctx, lb.Id)
+					if err != nil {
+						return err
+					}
+					continue
+				}
+				if isInSubnets(lb.SubnetIds, subnetIds) {
+					blocking = append(blocking, fmt.Sprintf("load balancer %s", *lb.Id))
+				}
+			}
+
+			nlbs, err := s.listWorkloadNetworkLoadBalancers(ctx, compartmentId)
+			if err != nil {
+				return err
+			}
+			for _, nlb := range nlbs {
+				if nlb.SubnetId == nil || !clusterSubnetIds[*nlb.SubnetId] {
+					continue
+				}
+				if isWorkloadResource(cleanup, nlb.FreeformTags, nlb.DefinedTags) {
+					err = s.deleteWorkloadNetworkLoadBalancer(ctx, nlb.Id)
+					if err != nil {
+						return err
+					}
+					continue
+				}
+				if subnetIds[*nlb.SubnetId] {
+					blocking = append(blocking, fmt.Sprintf("network load balancer %s", *nlb.Id))
+				}
+			}
+		}
+
+		err := s.deleteWorkloadVolumes(ctx, compartmentId, attachmentCompartmentIds, cleanup)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(blocking) > 0 {
+		// the subnets of a shared network are only deleted along with the network by the last cluster
+		inUse, err := s.IsSharedNetworkInUse(ctx)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			s.Logger.Info("Shared VCN is used by other clusters, ignoring the load balancers in its subnets")
+			s.OCIClusterAccessor.MarkConditionTrue(infrastructurev1beta2.WorkloadResourcesDeletedCondition)
+			return nil
+		}
+		s.OCIClusterAccessor.MarkConditionFalse(infrastructurev1beta2.WorkloadResourcesDeletedCondition,
+			infrastructurev1beta2.WorkloadResourcesBlockingReason, clusterv1.ConditionSeverityWarning,
+			"resources blocking the deletion of the subnets: %s", strings.Join(blocking, ", "))
+		return errors.Errorf("%d resources not created by Cluster API block the deletion of the subnets", len(blocking))
+	}
+	s.OCIClusterAccessor.MarkConditionTrue(infrastructurev1beta2.WorkloadResourcesDeletedCondition)
+	return nil
+}
+
+func (s *ClusterScope) listWorkloadLoadBalancers(ctx context.Context, compartmentId string) ([]loadbalancer.LoadBalancer, error) {
+	var lbs []loadbalancer.LoadBalancer
+	var page *string
+	for {
+		resp, err := s.LoadBalancerClient.ListLoadBalancers(ctx, loadbalancer.ListLoadBalancersRequest{
+			CompartmentId: common.String(compartmentId),
+			Page:          page,
+		})
+		if err != nil {
+			s.Logger.Error(err, "failed to list load balancers")
+			return nil, errors.Wrap(err, "failed to list load balancers")
+		}
+		for _, lb := range resp.Items {
+			if isCreatedByClusterAPIProvider(lb.FreeformTags) ||
+				lb.LifecycleState == loadbalancer.LoadBalancerLifecycleStateDeleted ||
+				lb.LifecycleState == loadbalancer.LoadBalancerLifecycleStateDeleting {
+				continue
+			}
+			lbs = append(lbs, lb)
+		}
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return lbs, nil
+}
+
+func (s *ClusterScope) listWorkloadNetworkLoadBalancers(ctx context.Context, compartmentId string) ([]networkloadbalancer.NetworkLoadBalancerSummary, error) {
+	var nlbs []networkloadbalancer.NetworkLoadBalancerSummary
+	var page *string
+	for {
+		resp, err := s.NetworkLoadBalancerClient.ListNetworkLoadBalancers(ctx, networkloadbalancer.ListNetworkLoadBalancersRequest{
+			CompartmentId: common.String(compartmentId),
+			Page:          page,
+		})
+		if err != nil {
+			s.Logger.Error(err, "failed to list network load balancers")
+			return nil, errors.Wrap(err, "failed to list network load balancers")
+		}
+		for _, nlb := range resp.Items {
+			if isCreatedByClusterAPIProvider(nlb.FreeformTags) ||
+				nlb.LifecycleState == networkloadbalancer.LifecycleStateDeleted ||
+				nlb.LifecycleState == networkloadbalancer.LifecycleStateDeleting {
+				continue
+			}
+			nlbs = append(nlbs, nlb)
+		}
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return nlbs, nil
+}
+
+func (s *ClusterScope) deleteWorkloadLoadBalancer(ctx context.Context, lbId *string) error {
+	resp, err := s.LoadBalancerClient.DeleteLoadBalancer(ctx, loadbalancer.DeleteLoadBalancerRequest{
+		LoadBalancerId: lbId,
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to delete workload cluster lb")
+		s.markWorkloadResourceDeletionFailed("load balancer", lbId, err)
+		return errors.Wrap(err, "failed to delete workload cluster lb")
+	}
+	_, err = ociutil.AwaitLBWorkRequest(ctx, s.LoadBalancerClient, resp.OpcWorkRequestId)
+	if err != nil {
+		s.markWorkloadResourceDeletionFailed("load balancer", lbId, err)
+		return errors.Wrap(err, "work request to delete workload cluster lb failed")
+	}
+	s.Logger.Info("Successfully deleted workload cluster lb", "lb", lbId)
+	return nil
+}
+
+func (s *ClusterScope) deleteWorkloadNetworkLoadBalancer(ctx context.Context, nlbId *string) error {
+	resp, err := s.NetworkLoadBalancerClient.DeleteNetworkLoadBalancer(ctx, networkloadbalancer.DeleteNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: nlbId,
+	})
+	if err != nil {
+		s.Logger.Error(err, "failed to delete workload cluster nlb")
+		s.markWorkloadResourceDeletionFailed("network load balancer", nlbId, err)
+		return errors.Wrap(err, "failed to delete workload cluster nlb")
+	}
+	_, err = ociutil.AwaitNLBWorkRequest(ctx, s.NetworkLoadBalancerClient, resp.OpcWorkRequestId)
+	if err != nil {
+		s.markWorkloadResourceDeletionFailed("network load balancer", nlbId, err)
+		return errors.Wrap(err, "work request to delete workload cluster nlb failed")
+	}
+	s.Logger.Info("Successfully deleted workload cluster nlb", "nlb", nlbId)
+	return nil
+}
+
+// deleteWorkloadVolumes deletes the block volumes created by the CSI driver of the workload cluster which are
+// not attached to an instance. The volumes do not block the deletion of the network, hence their deletion is not
+// awaited.
+func (s *ClusterScope) deleteWorkloadVolumes(ctx context.Context, compartmentId string, attachmentCompartmentIds []string,
+	cleanup *infrastructurev1beta2.WorkloadResourceCleanup) error {
+	var page *string
+	for {
+		resp, err := s.BlockStorageClient.ListVolumes(ctx, core.ListVolumesRequest{
+			CompartmentId: common.String(compartmentId),
+			Page:          page,
+		})
+		if err != nil {
+			s.Logger.Error(err, "failed to list volumes")
+			return errors.Wrap(err, "failed to list volumes")
+		}
+		for _, volume := range resp.Items {
+			if volume.LifecycleState == core.VolumeLifecycleStateTerminated ||
+				volume.LifecycleState == core.VolumeLifecycleStateTerminating ||
+				!isWorkloadResource(cleanup, volume.FreeformTags, volume.DefinedTags) {
+				continue
+			}
+			attached, err := s.isVolumeAttached(ctx, volume.Id, attachmentCompartmentIds)
+			if err != nil {
+				return err
+			}
+			if attached {
+				s.Logger.Info("Workload cluster volume is attached, skipping its deletion", "volume", volume.Id)
+				continue
+			}
+			_, err = s.BlockStorageClient.DeleteVolume(ctx, core.DeleteVolumeRequest{
+				VolumeId: volume.Id,
+			})
+			if err != nil && !ociutil.IsNotFound(err) {
+				s.Logger.Error(err, "failed to delete workload cluster volume")
+				s.markWorkloadResourceDeletionFailed("volume", volume.Id, err)
+				return errors.Wrap(err, "failed to delete workload cluster volume")
+			}
+			s.Logger.Info("Successfully deleted workload cluster volume", "volume", volume.Id)
+		}
+		if resp.OpcNextPage == nil {
+			break
+		}
+		page = resp.OpcNextPage
+	}
+	return nil
+}
+
+// isVolumeAttached returns true if the volume is attached to an instance, or being attached or detached. The
+// attachments are in the compartment of the instance, hence they are looked up in each of the compartments.
+func (s *ClusterScope) isVolumeAttached(ctx context.Context, volumeId *string, compartmentIds []string) (bool, error) {
+	for _, compartmentId := range compartmentIds {
+		var page *string
+		for {
+			resp, err := s.ComputeClient.ListVolumeAttachments(ctx, core.ListVolumeAttachmentsRequest{
+				CompartmentId: common.String(compartmentId),
+				VolumeId:      volumeId,
+				Page:          page,
+			})
+			if err != nil {
+				s.Logger.Error(err, "failed to list volume attachments")
+				return false, errors.Wrap(err, "failed to list volume attachments")
+			}
+			for _, attachment := range resp.Items {
+				if attachment.GetLifecycleState() != core.VolumeAttachmentLifecycleStateDetached {
+					return true, nil
+				}
+			}
+			if resp.OpcNextPage == nil {
+				break
+			}
+			page = resp.OpcNextPage
+		}
+	}
+	return false, nil
+}
+
+// getVolumeAttachmentCompartmentIds returns the compartments the volumes of the workload cluster may be attached
+// in, the compartments of its resources and the compartments of the instances of its machines.
+func (s *ClusterScope) getVolumeAttachmentCompartmentIds(ctx context.Context) ([]string, error) {
+	compartmentIds := s.getWorkloadResourceCompartmentIds()
+	ociMachines := &infrastructurev1beta2.OCIMachineList{}
+	if err := s.client.List(ctx, ociMachines, client.InNamespace(s.OCIClusterAccessor.GetNameSpace()),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: s.Cluster.Name}); err != nil {
+		return nil, errors.Wrap(err, "failed to list the machines of the cluster")
+	}
+	for _, machine := range ociMachines.Items {
+		compartmentId := machine.Spec.CompartmentId
+		if compartmentId != "" && !slices.Contains(compartmentIds, compartmentId) {
+			compartmentIds = append(compartmentIds, compartmentId)
+		}
+	}
+	return compartmentIds, nil
+}
+
+func (s *ClusterScope) markWorkloadResourceDeletionFailed(resourceType string, id *string, err error) {
+	s.OCIClusterAccessor.MarkConditionFalse(infrastructurev1beta2.WorkloadResourcesDeletedCondition,
+		infrastructurev1beta2.WorkloadResourceDeletionFailedReason, clusterv1.ConditionSeverityError,
+		"failed to delete %s %s: %s", resourceType, *id, err.Error())
+}
+
+// getClusterSubnetIds returns the IDs of the subnets of the cluster, the load balancers of the workload cluster
+// are looked up in these subnets only.
+func (s *ClusterScope) getClusterSubnetIds() map[string]bool {
+	subnetIds := make(map[string]bool)
+	for _, subnet := range s.GetSubnetsSpec() {
+		if subnet.ID != nil {
+			subnetIds[*subnet.ID] = true
+		}
+	}
+	return subnetIds
+}
+
+// getWorkloadResourceSubnetIds returns the IDs of the subnets of the cluster which are deleted along with it,
+// load balancers in retained subnets do not block the deletion of the cluster.
+func (s *ClusterScope) getWorkloadResourceSubnetIds() map[string]bool {
+	subnetIds := make(map[string]bool)
+	for _, subnet := range s.GetSubnetsSpec() {
+		if subnet.ID != nil && !s.isSubnetRetained(*subnet) {
+			subnetIds[*subnet.ID] = true
+		}
+	}
+	return subnetIds
+}
+
+// getWorkloadResourceCompartmentIds returns the compartments the workload cluster creates its resources in,
+// the compartment of the cluster and the compartment of the network.
+func (s *ClusterScope) getWorkloadResourceCompartmentIds() []string {
+	compartmentIds := []string{s.GetCompartmentId()}
+	if s.GetNetworkCompartmentId() != s.GetCompartmentId() {
+		compartmentIds = append(compartmentIds, s.GetNetworkCompartmentId())
+	}
+	return compartmentIds
+}
+
+// isCreatedByClusterAPIProvider returns true if the resource has been created by Cluster API Provider for OCI,
+// for this cluster or for another cluster, including the resources retained on the deletion of a cluster.
+func isCreatedByClusterAPIProvider(freeformTags map[string]string) bool {
+	return freeformTags[ociutil.CreatedBy] == ociutil.OCIClusterAPIProvider ||
+		freeformTags[ociutil.CreatedBy] == ociutil.OCIClusterAPIProviderRetained
+}
+
+// isWorkloadResource returns true if the resource carries all the tags identifying the resources created by
+// the workload cluster. A cleanup configuration without tags does not identify any resource.
+func isWorkloadResource(cleanup *infrastructurev1beta2.WorkloadResourceCleanup, freeformTags map[string]string, definedTags map[string]map[string]interface{}) bool {
+	if len(cleanup.FreeformTags) == 0 && len(cleanup.DefinedTags) == 0 {
+		return false
+	}
+	for key, value := range cleanup.FreeformTags {
+		if actual, ok := freeformTags[key]; !ok || actual != value {
+			return false
+		}
+	}
+	for namespace, tags := range cleanup.DefinedTags {
+		for key, value := range tags {
+			actual, ok := definedTags[namespace][key]
+			if !ok || fmt.Sprint(actual) != value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isInSubnets(ids []string, subnetIds map[string]bool) bool {
+	for _, id := range ids {
+		if subnetIds[id] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,376 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/blockstorage/mock_blockstorage"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute/mock_compute"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/loadbalancer/mock_lb"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/networkloadbalancer/mock_nlb"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDeleteWorkloadResources(t *testing.T) {
+	var (
+		cs                 *ClusterScope
+		mockCtrl           *gomock.Controller
+		lbClient           *mock_lb.MockLoadBalancerClient
+		nlbClient          *mock_nlb.MockNetworkLoadBalancerClient
+		blockStorageClient *mock_blockstorage.MockClient
+		computeClient      *mock_compute.MockComputeClient
+		ociClusterAccessor OCISelfManagedCluster
+		capiTags           map[string]string
+		workloadTags       map[string]string
+	)
+
+	setup := func(t *testing.T, g *WithT) {
+		var err error
+		mockCtrl = gomock.NewController(t)
+		lbClient = mock_lb.NewMockLoadBalancerClient(mockCtrl)
+		nlbClient = mock_nlb.NewMockNetworkLoadBalancerClient(mockCtrl)
+		blockStorageClient = mock_blockstorage.NewMockClient(mockCtrl)
+		computeClient = mock_compute.NewMockComputeClient(mockCtrl)
+		client := fake.NewClientBuilder().WithObjects(
+			&infrastructurev1beta2.OCIMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "machine",
+					Namespace: "default",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster"},
+				},
+				Spec: infrastructurev1beta2.OCIMachineSpec{CompartmentId: "machine-compartment-id"},
+			},
+			&infrastructurev1beta2.OCIMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "machine-in-cluster-compartment",
+					Namespace: "default",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: "cluster"},
+				},
+			},
+		).Build()
+		ociClusterAccessor = OCISelfManagedCluster{
+			&infrastructurev1beta2.OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					UID:       "a",
+					Name:      "cluster",
+					Namespace: "default",
+				},
+				Spec: infrastructurev1beta2.OCIClusterSpec{
+					CompartmentId:         "compartment-id",
+					OCIResourceIdentifier: "resource_uid",
+					NetworkSpec: infrastructurev1beta2.NetworkSpec{
+						Vcn: infrastructurev1beta2.VCN{
+							Subnets: []*infrastructurev1beta2.Subnet{
+								{
+									Role: infrastructurev1beta2.ServiceLoadBalancerRole,
+									ID:   common.String("service-lb-subnet-id"),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		cs, err = NewClusterScope(ClusterScopeParams{
+			LoadBalancerClient:        lbClient,
+			NetworkLoadBalancerClient: nlbClient,
+			BlockStorageClient:        blockStorageClient,
+			ComputeClient:             computeClient,
+			Cluster:                   &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}},
+			OCIClusterAccessor:        ociClusterAccessor,
+			Client:                    client,
+		})
+		capiTags = map[string]string{
+			ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+			ociutil.ClusterResourceIdentifier: "resource_uid",
+		}
+		workloadTags = map[string]string{"cluster": "cluster"}
+		g.Expect(err).To(BeNil())
+	}
+	teardown := func(t *testing.T, g *WithT) {
+		mockCtrl.Finish()
+	}
+
+	tests := []struct {
+		name              string
+		errorExpected     bool
+		matchError        error
+		conditionStatus   corev1.ConditionStatus
+		conditionReason   string
+		testSpecificSetup func(clusterScope *ClusterScope)
+	}{
+		{
+			name:            "tagged resources are deleted",
+			conditionStatus: corev1.ConditionTrue,
+			testSpecificSetup: func(clusterScope *ClusterScope) {
+				ociClusterAccessor.OCICluster.Spec.WorkloadResourceCleanup = &infrastructurev1beta2.WorkloadResourceCleanup{
+					Policy:       infrastructurev1beta2.WorkloadResourceCleanupPolicyDelete,
+					FreeformTags: workloadTags,
+				}
+				lbClient.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Eq(loadbalancer.ListLoadBalancersRequest{
+					CompartmentId: common.String("compartment-id"),
+				})).Return(loadbalancer.ListLoadBalancersResponse{
+					Items: []loadbalancer.LoadBalancer{
+						{
+							Id:             common.String("apiserver-lb-id"),
+							FreeformTags:   capiTags,
+							SubnetIds:      []string{"service-lb-subnet-id"},
+							LifecycleState: loadbalancer.LoadBalancerLifecycleStateActive,
+						},
+						{
+							Id:             common.String("service-lb-id"),
+							FreeformTags:   workloadTags,
+							SubnetIds:      []string{"service-lb-subnet-id"},
+							LifecycleState: loadbalancer.LoadBalancerLifecycleStateActive,
+						},
+						{
+							Id:             common.String("other-cluster-lb-id"),
+							FreeformTags:   workloadTags,
+							SubnetIds:      []string{"other-subnet-id"},
+							LifecycleState: loadbalancer.LoadBalancerLifecycleStateActive,
+						},
+					},
+				}, nil)
+				lbClient.EXPECT().DeleteLoadBalancer(gomock.Any(), gomock.Eq(loadbalancer.DeleteLoadBalancerRequest{
+					LoadBalancerId: common.String("service-lb-id"),
+				})).Return(loadbalancer.DeleteLoadBalancerResponse{
+					OpcWorkRequestId: common.String("opc-wr-id"),
+				}, nil)
+				lbClient.EXPECT().GetWorkRequest(gomock.Any(), gomock.Eq(loadbalancer.GetWorkRequestRequest{
+					WorkRequestId: common.String("opc-wr-id"),
+				})).Return(loadbalancer.GetWorkRequestResponse{
+					WorkRequest: loadbalancer.WorkRequest{
+						LifecycleState: loadbalancer.WorkRequestLifecycleStateSucceeded,
+					},
+				}, nil)
+				nlbClient.EXPECT().ListNetworkLoadBalancers(gomock.Any(), gomock.Eq(networkloadbalancer.ListNetworkLoadBalancersRequest{
+					CompartmentId: common.String("compartment-id"),
+				})).Return(networkloadbalancer.ListNetworkLoadBalancersResponse{
+					NetworkLoadBalancerCollection: networkloadbalancer.NetworkLoadBalancerCollection{
+						Items: []networkloadbalancer.NetworkLoadBalancerSummary{
+							{
+								Id:             common.String("service-nlb-id"),
+								FreeformTags:   workloadTags,
+								SubnetId:       common.String("service-lb-subnet-id"),
+								LifecycleState: networkloadbalancer.LifecycleStateActive,
+							},
+						},
+					},
+				}, nil)
+				nlbClient.EXPECT().DeleteNetworkLoadBalancer(gomock.Any(), gomock.Eq(networkloadbalancer.DeleteNetworkLoadBalancerRequest{
+					NetworkLoadBalancerId: common.String("service-nlb-id"),
+				})).Return(networkloadbalancer.DeleteNetworkLoadBalancerResponse{
+					OpcWorkRequestId: common.String("opc-wr-id"),
+				}, nil)
+				nlbClient.EXPECT().GetWorkRequest(gomock.Any(), gomock.Eq(networkloadbalancer.GetWorkRequestRequest{
+					WorkRequestId: common.String("opc-wr-id"),
+				})).Return(networkloadbalancer.GetWorkRequestResponse{
+					WorkRequest: networkloadbalancer.WorkRequest{
+						Status: networkloadbalancer.OperationStatusSucceeded,
+					},
+				}, nil)
+				blockStorageClient.EXPECT().ListVolumes(gomock.Any(), gomock.Eq(core.ListVolumesRequest{
+					CompartmentId: common.String("compartment-id"),
+				})).Return(core.ListVolumesResponse{
+					Items: []core.Volume{
+						{
+							Id:             common.String("csi-volume-id"),
+							FreeformTags:   workloadTags,
+							LifecycleState: core.VolumeLifecycleStateAvailable,
+						},
+						{
+							Id:             common.String("attached-csi-volume-id"),
+							FreeformTags:   workloadTags,
+							LifecycleState: core.VolumeLifecycleStateAvailable,
+						},
+						{
+							Id:             common.String("other-volume-id"),
+							LifecycleState: core.VolumeLifecycleStateAvailable,
+						},
+					},
+				}, nil)
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(core.ListVolumeAttachmentsRequest{
+					CompartmentId: common.String("compartment-id"),
+					VolumeId:      common.String("csi-volume-id"),
+				})).Return(core.ListVolumeAttachmentsResponse{
+					Items: []core.VolumeAttachment{
+						core.IScsiVolumeAttachment{
+							Id:             common.String("detached-attachment-id"),
+							LifecycleState: core.VolumeAttachmentLifecycleStateDetached,
+						},
+					},
+				}, nil)
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(core.ListVolumeAttachmentsRequest{
+					CompartmentId: common.String("machine-compartment-id"),
+					VolumeId:      common.String("csi-volume-id"),
+				})).Return(core.ListVolumeAttachmentsResponse{}, nil)
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(core.ListVolumeAttachmentsRequest{
+					CompartmentId: common.String("compartment-id"),
+					VolumeId:      common.String("attached-csi-volume-id"),
+				})).Return(core.ListVolumeAttachmentsResponse{}, nil)
+				// the volume is attached to the instance of a machine in its own compartment
+				computeClient.EXPECT().ListVolumeAttachments(gomock.Any(), gomock.Eq(core.ListVolumeAttachmentsRequest{
+					CompartmentId: common.String("machine-compartment-id"),
+					VolumeId:      common.String("attached-csi-volume-id"),
+				})).Return(core.ListVolumeAttachmentsResponse{
+					Items: []core.VolumeAttachment{
+						core.ParavirtualizedVolumeAttachment{
+							Id:             common.String("attachment-id"),
+							LifecycleState: core.VolumeAttachmentLifecycleStateAttached,
+						},
+					},
+				}, nil)
+				blockStorageClient.EXPECT().DeleteVolume(gomock.Any(), gomock.Eq(core.DeleteVolumeRequest{
+					VolumeId: common.String("csi-volume-id"),
+				})).Return(core.DeleteVolumeResponse{}, nil)
+			},
+		},
+		{
+			name:            "untagged load balancers block the deletion",
+			errorExpected:   true,
+			matchError:      errors.New("2 resources not created by Cluster API block the deletion of the subnets"),
+			conditionStatus: corev1.ConditionFalse,
+			conditionReason: infrastructurev1beta2.WorkloadResourcesBlockingReason,
+			testSpecificSetup: func(clusterScope *ClusterScope) {
+				ociClusterAccessor.OCICluster.Spec.WorkloadResourceCleanup = &infrastructurev1beta2.WorkloadResourceCleanup{
+					Policy:       infrastructurev1beta2.WorkloadResourceCleanupPolicyDelete,
+					FreeformTags: workloadTags,
+				}
+				lbClient.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).Return(loadbalancer.ListLoadBalancersResponse{
+					Items: []loadbalancer.LoadBalancer{
+						{
+							Id:             common.String("service-lb-id"),
+							SubnetIds:      []string{"service-lb-subnet-id"},
+							LifecycleState: loadbalancer.LoadBalancerLifecycleStateActive,
+						},
+						{
+							Id:             common.String("other-subnet-lb-id"),
+							SubnetIds:      []string{"other-subnet-id"},
+							LifecycleState: loadbalancer.LoadBalancerLifecycleStateActive,
+						},
+					},
+				}, nil)
+				nlbClient.EXPECT().ListNetworkLoadBalancers(gomock.Any(), gomock.Any()).Return(networkloadbalancer.ListNetworkLoadBalancersResponse{
+					NetworkLoadBalancerCollection: networkloadbalancer.NetworkLoadBalancerCollection{
+						Items: []networkloadbalancer.NetworkLoadBalancerSummary{
+							{
+								Id:             common.String("service-nlb-id"),
+								SubnetId:       common.String("service-lb-subnet-id"),
+								LifecycleState: networkloadbalancer.LifecycleStateActive,
+							},
+							{
+								Id:             common.String("deleting-nlb-id"),
+								SubnetId:       common.String("service-lb-subnet-id"),
+								LifecycleState: networkloadbalancer.LifecycleStateDeleting,
+							},
+						},
+					},
+				}, nil)
+				blockStorageClient.EXPECT().ListVolumes(gomock.Any(), gomock.Any()).Return(core.ListVolumesResponse{}, nil)
+			},
+		},
+		{
+			name: "retain policy does not look up any resource",
+			testSpecificSetup: func(clusterScope *ClusterScope) {
+				ociClusterAccessor.OCICluster.Spec.WorkloadResourceCleanup = &infrastructurev1beta2.WorkloadResourceCleanup{
+					Policy:       infrastructurev1beta2.WorkloadResourceCleanupPolicyRetain,
+					FreeformTags: workloadTags,
+				}
+			},
+		},
+		{
+			name: "no cleanup configuration",
+			testSpecificSetup: func(clusterScope *ClusterScope) {
+			},
+		},
+		{
+			name:            "load balancer deletion failure",
+			errorExpected:   true,
+			matchError:      errors.New("failed to delete workload cluster lb: request failed"),
+			conditionStatus: corev1.ConditionFalse,
+			conditionReason: infrastructurev1beta2.WorkloadResourceDeletionFailedReason,
+			testSpecificSetup: func(clusterScope *ClusterScope) {
+				ociClusterAccessor.OCICluster.Spec.WorkloadResourceCleanup = &infrastructurev1beta2.WorkloadResourceCleanup{
+					Policy:       infrastructurev1beta2.WorkloadResourceCleanupPolicyDelete,
+					FreeformTags: workloadTags,
+				}
+				lbClient.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).Return(loadbalancer.ListLoadBalancersResponse{
+					Items: []loadbalancer.LoadBalancer{
+						{
+							Id:             common.String("service-lb-id"),
+							FreeformTags:   workloadTags,
+							SubnetIds:      []string{"service-lb-subnet-id"},
+							LifecycleState: loadbalancer.LoadBalancerLifecycleStateActive,
+						},
+					},
+				}, nil)
+				lbClient.EXPECT().DeleteLoadBalancer(gomock.Any(), gomock.Any()).
+					Return(loadbalancer.DeleteLoadBalancerResponse{}, errors.New("request failed"))
+			},
+		},
+		{
+			name:          "list load balancers failure",
+			errorExpected: true,
+			matchError:    errors.New("failed to list load balancers: request failed"),
+			testSpecificSetup: func(clusterScope *ClusterScope) {
+				ociClusterAccessor.OCICluster.Spec.WorkloadResourceCleanup = &infrastructurev1beta2.WorkloadResourceCleanup{
+					Policy:       infrastructurev1beta2.WorkloadResourceCleanupPolicyDelete,
+					FreeformTags: workloadTags,
+				}
+				lbClient.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).
+					Return(loadbalancer.ListLoadBalancersResponse{}, errors.New("request failed"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			defer teardown(t, g)
+			setup(t, g)
+			tc.testSpecificSetup(cs)
+			err := cs.DeleteWorkloadResources(context.Background())
+			if tc.errorExpected {
+				g.Expect(err).To(Not(BeNil()))
+				g.Expect(err.Error()).To(Equal(tc.matchError.Error()))
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			if tc.conditionStatus != "" {
+				condition := conditions.Get(ociClusterAccessor.OCICluster, infrastructurev1beta2.WorkloadResourcesDeletedCondition)
+				g.Expect(condition).To(Not(BeNil()))
+				g.Expect(condition.Status).To(Equal(tc.conditionStatus))
+				g.Expect(condition.Reason).To(Equal(tc.conditionReason))
+			}
+		})
+	}
+}
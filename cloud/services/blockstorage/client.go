@@ -0,0 +1,37 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package blockstorage
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/core"
+)
+
+type Client interface {
+	ListVolumes(ctx context.Context, request core.ListVolumesRequest) (response core.ListVolumesResponse, err error)
+	GetVolume(ctx context.Context, request core.GetVolumeRequest) (response core.GetVolumeResponse, err error)
+	CreateVolume(ctx context.Context, request core.CreateVolumeRequest) (response core.CreateVolumeResponse, err error)
+	DeleteVolume(ctx context.Context, request core.DeleteVolumeRequest) (response core.DeleteVolumeResponse, err error)
+	GetBootVolume(ctx context.Context, request core.GetBootVolumeRequest) (response core.GetBootVolumeResponse, err error)
+	UpdateBootVolume(ctx context.Context, request core.UpdateBootVolumeRequest) (response core.UpdateBootVolumeResponse, err error)
+	CreateBootVolume(ctx context.Context, request core.CreateBootVolumeRequest) (response core.CreateBootVolumeResponse, err error)
+	DeleteBootVolume(ctx context.Context, request core.DeleteBootVolumeRequest) (response core.DeleteBootVolumeResponse, err error)
+	GetVolumeBackupPolicyAssetAssignment(ctx context.Context, request core.GetVolumeBackupPolicyAssetAssignmentRequest) (response core.GetVolumeBackupPolicyAssetAssignmentResponse, err error)
+	CreateVolumeBackupPolicyAssignment(ctx context.Context, request core.CreateVolumeBackupPolicyAssignmentRequest) (response core.CreateVolumeBackupPolicyAssignmentResponse, err error)
+	DeleteVolumeBackupPolicyAssignment(ctx context.Context, request core.DeleteVolumeBackupPolicyAssignmentRequest) (response core.DeleteVolumeBackupPolicyAssignmentResponse, err error)
+}
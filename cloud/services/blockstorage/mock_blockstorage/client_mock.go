@@ -0,0 +1,201 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: client.go
+
+// Package mock_blockstorage is a generated GoMock package.
+package mock_blockstorage
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	core "github.com/oracle/oci-go-sdk/v65/core"
+)
+
+// MockClient is a mock of Client interface.
+type MockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientMockRecorder
+}
+
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock *MockClient
+}
+
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
+	return m.recorder
+}
+
+// CreateBootVolume mocks base method.
+func (m *MockClient) CreateBootVolume(ctx context.Context, request core.CreateBootVolumeRequest) (core.CreateBootVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBootVolume", ctx, request)
+	ret0, _ := ret[0].(core.CreateBootVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBootVolume indicates an expected call of CreateBootVolume.
+func (mr *MockClientMockRecorder) CreateBootVolume(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBootVolume", reflect.TypeOf((*MockClient)(nil).CreateBootVolume), ctx, request)
+}
+
+// CreateVolume mocks base method.
+func (m *MockClient) CreateVolume(ctx context.Context, request core.CreateVolumeRequest) (core.CreateVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", ctx, request)
+	ret0, _ := ret[0].(core.CreateVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockClientMockRecorder) CreateVolume(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockClient)(nil).CreateVolume), ctx, request)
+}
+
+// CreateVolumeBackupPolicyAssignment mocks base method.
+func (m *MockClient) CreateVolumeBackupPolicyAssignment(ctx context.Context, request core.CreateVolumeBackupPolicyAssignmentRequest) (core.CreateVolumeBackupPolicyAssignmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeBackupPolicyAssignment", ctx, request)
+	ret0, _ := ret[0].(core.CreateVolumeBackupPolicyAssignmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeBackupPolicyAssignment indicates an expected call of CreateVolumeBackupPolicyAssignment.
+func (mr *MockClientMockRecorder) CreateVolumeBackupPolicyAssignment(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeBackupPolicyAssignment", reflect.TypeOf((*MockClient)(nil).CreateVolumeBackupPolicyAssignment), ctx, request)
+}
+
+// DeleteBootVolume mocks base method.
+func (m *MockClient) DeleteBootVolume(ctx context.Context, request core.DeleteBootVolumeRequest) (core.DeleteBootVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBootVolume", ctx, request)
+	ret0, _ := ret[0].(core.DeleteBootVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBootVolume indicates an expected call of DeleteBootVolume.
+func (mr *MockClientMockRecorder) DeleteBootVolume(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBootVolume", reflect.TypeOf((*MockClient)(nil).DeleteBootVolume), ctx, request)
+}
+
+// DeleteVolume mocks base method.
+func (m *MockClient) DeleteVolume(ctx context.Context, request core.DeleteVolumeRequest) (core.DeleteVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", ctx, request)
+	ret0, _ := ret[0].(core.DeleteVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockClientMockRecorder) DeleteVolume(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockClient)(nil).DeleteVolume), ctx, request)
+}
+
+// DeleteVolumeBackupPolicyAssignment mocks base method.
+func (m *MockClient) DeleteVolumeBackupPolicyAssignment(ctx context.Context, request core.DeleteVolumeBackupPolicyAssignmentRequest) (core.DeleteVolumeBackupPolicyAssignmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolumeBackupPolicyAssignment", ctx, request)
+	ret0, _ := ret[0].(core.DeleteVolumeBackupPolicyAssignmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVolumeBackupPolicyAssignment indicates an expected call of DeleteVolumeBackupPolicyAssignment.
+func (mr *MockClientMockRecorder) DeleteVolumeBackupPolicyAssignment(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeBackupPolicyAssignment", reflect.TypeOf((*MockClient)(nil).DeleteVolumeBackupPolicyAssignment), ctx, request)
+}
+
+// GetBootVolume mocks base method.
+func (m *MockClient) GetBootVolume(ctx context.Context, request core.GetBootVolumeRequest) (core.GetBootVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBootVolume", ctx, request)
+	ret0, _ := ret[0].(core.GetBootVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBootVolume indicates an expected call of GetBootVolume.
+func (mr *MockClientMockRecorder) GetBootVolume(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBootVolume", reflect.TypeOf((*MockClient)(nil).GetBootVolume), ctx, request)
+}
+
+// GetVolume mocks base method.
+func (m *MockClient) GetVolume(ctx context.Context, request core.GetVolumeRequest) (core.GetVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", ctx, request)
+	ret0, _ := ret[0].(core.GetVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockClientMockRecorder) GetVolume(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockClient)(nil).GetVolume), ctx, request)
+}
+
+// GetVolumeBackupPolicyAssetAssignment mocks base method.
+func (m *MockClient) GetVolumeBackupPolicyAssetAssignment(ctx context.Context, request core.GetVolumeBackupPolicyAssetAssignmentRequest) (core.GetVolumeBackupPolicyAssetAssignmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeBackupPolicyAssetAssignment", ctx, request)
+	ret0, _ := ret[0].(core.GetVolumeBackupPolicyAssetAssignmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeBackupPolicyAssetAssignment indicates an expected call of GetVolumeBackupPolicyAssetAssignment.
+func (mr *MockClientMockRecorder) GetVolumeBackupPolicyAssetAssignment(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeBackupPolicyAssetAssignment", reflect.TypeOf((*MockClient)(nil).GetVolumeBackupPolicyAssetAssignment), ctx, request)
+}
+
+// ListVolumes mocks base method.
+func (m *MockClient) ListVolumes(ctx context.Context, request core.ListVolumesRequest) (core.ListVolumesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumes", ctx, request)
+	ret0, _ := ret[0].(core.ListVolumesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockClientMockRecorder) ListVolumes(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockClient)(nil).ListVolumes), ctx, request)
+}
+
+// UpdateBootVolume mocks base method.
+func (m *MockClient) UpdateBootVolume(ctx context.Context, request core.UpdateBootVolumeRequest) (core.UpdateBootVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBootVolume", ctx, request)
+	ret0, _ := ret[0].(core.UpdateBootVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBootVolume indicates an expected call of UpdateBootVolume.
+func (mr *MockClientMockRecorder) UpdateBootVolume(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBootVolume", reflect.TypeOf((*MockClient)(nil).UpdateBootVolume), ctx, request)
+}
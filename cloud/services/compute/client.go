@@ -25,8 +25,22 @@ import (
 type ComputeClient interface {
 	LaunchInstance(ctx context.Context, request core.LaunchInstanceRequest) (response core.LaunchInstanceResponse, err error)
 	TerminateInstance(ctx context.Context, request core.TerminateInstanceRequest) (response core.TerminateInstanceResponse, err error)
+	UpdateInstance(ctx context.Context, request core.UpdateInstanceRequest) (response core.UpdateInstanceResponse, err error)
+	InstanceAction(ctx context.Context, request core.InstanceActionRequest) (response core.InstanceActionResponse, err error)
 	GetInstance(ctx context.Context, request core.GetInstanceRequest) (response core.GetInstanceResponse, err error)
 	ListInstances(ctx context.Context, request core.ListInstancesRequest) (response core.ListInstancesResponse, err error)
+	ListImages(ctx context.Context, request core.ListImagesRequest) (response core.ListImagesResponse, err error)
+	CreateComputeCapacityReport(ctx context.Context, request core.CreateComputeCapacityReportRequest) (response core.CreateComputeCapacityReportResponse, err error)
 	AttachVnic(ctx context.Context, request core.AttachVnicRequest) (response core.AttachVnicResponse, err error)
 	ListVnicAttachments(ctx context.Context, request core.ListVnicAttachmentsRequest) (response core.ListVnicAttachmentsResponse, err error)
+	ListBootVolumeAttachments(ctx context.Context, request core.ListBootVolumeAttachmentsRequest) (response core.ListBootVolumeAttachmentsResponse, err error)
+	AttachVolume(ctx context.Context, request core.AttachVolumeRequest) (response core.AttachVolumeResponse, err error)
+	DetachVolume(ctx context.Context, request core.DetachVolumeRequest) (response core.DetachVolumeResponse, err error)
+	ListVolumeAttachments(ctx context.Context, request core.ListVolumeAttachmentsRequest) (response core.ListVolumeAttachmentsResponse, err error)
+	CaptureConsoleHistory(ctx context.Context, request core.CaptureConsoleHistoryRequest) (response core.CaptureConsoleHistoryResponse, err error)
+	GetConsoleHistory(ctx context.Context, request core.GetConsoleHistoryRequest) (response core.GetConsoleHistoryResponse, err error)
+	GetConsoleHistoryContent(ctx context.Context, request core.GetConsoleHistoryContentRequest) (response core.GetConsoleHistoryContentResponse, err error)
+	DeleteConsoleHistory(ctx context.Context, request core.DeleteConsoleHistoryRequest) (response core.DeleteConsoleHistoryResponse, err error)
+	GetWindowsInstanceInitialCredentials(ctx context.Context, request core.GetWindowsInstanceInitialCredentialsRequest) (response core.GetWindowsInstanceInitialCredentialsResponse, err error)
+	ListInstanceMaintenanceEvents(ctx context.Context, request core.ListInstanceMaintenanceEventsRequest) (response core.ListInstanceMaintenanceEventsResponse, err error)
 }
@@ -50,6 +50,111 @@ func (mr *MockComputeClientMockRecorder) AttachVnic(ctx, request interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVnic", reflect.TypeOf((*MockComputeClient)(nil).AttachVnic), ctx, request)
 }
 
+// AttachVolume mocks base method.
+func (m *MockComputeClient) AttachVolume(ctx context.Context, request core.AttachVolumeRequest) (core.AttachVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachVolume", ctx, request)
+	ret0, _ := ret[0].(core.AttachVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachVolume indicates an expected call of AttachVolume.
+func (mr *MockComputeClientMockRecorder) AttachVolume(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolume", reflect.TypeOf((*MockComputeClient)(nil).AttachVolume), ctx, request)
+}
+
+// CaptureConsoleHistory mocks base method.
+func (m *MockComputeClient) CaptureConsoleHistory(ctx context.Context, request core.CaptureConsoleHistoryRequest) (core.CaptureConsoleHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureConsoleHistory", ctx, request)
+	ret0, _ := ret[0].(core.CaptureConsoleHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureConsoleHistory indicates an expected call of CaptureConsoleHistory.
+func (mr *MockComputeClientMockRecorder) CaptureConsoleHistory(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureConsoleHistory", reflect.TypeOf((*MockComputeClient)(nil).CaptureConsoleHistory), ctx, request)
+}
+
+// CreateComputeCapacityReport mocks base method.
+func (m *MockComputeClient) CreateComputeCapacityReport(ctx context.Context, request core.CreateComputeCapacityReportRequest) (core.CreateComputeCapacityReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComputeCapacityReport", ctx, request)
+	ret0, _ := ret[0].(core.CreateComputeCapacityReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComputeCapacityReport indicates an expected call of CreateComputeCapacityReport.
+func (mr *MockComputeClientMockRecorder) CreateComputeCapacityReport(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComputeCapacityReport", reflect.TypeOf((*MockComputeClient)(nil).CreateComputeCapacityReport), ctx, request)
+}
+
+// DeleteConsoleHistory mocks base method.
+func (m *MockComputeClient) DeleteConsoleHistory(ctx context.Context, request core.DeleteConsoleHistoryRequest) (core.DeleteConsoleHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConsoleHistory", ctx, request)
+	ret0, _ := ret[0].(core.DeleteConsoleHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConsoleHistory indicates an expected call of DeleteConsoleHistory.
+func (mr *MockComputeClientMockRecorder) DeleteConsoleHistory(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsoleHistory", reflect.TypeOf((*MockComputeClient)(nil).DeleteConsoleHistory), ctx, request)
+}
+
+// DetachVolume mocks base method.
+func (m *MockComputeClient) DetachVolume(ctx context.Context, request core.DetachVolumeRequest) (core.DetachVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachVolume", ctx, request)
+	ret0, _ := ret[0].(core.DetachVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachVolume indicates an expected call of DetachVolume.
+func (mr *MockComputeClientMockRecorder) DetachVolume(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolume", reflect.TypeOf((*MockComputeClient)(nil).DetachVolume), ctx, request)
+}
+
+// GetConsoleHistory mocks base method.
+func (m *MockComputeClient) GetConsoleHistory(ctx context.Context, request core.GetConsoleHistoryRequest) (core.GetConsoleHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsoleHistory", ctx, request)
+	ret0, _ := ret[0].(core.GetConsoleHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsoleHistory indicates an expected call of GetConsoleHistory.
+func (mr *MockComputeClientMockRecorder) GetConsoleHistory(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsoleHistory", reflect.TypeOf((*MockComputeClient)(nil).GetConsoleHistory), ctx, request)
+}
+
+// GetConsoleHistoryContent mocks base method.
+func (m *MockComputeClient) GetConsoleHistoryContent(ctx context.Context, request core.GetConsoleHistoryContentRequest) (core.GetConsoleHistoryContentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsoleHistoryContent", ctx, request)
+	ret0, _ := ret[0].(core.GetConsoleHistoryContentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsoleHistoryContent indicates an expected call of GetConsoleHistoryContent.
+func (mr *MockComputeClientMockRecorder) GetConsoleHistoryContent(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsoleHistoryContent", reflect.TypeOf((*MockComputeClient)(nil).GetConsoleHistoryContent), ctx, request)
+}
+
 // GetInstance mocks base method.
 func (m *MockComputeClient) GetInstance(ctx context.Context, request core.GetInstanceRequest) (core.GetInstanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +170,36 @@ func (mr *MockComputeClientMockRecorder) GetInstance(ctx, request interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstance", reflect.TypeOf((*MockComputeClient)(nil).GetInstance), ctx, request)
 }
 
+// GetWindowsInstanceInitialCredentials mocks base method.
+func (m *MockComputeClient) GetWindowsInstanceInitialCredentials(ctx context.Context, request core.GetWindowsInstanceInitialCredentialsRequest) (core.GetWindowsInstanceInitialCredentialsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWindowsInstanceInitialCredentials", ctx, request)
+	ret0, _ := ret[0].(core.GetWindowsInstanceInitialCredentialsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWindowsInstanceInitialCredentials indicates an expected call of GetWindowsInstanceInitialCredentials.
+func (mr *MockComputeClientMockRecorder) GetWindowsInstanceInitialCredentials(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWindowsInstanceInitialCredentials", reflect.TypeOf((*MockComputeClient)(nil).GetWindowsInstanceInitialCredentials), ctx, request)
+}
+
+// InstanceAction mocks base method.
+func (m *MockComputeClient) InstanceAction(ctx context.Context, request core.InstanceActionRequest) (core.InstanceActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceAction", ctx, request)
+	ret0, _ := ret[0].(core.InstanceActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceAction indicates an expected call of InstanceAction.
+func (mr *MockComputeClientMockRecorder) InstanceAction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceAction", reflect.TypeOf((*MockComputeClient)(nil).InstanceAction), ctx, request)
+}
+
 // LaunchInstance mocks base method.
 func (m *MockComputeClient) LaunchInstance(ctx context.Context, request core.LaunchInstanceRequest) (core.LaunchInstanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +215,51 @@ func (mr *MockComputeClientMockRecorder) LaunchInstance(ctx, request interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LaunchInstance", reflect.TypeOf((*MockComputeClient)(nil).LaunchInstance), ctx, request)
 }
 
+// ListBootVolumeAttachments mocks base method.
+func (m *MockComputeClient) ListBootVolumeAttachments(ctx context.Context, request core.ListBootVolumeAttachmentsRequest) (core.ListBootVolumeAttachmentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBootVolumeAttachments", ctx, request)
+	ret0, _ := ret[0].(core.ListBootVolumeAttachmentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBootVolumeAttachments indicates an expected call of ListBootVolumeAttachments.
+func (mr *MockComputeClientMockRecorder) ListBootVolumeAttachments(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBootVolumeAttachments", reflect.TypeOf((*MockComputeClient)(nil).ListBootVolumeAttachments), ctx, request)
+}
+
+// ListImages mocks base method.
+func (m *MockComputeClient) ListImages(ctx context.Context, request core.ListImagesRequest) (core.ListImagesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", ctx, request)
+	ret0, _ := ret[0].(core.ListImagesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockComputeClientMockRecorder) ListImages(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockComputeClient)(nil).ListImages), ctx, request)
+}
+
+// ListInstanceMaintenanceEvents mocks base method.
+func (m *MockComputeClient) ListInstanceMaintenanceEvents(ctx context.Context, request core.ListInstanceMaintenanceEventsRequest) (core.ListInstanceMaintenanceEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceMaintenanceEvents", ctx, request)
+	ret0, _ := ret[0].(core.ListInstanceMaintenanceEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstanceMaintenanceEvents indicates an expected call of ListInstanceMaintenanceEvents.
+func (mr *MockComputeClientMockRecorder) ListInstanceMaintenanceEvents(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceMaintenanceEvents", reflect.TypeOf((*MockComputeClient)(nil).ListInstanceMaintenanceEvents), ctx, request)
+}
+
 // ListInstances mocks base method.
 func (m *MockComputeClient) ListInstances(ctx context.Context, request core.ListInstancesRequest) (core.ListInstancesResponse, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +290,21 @@ func (mr *MockComputeClientMockRecorder) ListVnicAttachments(ctx, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnicAttachments", reflect.TypeOf((*MockComputeClient)(nil).ListVnicAttachments), ctx, request)
 }
 
+// ListVolumeAttachments mocks base method.
+func (m *MockComputeClient) ListVolumeAttachments(ctx context.Context, request core.ListVolumeAttachmentsRequest) (core.ListVolumeAttachmentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumeAttachments", ctx, request)
+	ret0, _ := ret[0].(core.ListVolumeAttachmentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumeAttachments indicates an expected call of ListVolumeAttachments.
+func (mr *MockComputeClientMockRecorder) ListVolumeAttachments(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumeAttachments", reflect.TypeOf((*MockComputeClient)(nil).ListVolumeAttachments), ctx, request)
+}
+
 // TerminateInstance mocks base method.
 func (m *MockComputeClient) TerminateInstance(ctx context.Context, request core.TerminateInstanceRequest) (core.TerminateInstanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -124,3 +319,18 @@ func (mr *MockComputeClientMockRecorder) TerminateInstance(ctx, request interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateInstance", reflect.TypeOf((*MockComputeClient)(nil).TerminateInstance), ctx, request)
 }
+
+// UpdateInstance mocks base method.
+func (m *MockComputeClient) UpdateInstance(ctx context.Context, request core.UpdateInstanceRequest) (core.UpdateInstanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstance", ctx, request)
+	ret0, _ := ret[0].(core.UpdateInstanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInstance indicates an expected call of UpdateInstance.
+func (mr *MockComputeClientMockRecorder) UpdateInstance(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstance", reflect.TypeOf((*MockComputeClient)(nil).UpdateInstance), ctx, request)
+}
@@ -0,0 +1,29 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package computeinstanceagent
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
+)
+
+type Client interface {
+	CancelInstanceAgentCommand(ctx context.Context, request computeinstanceagent.CancelInstanceAgentCommandRequest) (response computeinstanceagent.CancelInstanceAgentCommandResponse, err error)
+	CreateInstanceAgentCommand(ctx context.Context, request computeinstanceagent.CreateInstanceAgentCommandRequest) (response computeinstanceagent.CreateInstanceAgentCommandResponse, err error)
+	GetInstanceAgentCommandExecution(ctx context.Context, request computeinstanceagent.GetInstanceAgentCommandExecutionRequest) (response computeinstanceagent.GetInstanceAgentCommandExecutionResponse, err error)
+}
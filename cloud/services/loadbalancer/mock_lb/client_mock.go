@@ -95,6 +95,21 @@ func (mr *MockLoadBalancerClientMockRecorder) DeleteLoadBalancer(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockLoadBalancerClient)(nil).DeleteLoadBalancer), arg0, arg1)
 }
 
+// GetBackendSetHealth mocks base method.
+func (m *MockLoadBalancerClient) GetBackendSetHealth(arg0 context.Context, arg1 loadbalancer.GetBackendSetHealthRequest) (loadbalancer.GetBackendSetHealthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackendSetHealth", arg0, arg1)
+	ret0, _ := ret[0].(loadbalancer.GetBackendSetHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBackendSetHealth indicates an expected call of GetBackendSetHealth.
+func (mr *MockLoadBalancerClientMockRecorder) GetBackendSetHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackendSetHealth", reflect.TypeOf((*MockLoadBalancerClient)(nil).GetBackendSetHealth), arg0, arg1)
+}
+
 // GetLoadBalancer mocks base method.
 func (m *MockLoadBalancerClient) GetLoadBalancer(arg0 context.Context, arg1 loadbalancer.GetLoadBalancerRequest) (loadbalancer.GetLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
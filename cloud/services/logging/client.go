@@ -0,0 +1,31 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package logging
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/logging"
+)
+
+type Client interface {
+	ListLogs(ctx context.Context, request logging.ListLogsRequest) (response logging.ListLogsResponse, err error)
+	GetLog(ctx context.Context, request logging.GetLogRequest) (response logging.GetLogResponse, err error)
+	CreateLog(ctx context.Context, request logging.CreateLogRequest) (response logging.CreateLogResponse, err error)
+	UpdateLog(ctx context.Context, request logging.UpdateLogRequest) (response logging.UpdateLogResponse, err error)
+	DeleteLog(ctx context.Context, request logging.DeleteLogRequest) (response logging.DeleteLogResponse, err error)
+}
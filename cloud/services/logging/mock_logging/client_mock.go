@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: client.go
+
+// Package mock_logging is a generated GoMock package.
+package mock_logging
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	logging "github.com/oracle/oci-go-sdk/v65/logging"
+)
+
+// MockClient is a mock of Client interface.
+type MockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientMockRecorder
+}
+
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock *MockClient
+}
+
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
+	return m.recorder
+}
+
+// CreateLog mocks base method.
+func (m *MockClient) CreateLog(ctx context.Context, request logging.CreateLogRequest) (logging.CreateLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLog", ctx, request)
+	ret0, _ := ret[0].(logging.CreateLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLog indicates an expected call of CreateLog.
+func (mr *MockClientMockRecorder) CreateLog(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLog", reflect.TypeOf((*MockClient)(nil).CreateLog), ctx, request)
+}
+
+// DeleteLog mocks base method.
+func (m *MockClient) DeleteLog(ctx context.Context, request logging.DeleteLogRequest) (logging.DeleteLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLog", ctx, request)
+	ret0, _ := ret[0].(logging.DeleteLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLog indicates an expected call of DeleteLog.
+func (mr *MockClientMockRecorder) DeleteLog(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLog", reflect.TypeOf((*MockClient)(nil).DeleteLog), ctx, request)
+}
+
+// GetLog mocks base method.
+func (m *MockClient) GetLog(ctx context.Context, request logging.GetLogRequest) (logging.GetLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLog", ctx, request)
+	ret0, _ := ret[0].(logging.GetLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLog indicates an expected call of GetLog.
+func (mr *MockClientMockRecorder) GetLog(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLog", reflect.TypeOf((*MockClient)(nil).GetLog), ctx, request)
+}
+
+// ListLogs mocks base method.
+func (m *MockClient) ListLogs(ctx context.Context, request logging.ListLogsRequest) (logging.ListLogsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLogs", ctx, request)
+	ret0, _ := ret[0].(logging.ListLogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLogs indicates an expected call of ListLogs.
+func (mr *MockClientMockRecorder) ListLogs(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLogs", reflect.TypeOf((*MockClient)(nil).ListLogs), ctx, request)
+}
+
+// UpdateLog mocks base method.
+func (m *MockClient) UpdateLog(ctx context.Context, request logging.UpdateLogRequest) (logging.UpdateLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLog", ctx, request)
+	ret0, _ := ret[0].(logging.UpdateLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLog indicates an expected call of UpdateLog.
+func (mr *MockClientMockRecorder) UpdateLog(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLog", reflect.TypeOf((*MockClient)(nil).UpdateLog), ctx, request)
+}
@@ -95,6 +95,21 @@ func (mr *MockNetworkLoadBalancerClientMockRecorder) DeleteNetworkLoadBalancer(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkLoadBalancer", reflect.TypeOf((*MockNetworkLoadBalancerClient)(nil).DeleteNetworkLoadBalancer), ctx, request)
 }
 
+// GetBackendSetHealth mocks base method.
+func (m *MockNetworkLoadBalancerClient) GetBackendSetHealth(ctx context.Context, request networkloadbalancer.GetBackendSetHealthRequest) (networkloadbalancer.GetBackendSetHealthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBackendSetHealth", ctx, request)
+	ret0, _ := ret[0].(networkloadbalancer.GetBackendSetHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBackendSetHealth indicates an expected call of GetBackendSetHealth.
+func (mr *MockNetworkLoadBalancerClientMockRecorder) GetBackendSetHealth(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackendSetHealth", reflect.TypeOf((*MockNetworkLoadBalancerClient)(nil).GetBackendSetHealth), ctx, request)
+}
+
 // GetNetworkLoadBalancer mocks base method.
 func (m *MockNetworkLoadBalancerClient) GetNetworkLoadBalancer(ctx context.Context, request networkloadbalancer.GetNetworkLoadBalancerRequest) (networkloadbalancer.GetNetworkLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,31 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package objectstorage
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/objectstorage"
+)
+
+type Client interface {
+	GetNamespace(ctx context.Context, request objectstorage.GetNamespaceRequest) (response objectstorage.GetNamespaceResponse, err error)
+	PutObject(ctx context.Context, request objectstorage.PutObjectRequest) (response objectstorage.PutObjectResponse, err error)
+	DeleteObject(ctx context.Context, request objectstorage.DeleteObjectRequest) (response objectstorage.DeleteObjectResponse, err error)
+	CreatePreauthenticatedRequest(ctx context.Context, request objectstorage.CreatePreauthenticatedRequestRequest) (response objectstorage.CreatePreauthenticatedRequestResponse, err error)
+	DeletePreauthenticatedRequest(ctx context.Context, request objectstorage.DeletePreauthenticatedRequestRequest) (response objectstorage.DeletePreauthenticatedRequestResponse, err error)
+}
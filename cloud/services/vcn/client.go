@@ -80,6 +80,11 @@ type Client interface {
 	DeleteNetworkSecurityGroup(ctx context.Context, request core.DeleteNetworkSecurityGroupRequest) (response core.DeleteNetworkSecurityGroupResponse, err error)
 	ListNetworkSecurityGroupSecurityRules(ctx context.Context, request core.ListNetworkSecurityGroupSecurityRulesRequest) (response core.ListNetworkSecurityGroupSecurityRulesResponse, err error)
 	RemoveNetworkSecurityGroupSecurityRules(ctx context.Context, request core.RemoveNetworkSecurityGroupSecurityRulesRequest) (response core.RemoveNetworkSecurityGroupSecurityRulesResponse, err error)
+	// CaptureFilter
+	GetCaptureFilter(ctx context.Context, request core.GetCaptureFilterRequest) (response core.GetCaptureFilterResponse, err error)
+	CreateCaptureFilter(ctx context.Context, request core.CreateCaptureFilterRequest) (response core.CreateCaptureFilterResponse, err error)
+	UpdateCaptureFilter(ctx context.Context, request core.UpdateCaptureFilterRequest) (response core.UpdateCaptureFilterResponse, err error)
+	DeleteCaptureFilter(ctx context.Context, request core.DeleteCaptureFilterRequest) (response core.DeleteCaptureFilterResponse, err error)
 	// Dynamic Routing Gateways (DRG)
 	GetDrg(ctx context.Context, request core.GetDrgRequest) (response core.GetDrgResponse, err error)
 	CreateDrg(ctx context.Context, request core.CreateDrgRequest) (response core.CreateDrgResponse, err error)
@@ -97,4 +102,18 @@ type Client interface {
 	UpdateRemotePeeringConnection(ctx context.Context, request core.UpdateRemotePeeringConnectionRequest) (response core.UpdateRemotePeeringConnectionResponse, err error)
 	ListRemotePeeringConnections(ctx context.Context, request core.ListRemotePeeringConnectionsRequest) (response core.ListRemotePeeringConnectionsResponse, err error)
 	ConnectRemotePeeringConnections(ctx context.Context, request core.ConnectRemotePeeringConnectionsRequest) (response core.ConnectRemotePeeringConnectionsResponse, err error)
+	// Site-to-Site VPN
+	GetCpe(ctx context.Context, request core.GetCpeRequest) (response core.GetCpeResponse, err error)
+	CreateCpe(ctx context.Context, request core.CreateCpeRequest) (response core.CreateCpeResponse, err error)
+	DeleteCpe(ctx context.Context, request core.DeleteCpeRequest) (response core.DeleteCpeResponse, err error)
+	ListCpes(ctx context.Context, request core.ListCpesRequest) (response core.ListCpesResponse, err error)
+	GetIPSecConnection(ctx context.Context, request core.GetIPSecConnectionRequest) (response core.GetIPSecConnectionResponse, err error)
+	CreateIPSecConnection(ctx context.Context, request core.CreateIPSecConnectionRequest) (response core.CreateIPSecConnectionResponse, err error)
+	UpdateIPSecConnection(ctx context.Context, request core.UpdateIPSecConnectionRequest) (response core.UpdateIPSecConnectionResponse, err error)
+	DeleteIPSecConnection(ctx context.Context, request core.DeleteIPSecConnectionRequest) (response core.DeleteIPSecConnectionResponse, err error)
+	ListIPSecConnections(ctx context.Context, request core.ListIPSecConnectionsRequest) (response core.ListIPSecConnectionsResponse, err error)
+	ListIPSecConnectionTunnels(ctx context.Context, request core.ListIPSecConnectionTunnelsRequest) (response core.ListIPSecConnectionTunnelsResponse, err error)
+	UpdateIPSecConnectionTunnel(ctx context.Context, request core.UpdateIPSecConnectionTunnelRequest) (response core.UpdateIPSecConnectionTunnelResponse, err error)
+	GetIPSecConnectionTunnelSharedSecret(ctx context.Context, request core.GetIPSecConnectionTunnelSharedSecretRequest) (response core.GetIPSecConnectionTunnelSharedSecretResponse, err error)
+	UpdateIPSecConnectionTunnelSharedSecret(ctx context.Context, request core.UpdateIPSecConnectionTunnelSharedSecretRequest) (response core.UpdateIPSecConnectionTunnelSharedSecretResponse, err error)
 }
@@ -425,6 +425,21 @@ func (mr *MockClientMockRecorder) GetDrgAttachment(ctx, request interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDrgAttachment", reflect.TypeOf((*MockClient)(nil).GetDrgAttachment), ctx, request)
 }
 
+// GetIPSecConnectionTunnelSharedSecret mocks base method.
+func (m *MockClient) GetIPSecConnectionTunnelSharedSecret(ctx context.Context, request core.GetIPSecConnectionTunnelSharedSecretRequest) (core.GetIPSecConnectionTunnelSharedSecretResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPSecConnectionTunnelSharedSecret", ctx, request)
+	ret0, _ := ret[0].(core.GetIPSecConnectionTunnelSharedSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIPSecConnectionTunnelSharedSecret indicates an expected call of GetIPSecConnectionTunnelSharedSecret.
+func (mr *MockClientMockRecorder) GetIPSecConnectionTunnelSharedSecret(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSecConnectionTunnelSharedSecret", reflect.TypeOf((*MockClient)(nil).GetIPSecConnectionTunnelSharedSecret), ctx, request)
+}
+
 // GetInternetGateway mocks base method.
 func (m *MockClient) GetInternetGateway(ctx context.Context, request core.GetInternetGatewayRequest) (core.GetInternetGatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -575,6 +590,36 @@ func (mr *MockClientMockRecorder) GetVnic(ctx, request interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnic", reflect.TypeOf((*MockClient)(nil).GetVnic), ctx, request)
 }
 
+// UpdateIPSecConnectionTunnel mocks base method.
+func (m *MockClient) UpdateIPSecConnectionTunnel(ctx context.Context, request core.UpdateIPSecConnectionTunnelRequest) (core.UpdateIPSecConnectionTunnelResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIPSecConnectionTunnel", ctx, request)
+	ret0, _ := ret[0].(core.UpdateIPSecConnectionTunnelResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIPSecConnectionTunnel indicates an expected call of UpdateIPSecConnectionTunnel.
+func (mr *MockClientMockRecorder) UpdateIPSecConnectionTunnel(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIPSecConnectionTunnel", reflect.TypeOf((*MockClient)(nil).UpdateIPSecConnectionTunnel), ctx, request)
+}
+
+// UpdateIPSecConnectionTunnelSharedSecret mocks base method.
+func (m *MockClient) UpdateIPSecConnectionTunnelSharedSecret(ctx context.Context, request core.UpdateIPSecConnectionTunnelSharedSecretRequest) (core.UpdateIPSecConnectionTunnelSharedSecretResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIPSecConnectionTunnelSharedSecret", ctx, request)
+	ret0, _ := ret[0].(core.UpdateIPSecConnectionTunnelSharedSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIPSecConnectionTunnelSharedSecret indicates an expected call of UpdateIPSecConnectionTunnelSharedSecret.
+func (mr *MockClientMockRecorder) UpdateIPSecConnectionTunnelSharedSecret(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIPSecConnectionTunnelSharedSecret", reflect.TypeOf((*MockClient)(nil).UpdateIPSecConnectionTunnelSharedSecret), ctx, request)
+}
+
 // UpdateVnic mocks base method.
 func (m *MockClient) UpdateVnic(ctx context.Context, request core.UpdateVnicRequest) (core.UpdateVnicResponse, error) {
 	m.ctrl.T.Helper()
@@ -979,3 +1024,213 @@ func (mr *MockClientMockRecorder) UpdateVcn(ctx, request interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVcn", reflect.TypeOf((*MockClient)(nil).UpdateVcn), ctx, request)
 }
+
+// CreateCaptureFilter mocks base method.
+func (m *MockClient) CreateCaptureFilter(ctx context.Context, request core.CreateCaptureFilterRequest) (core.CreateCaptureFilterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCaptureFilter", ctx, request)
+	ret0, _ := ret[0].(core.CreateCaptureFilterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCaptureFilter indicates an expected call of CreateCaptureFilter.
+func (mr *MockClientMockRecorder) CreateCaptureFilter(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCaptureFilter", reflect.TypeOf((*MockClient)(nil).CreateCaptureFilter), ctx, request)
+}
+
+// DeleteCaptureFilter mocks base method.
+func (m *MockClient) DeleteCaptureFilter(ctx context.Context, request core.DeleteCaptureFilterRequest) (core.DeleteCaptureFilterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCaptureFilter", ctx, request)
+	ret0, _ := ret[0].(core.DeleteCaptureFilterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCaptureFilter indicates an expected call of DeleteCaptureFilter.
+func (mr *MockClientMockRecorder) DeleteCaptureFilter(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCaptureFilter", reflect.TypeOf((*MockClient)(nil).DeleteCaptureFilter), ctx, request)
+}
+
+// GetCaptureFilter mocks base method.
+func (m *MockClient) GetCaptureFilter(ctx context.Context, request core.GetCaptureFilterRequest) (core.GetCaptureFilterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCaptureFilter", ctx, request)
+	ret0, _ := ret[0].(core.GetCaptureFilterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCaptureFilter indicates an expected call of GetCaptureFilter.
+func (mr *MockClientMockRecorder) GetCaptureFilter(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCaptureFilter", reflect.TypeOf((*MockClient)(nil).GetCaptureFilter), ctx, request)
+}
+
+// UpdateCaptureFilter mocks base method.
+func (m *MockClient) UpdateCaptureFilter(ctx context.Context, request core.UpdateCaptureFilterRequest) (core.UpdateCaptureFilterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCaptureFilter", ctx, request)
+	ret0, _ := ret[0].(core.UpdateCaptureFilterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCaptureFilter indicates an expected call of UpdateCaptureFilter.
+func (mr *MockClientMockRecorder) UpdateCaptureFilter(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCaptureFilter", reflect.TypeOf((*MockClient)(nil).UpdateCaptureFilter), ctx, request)
+}
+
+// CreateCpe mocks base method.
+func (m *MockClient) CreateCpe(ctx context.Context, request core.CreateCpeRequest) (core.CreateCpeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCpe", ctx, request)
+	ret0, _ := ret[0].(core.CreateCpeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCpe indicates an expected call of CreateCpe.
+func (mr *MockClientMockRecorder) CreateCpe(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCpe", reflect.TypeOf((*MockClient)(nil).CreateCpe), ctx, request)
+}
+
+// CreateIPSecConnection mocks base method.
+func (m *MockClient) CreateIPSecConnection(ctx context.Context, request core.CreateIPSecConnectionRequest) (core.CreateIPSecConnectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIPSecConnection", ctx, request)
+	ret0, _ := ret[0].(core.CreateIPSecConnectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIPSecConnection indicates an expected call of CreateIPSecConnection.
+func (mr *MockClientMockRecorder) CreateIPSecConnection(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIPSecConnection", reflect.TypeOf((*MockClient)(nil).CreateIPSecConnection), ctx, request)
+}
+
+// DeleteCpe mocks base method.
+func (m *MockClient) DeleteCpe(ctx context.Context, request core.DeleteCpeRequest) (core.DeleteCpeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCpe", ctx, request)
+	ret0, _ := ret[0].(core.DeleteCpeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCpe indicates an expected call of DeleteCpe.
+func (mr *MockClientMockRecorder) DeleteCpe(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCpe", reflect.TypeOf((*MockClient)(nil).DeleteCpe), ctx, request)
+}
+
+// DeleteIPSecConnection mocks base method.
+func (m *MockClient) DeleteIPSecConnection(ctx context.Context, request core.DeleteIPSecConnectionRequest) (core.DeleteIPSecConnectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIPSecConnection", ctx, request)
+	ret0, _ := ret[0].(core.DeleteIPSecConnectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIPSecConnection indicates an expected call of DeleteIPSecConnection.
+func (mr *MockClientMockRecorder) DeleteIPSecConnection(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIPSecConnection", reflect.TypeOf((*MockClient)(nil).DeleteIPSecConnection), ctx, request)
+}
+
+// GetCpe mocks base method.
+func (m *MockClient) GetCpe(ctx context.Context, request core.GetCpeRequest) (core.GetCpeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCpe", ctx, request)
+	ret0, _ := ret[0].(core.GetCpeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCpe indicates an expected call of GetCpe.
+func (mr *MockClientMockRecorder) GetCpe(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCpe", reflect.TypeOf((*MockClient)(nil).GetCpe), ctx, request)
+}
+
+// GetIPSecConnection mocks base method.
+func (m *MockClient) GetIPSecConnection(ctx context.Context, request core.GetIPSecConnectionRequest) (core.GetIPSecConnectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIPSecConnection", ctx, request)
+	ret0, _ := ret[0].(core.GetIPSecConnectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIPSecConnection indicates an expected call of GetIPSecConnection.
+func (mr *MockClientMockRecorder) GetIPSecConnection(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIPSecConnection", reflect.TypeOf((*MockClient)(nil).GetIPSecConnection), ctx, request)
+}
+
+// ListCpes mocks base method.
+func (m *MockClient) ListCpes(ctx context.Context, request core.ListCpesRequest) (core.ListCpesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCpes", ctx, request)
+	ret0, _ := ret[0].(core.ListCpesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCpes indicates an expected call of ListCpes.
+func (mr *MockClientMockRecorder) ListCpes(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCpes", reflect.TypeOf((*MockClient)(nil).ListCpes), ctx, request)
+}
+
+// ListIPSecConnectionTunnels mocks base method.
+func (m *MockClient) ListIPSecConnectionTunnels(ctx context.Context, request core.ListIPSecConnectionTunnelsRequest) (core.ListIPSecConnectionTunnelsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIPSecConnectionTunnels", ctx, request)
+	ret0, _ := ret[0].(core.ListIPSecConnectionTunnelsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIPSecConnectionTunnels indicates an expected call of ListIPSecConnectionTunnels.
+func (mr *MockClientMockRecorder) ListIPSecConnectionTunnels(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIPSecConnectionTunnels", reflect.TypeOf((*MockClient)(nil).ListIPSecConnectionTunnels), ctx, request)
+}
+
+// ListIPSecConnections mocks base method.
+func (m *MockClient) ListIPSecConnections(ctx context.Context, request core.ListIPSecConnectionsRequest) (core.ListIPSecConnectionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIPSecConnections", ctx, request)
+	ret0, _ := ret[0].(core.ListIPSecConnectionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIPSecConnections indicates an expected call of ListIPSecConnections.
+func (mr *MockClientMockRecorder) ListIPSecConnections(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIPSecConnections", reflect.TypeOf((*MockClient)(nil).ListIPSecConnections), ctx, request)
+}
+
+// UpdateIPSecConnection mocks base method.
+func (m *MockClient) UpdateIPSecConnection(ctx context.Context, request core.UpdateIPSecConnectionRequest) (core.UpdateIPSecConnectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIPSecConnection", ctx, request)
+	ret0, _ := ret[0].(core.UpdateIPSecConnectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIPSecConnection indicates an expected call of UpdateIPSecConnection.
+func (mr *MockClientMockRecorder) UpdateIPSecConnection(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIPSecConnection", reflect.TypeOf((*MockClient)(nil).UpdateIPSecConnection), ctx, request)
+}
@@ -332,6 +332,27 @@ func CreateClientProviderFromClusterIdentity(ctx context.Context, client client.
 	return clientProvider, nil
 }
 
+// InitPeerClientProviders creates the client providers of the peer identities of the Remote Peering Connections
+// of the cluster, keyed by the name of the Remote Peering Connection
+func InitPeerClientProviders(ctx context.Context, client client.Client, defaultRegion string, clusterAccessor scope.OCIClusterAccessor) (map[string]*scope.ClientProvider, error) {
+	vcnPeering := clusterAccessor.GetNetworkSpec().VCNPeering
+	if vcnPeering == nil {
+		return nil, nil
+	}
+	clientProviders := make(map[string]*scope.ClientProvider)
+	for _, rpc := range vcnPeering.RemotePeeringConnections {
+		if rpc.PeerIdentityRef == nil {
+			continue
+		}
+		clientProvider, err := CreateClientProviderFromClusterIdentity(ctx, client, clusterAccessor.GetNameSpace(), defaultRegion, clusterAccessor, rpc.PeerIdentityRef)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create client provider for the peer identity of RPC %s", rpc.Name)
+		}
+		clientProviders[rpc.Name] = clientProvider
+	}
+	return clientProviders, nil
+}
+
 // CreateMachinePoolMachinesIfNotExists creates the machine pool machines if not exists. This method lists the existing
 // machines in the clusters and does a diff, and creates any missing machines based ont he spec provided.
 func CreateMachinePoolMachinesIfNotExists(ctx context.Context, params MachineParams) error {
@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2024 Oracle and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/metrics"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reportDrift emits an event for the drift which was not present in the previous reconciliation and updates the
+// drift metrics of the cluster.
+func reportDrift(recorder record.EventRecorder, cluster client.Object, previous []infrastructurev1beta2.ResourceDrift, current []infrastructurev1beta2.ResourceDrift) {
+	counts := make(map[string]int)
+	for _, drift := range current {
+		if !drift.Corrected {
+			counts[drift.ResourceType]++
+		}
+		if containsDrift(previous, drift) {
+			continue
+		}
+		recorder.Event(cluster, corev1.EventTypeWarning, "DriftDetected", driftMessage(drift))
+	}
+	metrics.SetClusterDrift(cluster.GetNamespace(), cluster.GetName(), counts)
+}
+
+// mergeDrift returns the drift of a reconciliation which did not complete: the drift found by the reconciliation and
+// the previous drift of the resources which were not compared again. The drift is only reported afresh once all the
+// resources were compared.
+func mergeDrift(previous []infrastructurev1beta2.ResourceDrift, current []infrastructurev1beta2.ResourceDrift) []infrastructurev1beta2.ResourceDrift {
+	merged := append([]infrastructurev1beta2.ResourceDrift{}, current...)
+	for _, drift := range previous {
+		compared := false
+		for _, d := range current {
+			if d.ResourceType == drift.ResourceType && d.Name == drift.Name {
+				compared = true
+				break
+			}
+		}
+		if !compared {
+			merged = append(merged, drift)
+		}
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
+
+func containsDrift(drifts []infrastructurev1beta2.ResourceDrift, drift infrastructurev1beta2.ResourceDrift) bool {
+	for _, d := range drifts {
+		if d.ResourceType == drift.ResourceType && d.Name == drift.Name && reflect.DeepEqual(d.Fields, drift.Fields) {
+			return true
+		}
+	}
+	return false
+}
+
+func driftMessage(drift infrastructurev1beta2.ResourceDrift) string {
+	fields := make([]string, 0, len(drift.Fields))
+	for _, field := range drift.Fields {
+		// the fields are sorted, a field with several drifted values such as rules is listed once
+		if len(fields) == 0 || fields[len(fields)-1] != field.Field {
+			fields = append(fields, field.Field)
+		}
+	}
+	action := "not corrected"
+	if drift.Corrected {
+		action = "corrected"
+	}
+	return fmt.Sprintf("%s %s differs from the spec in %s, %s", drift.ResourceType, drift.Name, strings.Join(fields, ", "), action)
+}
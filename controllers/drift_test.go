@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2024 Oracle and/or its affiliates.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestReportDrift(t *testing.T) {
+	vcnDrift := infrastructurev1beta2.ResourceDrift{
+		ResourceType: "vcn",
+		Name:         "foo",
+		ID:           common.String("vcn_id"),
+		Fields: []infrastructurev1beta2.FieldDrift{
+			{Field: "displayName", Desired: "foo", Actual: "bar"},
+		},
+	}
+	routeTableDrift := infrastructurev1beta2.ResourceDrift{
+		ResourceType: "routeTable",
+		Name:         "private-route-table",
+		ID:           common.String("rt_id"),
+		Fields: []infrastructurev1beta2.FieldDrift{
+			{Field: "routeRules", Actual: "0.0.0.0/0 (CIDR_BLOCK) via other"},
+			{Field: "routeRules", Desired: "0.0.0.0/0 (CIDR_BLOCK) via ngw"},
+		},
+		Corrected: true,
+	}
+
+	tests := []struct {
+		name           string
+		previous       []infrastructurev1beta2.ResourceDrift
+		current        []infrastructurev1beta2.ResourceDrift
+		expectedEvents []string
+	}{
+		{
+			name:    "new drift",
+			current: []infrastructurev1beta2.ResourceDrift{vcnDrift, routeTableDrift},
+			expectedEvents: []string{
+				"Warning DriftDetected vcn foo differs from the spec in displayName, not corrected",
+				"Warning DriftDetected routeTable private-route-table differs from the spec in routeRules, corrected",
+			},
+		},
+		{
+			name:     "drift already reported",
+			previous: []infrastructurev1beta2.ResourceDrift{vcnDrift},
+			current:  []infrastructurev1beta2.ResourceDrift{vcnDrift},
+		},
+		{
+			name:     "drift resolved",
+			previous: []infrastructurev1beta2.ResourceDrift{vcnDrift},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			recorder := record.NewFakeRecorder(10)
+			cluster := &infrastructurev1beta2.OCICluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-cluster",
+					Namespace: "test",
+				},
+			}
+			reportDrift(recorder, cluster, tc.previous, tc.current)
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			g.Expect(events).To(Equal(tc.expectedEvents))
+		})
+	}
+}
+
+func TestMergeDrift(t *testing.T) {
+	vcnDrift := infrastructurev1beta2.ResourceDrift{
+		ResourceType: "vcn",
+		Name:         "foo",
+		Fields: []infrastructurev1beta2.FieldDrift{
+			{Field: "displayName", Desired: "foo", Actual: "bar"},
+		},
+	}
+	updatedVcnDrift := infrastructurev1beta2.ResourceDrift{
+		ResourceType: "vcn",
+		Name:         "foo",
+		Fields: []infrastructurev1beta2.FieldDrift{
+			{Field: "displayName", Desired: "foo", Actual: "baz"},
+		},
+	}
+	routeTableDrift := infrastructurev1beta2.ResourceDrift{
+		ResourceType: "routeTable",
+		Name:         "private-route-table",
+		Fields: []infrastructurev1beta2.FieldDrift{
+			{Field: "routeRules", Desired: "0.0.0.0/0 (CIDR_BLOCK) via ngw"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		previous []infrastructurev1beta2.ResourceDrift
+		current  []infrastructurev1beta2.ResourceDrift
+		expected []infrastructurev1beta2.ResourceDrift
+	}{
+		{
+			name:     "no drift",
+			expected: nil,
+		},
+		{
+			name:     "drift of the resources not compared again is kept",
+			previous: []infrastructurev1beta2.ResourceDrift{vcnDrift, routeTableDrift},
+			expected: []infrastructurev1beta2.ResourceDrift{vcnDrift, routeTableDrift},
+		},
+		{
+			name:     "drift of the resources compared again is replaced",
+			previous: []infrastructurev1beta2.ResourceDrift{vcnDrift, routeTableDrift},
+			current:  []infrastructurev1beta2.ResourceDrift{updatedVcnDrift},
+			expected: []infrastructurev1beta2.ResourceDrift{updatedVcnDrift, routeTableDrift},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(mergeDrift(tc.previous, tc.current)).To(Equal(tc.expected))
+		})
+	}
+}
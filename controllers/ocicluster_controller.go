@@ -20,10 +20,14 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/metrics"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/plan"
 	"github.com/oracle/cluster-api-provider-oci/cloud/scope"
 	cloudutil "github.com/oracle/cluster-api-provider-oci/cloud/util"
 	"github.com/pkg/errors"
@@ -55,6 +59,9 @@ type OCIClusterReconciler struct {
 	Recorder       record.EventRecorder
 	Region         string
 	ClientProvider *scope.ClientProvider
+	// MaxConcurrentNetworkReconciles is the number of network resources of a cluster which are reconciled or
+	// deleted concurrently, the resources are processed one after another if it is not set.
+	MaxConcurrentNetworkReconciles int
 }
 
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ociclusters,verbs=get;list;watch;create;update;patch;delete
@@ -110,10 +117,25 @@ func (r *OCIClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	peerClientProviders, err := cloudutil.InitPeerClientProviders(ctx, r.Client, r.Region, clusterAccessor)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 	helper, err := patch.NewHelper(ociCluster, r.Client)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "failed to init patch helper")
 	}
+	// The OCIDs of the network resources created by the controller are kept in the status of the OCICluster, they
+	// are loaded in the spec for the duration of the reconciliation only, the network spec is restored before the
+	// OCICluster is patched.
+	networkSpec := ociCluster.Spec.NetworkSpec.DeepCopy()
+	scope.LoadNetworkInventory(&ociCluster.Spec.NetworkSpec, ociCluster.Status.Network)
+	var planRecorder *plan.Recorder
+	if plan.IsPlanMode(ociCluster) {
+		planRecorder = plan.NewRecorder()
+	} else {
+		ociCluster.Status.PlannedOperations = nil
+	}
 	clusterScope, err = scope.NewClusterScope(scope.ClusterScopeParams{
 		Client:                    r.Client,
 		Logger:                    &logger,
@@ -124,7 +146,12 @@ func (r *OCIClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		NetworkLoadBalancerClient: clients.NetworkLoadBalancerClient,
 		LoadBalancerClient:        clients.LoadBalancerClient,
 		IdentityClient:            clients.IdentityClient,
+		LoggingClient:             clients.LoggingClient,
+		BlockStorageClient:        clients.BlockStorageClient,
+		ComputeClient:             clients.ComputeClient,
 		RegionIdentifier:          clusterRegion,
+		PeerClientProviders:       peerClientProviders,
+		PlanRecorder:              planRecorder,
 	})
 	if err != nil {
 		logger.Error(err, "Couldn't create cluster scope")
@@ -135,6 +162,7 @@ func (r *OCIClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	defer func() {
 		logger.Info("Closing cluster scope")
 		conditions.SetSummary(ociCluster)
+		ociCluster.Spec.NetworkSpec = *networkSpec
 
 		if err := helper.Patch(ctx, ociCluster); err != nil && reterr == nil {
 			reterr = err
@@ -154,30 +182,115 @@ func (r *OCIClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 }
 
-func (r *OCIClusterReconciler) reconcileComponent(ctx context.Context, cluster *v1beta2.OCICluster,
-	reconciler func(context.Context) error,
-	componentName string, failReason string, readyEventtype string) error {
+// reconcileNode returns a graph node which reconciles a component of the cluster and records an event once the
+// component is ready.
+func (r *OCIClusterReconciler) reconcileNode(cluster *v1beta2.OCICluster,
+	reconciler func(context.Context) error, componentName string, readyEventtype string,
+	condition clusterv1.ConditionType, failReason string, dependsOn ...string) scope.GraphNode {
+	return scope.GraphNode{
+		Name:      componentName,
+		DependsOn: dependsOn,
+		Run: func(ctx context.Context) error {
+			if err := reconciler(ctx); err != nil {
+				return err
+			}
+			trimmedComponentName := strings.ReplaceAll(componentName, " ", "")
+			r.Recorder.Eventf(cluster, corev1.EventTypeNormal, readyEventtype,
+				fmt.Sprintf("%s is in ready state", trimmedComponentName))
+			return nil
+		},
+		Condition:    condition,
+		FailedReason: failReason,
+	}
+}
 
-	err := reconciler(ctx)
+// executeGraph runs the nodes of the cluster with bounded concurrency. If a node fails, an event is recorded
+// and the cluster is marked not ready with the reason of the node.
+func (r *OCIClusterReconciler) executeGraph(ctx context.Context, cluster *v1beta2.OCICluster, action string, nodes ...scope.GraphNode) error {
+	graph, err := scope.NewReconcileGraph(r.MaxConcurrentNetworkReconciles, nodes...)
 	if err != nil {
-		r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err,
-			fmt.Sprintf("failed to reconcile %s", componentName)).Error())
-		conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, failReason, clusterv1.ConditionSeverityError, "")
-		return errors.Wrapf(err, "failed to reconcile %s for OCICluster %s/%s", componentName, cluster.Namespace,
+		return err
+	}
+	err = graph.Execute(ctx, cluster)
+	setGroupConditions(cluster)
+	var nodeErr *scope.GraphNodeError
+	if errors.As(err, &nodeErr) {
+		r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(nodeErr.Err,
+			fmt.Sprintf("failed to %s %s", action, nodeErr.Node.Name)).Error())
+		conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, nodeErr.Node.FailedReason, clusterv1.ConditionSeverityError,
+			"%s failed: %s", nodeErr.Node.Name, ociutil.ConditionMessage(nodeErr.Err))
+		return errors.Wrapf(nodeErr.Err, "failed to %s %s for OCICluster %s/%s", action, nodeErr.Node.Name, cluster.Namespace,
 			cluster.Name)
 	}
+	return err
+}
 
-	trimmedComponentName := strings.ReplaceAll(componentName, " ", "")
-	r.Recorder.Eventf(cluster, corev1.EventTypeNormal, readyEventtype,
-		fmt.Sprintf("%s is in ready state", trimmedComponentName))
+// clusterConditionGroups are the conditions which summarize the conditions of related components of the cluster.
+var clusterConditionGroups = []struct {
+	condition clusterv1.ConditionType
+	members   []clusterv1.ConditionType
+}{
+	{
+		condition: infrastructurev1beta2.GatewaysReadyCondition,
+		members: []clusterv1.ConditionType{infrastructurev1beta2.InternetGatewayReadyCondition,
+			infrastructurev1beta2.NATGatewayReadyCondition, infrastructurev1beta2.ServiceGatewayReadyCondition},
+	},
+	{
+		condition: infrastructurev1beta2.PeeringReadyCondition,
+		members: []clusterv1.ConditionType{infrastructurev1beta2.DRGVCNAttachmentReadyCondition,
+			infrastructurev1beta2.DRGRPCAttachmentReadyCondition},
+	},
+}
 
-	return nil
+// setGroupConditions summarizes the conditions of each group of clusterConditionGroups into the condition of the
+// group, the same way the Ready condition summarizes all the conditions. A group none of whose members is set,
+// for example the peering of a cluster without a DRG, has no condition.
+func setGroupConditions(cluster *infrastructurev1beta2.OCICluster) {
+	for _, group := range clusterConditionGroups {
+		members := &infrastructurev1beta2.OCICluster{}
+		for _, member := range group.members {
+			if condition := conditions.Get(cluster, member); condition != nil {
+				members.Status.Conditions = append(members.Status.Conditions, *condition)
+			}
+		}
+		if len(members.Status.Conditions) == 0 {
+			conditions.Delete(cluster, group.condition)
+			continue
+		}
+		conditions.SetSummary(members)
+		summary := conditions.Get(members, clusterv1.ReadyCondition)
+		summary.Type = group.condition
+		conditions.Set(cluster, summary)
+	}
+}
+
+// reportPlan lists the planned operations in the status of the OCICluster. The OCICluster is neither marked ready
+// nor deleted in plan mode, the plan is applied once the plan annotation is removed.
+func (r *OCIClusterReconciler) reportPlan(cluster *infrastructurev1beta2.OCICluster, planRecorder *plan.Recorder, err error) (ctrl.Result, error) {
+	previous := cluster.Status.PlannedOperations
+	cluster.Status.PlannedOperations = planRecorder.Operations()
+	plan.ReportEvent(r.Recorder, cluster, previous, cluster.Status.PlannedOperations)
+	return ctrl.Result{}, err
 }
 
 func (r *OCIClusterReconciler) reconcile(ctx context.Context, logger logr.Logger, clusterScope scope.ClusterScopeClient, cluster *infrastructurev1beta2.OCICluster) (ctrl.Result, error) {
 	// If the OCICluster doesn't have our finalizer, add it.
 	controllerutil.AddFinalizer(cluster, infrastructurev1beta2.ClusterFinalizer)
 
+	// the drift is reported afresh by every complete reconciliation, a reconciliation which fails part way keeps
+	// the previous drift of the resources it did not reach
+	previousDrift := cluster.Status.Drift
+	cluster.Status.Drift = nil
+	driftComplete := false
+	defer func() {
+		if !driftComplete {
+			cluster.Status.Drift = mergeDrift(previousDrift, cluster.Status.Drift)
+		}
+		reportDrift(r.Recorder, cluster, previousDrift, cluster.Status.Drift)
+	}()
+
+	var nodes []scope.GraphNode
+	var lbDependencies []string
 	// This below if condition specifies if the network related infrastructure needs to be reconciled. Any new
 	// network related reconcilication should happen in this if condition
 	if !cluster.Spec.NetworkSpec.SkipNetworkManagement {
@@ -187,80 +300,106 @@ func (r *OCIClusterReconciler) reconcile(ctx context.Context, logger logr.Logger
 			return ctrl.Result{}, err
 		}
 
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileDRG, "DRG",
-			infrastructurev1beta2.DrgReconciliationFailedReason, infrastructurev1beta2.DrgEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
-
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileVCN, "VCN",
-			infrastructurev1beta2.VcnReconciliationFailedReason, infrastructurev1beta2.VcnEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
-
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileInternetGateway, "Internet Gateway",
-			infrastructurev1beta2.InternetGatewayReconciliationFailedReason, infrastructurev1beta2.InternetGatewayEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
-
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileNatGateway, "NAT Gateway",
-			infrastructurev1beta2.NatGatewayReconciliationFailedReason, infrastructurev1beta2.NatEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
-
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileServiceGateway, "Service Gateway",
-			infrastructurev1beta2.ServiceGatewayReconciliationFailedReason, infrastructurev1beta2.ServiceGatewayEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
-
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileNSG, "Network Security Group",
-			infrastructurev1beta2.NSGReconciliationFailedReason, infrastructurev1beta2.NetworkSecurityEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
-
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileRouteTable, "Route Table",
-			infrastructurev1beta2.RouteTableReconciliationFailedReason, infrastructurev1beta2.RouteTableEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
-
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileSubnet, "Subnet",
-			infrastructurev1beta2.SubnetReconciliationFailedReason, infrastructurev1beta2.SubnetEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
-
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileDRGVCNAttachment, "DRGVCNAttachment",
-			infrastructurev1beta2.DRGVCNAttachmentReconciliationFailedReason, infrastructurev1beta2.DRGVCNAttachmentEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
-
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileDRGRPCAttachment, "DRGRPCAttachment",
-			infrastructurev1beta2.DRGRPCAttachmentReconciliationFailedReason, infrastructurev1beta2.DRGRPCAttachmentEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
+		// the nodes are declared in the order they were reconciled in sequentially, the nodes run concurrently
+		// as soon as the nodes they depend on are ready
+		nodes = append(nodes,
+			r.reconcileNode(cluster, clusterScope.ReconcileDRG, "DRG", infrastructurev1beta2.DrgEventReady,
+				infrastructurev1beta2.DRGReadyCondition, infrastructurev1beta2.DrgReconciliationFailedReason),
+			r.reconcileNode(cluster, clusterScope.ReconcileVCN, "VCN", infrastructurev1beta2.VcnEventReady,
+				infrastructurev1beta2.VCNReadyCondition, infrastructurev1beta2.VcnReconciliationFailedReason),
+			r.reconcileNode(cluster, clusterScope.ReconcileInternetGateway, "Internet Gateway", infrastructurev1beta2.InternetGatewayEventReady,
+				infrastructurev1beta2.InternetGatewayReadyCondition, infrastructurev1beta2.InternetGatewayReconciliationFailedReason,
+				"VCN"),
+			r.reconcileNode(cluster, clusterScope.ReconcileNatGateway, "NAT Gateway", infrastructurev1beta2.NatEventReady,
+				infrastructurev1beta2.NATGatewayReadyCondition, infrastructurev1beta2.NatGatewayReconciliationFailedReason,
+				"VCN"),
+			r.reconcileNode(cluster, clusterScope.ReconcileServiceGateway, "Service Gateway", infrastructurev1beta2.ServiceGatewayEventReady,
+				infrastructurev1beta2.ServiceGatewayReadyCondition, infrastructurev1beta2.ServiceGatewayReconciliationFailedReason,
+				"VCN"),
+			r.reconcileNode(cluster, clusterScope.ReconcileNSG, "Network Security Group", infrastructurev1beta2.NetworkSecurityEventReady,
+				infrastructurev1beta2.NSGsReadyCondition, infrastructurev1beta2.NSGReconciliationFailedReason,
+				"VCN"),
+			// the route rules target the gateways and the DRG
+			r.reconcileNode(cluster, clusterScope.ReconcileRouteTable, "Route Table", infrastructurev1beta2.RouteTableEventReady,
+				infrastructurev1beta2.RouteTablesReadyCondition, infrastructurev1beta2.RouteTableReconciliationFailedReason,
+				"DRG", "VCN", "Internet Gateway", "NAT Gateway", "Service Gateway"),
+			r.reconcileNode(cluster, clusterScope.ReconcileSubnet, "Subnet", infrastructurev1beta2.SubnetEventReady,
+				infrastructurev1beta2.SubnetsReadyCondition, infrastructurev1beta2.SubnetReconciliationFailedReason,
+				"VCN", "Route Table"),
+			r.reconcileNode(cluster, clusterScope.ReconcileFlowLogs, "Flow Log", infrastructurev1beta2.FlowLogEventReady,
+				infrastructurev1beta2.FlowLogsReadyCondition, infrastructurev1beta2.FlowLogReconciliationFailedReason,
+				"Subnet"),
+			r.reconcileNode(cluster, clusterScope.ReconcileDRGVCNAttachment, "DRGVCNAttachment", infrastructurev1beta2.DRGVCNAttachmentEventReady,
+				infrastructurev1beta2.DRGVCNAttachmentReadyCondition, infrastructurev1beta2.DRGVCNAttachmentReconciliationFailedReason,
+				"DRG", "VCN"),
+			r.reconcileNode(cluster, clusterScope.ReconcileDRGRPCAttachment, "DRGRPCAttachment", infrastructurev1beta2.DRGRPCAttachmentEventReady,
+				infrastructurev1beta2.DRGRPCAttachmentReadyCondition, infrastructurev1beta2.DRGRPCAttachmentReconciliationFailedReason,
+				"DRG"),
+			r.reconcileNode(cluster, clusterScope.ReconcileVPN, "VPN", infrastructurev1beta2.VPNEventReady,
+				infrastructurev1beta2.VPNReadyCondition, infrastructurev1beta2.VPNReconciliationFailedReason,
+				"DRG"),
+		)
+		lbDependencies = []string{"Network Security Group", "Subnet"}
 	} else {
 		logger.Info("VCN Reconciliation is skipped")
 	}
 
-	if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileFailureDomains, "Failure Domain",
-		infrastructurev1beta2.FailureDomainFailedReason, infrastructurev1beta2.FailureDomainEventReady); err != nil {
-		return ctrl.Result{}, err
-	}
+	nodes = append(nodes, r.reconcileNode(cluster, clusterScope.ReconcileFailureDomains, "Failure Domain", infrastructurev1beta2.FailureDomainEventReady,
+		infrastructurev1beta2.FailureDomainsReadyCondition, infrastructurev1beta2.FailureDomainFailedReason))
 
 	// Reconcile the API Server LoadBalancer based on the specified LoadBalancerType.
 	loadBalancerType := cluster.Spec.NetworkSpec.APIServerLB.LoadBalancerType
 	if loadBalancerType == infrastructurev1beta2.LoadBalancerTypeLB {
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileApiServerLB, "Api Server Loadbalancer",
-			infrastructurev1beta2.APIServerLoadBalancerFailedReason, infrastructurev1beta2.ApiServerLoadBalancerEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
+		nodes = append(nodes, r.reconcileNode(cluster, clusterScope.ReconcileApiServerLB, "Api Server Loadbalancer", infrastructurev1beta2.ApiServerLoadBalancerEventReady,
+			infrastructurev1beta2.APIServerLBReadyCondition, infrastructurev1beta2.APIServerLoadBalancerFailedReason, lbDependencies...))
 	} else {
-		if err := r.reconcileComponent(ctx, cluster, clusterScope.ReconcileApiServerNLB, "Api Server Network Loadbalancer",
-			infrastructurev1beta2.APIServerLoadBalancerFailedReason, infrastructurev1beta2.ApiServerLoadBalancerEventReady); err != nil {
-			return ctrl.Result{}, err
-		}
+		nodes = append(nodes, r.reconcileNode(cluster, clusterScope.ReconcileApiServerNLB, "Api Server Network Loadbalancer", infrastructurev1beta2.ApiServerLoadBalancerEventReady,
+			infrastructurev1beta2.APIServerLBReadyCondition, infrastructurev1beta2.APIServerLoadBalancerFailedReason, lbDependencies...))
+	}
+
+	err := r.executeGraph(ctx, cluster, "reconcile", nodes...)
+	driftComplete = err == nil
+	if plan.IsPlanMode(cluster) {
+		return r.reportPlan(cluster, clusterScope.GetPlanRecorder(), err)
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	// the tags are recorded once applied, a tag removed from the spec afterwards is removed from the resources
+	if cluster.Spec.DriftPolicy != infrastructurev1beta2.DriftPolicyReport {
+		cluster.Status.AppliedTags = scope.NewAppliedTags(cluster.Spec.FreeformTags, cluster.Spec.DefinedTags)
 	}
 
 	conditions.MarkTrue(cluster, infrastructurev1beta2.ClusterReadyCondition)
 	cluster.Status.Ready = true
+	return r.reconcileHibernation(ctx, logger, clusterScope, cluster)
+}
+
+// reconcileHibernation updates the hibernation state of the OCICluster. Once the hibernate annotation is removed
+// from the Cluster, the OCICluster is resuming until all the backends of the API server load balancer are healthy,
+// the worker machines are resumed after the control plane.
+func (r *OCIClusterReconciler) reconcileHibernation(ctx context.Context, logger logr.Logger, clusterScope scope.ClusterScopeClient, cluster *infrastructurev1beta2.OCICluster) (ctrl.Result, error) {
+	if clusterScope.IsHibernated() {
+		if cluster.Status.Hibernation != infrastructurev1beta2.HibernationStateHibernated {
+			r.Recorder.Eventf(cluster, corev1.EventTypeNormal, "ClusterHibernated", "Cluster is hibernated, the instances are stopped")
+		}
+		cluster.Status.Hibernation = infrastructurev1beta2.HibernationStateHibernated
+		return ctrl.Result{}, nil
+	}
+	if cluster.Status.Hibernation == "" {
+		return ctrl.Result{}, nil
+	}
+	cluster.Status.Hibernation = infrastructurev1beta2.HibernationStateResuming
+	healthy, err := clusterScope.IsAPIServerLBHealthy(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !healthy {
+		logger.Info("Waiting for the backends of the API server load balancer to be healthy")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	cluster.Status.Hibernation = ""
+	r.Recorder.Eventf(cluster, corev1.EventTypeNormal, "ClusterResumed", "Control plane has resumed from hibernation")
 	return ctrl.Result{}, nil
 }
 
@@ -276,7 +415,7 @@ func (r *OCIClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(r.clusterToInfrastructureMapFunc(log)),
 			builder.WithPredicates(
-				predicates.ClusterUnpaused(log),
+				predicates.Any(log, predicates.ClusterUnpaused(log), scope.ClusterHibernationChanged(log)),
 				predicates.ResourceNotPausedAndHasFilterLabel(log, ""),
 			),
 		).
@@ -335,108 +474,79 @@ func (r *OCIClusterReconciler) clusterToInfrastructureMapFunc(log logr.Logger) h
 }
 
 func (r *OCIClusterReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, clusterScope scope.ClusterScopeClient, cluster *infrastructurev1beta2.OCICluster) (ctrl.Result, error) {
-	// Declare the err variable before the if-else block
-	var err error
-
 	// Delete API Server LoadBalancer based on the specified LoadBalancerType
 	// If the type is LB, it calls DeleteApiServerLbsLB(),
 	// If no specific type is provided, it defaults to calling DeleteApiServerLB().
-	loadBalancerType := cluster.Spec.NetworkSpec.APIServerLB.LoadBalancerType
-	if loadBalancerType == infrastructurev1beta2.LoadBalancerTypeLB {
-		err = clusterScope.DeleteApiServerLB(ctx)
-	} else {
-		err = clusterScope.DeleteApiServerNLB(ctx)
+	deleteApiServerLB := clusterScope.DeleteApiServerNLB
+	if cluster.Spec.NetworkSpec.APIServerLB.LoadBalancerType == infrastructurev1beta2.LoadBalancerTypeLB {
+		deleteApiServerLB = clusterScope.DeleteApiServerLB
 	}
-
-	if err != nil {
-		r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Api Server Loadbalancer").Error())
-		conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.APIServerLoadBalancerFailedReason, clusterv1.ConditionSeverityError, "")
-		return ctrl.Result{}, errors.Wrapf(err, "failed to delete apiserver LB for OCICluster %s/%s", cluster.Namespace, cluster.Name)
+	nodes := []scope.GraphNode{
+		{Name: "Api Server Loadbalancer", Run: deleteApiServerLB, FailedReason: infrastructurev1beta2.APIServerLoadBalancerFailedReason},
 	}
 
 	// This below if condition specifies if the network related infrastructure needs to be reconciled. Any new
 	// network related reconcilication should happen in this if condition
 	if !cluster.Spec.NetworkSpec.SkipNetworkManagement {
-		err = clusterScope.DeleteDRGRPCAttachment(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete DRG RPC attachment").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.DRGRPCAttachmentReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete DRG RPC Attachment  for OCICluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
-		err = clusterScope.DeleteDRGVCNAttachment(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete DRG VCN attachment").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.DRGVCNAttachmentReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete DRG VCN Attachment  for OCICluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
-		err = clusterScope.DeleteNSGs(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Network Security Group").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.NSGReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete Network Security Groups for OCICluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
-		err = clusterScope.DeleteSubnets(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Subnet").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.SubnetReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete subnet for OCICluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
-		err = clusterScope.DeleteRouteTables(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Route Table").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.RouteTableReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete RouteTables for OCICluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
-		err = clusterScope.DeleteSecurityLists(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Security Lists").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.SecurityListReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete SecurityLists for OCICluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
-		err = clusterScope.DeleteServiceGateway(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Service Gateway").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.ServiceGatewayReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete ServiceGateway for OCICluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
-		err = clusterScope.DeleteNatGateway(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete NAT Gateway").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.NatGatewayReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete NatGateway for OCICluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
-		err = clusterScope.DeleteInternetGateway(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Internet Gateway").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.InternetGatewayReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete InternetGateway for OCICluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
-		err = clusterScope.DeleteVCN(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete VCN").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.VcnReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete VCN for OCICluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
-		err = clusterScope.DeleteDRG(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete DRG").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.DrgReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete DRG for OCICluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
+		// the nodes are declared in the order they were deleted in sequentially, a node runs as soon as the
+		// resources which reference its resources are deleted
+		nodes = append(nodes,
+			scope.GraphNode{Name: "workload cluster resources", Run: clusterScope.DeleteWorkloadResources,
+				FailedReason: infrastructurev1beta2.WorkloadResourceDeletionFailedReason},
+			scope.GraphNode{Name: "VPN", Run: clusterScope.DeleteVPN,
+				FailedReason: infrastructurev1beta2.VPNReconciliationFailedReason},
+			scope.GraphNode{Name: "DRG RPC attachment", Run: clusterScope.DeleteDRGRPCAttachment,
+				FailedReason: infrastructurev1beta2.DRGRPCAttachmentReconciliationFailedReason},
+			scope.GraphNode{Name: "DRG VCN attachment", Run: clusterScope.DeleteDRGVCNAttachment,
+				FailedReason: infrastructurev1beta2.DRGVCNAttachmentReconciliationFailedReason},
+			scope.GraphNode{Name: "Network Security Group", Run: clusterScope.DeleteNSGs,
+				FailedReason: infrastructurev1beta2.NSGReconciliationFailedReason,
+				DependsOn:    []string{"Api Server Loadbalancer"}},
+			scope.GraphNode{Name: "Flow Log", Run: clusterScope.DeleteFlowLogs,
+				FailedReason: infrastructurev1beta2.FlowLogReconciliationFailedReason},
+			scope.GraphNode{Name: "Subnet", Run: clusterScope.DeleteSubnets,
+				FailedReason: infrastructurev1beta2.SubnetReconciliationFailedReason,
+				DependsOn:    []string{"Api Server Loadbalancer", "workload cluster resources", "Flow Log"}},
+			scope.GraphNode{Name: "Security Lists", Run: clusterScope.DeleteSecurityLists,
+				FailedReason: infrastructurev1beta2.SecurityListReconciliationFailedReason,
+				DependsOn:    []string{"Subnet"}},
+			// the cluster stays registered as a consumer of a shared network until its own resources in the VCN
+			// are deleted, the shared resources are only deleted once it is released
+			scope.GraphNode{Name: "Shared network release", Run: clusterScope.ReleaseSharedNetwork,
+				FailedReason: infrastructurev1beta2.VcnReconciliationFailedReason,
+				DependsOn:    []string{"Network Security Group", "Subnet", "Security Lists"}},
+			scope.GraphNode{Name: "Route Table", Run: clusterScope.DeleteRouteTables,
+				FailedReason: infrastructurev1beta2.RouteTableReconciliationFailedReason,
+				DependsOn:    []string{"Subnet", "Shared network release"}},
+			scope.GraphNode{Name: "Service Gateway", Run: clusterScope.DeleteServiceGateway,
+				FailedReason: infrastructurev1beta2.ServiceGatewayReconciliationFailedReason,
+				DependsOn:    []string{"Route Table"}},
+			scope.GraphNode{Name: "NAT Gateway", Run: clusterScope.DeleteNatGateway,
+				FailedReason: infrastructurev1beta2.NatGatewayReconciliationFailedReason,
+				DependsOn:    []string{"Route Table"}},
+			scope.GraphNode{Name: "Internet Gateway", Run: clusterScope.DeleteInternetGateway,
+				FailedReason: infrastructurev1beta2.InternetGatewayReconciliationFailedReason,
+				DependsOn:    []string{"Route Table"}},
+			scope.GraphNode{Name: "VCN", Run: clusterScope.DeleteVCN,
+				FailedReason: infrastructurev1beta2.VcnReconciliationFailedReason,
+				DependsOn: []string{"DRG VCN attachment", "Network Security Group", "Subnet", "Route Table", "Security Lists",
+					"Shared network release", "Service Gateway", "NAT Gateway", "Internet Gateway"}},
+			scope.GraphNode{Name: "DRG", Run: clusterScope.DeleteDRG,
+				FailedReason: infrastructurev1beta2.DrgReconciliationFailedReason,
+				DependsOn:    []string{"VPN", "DRG RPC attachment", "DRG VCN attachment"}},
+		)
 	} else {
 		logger.Info("VCN Reconciliation is skipped, none of the VCN related resources will be deleted")
 	}
+
+	err := r.executeGraph(ctx, cluster, "delete", nodes...)
+	if plan.IsPlanMode(cluster) {
+		return r.reportPlan(cluster, clusterScope.GetPlanRecorder(), err)
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	metrics.DeleteClusterDrift(cluster.Namespace, cluster.Name)
 	controllerutil.RemoveFinalizer(cluster, v1beta2.ClusterFinalizer)
 
 	return reconcile.Result{}, nil
@@ -23,7 +23,9 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/gomega"
 	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/plan"
 	mock_scope "github.com/oracle/cluster-api-provider-oci/cloud/scope/mocks"
+	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -144,6 +146,7 @@ func TestOCIClusterReconciler_reconcile(t *testing.T) {
 		expectedEvent      string
 		eventNotExpected   string
 		conditionAssertion conditionAssertion
+		hibernation        infrastructurev1beta2.HibernationState
 		testSpecificSetup  func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster)
 	}{
 		{
@@ -160,10 +163,13 @@ func TestOCIClusterReconciler_reconcile(t *testing.T) {
 				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileSubnet(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGRPCAttachment(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVPN(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileFailureDomains(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().IsHibernated().Return(false)
 			},
 		},
 		{
@@ -282,6 +288,24 @@ func TestOCIClusterReconciler_reconcile(t *testing.T) {
 				cs.EXPECT().ReconcileSubnet(context.Background()).Return(errors.New("some error"))
 			},
 		},
+		{
+			name:               "subnet condition is marked on subnet reconciliation failure",
+			expectedEvent:      "ReconcileError",
+			eventNotExpected:   infrastructurev1beta2.SubnetEventReady,
+			errorExpected:      true,
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.SubnetsReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.SubnetReconciliationFailedReason},
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
+				cs.EXPECT().SetRegionCode(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileDRG(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVCN(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileInternetGateway(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileNatGateway(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileServiceGateway(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileSubnet(context.Background()).Return(errors.New("some error"))
+			},
+		},
 		{
 			name:               "api server lb reconciliation failure",
 			expectedEvent:      "ReconcileError",
@@ -298,8 +322,10 @@ func TestOCIClusterReconciler_reconcile(t *testing.T) {
 				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileSubnet(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGRPCAttachment(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVPN(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileFailureDomains(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileApiServerNLB(context.Background()).Return(errors.New("some error"))
 			},
@@ -320,8 +346,10 @@ func TestOCIClusterReconciler_reconcile(t *testing.T) {
 				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileSubnet(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGRPCAttachment(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVPN(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileFailureDomains(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -341,6 +369,7 @@ func TestOCIClusterReconciler_reconcile(t *testing.T) {
 				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileSubnet(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGVCNAttachment(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -360,10 +389,50 @@ func TestOCIClusterReconciler_reconcile(t *testing.T) {
 				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileSubnet(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGRPCAttachment(context.Background()).Return(errors.New("some error"))
 			},
 		},
+		{
+			name:               "vpn reconciliation failure",
+			expectedEvent:      "ReconcileError",
+			eventNotExpected:   infrastructurev1beta2.VPNEventReady,
+			errorExpected:      true,
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.VPNReconciliationFailedReason},
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
+				cs.EXPECT().SetRegionCode(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileDRG(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVCN(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileInternetGateway(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileNatGateway(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileServiceGateway(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileSubnet(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFlowLogs(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileDRGVCNAttachment(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileDRGRPCAttachment(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVPN(context.Background()).Return(errors.New("some error"))
+			},
+		},
+		{
+			name:               "plan mode stops at the planned operations",
+			expectedEvent:      "PlanUpdated",
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.DRGReadyCondition, corev1.ConditionTrue, "", ""},
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
+				ociCluster.Annotations = map[string]string{infrastructurev1beta2.PlanAnnotation: ""}
+				planRecorder := plan.NewRecorder()
+				planRecorder.Record(infrastructurev1beta2.PlannedOperationCreate, "vcn", common.String("cluster"), nil)
+				cs.EXPECT().SetRegionCode(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileDRG(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVCN(context.Background()).Return(&plan.PlannedError{})
+				cs.EXPECT().ReconcileDRGRPCAttachment(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVPN(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFailureDomains(context.Background()).Return(nil)
+				cs.EXPECT().GetPlanRecorder().Return(planRecorder)
+			},
+		},
 		{
 			name:               "skip vcn reconciliation",
 			expectedEvent:      infrastructurev1beta2.ApiServerLoadBalancerEventReady,
@@ -372,6 +441,45 @@ func TestOCIClusterReconciler_reconcile(t *testing.T) {
 				ociCluster.Spec.NetworkSpec.SkipNetworkManagement = true
 				cs.EXPECT().ReconcileFailureDomains(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().IsHibernated().Return(false)
+			},
+		},
+		{
+			name:               "cluster is hibernated",
+			expectedEvent:      "ClusterHibernated",
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionTrue, "", ""},
+			hibernation:        infrastructurev1beta2.HibernationStateHibernated,
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
+				ociCluster.Spec.NetworkSpec.SkipNetworkManagement = true
+				cs.EXPECT().ReconcileFailureDomains(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().IsHibernated().Return(true)
+			},
+		},
+		{
+			name:               "cluster resumes until the api server load balancer backends are healthy",
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionTrue, "", ""},
+			hibernation:        infrastructurev1beta2.HibernationStateResuming,
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
+				ociCluster.Spec.NetworkSpec.SkipNetworkManagement = true
+				ociCluster.Status.Hibernation = infrastructurev1beta2.HibernationStateHibernated
+				cs.EXPECT().ReconcileFailureDomains(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().IsHibernated().Return(false)
+				cs.EXPECT().IsAPIServerLBHealthy(context.Background()).Return(false, nil)
+			},
+		},
+		{
+			name:               "cluster is resumed once the api server load balancer backends are healthy",
+			expectedEvent:      "ClusterResumed",
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionTrue, "", ""},
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
+				ociCluster.Spec.NetworkSpec.SkipNetworkManagement = true
+				ociCluster.Status.Hibernation = infrastructurev1beta2.HibernationStateResuming
+				cs.EXPECT().ReconcileFailureDomains(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().IsHibernated().Return(false)
+				cs.EXPECT().IsAPIServerLBHealthy(context.Background()).Return(true, nil)
 			},
 		},
 	}
@@ -384,6 +492,7 @@ func TestOCIClusterReconciler_reconcile(t *testing.T) {
 			tc.testSpecificSetup(cs, ociCluster)
 			ctx := context.Background()
 			_, err := r.reconcile(ctx, log.FromContext(ctx), cs, ociCluster)
+			g.Expect(ociCluster.Status.Hibernation).To(Equal(tc.hibernation))
 			actual := conditions.Get(ociCluster, tc.conditionAssertion.conditionType)
 			g.Expect(actual).To(Not(BeNil()))
 			g.Expect(actual.Type).To(Equal(tc.conditionAssertion.conditionType))
@@ -447,12 +556,16 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			name: "all success",
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNatGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteInternetGateway(context.Background()).Return(nil)
@@ -460,6 +573,27 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 				cs.EXPECT().DeleteDRG(context.Background()).Return(nil)
 			},
 		},
+		{
+			name:               "workload resources delete failure",
+			expectedEvent:      "ReconcileError",
+			errorExpected:      true,
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.WorkloadResourceDeletionFailedReason},
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
+				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(errors.New("some error"))
+			},
+		},
+		{
+			name:               "vpn delete failure",
+			expectedEvent:      "ReconcileError",
+			errorExpected:      true,
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.VPNReconciliationFailedReason},
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
+				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(errors.New("some error"))
+			},
+		},
 		{
 			name:               "drg rpc delete failure",
 			expectedEvent:      "ReconcileError",
@@ -467,6 +601,8 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.DRGRPCAttachmentReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -477,6 +613,8 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.DRGVCNAttachmentReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(errors.New("some error"))
 			},
@@ -506,6 +644,8 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.NSGReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(errors.New("some error"))
@@ -518,12 +658,33 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.SubnetReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(errors.New("some error"))
 			},
 		},
+		{
+			name:               "shared network release failure",
+			expectedEvent:      "ReconcileError",
+			errorExpected:      true,
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.VcnReconciliationFailedReason},
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
+				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
+				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
+				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
+				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
+				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(errors.New("some error"))
+			},
+		},
 		{
 			name:               "route table delete failure",
 			expectedEvent:      "ReconcileError",
@@ -531,10 +692,15 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.RouteTableReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
+				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
 				cs.EXPECT().DeleteRouteTables(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -545,11 +711,13 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.SecurityListReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -560,12 +728,16 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.ServiceGatewayReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -576,12 +748,16 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.NatGatewayReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNatGateway(context.Background()).Return(errors.New("some error"))
 			},
@@ -593,12 +769,16 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.InternetGatewayReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNatGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteInternetGateway(context.Background()).Return(errors.New("some error"))
@@ -611,12 +791,16 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.VcnReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNatGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteInternetGateway(context.Background()).Return(nil)
@@ -630,12 +814,16 @@ func TestOCIClusterReconciler_reconcileDelete(t *testing.T) {
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.DrgReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCICluster) {
 				cs.EXPECT().DeleteApiServerNLB(context.Background()).Return(nil)
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNatGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteInternetGateway(context.Background()).Return(nil)
@@ -725,3 +913,24 @@ func getPausedInfraCluster() *clusterv1.Cluster {
 		},
 	}
 }
+
+func TestSetGroupConditions(t *testing.T) {
+	g := NewWithT(t)
+	cluster := &infrastructurev1beta2.OCICluster{}
+	conditions.MarkTrue(cluster, infrastructurev1beta2.InternetGatewayReadyCondition)
+	conditions.MarkFalse(cluster, infrastructurev1beta2.NATGatewayReadyCondition, infrastructurev1beta2.NatGatewayReconciliationFailedReason,
+		clusterv1.ConditionSeverityError, "LimitExceeded: NAT gateway limit reached (opc-request-id: id)")
+	conditions.MarkTrue(cluster, infrastructurev1beta2.ServiceGatewayReadyCondition)
+
+	setGroupConditions(cluster)
+	g.Expect(conditions.IsFalse(cluster, infrastructurev1beta2.GatewaysReadyCondition)).To(BeTrue())
+	g.Expect(conditions.GetReason(cluster, infrastructurev1beta2.GatewaysReadyCondition)).To(Equal(infrastructurev1beta2.NatGatewayReconciliationFailedReason))
+	g.Expect(conditions.GetMessage(cluster, infrastructurev1beta2.GatewaysReadyCondition)).To(Equal("LimitExceeded: NAT gateway limit reached (opc-request-id: id)"))
+	g.Expect(conditions.Has(cluster, infrastructurev1beta2.PeeringReadyCondition)).To(BeFalse())
+
+	conditions.MarkTrue(cluster, infrastructurev1beta2.NATGatewayReadyCondition)
+	conditions.MarkTrue(cluster, infrastructurev1beta2.DRGVCNAttachmentReadyCondition)
+	setGroupConditions(cluster)
+	g.Expect(conditions.IsTrue(cluster, infrastructurev1beta2.GatewaysReadyCondition)).To(BeTrue())
+	g.Expect(conditions.IsTrue(cluster, infrastructurev1beta2.PeeringReadyCondition)).To(BeTrue())
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -49,6 +50,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// instanceRebootTimeout is the time after which an instance, which does not report the new shape config after
+// the reboot of an in-place update, is considered to have failed the update.
+const instanceRebootTimeout = 15 * time.Minute
+
 // OCIMachineReconciler reconciles a OciMachine object
 type OCIMachineReconciler struct {
 	client.Client
@@ -118,6 +123,7 @@ func (r *OCIMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			r.Recorder.Eventf(ociMachine, corev1.EventTypeWarning, "ClusterNotAvailable", "Cluster is not available yet")
 			return ctrl.Result{}, nil
 		}
+		scope.LoadNetworkInventory(&ociCluster.Spec.NetworkSpec, ociCluster.Status.Network)
 		clusterAccessor = scope.OCISelfManagedCluster{
 			OCICluster: ociCluster,
 		}
@@ -156,6 +162,9 @@ func (r *OCIMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		VCNClient:                 clients.VCNClient,
 		NetworkLoadBalancerClient: clients.NetworkLoadBalancerClient,
 		LoadBalancerClient:        clients.LoadBalancerClient,
+		BlockStorageClient:        clients.BlockStorageClient,
+		ObjectStorageClient:       clients.ObjectStorageClient,
+		InstanceAgentClient:       clients.InstanceAgentClient,
 	})
 	if err != nil {
 		return ctrl.Result{}, errors.Errorf("failed to create scope: %+v", err)
@@ -203,7 +212,10 @@ func (r *OCIMachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(clusterToObjectFunc),
 			builder.WithPredicates(
-				predicates.ClusterUnpausedAndInfrastructureReady(ctrl.LoggerFrom(ctx)),
+				predicates.Any(ctrl.LoggerFrom(ctx),
+					predicates.ClusterUnpausedAndInfrastructureReady(ctrl.LoggerFrom(ctx)),
+					scope.ClusterHibernationChanged(ctrl.LoggerFrom(ctx)),
+				),
 			),
 		).
 		// don't queue reconcile if resource is paused
@@ -309,6 +321,11 @@ func (r *OCIMachineReconciler) reconcileNormal(ctx context.Context, logger logr.
 	}
 
 	instance, err := r.getOrCreate(ctx, machineScope)
+	if errors.Is(err, scope.ErrBootVolumeNotAvailable) {
+		logger.Info("Waiting for the boot volume to be available", "reason", err.Error())
+		conditions.MarkFalse(machine, infrastructurev1beta2.InstanceReadyCondition, infrastructurev1beta2.WaitingForBootVolumeReason, clusterv1.ConditionSeverityInfo, "")
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
 	if err != nil {
 		r.Recorder.Event(machine, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "Failed to reconcile OCIMachine").Error())
 		conditions.MarkFalse(machine, infrastructurev1beta2.InstanceReadyCondition, infrastructurev1beta2.InstanceProvisionFailedReason, clusterv1.ConditionSeverityError, "")
@@ -320,6 +337,16 @@ func (r *OCIMachineReconciler) reconcileNormal(ctx context.Context, logger logr.
 
 	machine.Spec.ProviderID = common.String(machineScope.OCIClusterAccessor.GetProviderID(*instance.Id))
 
+	err = machineScope.ReconcileConsoleHistory(ctx, instance)
+	if errors.Is(err, scope.ErrConsoleHistoryNotCaptured) {
+		logger.Info("Waiting for the console history to be captured")
+		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	if err != nil {
+		// the console history only helps to diagnose the machine, the machine is reconciled whether it is captured or not
+		r.Recorder.Event(machine, corev1.EventTypeWarning, "ConsoleHistoryCaptureFailed", err.Error())
+	}
+
 	// Proceed to reconcile the DOMachine state.
 	switch instance.LifecycleState {
 	case core.InstanceLifecycleStateProvisioning, core.InstanceLifecycleStateStarting:
@@ -328,10 +355,21 @@ func (r *OCIMachineReconciler) reconcileNormal(ctx context.Context, logger logr.
 		return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
 	case core.InstanceLifecycleStateStopping, core.InstanceLifecycleStateStopped, core.InstanceLifecycleStateMoving:
 		machineScope.SetNotReady()
+		if machine.Status.Hibernated {
+			return r.resumeInstance(ctx, machineScope, instance)
+		}
 		machineScope.Info(fmt.Sprintf("Instance is in %s state and not ready", instance.LifecycleState))
 		conditions.MarkFalse(machineScope.OCIMachine, infrastructurev1beta2.InstanceReadyCondition, infrastructurev1beta2.InstanceNotReadyReason, clusterv1.ConditionSeverityInfo, "")
+		if conditions.GetReason(machine, infrastructurev1beta2.InstanceUpdatedCondition) == infrastructurev1beta2.InstanceRebootingReason ||
+			machineScope.IsRebootMigrating() {
+			return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+		}
 		return reconcile.Result{}, nil
 	case core.InstanceLifecycleStateRunning:
+		if machineScope.IsHibernated() {
+			return r.hibernateInstance(ctx, machineScope, instance)
+		}
+		machine.Status.Hibernated = false
 		machineScope.Info("Instance is active")
 		if machine.Status.Addresses == nil || len(machine.Status.Addresses) == 0 {
 			machineScope.Info("IP address is not set on the instance, looking up the address")
@@ -371,18 +409,78 @@ func (r *OCIMachineReconciler) reconcileNormal(ctx context.Context, logger logr.
 				"VNICs have been attached to instance.")
 		}
 
+		if err := machineScope.ReconcileTags(ctx, instance); err != nil {
+			r.Recorder.Event(machine, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to reconcile OCIMachine").Error())
+			conditions.MarkFalse(machineScope.OCIMachine, infrastructurev1beta2.InstanceReadyCondition,
+				infrastructurev1beta2.InstanceTagsUpdateFailedReason, clusterv1.ConditionSeverityError, "")
+			return ctrl.Result{}, err
+		}
+
+		if err := machineScope.ReconcileBootVolumeBackupPolicy(ctx, instance); err != nil {
+			r.Recorder.Event(machine, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to reconcile OCIMachine").Error())
+			conditions.MarkFalse(machineScope.OCIMachine, infrastructurev1beta2.InstanceReadyCondition,
+				infrastructurev1beta2.InstanceBootVolumeBackupPolicyFailedReason, clusterv1.ConditionSeverityError, "")
+			return ctrl.Result{}, err
+		}
+
+		err = machineScope.ReconcilePersistentVolumes(ctx, instance)
+		if errors.Is(err, scope.ErrPersistentVolumeNotAvailable) {
+			logger.Info("Waiting for the persistent volumes to be attached", "reason", err.Error())
+			conditions.MarkFalse(machineScope.OCIMachine, infrastructurev1beta2.InstanceReadyCondition,
+				infrastructurev1beta2.WaitingForPersistentVolumesReason, clusterv1.ConditionSeverityInfo, "")
+			return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+		if err != nil {
+			r.Recorder.Event(machine, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to reconcile OCIMachine").Error())
+			conditions.MarkFalse(machineScope.OCIMachine, infrastructurev1beta2.InstanceReadyCondition,
+				infrastructurev1beta2.PersistentVolumeAttachmentFailedReason, clusterv1.ConditionSeverityError, "")
+			return ctrl.Result{}, err
+		}
+
 		// record the event only when machine goes from not ready to ready state
 		r.Recorder.Eventf(machine, corev1.EventTypeNormal, "InstanceReady",
 			"Instance is in ready state")
 		conditions.MarkTrue(machineScope.OCIMachine, infrastructurev1beta2.InstanceReadyCondition)
 		machineScope.SetReady()
-		if deleteMachineOnTermination {
+		if machineScope.IsInPlaceUpdateEnabled() {
+			result, err := r.reconcileInPlaceUpdate(ctx, machineScope, instance)
+			if err != nil || !result.IsZero() {
+				return result, err
+			}
+		} else {
+			conditions.Delete(machine, infrastructurev1beta2.InstanceUpdatedCondition)
+		}
+		maintenanceRequeue, err := machineScope.ReconcileMaintenance(ctx, instance)
+		if err != nil {
+			r.Recorder.Event(machine, corev1.EventTypeWarning, "MaintenanceFailed", err.Error())
+			return ctrl.Result{}, err
+		}
+		err = machineScope.ReconcileWindowsCredentials(ctx, instance)
+		if errors.Is(err, scope.ErrWindowsCredentialsNotAvailable) {
+			logger.Info("Waiting for the Windows credentials", "reason", err.Error())
+			// the maintenance of the instance is polled while the credentials are pending
+			requeueAfter := 10 * time.Second
+			if maintenanceRequeue > 0 && maintenanceRequeue < requeueAfter {
+				requeueAfter = maintenanceRequeue
+			}
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		}
+		if err != nil {
+			r.Recorder.Event(machine, corev1.EventTypeWarning, "WindowsCredentialsFailed", err.Error())
+			return ctrl.Result{}, err
+		}
+		if requeueAfter := machineScope.GetBootstrapTimeoutRequeue(instance); requeueAfter > 0 &&
+			(maintenanceRequeue == 0 || requeueAfter < maintenanceRequeue) {
+			// the console history is captured if the node has not joined the cluster when the bootstrap times out
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		}
+		if deleteMachineOnTermination && (maintenanceRequeue == 0 || maintenanceRequeue > 300*time.Second) {
 			// typically, if the VM is terminated, we should get machine events, so ideally, the 300 seconds
 			// requeue time is not required, but in case, the event is missed, adding the requeue time
 			return reconcile.Result{RequeueAfter: 300 * time.Second}, nil
-		} else {
-			return reconcile.Result{}, nil
 		}
+		// the maintenance of the instance is polled while the maintenance event handling is set
+		return reconcile.Result{RequeueAfter: maintenanceRequeue}, nil
 	case core.InstanceLifecycleStateTerminated:
 		if deleteMachineOnTermination && infraMachine.DeletionTimestamp == nil {
 			logger.Info("Deleting underlying machine as instance is terminated")
@@ -402,8 +500,118 @@ func (r *OCIMachineReconciler) reconcileNormal(ctx context.Context, logger logr.
 	}
 }
 
-func (r *OCIMachineReconciler) getOrCreate(ctx context.Context, scope *scope.MachineScope) (*core.Instance, error) {
-	instance, err := scope.GetOrCreateMachine(ctx)
+// hibernateInstance stops the instance of a machine of a hibernated cluster. The stopped instance is not a failure,
+// the machine is not ready until the cluster resumes.
+func (r *OCIMachineReconciler) hibernateInstance(ctx context.Context, machineScope *scope.MachineScope, instance *core.Instance) (ctrl.Result, error) {
+	machine := machineScope.OCIMachine
+	if err := machineScope.StopInstance(ctx, instance); err != nil {
+		r.Recorder.Event(machine, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to hibernate OCIMachine").Error())
+		return ctrl.Result{}, err
+	}
+	machine.Status.Hibernated = true
+	machineScope.SetNotReady()
+	conditions.MarkFalse(machine, infrastructurev1beta2.InstanceReadyCondition, infrastructurev1beta2.InstanceHibernatedReason, clusterv1.ConditionSeverityInfo, "")
+	r.Recorder.Eventf(machine, corev1.EventTypeNormal, "InstanceHibernated", "Instance is stopped by the hibernation of the cluster")
+	return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+}
+
+// resumeInstance starts the instance stopped by the hibernation of the cluster once the cluster resumes. The
+// instances of the worker machines are started once the control plane has resumed.
+func (r *OCIMachineReconciler) resumeInstance(ctx context.Context, machineScope *scope.MachineScope, instance *core.Instance) (ctrl.Result, error) {
+	machine := machineScope.OCIMachine
+	if machineScope.IsHibernated() || instance.LifecycleState != core.InstanceLifecycleStateStopped {
+		machineScope.Info(fmt.Sprintf("Instance is hibernated and in %s state", instance.LifecycleState))
+		conditions.MarkFalse(machine, infrastructurev1beta2.InstanceReadyCondition, infrastructurev1beta2.InstanceHibernatedReason, clusterv1.ConditionSeverityInfo, "")
+		if instance.LifecycleState == core.InstanceLifecycleStateStopped {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	if machineScope.IsWaitingForControlPlaneResume() {
+		machineScope.Info("Waiting for the control plane to resume before starting the instance")
+		conditions.MarkFalse(machine, infrastructurev1beta2.InstanceReadyCondition, infrastructurev1beta2.WaitingForControlPlaneResumeReason, clusterv1.ConditionSeverityInfo, "")
+		return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	if err := machineScope.StartInstance(ctx, instance); err != nil {
+		r.Recorder.Event(machine, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to resume OCIMachine").Error())
+		return ctrl.Result{}, err
+	}
+	conditions.MarkFalse(machine, infrastructurev1beta2.InstanceReadyCondition, infrastructurev1beta2.InstanceNotReadyReason, clusterv1.ConditionSeverityInfo, "")
+	r.Recorder.Eventf(machine, corev1.EventTypeNormal, "InstanceResumed", "Instance is started after the hibernation of the cluster")
+	return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+}
+
+// reconcileInPlaceUpdate applies the changes to the spec of a machine with the InPlace update policy to the running
+// instance. A new shape config reboots the instance, the instance of a control plane machine is only rebooted once
+// the other control plane machines are ready.
+func (r *OCIMachineReconciler) reconcileInPlaceUpdate(ctx context.Context, machineScope *scope.MachineScope, instance *core.Instance) (ctrl.Result, error) {
+	machine := machineScope.OCIMachine
+	update, err := machineScope.GetInstanceUpdate(instance)
+	if err != nil {
+		r.Recorder.Event(machine, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to update OCIMachine").Error())
+		conditions.MarkFalse(machine, infrastructurev1beta2.InstanceUpdatedCondition, infrastructurev1beta2.InstanceUpdateFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return ctrl.Result{}, err
+	}
+	if update == nil {
+		if conditions.Has(machine, infrastructurev1beta2.InstanceUpdatedCondition) && !conditions.IsTrue(machine, infrastructurev1beta2.InstanceUpdatedCondition) {
+			r.Recorder.Eventf(machine, corev1.EventTypeNormal, "InstanceUpdated", "Instance is updated in place")
+		}
+		conditions.MarkTrue(machine, infrastructurev1beta2.InstanceUpdatedCondition)
+		return ctrl.Result{}, nil
+	}
+	if update.ShapeConfig != nil {
+		// the instance reports the new shape config once it has rebooted
+		if condition := conditions.Get(machine, infrastructurev1beta2.InstanceUpdatedCondition); condition != nil && condition.Reason == infrastructurev1beta2.InstanceRebootingReason {
+			if time.Since(condition.LastTransitionTime.Time) < instanceRebootTimeout {
+				machineScope.Info("Waiting for the instance to reboot with the new shape config")
+				return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+			}
+			r.Recorder.Event(machine, corev1.EventTypeWarning, "ReconcileError", "Instance does not report the new shape config after the reboot")
+			conditions.MarkFalse(machine, infrastructurev1beta2.InstanceUpdatedCondition, infrastructurev1beta2.InstanceUpdateFailedReason, clusterv1.ConditionSeverityWarning,
+				"instance does not report the new shape config after the reboot")
+			return ctrl.Result{}, nil
+		}
+		if machineScope.IsControlPlane() {
+			allowed, err := machineScope.IsControlPlaneRebootAllowed(ctx)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if !allowed {
+				conditions.MarkFalse(machine, infrastructurev1beta2.InstanceUpdatedCondition, infrastructurev1beta2.WaitingForControlPlaneRebootReason, clusterv1.ConditionSeverityInfo, "")
+				return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+			}
+		}
+	}
+	if err := machineScope.ApplyInstanceUpdate(ctx, instance, *update); err != nil {
+		r.Recorder.Event(machine, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to update OCIMachine").Error())
+		conditions.MarkFalse(machine, infrastructurev1beta2.InstanceUpdatedCondition, infrastructurev1beta2.InstanceUpdateFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		return ctrl.Result{}, err
+	}
+	if update.ShapeConfig != nil {
+		conditions.MarkFalse(machine, infrastructurev1beta2.InstanceUpdatedCondition, infrastructurev1beta2.InstanceRebootingReason, clusterv1.ConditionSeverityInfo, "")
+		r.Recorder.Eventf(machine, corev1.EventTypeNormal, "InstanceRebooting", "Instance reboots to apply the new shape config")
+		return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	conditions.MarkFalse(machine, infrastructurev1beta2.InstanceUpdatedCondition, infrastructurev1beta2.InstanceUpdatingReason, clusterv1.ConditionSeverityInfo, "")
+	r.Recorder.Eventf(machine, corev1.EventTypeNormal, "InstanceUpdating", "Instance is updated in place")
+	return reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+}
+
+func (r *OCIMachineReconciler) getOrCreate(ctx context.Context, machineScope *scope.MachineScope) (*core.Instance, error) {
+	machine := machineScope.OCIMachine
+	launchTime, placement := metav1.Now(), machine.Status.Placement
+	instance, err := machineScope.GetOrCreateMachine(ctx)
+	for _, attempt := range machine.Status.LaunchAttempts {
+		if attempt.Time.Before(&launchTime) {
+			continue
+		}
+		r.Recorder.Eventf(machine, corev1.EventTypeWarning, "InstanceLaunchFallback", "Could not launch the instance as %s: %s",
+			scope.FormatPlacement(attempt.InstancePlacement), attempt.Message)
+	}
+	if machine.Status.Placement != nil && machine.Status.Placement != placement {
+		r.Recorder.Eventf(machine, corev1.EventTypeNormal, "InstanceLaunched", "Launched the instance as %s",
+			scope.FormatPlacement(*machine.Status.Placement))
+	}
 	return instance, err
 }
 
@@ -425,8 +633,15 @@ func (r *OCIMachineReconciler) reconcileDelete(ctx context.Context, machineScope
 			return reconcile.Result{}, err
 		}
 	}
+	// the Ignition config is only read by the instance when it boots
+	if err := machineScope.DeleteIgnitionConfig(ctx); err != nil {
+		return reconcile.Result{}, err
+	}
 	if instance == nil {
 		machineScope.Info("Instance is not found, may have been deleted")
+		if err := machineScope.DeleteRestoredBootVolume(ctx); err != nil {
+			return reconcile.Result{}, err
+		}
 		controllerutil.RemoveFinalizer(machineScope.OCIMachine, infrastructurev1beta2.MachineFinalizer)
 		return reconcile.Result{}, nil
 	}
@@ -452,6 +667,9 @@ func (r *OCIMachineReconciler) reconcileDelete(ctx context.Context, machineScope
 		if err != nil {
 			return reconcile.Result{}, err
 		}
+		if err := machineScope.DeleteConsoleHistory(ctx); err != nil {
+			return reconcile.Result{}, err
+		}
 		if err := machineScope.DeleteMachine(ctx, instance); err != nil {
 			machineScope.Error(err, "Error deleting Instance")
 			return ctrl.Result{}, errors.Wrapf(err, "error deleting instance %s", machineScope.Name())
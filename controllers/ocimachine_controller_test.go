@@ -243,6 +243,7 @@ func TestNormalReconciliationFunction(t *testing.T) {
 						Instance: core.Instance{
 							Id:             common.String("test"),
 							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
 						},
 					}, nil)
 			},
@@ -269,6 +270,7 @@ func TestNormalReconciliationFunction(t *testing.T) {
 						Instance: core.Instance{
 							Id:             common.String("test"),
 							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
 						},
 					}, nil)
 			},
@@ -295,6 +297,7 @@ func TestNormalReconciliationFunction(t *testing.T) {
 						Instance: core.Instance{
 							Id:             common.String("test"),
 							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
 						},
 					}, nil)
 			},
@@ -350,6 +353,287 @@ func TestNormalReconciliationFunction(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name:               "instance of a hibernated cluster is stopped",
+			errorExpected:      false,
+			expectedEvent:      "InstanceHibernated",
+			conditionAssertion: []conditionAssertion{{infrastructurev1beta2.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrastructurev1beta2.InstanceHibernatedReason}},
+			testSpecificSetup: func(t *test, machineScope *scope.MachineScope, computeClient *mock_compute.MockComputeClient, vcnClient *mock_vcn.MockClient, nlbclient *mock_nlb.MockNetworkLoadBalancerClient) {
+				machineScope.Cluster.Annotations = map[string]string{infrastructurev1beta2.HibernateAnnotation: ""}
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{
+					InstanceId: common.String("test"),
+				})).
+					Return(core.GetInstanceResponse{
+						Instance: core.Instance{
+							Id:             common.String("test"),
+							LifecycleState: core.InstanceLifecycleStateRunning,
+						},
+					}, nil)
+				computeClient.EXPECT().InstanceAction(gomock.Any(), gomock.Eq(core.InstanceActionRequest{
+					InstanceId: common.String("test"),
+					Action:     core.InstanceActionActionSoftstop,
+				})).
+					Return(core.InstanceActionResponse{}, nil)
+			},
+			validate: func(g *WithT, t *test, result ctrl.Result) {
+				g.Expect(ms.OCIMachine.Status.Hibernated).To(BeTrue())
+				g.Expect(ms.OCIMachine.Status.Ready).To(BeFalse())
+			},
+		},
+		{
+			name:               "hibernated instance stays stopped while the cluster is hibernated",
+			errorExpected:      false,
+			conditionAssertion: []conditionAssertion{{infrastructurev1beta2.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrastructurev1beta2.InstanceHibernatedReason}},
+			testSpecificSetup: func(t *test, machineScope *scope.MachineScope, computeClient *mock_compute.MockComputeClient, vcnClient *mock_vcn.MockClient, nlbclient *mock_nlb.MockNetworkLoadBalancerClient) {
+				machineScope.Cluster.Annotations = map[string]string{infrastructurev1beta2.HibernateAnnotation: ""}
+				machineScope.OCIMachine.Status.Hibernated = true
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{
+					InstanceId: common.String("test"),
+				})).
+					Return(core.GetInstanceResponse{
+						Instance: core.Instance{
+							Id:             common.String("test"),
+							LifecycleState: core.InstanceLifecycleStateStopped,
+						},
+					}, nil)
+			},
+			validate: func(g *WithT, t *test, result ctrl.Result) {
+				g.Expect(result.RequeueAfter).To(BeZero())
+				g.Expect(ms.OCIMachine.Status.FailureReason).To(BeNil())
+			},
+		},
+		{
+			name:               "worker instance waits for the control plane to resume",
+			errorExpected:      false,
+			conditionAssertion: []conditionAssertion{{infrastructurev1beta2.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrastructurev1beta2.WaitingForControlPlaneResumeReason}},
+			testSpecificSetup: func(t *test, machineScope *scope.MachineScope, computeClient *mock_compute.MockComputeClient, vcnClient *mock_vcn.MockClient, nlbclient *mock_nlb.MockNetworkLoadBalancerClient) {
+				machineScope.OCIMachine.Status.Hibernated = true
+				machineScope.OCIClusterAccessor.(scope.OCISelfManagedCluster).OCICluster.Status.Hibernation = infrastructurev1beta2.HibernationStateResuming
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{
+					InstanceId: common.String("test"),
+				})).
+					Return(core.GetInstanceResponse{
+						Instance: core.Instance{
+							Id:             common.String("test"),
+							LifecycleState: core.InstanceLifecycleStateStopped,
+						},
+					}, nil)
+			},
+			validate: func(g *WithT, t *test, result ctrl.Result) {
+				g.Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+			},
+		},
+		{
+			name:               "hibernated instance is started once the cluster resumes",
+			errorExpected:      false,
+			expectedEvent:      "InstanceResumed",
+			conditionAssertion: []conditionAssertion{{infrastructurev1beta2.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrastructurev1beta2.InstanceNotReadyReason}},
+			testSpecificSetup: func(t *test, machineScope *scope.MachineScope, computeClient *mock_compute.MockComputeClient, vcnClient *mock_vcn.MockClient, nlbclient *mock_nlb.MockNetworkLoadBalancerClient) {
+				machineScope.OCIMachine.Status.Hibernated = true
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{
+					InstanceId: common.String("test"),
+				})).
+					Return(core.GetInstanceResponse{
+						Instance: core.Instance{
+							Id:             common.String("test"),
+							LifecycleState: core.InstanceLifecycleStateStopped,
+						},
+					}, nil)
+				computeClient.EXPECT().InstanceAction(gomock.Any(), gomock.Eq(core.InstanceActionRequest{
+					InstanceId: common.String("test"),
+					Action:     core.InstanceActionActionStart,
+				})).
+					Return(core.InstanceActionResponse{}, nil)
+			},
+		},
+		{
+			name:          "instance is updated in place",
+			errorExpected: false,
+			expectedEvent: "InstanceUpdating",
+			conditionAssertion: []conditionAssertion{
+				{infrastructurev1beta2.InstanceReadyCondition, corev1.ConditionTrue, "", ""},
+				{infrastructurev1beta2.InstanceUpdatedCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrastructurev1beta2.InstanceUpdatingReason},
+			},
+			testSpecificSetup: func(t *test, machineScope *scope.MachineScope, computeClient *mock_compute.MockComputeClient, vcnClient *mock_vcn.MockClient, nlbclient *mock_nlb.MockNetworkLoadBalancerClient) {
+				machineScope.OCIMachine.Spec.UpdatePolicy = infrastructurev1beta2.MachineUpdatePolicyInPlace
+				machineScope.OCIMachine.Spec.ShapeConfig.Ocpus = "4"
+				machineScope.OCIMachine.Spec.AgentConfig = &infrastructurev1beta2.LaunchInstanceAgentConfig{
+					IsMonitoringDisabled: common.Bool(true),
+				}
+				machineScope.OCIMachine.Status.Addresses = []clusterv1.MachineAddress{
+					{
+						Type:    clusterv1.MachineInternalIP,
+						Address: "1.1.1.1",
+					},
+				}
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{
+					InstanceId: common.String("test"),
+				})).
+					Return(core.GetInstanceResponse{
+						Instance: core.Instance{
+							Id:             common.String("test"),
+							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
+							ShapeConfig: &core.InstanceShapeConfig{
+								Ocpus: common.Float32(2),
+							},
+						},
+					}, nil)
+				computeClient.EXPECT().UpdateInstance(gomock.Any(), gomock.Eq(core.UpdateInstanceRequest{
+					InstanceId: common.String("test"),
+					UpdateInstanceDetails: core.UpdateInstanceDetails{
+						AgentConfig: &core.UpdateInstanceAgentConfigDetails{
+							IsMonitoringDisabled: common.Bool(true),
+						},
+					},
+				})).
+					Return(core.UpdateInstanceResponse{}, nil)
+			},
+			validate: func(g *WithT, t *test, result ctrl.Result) {
+				g.Expect(result.RequeueAfter).To(Equal(10 * time.Second))
+			},
+		},
+		{
+			name:          "instance reboots to apply the new shape config",
+			errorExpected: false,
+			expectedEvent: "InstanceRebooting",
+			conditionAssertion: []conditionAssertion{
+				{infrastructurev1beta2.InstanceUpdatedCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrastructurev1beta2.InstanceRebootingReason},
+			},
+			testSpecificSetup: func(t *test, machineScope *scope.MachineScope, computeClient *mock_compute.MockComputeClient, vcnClient *mock_vcn.MockClient, nlbclient *mock_nlb.MockNetworkLoadBalancerClient) {
+				machineScope.OCIMachine.Spec.UpdatePolicy = infrastructurev1beta2.MachineUpdatePolicyInPlace
+				machineScope.OCIMachine.Spec.ShapeConfig.Ocpus = "4"
+				machineScope.OCIMachine.Status.Addresses = []clusterv1.MachineAddress{
+					{
+						Type:    clusterv1.MachineInternalIP,
+						Address: "1.1.1.1",
+					},
+				}
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{
+					InstanceId: common.String("test"),
+				})).
+					Return(core.GetInstanceResponse{
+						Instance: core.Instance{
+							Id:             common.String("test"),
+							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
+							ShapeConfig: &core.InstanceShapeConfig{
+								Ocpus: common.Float32(2),
+							},
+						},
+					}, nil)
+				computeClient.EXPECT().UpdateInstance(gomock.Any(), gomock.Eq(core.UpdateInstanceRequest{
+					InstanceId: common.String("test"),
+					UpdateInstanceDetails: core.UpdateInstanceDetails{
+						ShapeConfig: &core.UpdateInstanceShapeConfigDetails{
+							Ocpus: common.Float32(4),
+						},
+						UpdateOperationConstraint: core.UpdateInstanceDetailsUpdateOperationConstraintAllowDowntime,
+					},
+				})).
+					Return(core.UpdateInstanceResponse{}, nil)
+			},
+			validate: func(g *WithT, t *test, result ctrl.Result) {
+				g.Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+			},
+		},
+		{
+			name:          "shape config is not sent again while the instance reboots",
+			errorExpected: false,
+			conditionAssertion: []conditionAssertion{
+				{infrastructurev1beta2.InstanceUpdatedCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrastructurev1beta2.InstanceRebootingReason},
+			},
+			testSpecificSetup: func(t *test, machineScope *scope.MachineScope, computeClient *mock_compute.MockComputeClient, vcnClient *mock_vcn.MockClient, nlbclient *mock_nlb.MockNetworkLoadBalancerClient) {
+				machineScope.OCIMachine.Spec.UpdatePolicy = infrastructurev1beta2.MachineUpdatePolicyInPlace
+				machineScope.OCIMachine.Spec.ShapeConfig.Ocpus = "4"
+				conditions.MarkFalse(machineScope.OCIMachine, infrastructurev1beta2.InstanceUpdatedCondition, infrastructurev1beta2.InstanceRebootingReason, clusterv1.ConditionSeverityInfo, "")
+				machineScope.OCIMachine.Status.Addresses = []clusterv1.MachineAddress{
+					{
+						Type:    clusterv1.MachineInternalIP,
+						Address: "1.1.1.1",
+					},
+				}
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{
+					InstanceId: common.String("test"),
+				})).
+					Return(core.GetInstanceResponse{
+						Instance: core.Instance{
+							Id:             common.String("test"),
+							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
+							ShapeConfig: &core.InstanceShapeConfig{
+								Ocpus: common.Float32(2),
+							},
+						},
+					}, nil)
+			},
+			validate: func(g *WithT, t *test, result ctrl.Result) {
+				g.Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+			},
+		},
+		{
+			name:               "rebooting instance is not a failure",
+			errorExpected:      false,
+			conditionAssertion: []conditionAssertion{{infrastructurev1beta2.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrastructurev1beta2.InstanceNotReadyReason}},
+			testSpecificSetup: func(t *test, machineScope *scope.MachineScope, computeClient *mock_compute.MockComputeClient, vcnClient *mock_vcn.MockClient, nlbclient *mock_nlb.MockNetworkLoadBalancerClient) {
+				machineScope.OCIMachine.Spec.UpdatePolicy = infrastructurev1beta2.MachineUpdatePolicyInPlace
+				machineScope.OCIMachine.Spec.ShapeConfig.Ocpus = "4"
+				conditions.MarkFalse(machineScope.OCIMachine, infrastructurev1beta2.InstanceUpdatedCondition, infrastructurev1beta2.InstanceRebootingReason, clusterv1.ConditionSeverityInfo, "")
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{
+					InstanceId: common.String("test"),
+				})).
+					Return(core.GetInstanceResponse{
+						Instance: core.Instance{
+							Id:             common.String("test"),
+							LifecycleState: core.InstanceLifecycleStateStopped,
+							FreeformTags:   ociutil.BuildClusterTags(""),
+							ShapeConfig: &core.InstanceShapeConfig{
+								Ocpus: common.Float32(2),
+							},
+						},
+					}, nil)
+			},
+			validate: func(g *WithT, t *test, result ctrl.Result) {
+				g.Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+				g.Expect(ms.OCIMachine.Status.FailureReason).To(BeNil())
+			},
+		},
+		{
+			name:          "in-place update completes once the instance reports the new shape config",
+			errorExpected: false,
+			expectedEvent: "InstanceUpdated",
+			conditionAssertion: []conditionAssertion{
+				{infrastructurev1beta2.InstanceReadyCondition, corev1.ConditionTrue, "", ""},
+				{infrastructurev1beta2.InstanceUpdatedCondition, corev1.ConditionTrue, "", ""},
+			},
+			testSpecificSetup: func(t *test, machineScope *scope.MachineScope, computeClient *mock_compute.MockComputeClient, vcnClient *mock_vcn.MockClient, nlbclient *mock_nlb.MockNetworkLoadBalancerClient) {
+				machineScope.OCIMachine.Spec.UpdatePolicy = infrastructurev1beta2.MachineUpdatePolicyInPlace
+				machineScope.OCIMachine.Spec.ShapeConfig.Ocpus = "4"
+				conditions.MarkFalse(machineScope.OCIMachine, infrastructurev1beta2.InstanceUpdatedCondition, infrastructurev1beta2.InstanceRebootingReason, clusterv1.ConditionSeverityInfo, "")
+				machineScope.OCIMachine.Status.Addresses = []clusterv1.MachineAddress{
+					{
+						Type:    clusterv1.MachineInternalIP,
+						Address: "1.1.1.1",
+					},
+				}
+				computeClient.EXPECT().GetInstance(gomock.Any(), gomock.Eq(core.GetInstanceRequest{
+					InstanceId: common.String("test"),
+				})).
+					Return(core.GetInstanceResponse{
+						Instance: core.Instance{
+							Id:             common.String("test"),
+							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
+							ShapeConfig: &core.InstanceShapeConfig{
+								Ocpus: common.Float32(4),
+							},
+						},
+					}, nil)
+			},
+			validate: func(g *WithT, t *test, result ctrl.Result) {
+				g.Expect(result.RequeueAfter).To(BeZero())
+			},
+		},
 		{
 			name:               "instance in terminated state",
 			errorExpected:      true,
@@ -418,6 +702,7 @@ func TestNormalReconciliationFunction(t *testing.T) {
 						Instance: core.Instance{
 							Id:             common.String("test"),
 							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
 						},
 					}, nil)
 				computeClient.EXPECT().ListVnicAttachments(gomock.Any(), gomock.Eq(core.ListVnicAttachmentsRequest{
@@ -441,6 +726,7 @@ func TestNormalReconciliationFunction(t *testing.T) {
 						Instance: core.Instance{
 							Id:             common.String("test"),
 							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
 						},
 					}, nil)
 				computeClient.EXPECT().ListVnicAttachments(gomock.Any(), gomock.Eq(core.ListVnicAttachmentsRequest{
@@ -498,6 +784,7 @@ func TestNormalReconciliationFunction(t *testing.T) {
 						Instance: core.Instance{
 							Id:             common.String("test"),
 							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
 						},
 					}, nil)
 				computeClient.EXPECT().ListVnicAttachments(gomock.Any(), gomock.Eq(core.ListVnicAttachmentsRequest{
@@ -579,6 +866,7 @@ func TestNormalReconciliationFunction(t *testing.T) {
 						Instance: core.Instance{
 							Id:             common.String("test"),
 							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
 						},
 					}, nil)
 
@@ -632,6 +920,7 @@ func TestNormalReconciliationFunction(t *testing.T) {
 						Instance: core.Instance{
 							Id:             common.String("test"),
 							LifecycleState: core.InstanceLifecycleStateRunning,
+							FreeformTags:   ociutil.BuildClusterTags(""),
 						},
 					}, nil)
 				computeClient.EXPECT().ListVnicAttachments(gomock.Any(), gomock.Eq(core.ListVnicAttachmentsRequest{
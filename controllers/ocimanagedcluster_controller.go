@@ -23,6 +23,7 @@ import (
 
 	"github.com/go-logr/logr"
 	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/metrics"
 	"github.com/oracle/cluster-api-provider-oci/cloud/scope"
 	cloudutil "github.com/oracle/cluster-api-provider-oci/cloud/util"
 	"github.com/pkg/errors"
@@ -112,6 +113,10 @@ func (r *OCIManagedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	peerClientProviders, err := cloudutil.InitPeerClientProviders(ctx, r.Client, r.Region, clusterAccessor)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 
 	helper, err := patch.NewHelper(ociCluster, r.Client)
 	if err != nil {
@@ -119,15 +124,18 @@ func (r *OCIManagedClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	}
 
 	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
-		Client:             r.Client,
-		Logger:             &logger,
-		Cluster:            cluster,
-		OCIClusterAccessor: clusterAccessor,
-		ClientProvider:     clientProvider,
-		VCNClient:          clients.VCNClient,
-		LoadBalancerClient: clients.LoadBalancerClient,
-		IdentityClient:     clients.IdentityClient,
-		RegionIdentifier:   clusterRegion,
+		Client:              r.Client,
+		Logger:              &logger,
+		Cluster:             cluster,
+		OCIClusterAccessor:  clusterAccessor,
+		ClientProvider:      clientProvider,
+		VCNClient:           clients.VCNClient,
+		LoadBalancerClient:  clients.LoadBalancerClient,
+		IdentityClient:      clients.IdentityClient,
+		LoggingClient:       clients.LoggingClient,
+		BlockStorageClient:  clients.BlockStorageClient,
+		RegionIdentifier:    clusterRegion,
+		PeerClientProviders: peerClientProviders,
 	})
 	if err != nil {
 		logger.Error(err, "Couldn't create cluster scope")
@@ -186,6 +194,18 @@ func (r *OCIManagedClusterReconciler) reconcile(ctx context.Context, logger logr
 	// If the OCIManagedCluster doesn't have our finalizer, add it.
 	controllerutil.AddFinalizer(ociManagedCluster, infrastructurev1beta2.ManagedClusterFinalizer)
 
+	// the drift is reported afresh by every complete reconciliation, a reconciliation which fails part way keeps
+	// the previous drift of the resources it did not reach
+	previousDrift := ociManagedCluster.Status.Drift
+	ociManagedCluster.Status.Drift = nil
+	driftComplete := false
+	defer func() {
+		if !driftComplete {
+			ociManagedCluster.Status.Drift = mergeDrift(previousDrift, ociManagedCluster.Status.Drift)
+		}
+		reportDrift(r.Recorder, ociManagedCluster, previousDrift, ociManagedCluster.Status.Drift)
+	}()
+
 	controlPlane := &infrastructurev1beta2.OCIManagedControlPlane{}
 	controlPlaneRef := types.NamespacedName{
 		Name:      cluster.Spec.ControlPlaneRef.Name,
@@ -246,6 +266,11 @@ func (r *OCIManagedClusterReconciler) reconcile(ctx context.Context, logger logr
 			return ctrl.Result{}, err
 		}
 
+		if err := r.reconcileComponent(ctx, ociManagedCluster, clusterScope.ReconcileFlowLogs, "Flow Log",
+			infrastructurev1beta2.FlowLogReconciliationFailedReason, infrastructurev1beta2.FlowLogEventReady); err != nil {
+			return ctrl.Result{}, err
+		}
+
 		if err := r.reconcileComponent(ctx, ociManagedCluster, clusterScope.ReconcileDRGVCNAttachment, "DRGVCNAttachment",
 			infrastructurev1beta2.DRGVCNAttachmentReconciliationFailedReason, infrastructurev1beta2.DRGVCNAttachmentEventReady); err != nil {
 			return ctrl.Result{}, err
@@ -255,6 +280,11 @@ func (r *OCIManagedClusterReconciler) reconcile(ctx context.Context, logger logr
 			infrastructurev1beta2.DRGRPCAttachmentReconciliationFailedReason, infrastructurev1beta2.DRGRPCAttachmentEventReady); err != nil {
 			return ctrl.Result{}, err
 		}
+
+		if err := r.reconcileComponent(ctx, ociManagedCluster, clusterScope.ReconcileVPN, "VPN",
+			infrastructurev1beta2.VPNReconciliationFailedReason, infrastructurev1beta2.VPNEventReady); err != nil {
+			return ctrl.Result{}, err
+		}
 	} else {
 		logger.Info("VCN Reconciliation is skipped")
 	}
@@ -263,6 +293,11 @@ func (r *OCIManagedClusterReconciler) reconcile(ctx context.Context, logger logr
 		infrastructurev1beta2.FailureDomainFailedReason, infrastructurev1beta2.FailureDomainEventReady); err != nil {
 		return ctrl.Result{}, err
 	}
+	driftComplete = true
+	// the tags are recorded once applied, a tag removed from the spec afterwards is removed from the resources
+	if ociManagedCluster.Spec.DriftPolicy != infrastructurev1beta2.DriftPolicyReport {
+		ociManagedCluster.Status.AppliedTags = scope.NewAppliedTags(ociManagedCluster.Spec.FreeformTags, ociManagedCluster.Spec.DefinedTags)
+	}
 
 	conditions.MarkTrue(ociManagedCluster, infrastructurev1beta2.ClusterReadyCondition)
 	ociManagedCluster.Status.Ready = true
@@ -354,7 +389,21 @@ func (r *OCIManagedClusterReconciler) reconcileDelete(ctx context.Context, logge
 	// This below if condition specifies if the network related infrastructure needs to be reconciled. Any new
 	// network related reconcilication should happen in this if condition
 	if !cluster.Spec.NetworkSpec.SkipNetworkManagement {
-		err := clusterScope.DeleteDRGRPCAttachment(ctx)
+		err := clusterScope.DeleteWorkloadResources(ctx)
+		if err != nil {
+			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete workload cluster resources").Error())
+			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.WorkloadResourceDeletionFailedReason, clusterv1.ConditionSeverityError, "")
+			return ctrl.Result{}, errors.Wrapf(err, "failed to delete workload cluster resources for OCIManagedCluster %s/%s", cluster.Namespace, cluster.Name)
+		}
+
+		err = clusterScope.DeleteVPN(ctx)
+		if err != nil {
+			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete VPN").Error())
+			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.VPNReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
+			return ctrl.Result{}, errors.Wrapf(err, "failed to delete VPN for OCIManagedCluster %s/%s", cluster.Namespace, cluster.Name)
+		}
+
+		err = clusterScope.DeleteDRGRPCAttachment(ctx)
 		if err != nil {
 			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete DRG RPC attachment").Error())
 			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.DRGRPCAttachmentReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
@@ -375,6 +424,13 @@ func (r *OCIManagedClusterReconciler) reconcileDelete(ctx context.Context, logge
 			return ctrl.Result{}, errors.Wrapf(err, "failed to delete Network Security Groups for OCIManagedCluster %s/%s", cluster.Namespace, cluster.Name)
 		}
 
+		err = clusterScope.DeleteFlowLogs(ctx)
+		if err != nil {
+			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Flow Log").Error())
+			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.FlowLogReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
+			return ctrl.Result{}, errors.Wrapf(err, "failed to delete flow logs for OCIManagedCluster %s/%s", cluster.Namespace, cluster.Name)
+		}
+
 		err = clusterScope.DeleteSubnets(ctx)
 		if err != nil {
 			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Subnet").Error())
@@ -382,13 +438,6 @@ func (r *OCIManagedClusterReconciler) reconcileDelete(ctx context.Context, logge
 			return ctrl.Result{}, errors.Wrapf(err, "failed to delete subnet for OCIManagedCluster %s/%s", cluster.Namespace, cluster.Name)
 		}
 
-		err = clusterScope.DeleteRouteTables(ctx)
-		if err != nil {
-			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Route Table").Error())
-			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.RouteTableReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
-			return ctrl.Result{}, errors.Wrapf(err, "failed to delete RouteTables for OCIManagedCluster %s/%s", cluster.Namespace, cluster.Name)
-		}
-
 		err = clusterScope.DeleteSecurityLists(ctx)
 		if err != nil {
 			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Security Lists").Error())
@@ -396,6 +445,20 @@ func (r *OCIManagedClusterReconciler) reconcileDelete(ctx context.Context, logge
 			return ctrl.Result{}, errors.Wrapf(err, "failed to delete SecurityLists for OCIManagedCluster %s/%s", cluster.Namespace, cluster.Name)
 		}
 
+		err = clusterScope.ReleaseSharedNetwork(ctx)
+		if err != nil {
+			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to release the shared network").Error())
+			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.VcnReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
+			return ctrl.Result{}, errors.Wrapf(err, "failed to release the shared network for OCIManagedCluster %s/%s", cluster.Namespace, cluster.Name)
+		}
+
+		err = clusterScope.DeleteRouteTables(ctx)
+		if err != nil {
+			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Route Table").Error())
+			conditions.MarkFalse(cluster, infrastructurev1beta2.ClusterReadyCondition, infrastructurev1beta2.RouteTableReconciliationFailedReason, clusterv1.ConditionSeverityError, "")
+			return ctrl.Result{}, errors.Wrapf(err, "failed to delete RouteTables for OCIManagedCluster %s/%s", cluster.Namespace, cluster.Name)
+		}
+
 		err = clusterScope.DeleteServiceGateway(ctx)
 		if err != nil {
 			r.Recorder.Event(cluster, corev1.EventTypeWarning, "ReconcileError", errors.Wrapf(err, "failed to delete Service Gateway").Error())
@@ -434,6 +497,7 @@ func (r *OCIManagedClusterReconciler) reconcileDelete(ctx context.Context, logge
 	} else {
 		logger.Info("VCN Reconciliation is skipped, none of the VCN related resources will be deleted")
 	}
+	metrics.DeleteClusterDrift(cluster.Namespace, cluster.Name)
 	controllerutil.RemoveFinalizer(cluster, infrastructurev1beta2.ManagedClusterFinalizer)
 
 	return reconcile.Result{}, nil
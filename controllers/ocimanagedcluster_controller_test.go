@@ -177,8 +177,10 @@ func TestOCIManagedClusterReconciler_reconcile(t *testing.T) {
 				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileSubnet(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGRPCAttachment(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVPN(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileFailureDomains(context.Background()).Return(nil)
 			},
 		},
@@ -314,8 +316,10 @@ func TestOCIManagedClusterReconciler_reconcile(t *testing.T) {
 				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileSubnet(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGRPCAttachment(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVPN(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileFailureDomains(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -335,6 +339,7 @@ func TestOCIManagedClusterReconciler_reconcile(t *testing.T) {
 				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileSubnet(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGVCNAttachment(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -354,10 +359,33 @@ func TestOCIManagedClusterReconciler_reconcile(t *testing.T) {
 				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileSubnet(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().ReconcileDRGRPCAttachment(context.Background()).Return(errors.New("some error"))
 			},
 		},
+		{
+			name:               "vpn reconciliation failure",
+			expectedEvent:      "ReconcileError",
+			eventNotExpected:   infrastructurev1beta2.VPNEventReady,
+			errorExpected:      true,
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.VPNReconciliationFailedReason},
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().SetRegionCode(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileDRG(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVCN(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileInternetGateway(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileNatGateway(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileServiceGateway(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileNSG(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileRouteTable(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileSubnet(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileFlowLogs(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileDRGVCNAttachment(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileDRGRPCAttachment(context.Background()).Return(nil)
+				cs.EXPECT().ReconcileVPN(context.Background()).Return(errors.New("some error"))
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -430,12 +458,16 @@ func TestOCIManagedClusterReconciler_reconcileDelete(t *testing.T) {
 		{
 			name: "all success",
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNatGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteInternetGateway(context.Background()).Return(nil)
@@ -443,12 +475,33 @@ func TestOCIManagedClusterReconciler_reconcileDelete(t *testing.T) {
 				cs.EXPECT().DeleteDRG(context.Background()).Return(nil)
 			},
 		},
+		{
+			name:               "workload resources delete failure",
+			expectedEvent:      "ReconcileError",
+			errorExpected:      true,
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.WorkloadResourceDeletionFailedReason},
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(errors.New("some error"))
+			},
+		},
+		{
+			name:               "vpn delete failure",
+			expectedEvent:      "ReconcileError",
+			errorExpected:      true,
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.VPNReconciliationFailedReason},
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(errors.New("some error"))
+			},
+		},
 		{
 			name:               "drg rpc delete failure",
 			expectedEvent:      "ReconcileError",
 			errorExpected:      true,
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.DRGRPCAttachmentReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -458,6 +511,8 @@ func TestOCIManagedClusterReconciler_reconcileDelete(t *testing.T) {
 			errorExpected:      true,
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.DRGVCNAttachmentReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(errors.New("some error"))
 			},
@@ -468,6 +523,8 @@ func TestOCIManagedClusterReconciler_reconcileDelete(t *testing.T) {
 			errorExpected:      true,
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.NSGReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(errors.New("some error"))
@@ -479,22 +536,47 @@ func TestOCIManagedClusterReconciler_reconcileDelete(t *testing.T) {
 			errorExpected:      true,
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.SubnetReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(errors.New("some error"))
 			},
 		},
+		{
+			name:               "shared network release failure",
+			expectedEvent:      "ReconcileError",
+			errorExpected:      true,
+			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.VcnReconciliationFailedReason},
+			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
+				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
+				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
+				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
+				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(errors.New("some error"))
+			},
+		},
 		{
 			name:               "route table delete failure",
 			expectedEvent:      "ReconcileError",
 			errorExpected:      true,
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.RouteTableReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
+				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
 				cs.EXPECT().DeleteRouteTables(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -504,11 +586,13 @@ func TestOCIManagedClusterReconciler_reconcileDelete(t *testing.T) {
 			errorExpected:      true,
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.SecurityListReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -518,12 +602,16 @@ func TestOCIManagedClusterReconciler_reconcileDelete(t *testing.T) {
 			errorExpected:      true,
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.ServiceGatewayReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(errors.New("some error"))
 			},
 		},
@@ -533,12 +621,16 @@ func TestOCIManagedClusterReconciler_reconcileDelete(t *testing.T) {
 			errorExpected:      true,
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.NatGatewayReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNatGateway(context.Background()).Return(errors.New("some error"))
 			},
@@ -549,12 +641,16 @@ func TestOCIManagedClusterReconciler_reconcileDelete(t *testing.T) {
 			errorExpected:      true,
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.InternetGatewayReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNatGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteInternetGateway(context.Background()).Return(errors.New("some error"))
@@ -566,12 +662,16 @@ func TestOCIManagedClusterReconciler_reconcileDelete(t *testing.T) {
 			errorExpected:      true,
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.VcnReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNatGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteInternetGateway(context.Background()).Return(nil)
@@ -584,12 +684,16 @@ func TestOCIManagedClusterReconciler_reconcileDelete(t *testing.T) {
 			errorExpected:      true,
 			conditionAssertion: conditionAssertion{infrastructurev1beta2.ClusterReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrastructurev1beta2.DrgReconciliationFailedReason},
 			testSpecificSetup: func(cs *mock_scope.MockClusterScopeClient, ociCluster *infrastructurev1beta2.OCIManagedCluster) {
+				cs.EXPECT().DeleteWorkloadResources(context.Background()).Return(nil)
+				cs.EXPECT().DeleteVPN(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGRPCAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteDRGVCNAttachment(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNSGs(context.Background()).Return(nil)
+				cs.EXPECT().DeleteFlowLogs(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSubnets(context.Background()).Return(nil)
-				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteSecurityLists(context.Background()).Return(nil)
+				cs.EXPECT().ReleaseSharedNetwork(context.Background()).Return(nil)
+				cs.EXPECT().DeleteRouteTables(context.Background()).Return(nil)
 				cs.EXPECT().DeleteServiceGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteNatGateway(context.Background()).Return(nil)
 				cs.EXPECT().DeleteInternetGateway(context.Background()).Return(nil)
@@ -24,6 +24,7 @@ import (
 	"github.com/go-logr/logr"
 	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/plan"
 	"github.com/oracle/cluster-api-provider-oci/cloud/scope"
 	cloudutil "github.com/oracle/cluster-api-provider-oci/cloud/util"
 	"github.com/oracle/oci-go-sdk/v65/containerengine"
@@ -148,6 +149,15 @@ func (r *OCIManagedClusterControlPlaneReconciler) Reconcile(ctx context.Context,
 		}
 	}()
 
+	containerEngineClient := clients.ContainerEngineClient
+	var planRecorder *plan.Recorder
+	if plan.IsPlanMode(controlPlane) {
+		planRecorder = plan.NewRecorder()
+		containerEngineClient = planRecorder.NewContainerEngineClient(containerEngineClient)
+	} else {
+		controlPlane.Status.PlannedOperations = nil
+	}
+
 	var controlPlaneScope *scope.ManagedControlPlaneScope
 
 	controlPlaneScope, err = scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
@@ -156,7 +166,7 @@ func (r *OCIManagedClusterControlPlaneReconciler) Reconcile(ctx context.Context,
 		Cluster:                cluster,
 		OCIClusterAccessor:     clusterAccessor,
 		ClientProvider:         clientProvider,
-		ContainerEngineClient:  clients.ContainerEngineClient,
+		ContainerEngineClient:  containerEngineClient,
 		RegionIdentifier:       clusterRegion,
 		OCIManagedControlPlane: controlPlane,
 		BaseClient:             clients.BaseClient,
@@ -166,6 +176,10 @@ func (r *OCIManagedClusterControlPlaneReconciler) Reconcile(ctx context.Context,
 		return ctrl.Result{}, err
 	}
 
+	if planRecorder != nil {
+		return r.reconcilePlan(ctx, controlPlaneScope, controlPlane, planRecorder)
+	}
+
 	// Handle deleted clusters
 	if !controlPlane.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, controlPlaneScope, controlPlane)
@@ -325,6 +339,55 @@ func (r *OCIManagedClusterControlPlaneReconciler) clusterToInfrastructureMapFunc
 	}
 }
 
+// reconcilePlan lists the operations which the reconciliation would perform on the OKE cluster and its addons in
+// the status of the OCIManagedControlPlane. The kubeconfig and bootstrap secrets are not reconciled in plan mode.
+func (r *OCIManagedClusterControlPlaneReconciler) reconcilePlan(ctx context.Context, controlPlaneScope *scope.ManagedControlPlaneScope,
+	controlPlane *infrastructurev1beta2.OCIManagedControlPlane, planRecorder *plan.Recorder) (ctrl.Result, error) {
+	var err error
+	if controlPlane.DeletionTimestamp.IsZero() {
+		err = r.planNormal(ctx, controlPlaneScope)
+	} else {
+		err = r.planDelete(ctx, controlPlaneScope)
+	}
+	if err != nil && !plan.IsPlanned(err) {
+		return ctrl.Result{}, err
+	}
+	previous := controlPlane.Status.PlannedOperations
+	controlPlane.Status.PlannedOperations = planRecorder.Operations()
+	plan.ReportEvent(r.Recorder, controlPlane, previous, controlPlane.Status.PlannedOperations)
+	return ctrl.Result{}, nil
+}
+
+func (r *OCIManagedClusterControlPlaneReconciler) planNormal(ctx context.Context, controlPlaneScope *scope.ManagedControlPlaneScope) error {
+	okeControlPlane, err := controlPlaneScope.GetOrCreateControlPlane(ctx)
+	if err != nil {
+		return err
+	}
+	// the control plane is only updated once it is active
+	if okeControlPlane.LifecycleState != containerengine.ClusterLifecycleStateActive {
+		return nil
+	}
+	if _, err := controlPlaneScope.UpdateControlPlane(ctx, okeControlPlane); err != nil {
+		return err
+	}
+	return controlPlaneScope.ReconcileAddons(ctx, okeControlPlane)
+}
+
+func (r *OCIManagedClusterControlPlaneReconciler) planDelete(ctx context.Context, controlPlaneScope *scope.ManagedControlPlaneScope) error {
+	cluster, err := controlPlaneScope.GetOKECluster(ctx)
+	if err != nil {
+		if ociutil.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if cluster == nil || cluster.LifecycleState == containerengine.ClusterLifecycleStateDeleting ||
+		cluster.LifecycleState == containerengine.ClusterLifecycleStateDeleted {
+		return nil
+	}
+	return controlPlaneScope.DeleteOKECluster(ctx, cluster)
+}
+
 func (r *OCIManagedClusterControlPlaneReconciler) reconcileDelete(ctx context.Context,
 	controlPlaneScope *scope.ManagedControlPlaneScope, controlPlane *infrastructurev1beta2.OCIManagedControlPlane) (ctrl.Result, error) {
 	controlPlaneScope.Info("Handling deleted OCiManagedControlPlane")
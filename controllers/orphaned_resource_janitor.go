@@ -0,0 +1,353 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/metrics"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/scope"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+	"github.com/oracle/oci-go-sdk/v65/resourcesearch"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// OrphanedResourceDetectedReason is the event reason used when an orphaned resource is found.
+	OrphanedResourceDetectedReason = "OrphanedResourceDetected"
+	// OrphanedResourceDeletedReason is the event reason used when an orphaned resource is deleted.
+	OrphanedResourceDeletedReason = "OrphanedResourceDeleted"
+	// OrphanedResourceDeletionFailedReason is the event reason used when an orphaned resource could not be deleted.
+	OrphanedResourceDeletionFailedReason = "OrphanedResourceDeletionFailed"
+
+	// orphanedResourceQuery is the OCI Search query of the resources created by Cluster API Provider for OCI
+	// in a compartment.
+	orphanedResourceQuery = "query all resources where (freeformTags.key = '%s' && freeformTags.value = '%s') && compartmentId = '%s'"
+)
+
+// orphanedResourceDeletionOrder is the order in which the orphaned resources are deleted, so that
+// the dependent resources are deleted before the resources they depend on. Orphaned resources of
+// other types are only reported.
+var orphanedResourceDeletionOrder = []string{
+	"Instance",
+	"LoadBalancer",
+	"NetworkLoadBalancer",
+	"NetworkSecurityGroup",
+	"Subnet",
+	"RouteTable",
+	"SecurityList",
+	"InternetGateway",
+	"NatGateway",
+	"ServiceGateway",
+	"Vcn",
+}
+
+// OrphanedResourceJanitor periodically scans compartments for the OCI resources tagged by Cluster API
+// Provider for OCI with a resource identifier which is not used by any OCICluster or OCIManagedCluster.
+// The orphaned resources are reported as events and metrics, and are deleted once they have been
+// orphaned for the grace period, if deletion is enabled.
+//
+// The janitor considers the clusters of the management cluster it runs in only, hence the compartments
+// must not be shared with clusters managed by another management cluster.
+type OrphanedResourceJanitor struct {
+	Client         client.Client
+	Recorder       record.EventRecorder
+	Region         string
+	ClientProvider *scope.ClientProvider
+
+	// Compartments are the compartments which are scanned for orphaned resources.
+	Compartments []string
+	// Interval is the interval between two scans.
+	Interval time.Duration
+	// GracePeriod is the duration a resource has to be orphaned for before it is deleted.
+	GracePeriod time.Duration
+	// DeleteOrphans enables the deletion of the orphaned resources, otherwise they are only reported.
+	DeleteOrphans bool
+	// Namespace is the namespace the events are recorded in.
+	Namespace string
+
+	// orphanedSince records when the orphaned resources were first found, keyed by OCID.
+	orphanedSince map[string]time.Time
+}
+
+// SetupWithManager adds the janitor to the manager, it runs on the leader only.
+func (j *OrphanedResourceJanitor) SetupWithManager(mgr ctrl.Manager) error {
+	if j.ClientProvider == nil {
+		return errors.New("the OCI clients have to be initialized on startup to run the orphaned resource janitor")
+	}
+	if len(j.Compartments) == 0 {
+		return errors.New("at least one compartment has to be configured for the orphaned resource janitor")
+	}
+	return mgr.Add(j)
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (j *OrphanedResourceJanitor) NeedLeaderElection() bool {
+	return true
+}
+
+// Start runs the scans until the context is cancelled.
+func (j *OrphanedResourceJanitor) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("orphaned-resource-janitor")
+	ctx = log.IntoContext(ctx, logger)
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := j.Scan(ctx); err != nil {
+			logger.Error(err, "failed to scan for orphaned resources")
+		}
+	}, j.Interval)
+	return nil
+}
+
+// Scan finds the orphaned resources of the compartments, reports them, and deletes the ones which
+// have been orphaned for the grace period if deletion is enabled.
+func (j *OrphanedResourceJanitor) Scan(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	if j.orphanedSince == nil {
+		j.orphanedSince = make(map[string]time.Time)
+	}
+
+	// the orphaned resources are determined from the live clusters, if they cannot be listed nothing
+	// can be considered orphaned
+	liveIdentifiers, err := j.getLiveResourceIdentifiers(ctx)
+	if err != nil {
+		return err
+	}
+	clients, err := j.ClientProvider.GetOrBuildClient(j.Region)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var orphans []resourcesearch.ResourceSummary
+	for _, compartmentId := range j.Compartments {
+		resources, err := j.searchResources(ctx, clients, compartmentId)
+		if err != nil {
+			return err
+		}
+		for _, resource := range resources {
+			identifier, ok := resource.FreeformTags[ociutil.ClusterResourceIdentifier]
+			if !ok || liveIdentifiers[identifier] || isOrphanedResourceGone(resource) {
+				continue
+			}
+			orphans = append(orphans, resource)
+		}
+	}
+
+	counts := make(map[string]int)
+	seen := make(map[string]time.Time)
+	for _, orphan := range orphans {
+		counts[*orphan.ResourceType]++
+		since, ok := j.orphanedSince[*orphan.Identifier]
+		if !ok {
+			since = now
+			logger.Info("Found orphaned resource", "resourceType", *orphan.ResourceType, "ocid", *orphan.Identifier)
+			j.Recorder.Eventf(j.eventObject(), corev1.EventTypeWarning, OrphanedResourceDetectedReason,
+				"%s %s tagged with resource identifier %s is not owned by any cluster", *orphan.ResourceType,
+				*orphan.Identifier, orphan.FreeformTags[ociutil.ClusterResourceIdentifier])
+		}
+		seen[*orphan.Identifier] = since
+	}
+	// resources which are no longer orphaned, or are gone, are forgotten
+	j.orphanedSince = seen
+	metrics.SetOrphanedResources(counts)
+
+	if !j.DeleteOrphans {
+		return nil
+	}
+	sort.SliceStable(orphans, func(a, b int) bool {
+		return orphanedResourceDeletionPriority(*orphans[a].ResourceType) < orphanedResourceDeletionPriority(*orphans[b].ResourceType)
+	})
+	for _, orphan := range orphans {
+		if now.Sub(j.orphanedSince[*orphan.Identifier]) < j.GracePeriod {
+			continue
+		}
+		deleted, err := j.deleteResource(ctx, clients, orphan)
+		if err != nil {
+			// the deletion is retried in the next scan, dependent resources may not be deleted yet
+			logger.Error(err, "failed to delete orphaned resource", "resourceType", *orphan.ResourceType, "ocid", *orphan.Identifier)
+			j.Recorder.Eventf(j.eventObject(), corev1.EventTypeWarning, OrphanedResourceDeletionFailedReason,
+				"failed to delete %s %s: %s", *orphan.ResourceType, *orphan.Identifier, err.Error())
+			continue
+		}
+		if !deleted {
+			continue
+		}
+		logger.Info("Deleted orphaned resource", "resourceType", *orphan.ResourceType, "ocid", *orphan.Identifier)
+		metrics.IncOrphanedResourcesDeletedCounter(*orphan.ResourceType)
+		j.Recorder.Eventf(j.eventObject(), corev1.EventTypeNormal, OrphanedResourceDeletedReason,
+			"deleted %s %s", *orphan.ResourceType, *orphan.Identifier)
+	}
+	return nil
+}
+
+// getLiveResourceIdentifiers returns the resource identifiers used to tag the resources of the clusters,
+// including the identifiers of the shared networks.
+func (j *OrphanedResourceJanitor) getLiveResourceIdentifiers(ctx context.Context) (map[string]bool, error) {
+	identifiers := make(map[string]bool)
+	addNetworkSpec := func(identifier string, networkSpec infrastructurev1beta2.NetworkSpec) {
+		identifiers[identifier] = true
+		if networkSpec.Vcn.Shared != nil {
+			identifiers[networkSpec.Vcn.Shared.Identifier] = true
+		}
+	}
+	ociClusters := &infrastructurev1beta2.OCIClusterList{}
+	if err := j.Client.List(ctx, ociClusters); err != nil {
+		return nil, errors.Wrap(err, "failed to list OCIClusters")
+	}
+	for _, ociCluster := range ociClusters.Items {
+		addNetworkSpec(ociCluster.Spec.OCIResourceIdentifier, ociCluster.Spec.NetworkSpec)
+	}
+	ociManagedClusters := &infrastructurev1beta2.OCIManagedClusterList{}
+	if err := j.Client.List(ctx, ociManagedClusters); err != nil {
+		return nil, errors.Wrap(err, "failed to list OCIManagedClusters")
+	}
+	for _, ociManagedCluster := range ociManagedClusters.Items {
+		addNetworkSpec(ociManagedCluster.Spec.OCIResourceIdentifier, ociManagedCluster.Spec.NetworkSpec)
+	}
+	return identifiers, nil
+}
+
+func (j *OrphanedResourceJanitor) searchResources(ctx context.Context, clients scope.OCIClients, compartmentId string) ([]resourcesearch.ResourceSummary, error) {
+	var resources []resourcesearch.ResourceSummary
+	var page *string
+	for {
+		response, err := clients.ResourceSearchClient.SearchResources(ctx, resourcesearch.SearchResourcesRequest{
+			SearchDetails: resourcesearch.StructuredSearchDetails{
+				Query:               common.String(fmt.Sprintf(orphanedResourceQuery, ociutil.CreatedBy, ociutil.OCIClusterAPIProvider, compartmentId)),
+				MatchingContextType: resourcesearch.SearchDetailsMatchingContextTypeNone,
+			},
+			Page: page,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to search resources of compartment %s", compartmentId)
+		}
+		resources = append(resources, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		page = response.OpcNextPage
+	}
+	return resources, nil
+}
+
+// deleteResource deletes the orphaned resource, it returns false if the resource type is not supported.
+func (j *OrphanedResourceJanitor) deleteResource(ctx context.Context, clients scope.OCIClients, resource resourcesearch.ResourceSummary) (bool, error) {
+	var err error
+	switch *resource.ResourceType {
+	case "Instance":
+		_, err = clients.ComputeClient.TerminateInstance(ctx, core.TerminateInstanceRequest{
+			InstanceId:         resource.Identifier,
+			PreserveBootVolume: common.Bool(false),
+		})
+	case "LoadBalancer":
+		_, err = clients.LoadBalancerClient.DeleteLoadBalancer(ctx, loadbalancer.DeleteLoadBalancerRequest{
+			LoadBalancerId: resource.Identifier,
+		})
+	case "NetworkLoadBalancer":
+		_, err = clients.NetworkLoadBalancerClient.DeleteNetworkLoadBalancer(ctx, networkloadbalancer.DeleteNetworkLoadBalancerRequest{
+			NetworkLoadBalancerId: resource.Identifier,
+		})
+	case "NetworkSecurityGroup":
+		_, err = clients.VCNClient.DeleteNetworkSecurityGroup(ctx, core.DeleteNetworkSecurityGroupRequest{
+			NetworkSecurityGroupId: resource.Identifier,
+		})
+	case "Subnet":
+		_, err = clients.VCNClient.DeleteSubnet(ctx, core.DeleteSubnetRequest{
+			SubnetId: resource.Identifier,
+		})
+	case "RouteTable":
+		_, err = clients.VCNClient.DeleteRouteTable(ctx, core.DeleteRouteTableRequest{
+			RtId: resource.Identifier,
+		})
+	case "SecurityList":
+		_, err = clients.VCNClient.DeleteSecurityList(ctx, core.DeleteSecurityListRequest{
+			SecurityListId: resource.Identifier,
+		})
+	case "InternetGateway":
+		_, err = clients.VCNClient.DeleteInternetGateway(ctx, core.DeleteInternetGatewayRequest{
+			IgId: resource.Identifier,
+		})
+	case "NatGateway":
+		_, err = clients.VCNClient.DeleteNatGateway(ctx, core.DeleteNatGatewayRequest{
+			NatGatewayId: resource.Identifier,
+		})
+	case "ServiceGateway":
+		_, err = clients.VCNClient.DeleteServiceGateway(ctx, core.DeleteServiceGatewayRequest{
+			ServiceGatewayId: resource.Identifier,
+		})
+	case "Vcn":
+		_, err = clients.VCNClient.DeleteVcn(ctx, core.DeleteVcnRequest{
+			VcnId: resource.Identifier,
+		})
+	default:
+		return false, nil
+	}
+	if err != nil && !ociutil.IsNotFound(err) {
+		return false, err
+	}
+	return true, nil
+}
+
+// eventObject returns the object the janitor events are recorded on, as the orphaned resources are not
+// owned by any Kubernetes object.
+func (j *OrphanedResourceJanitor) eventObject() *corev1.ObjectReference {
+	namespace := j.Namespace
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	return &corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Namespace",
+		Name:       namespace,
+		Namespace:  namespace,
+	}
+}
+
+func orphanedResourceDeletionPriority(resourceType string) int {
+	for i, t := range orphanedResourceDeletionOrder {
+		if t == resourceType {
+			return i
+		}
+	}
+	return len(orphanedResourceDeletionOrder)
+}
+
+func isOrphanedResourceGone(resource resourcesearch.ResourceSummary) bool {
+	if resource.LifecycleState == nil {
+		return false
+	}
+	switch *resource.LifecycleState {
+	case "TERMINATING", "TERMINATED", "DELETING", "DELETED":
+		return true
+	}
+	return false
+}
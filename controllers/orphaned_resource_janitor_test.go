@@ -0,0 +1,267 @@
+/*
+ Copyright (c) 2024 Oracle and/or its affiliates.
+
+ Licensed under the Apache License, Version 2.0 (the "License");
+ you may not use this file except in compliance with the License.
+ You may obtain a copy of the License at
+
+      https://www.apache.org/licenses/LICENSE-2.0
+
+ Unless required by applicable law or agreed to in writing, software
+ distributed under the License is distributed on an "AS IS" BASIS,
+ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ See the License for the specific language governing permissions and
+ limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
+	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/scope"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/compute/mock_compute"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/resourcesearch/mock_resourcesearch"
+	"github.com/oracle/cluster-api-provider-oci/cloud/services/vcn/mock_vcn"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/resourcesearch"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestOrphanedResourceJanitor_Scan(t *testing.T) {
+	var (
+		j             OrphanedResourceJanitor
+		mockCtrl      *gomock.Controller
+		recorder      *record.FakeRecorder
+		searchClient  *mock_resourcesearch.MockClient
+		vcnClient     *mock_vcn.MockClient
+		computeClient *mock_compute.MockComputeClient
+	)
+
+	setup := func(t *testing.T, g *WithT, objects []client.Object) {
+		mockCtrl = gomock.NewController(t)
+		searchClient = mock_resourcesearch.NewMockClient(mockCtrl)
+		vcnClient = mock_vcn.NewMockClient(mockCtrl)
+		computeClient = mock_compute.NewMockComputeClient(mockCtrl)
+		clientProvider, err := scope.MockNewClientProvider(scope.MockOCIClients{
+			VCNClient:            vcnClient,
+			ComputeClient:        computeClient,
+			ResourceSearchClient: searchClient,
+		})
+		g.Expect(err).To(BeNil())
+		recorder = record.NewFakeRecorder(10)
+		j = OrphanedResourceJanitor{
+			Client:         fake.NewClientBuilder().WithObjects(objects...).Build(),
+			Recorder:       recorder,
+			Region:         scope.MockTestRegion,
+			ClientProvider: clientProvider,
+			Compartments:   []string{"compartment-id"},
+		}
+	}
+	teardown := func(t *testing.T, g *WithT) {
+		mockCtrl.Finish()
+	}
+
+	tests := []struct {
+		name              string
+		objects           []client.Object
+		errorExpected     bool
+		matchError        error
+		expectedEvents    []string
+		expectedOrphans   []string
+		testSpecificSetup func(j *OrphanedResourceJanitor)
+	}{
+		{
+			name:            "orphaned resources are reported",
+			objects:         []client.Object{getJanitorOCICluster("live_id", nil)},
+			expectedEvents:  []string{OrphanedResourceDetectedReason},
+			expectedOrphans: []string{"orphaned-vcn"},
+			testSpecificSetup: func(j *OrphanedResourceJanitor) {
+				searchClient.EXPECT().SearchResources(gomock.Any(), gomock.Eq(getJanitorSearchRequest(nil))).
+					Return(resourcesearch.SearchResourcesResponse{
+						ResourceSummaryCollection: resourcesearch.ResourceSummaryCollection{
+							Items: []resourcesearch.ResourceSummary{
+								getJanitorResource("Vcn", "live-vcn", "live_id", "AVAILABLE"),
+								getJanitorResource("Vcn", "orphaned-vcn", "orphan_id", "AVAILABLE"),
+								getJanitorResource("Instance", "terminated-instance", "orphan_id", "TERMINATED"),
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name:            "shared network is not orphaned",
+			objects:         []client.Object{getJanitorOCICluster("live_id", &infrastructurev1beta2.SharedNetwork{Identifier: "shared_id"})},
+			expectedOrphans: []string{},
+			testSpecificSetup: func(j *OrphanedResourceJanitor) {
+				searchClient.EXPECT().SearchResources(gomock.Any(), gomock.Eq(getJanitorSearchRequest(nil))).
+					Return(resourcesearch.SearchResourcesResponse{
+						ResourceSummaryCollection: resourcesearch.ResourceSummaryCollection{
+							Items: []resourcesearch.ResourceSummary{
+								getJanitorResource("Vcn", "shared-vcn", "shared_id", "AVAILABLE"),
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name:            "orphaned resources are deleted in dependency order",
+			expectedEvents:  []string{OrphanedResourceDetectedReason, OrphanedResourceDetectedReason, OrphanedResourceDeletedReason, OrphanedResourceDeletedReason},
+			expectedOrphans: []string{"orphaned-instance", "orphaned-vcn"},
+			testSpecificSetup: func(j *OrphanedResourceJanitor) {
+				j.DeleteOrphans = true
+				searchClient.EXPECT().SearchResources(gomock.Any(), gomock.Eq(getJanitorSearchRequest(nil))).
+					Return(resourcesearch.SearchResourcesResponse{
+						ResourceSummaryCollection: resourcesearch.ResourceSummaryCollection{
+							Items: []resourcesearch.ResourceSummary{
+								getJanitorResource("Vcn", "orphaned-vcn", "orphan_id", "AVAILABLE"),
+							},
+						},
+						OpcNextPage: common.String("next-page"),
+					}, nil)
+				searchClient.EXPECT().SearchResources(gomock.Any(), gomock.Eq(getJanitorSearchRequest(common.String("next-page")))).
+					Return(resourcesearch.SearchResourcesResponse{
+						ResourceSummaryCollection: resourcesearch.ResourceSummaryCollection{
+							Items: []resourcesearch.ResourceSummary{
+								getJanitorResource("Instance", "orphaned-instance", "orphan_id", "RUNNING"),
+							},
+						},
+					}, nil)
+				gomock.InOrder(
+					computeClient.EXPECT().TerminateInstance(gomock.Any(), gomock.Eq(core.TerminateInstanceRequest{
+						InstanceId:         common.String("orphaned-instance"),
+						PreserveBootVolume: common.Bool(false),
+					})).
+						Return(core.TerminateInstanceResponse{}, nil),
+					vcnClient.EXPECT().DeleteVcn(gomock.Any(), gomock.Eq(core.DeleteVcnRequest{
+						VcnId: common.String("orphaned-vcn"),
+					})).
+						Return(core.DeleteVcnResponse{}, nil),
+				)
+			},
+		},
+		{
+			name:            "orphaned resources are not deleted within the grace period",
+			expectedEvents:  []string{OrphanedResourceDetectedReason},
+			expectedOrphans: []string{"orphaned-vcn"},
+			testSpecificSetup: func(j *OrphanedResourceJanitor) {
+				j.DeleteOrphans = true
+				j.GracePeriod = time.Hour
+				searchClient.EXPECT().SearchResources(gomock.Any(), gomock.Eq(getJanitorSearchRequest(nil))).
+					Return(resourcesearch.SearchResourcesResponse{
+						ResourceSummaryCollection: resourcesearch.ResourceSummaryCollection{
+							Items: []resourcesearch.ResourceSummary{
+								getJanitorResource("Vcn", "orphaned-vcn", "orphan_id", "AVAILABLE"),
+							},
+						},
+					}, nil)
+			},
+		},
+		{
+			name:            "orphaned resource deletion failure",
+			expectedEvents:  []string{OrphanedResourceDetectedReason, OrphanedResourceDeletionFailedReason},
+			expectedOrphans: []string{"orphaned-vcn"},
+			testSpecificSetup: func(j *OrphanedResourceJanitor) {
+				j.DeleteOrphans = true
+				searchClient.EXPECT().SearchResources(gomock.Any(), gomock.Eq(getJanitorSearchRequest(nil))).
+					Return(resourcesearch.SearchResourcesResponse{
+						ResourceSummaryCollection: resourcesearch.ResourceSummaryCollection{
+							Items: []resourcesearch.ResourceSummary{
+								getJanitorResource("Vcn", "orphaned-vcn", "orphan_id", "AVAILABLE"),
+							},
+						},
+					}, nil)
+				vcnClient.EXPECT().DeleteVcn(gomock.Any(), gomock.Eq(core.DeleteVcnRequest{
+					VcnId: common.String("orphaned-vcn"),
+				})).
+					Return(core.DeleteVcnResponse{}, errors.New("vcn has dependent resources"))
+			},
+		},
+		{
+			name:          "search failure",
+			errorExpected: true,
+			matchError:    errors.New("failed to search resources of compartment compartment-id: request failed"),
+			testSpecificSetup: func(j *OrphanedResourceJanitor) {
+				searchClient.EXPECT().SearchResources(gomock.Any(), gomock.Eq(getJanitorSearchRequest(nil))).
+					Return(resourcesearch.SearchResourcesResponse{}, errors.New("request failed"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t, g, tc.objects)
+			defer teardown(t, g)
+			tc.testSpecificSetup(&j)
+
+			err := j.Scan(context.Background())
+			if tc.errorExpected {
+				g.Expect(err).To(Not(BeNil()))
+				g.Expect(err.Error()).To(Equal(tc.matchError.Error()))
+				return
+			}
+			g.Expect(err).To(BeNil())
+			for _, event := range tc.expectedEvents {
+				g.Eventually(recorder.Events).Should(Receive(ContainSubstring(event)))
+			}
+			g.Expect(recorder.Events).To(BeEmpty())
+			orphans := make([]string, 0)
+			for ocid := range j.orphanedSince {
+				orphans = append(orphans, ocid)
+			}
+			g.Expect(orphans).To(ConsistOf(tc.expectedOrphans))
+		})
+	}
+}
+
+func getJanitorOCICluster(resourceIdentifier string, shared *infrastructurev1beta2.SharedNetwork) *infrastructurev1beta2.OCICluster {
+	return &infrastructurev1beta2.OCICluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "test",
+		},
+		Spec: infrastructurev1beta2.OCIClusterSpec{
+			OCIResourceIdentifier: resourceIdentifier,
+			NetworkSpec: infrastructurev1beta2.NetworkSpec{
+				Vcn: infrastructurev1beta2.VCN{
+					Shared: shared,
+				},
+			},
+		},
+	}
+}
+
+func getJanitorSearchRequest(page *string) resourcesearch.SearchResourcesRequest {
+	return resourcesearch.SearchResourcesRequest{
+		SearchDetails: resourcesearch.StructuredSearchDetails{
+			Query:               common.String("query all resources where (freeformTags.key = 'CreatedBy' && freeformTags.value = 'OCIClusterAPIProvider') && compartmentId = 'compartment-id'"),
+			MatchingContextType: resourcesearch.SearchDetailsMatchingContextTypeNone,
+		},
+		Page: page,
+	}
+}
+
+func getJanitorResource(resourceType string, ocid string, resourceIdentifier string, lifecycleState string) resourcesearch.ResourceSummary {
+	return resourcesearch.ResourceSummary{
+		ResourceType:   common.String(resourceType),
+		Identifier:     common.String(ocid),
+		CompartmentId:  common.String("compartment-id"),
+		LifecycleState: common.String(lifecycleState),
+		FreeformTags: map[string]string{
+			ociutil.CreatedBy:                 ociutil.OCIClusterAPIProvider,
+			ociutil.ClusterResourceIdentifier: resourceIdentifier,
+		},
+	}
+}
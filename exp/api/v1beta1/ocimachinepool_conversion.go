@@ -36,6 +36,19 @@ func (src *OCIMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		return err
 	}
 
+	dst.Spec.CompartmentId = restored.Spec.CompartmentId
+	dst.Status.PlannedOperations = restored.Status.PlannedOperations
+	dst.Status.HibernatedReplicas = restored.Status.HibernatedReplicas
+	dst.Status.Image = restored.Status.Image
+	dst.Spec.LaunchFallbackPolicy = restored.Spec.LaunchFallbackPolicy
+	dst.Spec.BootstrapFormat = restored.Spec.BootstrapFormat
+	dst.Status.LaunchAttempts = restored.Status.LaunchAttempts
+	dst.Status.Placement = restored.Status.Placement
+	dst.Status.AppliedTags = restored.Status.AppliedTags
+	if restored.Spec.InstanceConfiguration.InstanceSourceViaImageDetails != nil && dst.Spec.InstanceConfiguration.InstanceSourceViaImageDetails != nil {
+		dst.Spec.InstanceConfiguration.InstanceSourceViaImageDetails.ImageSelector = restored.Spec.InstanceConfiguration.InstanceSourceViaImageDetails.ImageSelector
+	}
+
 	return nil
 }
 
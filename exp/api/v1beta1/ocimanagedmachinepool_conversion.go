@@ -35,6 +35,8 @@ func (src *OCIManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		return err
 	}
 	dst.Spec.NodePoolCyclingDetails = restored.Spec.NodePoolCyclingDetails
+	dst.Status.HibernatedReplicas = restored.Status.HibernatedReplicas
+	dst.Status.AppliedTags = restored.Status.AppliedTags
 
 	return nil
 }
@@ -34,6 +34,7 @@ func (src *OCIVirtualMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
 		return err
 	}
+	dst.Status.AppliedTags = restored.Status.AppliedTags
 
 	return nil
 }
@@ -55,11 +55,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.InstanceSourceViaImageConfig)(nil), (*InstanceSourceViaImageConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_InstanceSourceViaImageConfig_To_v1beta1_InstanceSourceViaImageConfig(a.(*v1beta2.InstanceSourceViaImageConfig), b.(*InstanceSourceViaImageConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*InstanceVnicConfiguration)(nil), (*v1beta2.InstanceVnicConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_InstanceVnicConfiguration_To_v1beta2_InstanceVnicConfiguration(a.(*InstanceVnicConfiguration), b.(*v1beta2.InstanceVnicConfiguration), scope)
 	}); err != nil {
@@ -205,21 +200,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.OCIMachinePoolSpec)(nil), (*OCIMachinePoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_OCIMachinePoolSpec_To_v1beta1_OCIMachinePoolSpec(a.(*v1beta2.OCIMachinePoolSpec), b.(*OCIMachinePoolSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*OCIMachinePoolStatus)(nil), (*v1beta2.OCIMachinePoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OCIMachinePoolStatus_To_v1beta2_OCIMachinePoolStatus(a.(*OCIMachinePoolStatus), b.(*v1beta2.OCIMachinePoolStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.OCIMachinePoolStatus)(nil), (*OCIMachinePoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_OCIMachinePoolStatus_To_v1beta1_OCIMachinePoolStatus(a.(*v1beta2.OCIMachinePoolStatus), b.(*OCIMachinePoolStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*OCIManagedMachinePool)(nil), (*v1beta2.OCIManagedMachinePool)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OCIManagedMachinePool_To_v1beta2_OCIManagedMachinePool(a.(*OCIManagedMachinePool), b.(*v1beta2.OCIManagedMachinePool), scope)
 	}); err != nil {
@@ -250,11 +235,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.OCIManagedMachinePoolStatus)(nil), (*OCIManagedMachinePoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_OCIManagedMachinePoolStatus_To_v1beta1_OCIManagedMachinePoolStatus(a.(*v1beta2.OCIManagedMachinePoolStatus), b.(*OCIManagedMachinePoolStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*OCIManagedMachinePoolTemplate)(nil), (*v1beta2.OCIManagedMachinePoolTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OCIManagedMachinePoolTemplate_To_v1beta2_OCIManagedMachinePoolTemplate(a.(*OCIManagedMachinePoolTemplate), b.(*v1beta2.OCIManagedMachinePoolTemplate), scope)
 	}); err != nil {
@@ -410,13 +390,23 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.InstanceSourceViaImageConfig)(nil), (*InstanceSourceViaImageConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_InstanceSourceViaImageConfig_To_v1beta1_InstanceSourceViaImageConfig(a.(*v1beta2.InstanceSourceViaImageConfig), b.(*InstanceSourceViaImageConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*apiv1beta2.NetworkDetails)(nil), (*apiv1beta1.NetworkDetails)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_NetworkDetails_To_v1beta1_NetworkDetails(a.(*apiv1beta2.NetworkDetails), b.(*apiv1beta1.NetworkDetails), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddConversionFunc((*apiv1beta2.NetworkSpec)(nil), (*apiv1beta1.NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(a.(*apiv1beta2.NetworkSpec), b.(*apiv1beta1.NetworkSpec), scope)
+	if err := s.AddConversionFunc((*v1beta2.OCIMachinePoolSpec)(nil), (*OCIMachinePoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OCIMachinePoolSpec_To_v1beta1_OCIMachinePoolSpec(a.(*v1beta2.OCIMachinePoolSpec), b.(*OCIMachinePoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.OCIMachinePoolStatus)(nil), (*OCIMachinePoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OCIMachinePoolStatus_To_v1beta1_OCIMachinePoolStatus(a.(*v1beta2.OCIMachinePoolStatus), b.(*OCIMachinePoolStatus), scope)
 	}); err != nil {
 		return err
 	}
@@ -425,6 +415,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.OCIManagedMachinePoolStatus)(nil), (*OCIManagedMachinePoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_OCIManagedMachinePoolStatus_To_v1beta1_OCIManagedMachinePoolStatus(a.(*v1beta2.OCIManagedMachinePoolStatus), b.(*OCIManagedMachinePoolStatus), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -449,7 +444,15 @@ func autoConvert_v1beta1_InstanceConfiguration_To_v1beta2_InstanceConfiguration(
 	out.LaunchOptions = (*apiv1beta2.LaunchOptions)(unsafe.Pointer(in.LaunchOptions))
 	out.InstanceOptions = (*apiv1beta2.InstanceOptions)(unsafe.Pointer(in.InstanceOptions))
 	out.IsPvEncryptionInTransitEnabled = (*bool)(unsafe.Pointer(in.IsPvEncryptionInTransitEnabled))
-	out.InstanceSourceViaImageDetails = (*v1beta2.InstanceSourceViaImageConfig)(unsafe.Pointer(in.InstanceSourceViaImageDetails))
+	if in.InstanceSourceViaImageDetails != nil {
+		in, out := &in.InstanceSourceViaImageDetails, &out.InstanceSourceViaImageDetails
+		*out = new(v1beta2.InstanceSourceViaImageConfig)
+		if err := Convert_v1beta1_InstanceSourceViaImageConfig_To_v1beta2_InstanceSourceViaImageConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.InstanceSourceViaImageDetails = nil
+	}
 	out.CapacityReservationId = (*string)(unsafe.Pointer(in.CapacityReservationId))
 	out.Metadata = *(*map[string]string)(unsafe.Pointer(&in.Metadata))
 	return nil
@@ -481,7 +484,15 @@ func autoConvert_v1beta2_InstanceConfiguration_To_v1beta1_InstanceConfiguration(
 	out.LaunchOptions = (*apiv1beta1.LaunchOptions)(unsafe.Pointer(in.LaunchOptions))
 	out.InstanceOptions = (*apiv1beta1.InstanceOptions)(unsafe.Pointer(in.InstanceOptions))
 	out.IsPvEncryptionInTransitEnabled = (*bool)(unsafe.Pointer(in.IsPvEncryptionInTransitEnabled))
-	out.InstanceSourceViaImageDetails = (*InstanceSourceViaImageConfig)(unsafe.Pointer(in.InstanceSourceViaImageDetails))
+	if in.InstanceSourceViaImageDetails != nil {
+		in, out := &in.InstanceSourceViaImageDetails, &out.InstanceSourceViaImageDetails
+		*out = new(InstanceSourceViaImageConfig)
+		if err := Convert_v1beta2_InstanceSourceViaImageConfig_To_v1beta1_InstanceSourceViaImageConfig(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.InstanceSourceViaImageDetails = nil
+	}
 	out.CapacityReservationId = (*string)(unsafe.Pointer(in.CapacityReservationId))
 	out.Metadata = *(*map[string]string)(unsafe.Pointer(&in.Metadata))
 	return nil
@@ -507,17 +518,13 @@ func Convert_v1beta1_InstanceSourceViaImageConfig_To_v1beta2_InstanceSourceViaIm
 
 func autoConvert_v1beta2_InstanceSourceViaImageConfig_To_v1beta1_InstanceSourceViaImageConfig(in *v1beta2.InstanceSourceViaImageConfig, out *InstanceSourceViaImageConfig, s conversion.Scope) error {
 	out.ImageId = (*string)(unsafe.Pointer(in.ImageId))
+	// WARNING: in.ImageSelector requires manual conversion: does not exist in peer-type
 	out.KmsKeyId = (*string)(unsafe.Pointer(in.KmsKeyId))
 	out.BootVolumeSizeInGBs = (*int64)(unsafe.Pointer(in.BootVolumeSizeInGBs))
 	out.BootVolumeVpusPerGB = (*int64)(unsafe.Pointer(in.BootVolumeVpusPerGB))
 	return nil
 }
 
-// Convert_v1beta2_InstanceSourceViaImageConfig_To_v1beta1_InstanceSourceViaImageConfig is an autogenerated conversion function.
-func Convert_v1beta2_InstanceSourceViaImageConfig_To_v1beta1_InstanceSourceViaImageConfig(in *v1beta2.InstanceSourceViaImageConfig, out *InstanceSourceViaImageConfig, s conversion.Scope) error {
-	return autoConvert_v1beta2_InstanceSourceViaImageConfig_To_v1beta1_InstanceSourceViaImageConfig(in, out, s)
-}
-
 func autoConvert_v1beta1_InstanceVnicConfiguration_To_v1beta2_InstanceVnicConfiguration(in *InstanceVnicConfiguration, out *v1beta2.InstanceVnicConfiguration, s conversion.Scope) error {
 	out.AssignPublicIp = in.AssignPublicIp
 	out.SubnetName = in.SubnetName
@@ -911,19 +918,17 @@ func Convert_v1beta1_OCIMachinePoolSpec_To_v1beta2_OCIMachinePoolSpec(in *OCIMac
 func autoConvert_v1beta2_OCIMachinePoolSpec_To_v1beta1_OCIMachinePoolSpec(in *v1beta2.OCIMachinePoolSpec, out *OCIMachinePoolSpec, s conversion.Scope) error {
 	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
 	out.OCID = (*string)(unsafe.Pointer(in.OCID))
+	// WARNING: in.CompartmentId requires manual conversion: does not exist in peer-type
 	out.PlacementDetails = *(*[]PlacementDetails)(unsafe.Pointer(&in.PlacementDetails))
 	if err := Convert_v1beta2_InstanceConfiguration_To_v1beta1_InstanceConfiguration(&in.InstanceConfiguration, &out.InstanceConfiguration, s); err != nil {
 		return err
 	}
 	out.ProviderIDList = *(*[]string)(unsafe.Pointer(&in.ProviderIDList))
+	// WARNING: in.LaunchFallbackPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootstrapFormat requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_OCIMachinePoolSpec_To_v1beta1_OCIMachinePoolSpec is an autogenerated conversion function.
-func Convert_v1beta2_OCIMachinePoolSpec_To_v1beta1_OCIMachinePoolSpec(in *v1beta2.OCIMachinePoolSpec, out *OCIMachinePoolSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_OCIMachinePoolSpec_To_v1beta1_OCIMachinePoolSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_OCIMachinePoolStatus_To_v1beta2_OCIMachinePoolStatus(in *OCIMachinePoolStatus, out *v1beta2.OCIMachinePoolStatus, s conversion.Scope) error {
 	out.Ready = in.Ready
 	out.Replicas = in.Replicas
@@ -946,14 +951,15 @@ func autoConvert_v1beta2_OCIMachinePoolStatus_To_v1beta1_OCIMachinePoolStatus(in
 	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
 	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
 	out.InfrastructureMachineKind = in.InfrastructureMachineKind
+	// WARNING: in.PlannedOperations requires manual conversion: does not exist in peer-type
+	// WARNING: in.HibernatedReplicas requires manual conversion: does not exist in peer-type
+	// WARNING: in.Image requires manual conversion: does not exist in peer-type
+	// WARNING: in.LaunchAttempts requires manual conversion: does not exist in peer-type
+	// WARNING: in.Placement requires manual conversion: does not exist in peer-type
+	// WARNING: in.AppliedTags requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_OCIMachinePoolStatus_To_v1beta1_OCIMachinePoolStatus is an autogenerated conversion function.
-func Convert_v1beta2_OCIMachinePoolStatus_To_v1beta1_OCIMachinePoolStatus(in *v1beta2.OCIMachinePoolStatus, out *OCIMachinePoolStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_OCIMachinePoolStatus_To_v1beta1_OCIMachinePoolStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_OCIManagedMachinePool_To_v1beta2_OCIManagedMachinePool(in *OCIManagedMachinePool, out *v1beta2.OCIManagedMachinePool, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_OCIManagedMachinePoolSpec_To_v1beta2_OCIManagedMachinePoolSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1090,14 +1096,11 @@ func autoConvert_v1beta2_OCIManagedMachinePoolStatus_To_v1beta1_OCIManagedMachin
 	out.FailureReason = (*errors.MachineStatusError)(unsafe.Pointer(in.FailureReason))
 	out.FailureMessages = *(*[]string)(unsafe.Pointer(&in.FailureMessages))
 	out.InfrastructureMachineKind = in.InfrastructureMachineKind
+	// WARNING: in.HibernatedReplicas requires manual conversion: does not exist in peer-type
+	// WARNING: in.AppliedTags requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_OCIManagedMachinePoolStatus_To_v1beta1_OCIManagedMachinePoolStatus is an autogenerated conversion function.
-func Convert_v1beta2_OCIManagedMachinePoolStatus_To_v1beta1_OCIManagedMachinePoolStatus(in *v1beta2.OCIManagedMachinePoolStatus, out *OCIManagedMachinePoolStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_OCIManagedMachinePoolStatus_To_v1beta1_OCIManagedMachinePoolStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_OCIManagedMachinePoolTemplate_To_v1beta2_OCIManagedMachinePoolTemplate(in *OCIManagedMachinePoolTemplate, out *v1beta2.OCIManagedMachinePoolTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_OCIManagedMachinePoolTemplateSpec_To_v1beta2_OCIManagedMachinePoolTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1248,7 +1251,17 @@ func Convert_v1beta2_OCIVirtualMachinePool_To_v1beta1_OCIVirtualMachinePool(in *
 
 func autoConvert_v1beta1_OCIVirtualMachinePoolList_To_v1beta2_OCIVirtualMachinePoolList(in *OCIVirtualMachinePoolList, out *v1beta2.OCIVirtualMachinePoolList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]v1beta2.OCIVirtualMachinePool)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1beta2.OCIVirtualMachinePool, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_OCIVirtualMachinePool_To_v1beta2_OCIVirtualMachinePool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -1259,7 +1272,17 @@ func Convert_v1beta1_OCIVirtualMachinePoolList_To_v1beta2_OCIVirtualMachinePoolL
 
 func autoConvert_v1beta2_OCIVirtualMachinePoolList_To_v1beta1_OCIVirtualMachinePoolList(in *v1beta2.OCIVirtualMachinePoolList, out *OCIVirtualMachinePoolList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]OCIVirtualMachinePool)(unsafe.Pointer(&in.Items))
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OCIVirtualMachinePool, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_OCIVirtualMachinePool_To_v1beta1_OCIVirtualMachinePool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
 	return nil
 }
 
@@ -1330,14 +1353,10 @@ func autoConvert_v1beta2_OCIVirtualMachinePoolStatus_To_v1beta1_OCIVirtualMachin
 	out.FailureReason = (*errors.MachinePoolStatusFailure)(unsafe.Pointer(in.FailureReason))
 	out.FailureMessages = *(*[]string)(unsafe.Pointer(&in.FailureMessages))
 	out.InfrastructureMachineKind = in.InfrastructureMachineKind
+	// WARNING: in.AppliedTags requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_OCIVirtualMachinePoolStatus_To_v1beta1_OCIVirtualMachinePoolStatus is an autogenerated conversion function.
-func Convert_v1beta2_OCIVirtualMachinePoolStatus_To_v1beta1_OCIVirtualMachinePoolStatus(in *v1beta2.OCIVirtualMachinePoolStatus, out *OCIVirtualMachinePoolStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_OCIVirtualMachinePoolStatus_To_v1beta1_OCIVirtualMachinePoolStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_PlacementConfig_To_v1beta2_PlacementConfig(in *PlacementConfig, out *v1beta2.PlacementConfig, s conversion.Scope) error {
 	out.AvailabilityDomain = (*string)(unsafe.Pointer(in.AvailabilityDomain))
 	out.CapacityReservationId = (*string)(unsafe.Pointer(in.CapacityReservationId))
@@ -41,6 +41,11 @@ type OCIMachinePoolSpec struct {
 	// +optional
 	OCID *string `json:"ocid,omitempty"`
 
+	// CompartmentId is the compartment to launch the instance pool in. If not set, the
+	// compartment of the cluster is used.
+	// +optional
+	CompartmentId string `json:"compartmentId,omitempty"`
+
 	// PlacementDetails defines the placement details of the instance pool.
 	PlacementDetails []PlacementDetails `json:"placementDetails,omitempty"`
 
@@ -51,6 +56,19 @@ type OCIMachinePoolSpec struct {
 	// This field must match the provider IDs as seen on the node objects corresponding to a machine pool's machine instances.
 	// +optional
 	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// LaunchFallbackPolicy defines the other shapes and the other capacity type of the instances when the compute
+	// capacity report shows no capacity for the instance configuration as specified. The placements of the
+	// policy do not apply, the instance pool is placed by its placement details.
+	// +optional
+	LaunchFallbackPolicy *infrastructurev1beta2.LaunchFallbackPolicy `json:"launchFallbackPolicy,omitempty"`
+
+	// BootstrapFormat declares the format of the bootstrap data, cloud-config or ignition. The format of the
+	// bootstrap data secret is used when it is not declared. The bootstrap data of an instance pool is always
+	// delivered in the instance metadata.
+	// +kubebuilder:validation:Enum=cloud-config;ignition
+	// +optional
+	BootstrapFormat infrastructurev1beta2.BootstrapFormat `json:"bootstrapFormat,omitempty"`
 }
 
 type InstanceConfiguration struct {
@@ -170,6 +188,12 @@ type InstanceSourceViaImageConfig struct {
 	// OCID of the image to be used to launch the instance.
 	ImageId *string `json:"imageId,omitempty"`
 
+	// ImageSelector selects the image to be used to launch the instances by its attributes, it can not be used
+	// together with ImageId. The image is selected again, and a new instance configuration created, when the
+	// selector changes.
+	// +optional
+	ImageSelector *infrastructurev1beta2.ImageSelector `json:"imageSelector,omitempty"`
+
 	// KmsKeyId defines the OCID of the Key Management key to assign as the master encryption key for the boot volume.
 	KmsKeyId *string `json:"kmsKeyId,omitempty"`
 
@@ -209,6 +233,33 @@ type OCIMachinePoolStatus struct {
 	// InfrastructureMachineKind is the kind of the infrastructure resources behind MachinePool Machines.
 	// +optional
 	InfrastructureMachineKind string `json:"infrastructureMachineKind,omitempty"`
+
+	// PlannedOperations are the operations on the OCI resources which the last reconciliation in plan mode
+	// would have performed. See infrastructurev1beta2.PlanAnnotation.
+	// +optional
+	PlannedOperations []infrastructurev1beta2.PlannedOperation `json:"plannedOperations,omitempty"`
+
+	// HibernatedReplicas is the size of the instance pool when the cluster was hibernated, it is set while the
+	// instance pool is scaled to zero by the hibernation. See infrastructurev1beta2.HibernateAnnotation.
+	// +optional
+	HibernatedReplicas *int32 `json:"hibernatedReplicas,omitempty"`
+
+	// Image is the image selected by the image selector of the instance configuration.
+	// +optional
+	Image *infrastructurev1beta2.SelectedImage `json:"image,omitempty"`
+
+	// LaunchAttempts are the most recent capacity checks of the launch fallback policy which found no capacity.
+	// +optional
+	LaunchAttempts []infrastructurev1beta2.LaunchAttempt `json:"launchAttempts,omitempty"`
+
+	// Placement is the shape and the capacity type of the instance configuration selected by the launch fallback
+	// policy.
+	// +optional
+	Placement *infrastructurev1beta2.InstancePlacement `json:"placement,omitempty"`
+
+	// AppliedTags are the tags of the cluster which were last applied to the instance pool and its instances.
+	// +optional
+	AppliedTags *infrastructurev1beta2.AppliedTags `json:"appliedTags,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -230,6 +230,16 @@ type OCIManagedMachinePoolStatus struct {
 	// InfrastructureMachineKind is the kind of the infrastructure resources behind MachinePool Machines.
 	// +optional
 	InfrastructureMachineKind string `json:"infrastructureMachineKind,omitempty"`
+
+	// HibernatedReplicas is the size of the node pool when the cluster was hibernated, it is set while the
+	// node pool is scaled to zero by the hibernation. The node pool is scaled back to this size when the cluster
+	// resumes if its replicas are managed by an external autoscaler. See infrastructurev1beta2.HibernateAnnotation.
+	// +optional
+	HibernatedReplicas *int32 `json:"hibernatedReplicas,omitempty"`
+
+	// AppliedTags are the tags of the cluster which were last applied to the node pool and its nodes.
+	// +optional
+	AppliedTags *infrastructurev1beta2.AppliedTags `json:"appliedTags,omitempty"`
 }
 
 //+kubebuilder:object:root=true
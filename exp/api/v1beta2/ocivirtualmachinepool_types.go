@@ -1,6 +1,7 @@
 package v1beta2
 
 import (
+	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/errors"
@@ -120,6 +121,10 @@ type OCIVirtualMachinePoolStatus struct {
 	// InfrastructureMachineKind is the kind of the infrastructure resources behind MachinePool Machines.
 	// +optional
 	InfrastructureMachineKind string `json:"infrastructureMachineKind,omitempty"`
+
+	// AppliedTags are the tags of the cluster which were last applied to the virtual node pool.
+	// +optional
+	AppliedTags *infrastructurev1beta2.AppliedTags `json:"appliedTags,omitempty"`
 }
 
 //+kubebuilder:object:root=true
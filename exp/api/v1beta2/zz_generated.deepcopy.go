@@ -127,6 +127,11 @@ func (in *InstanceSourceViaImageConfig) DeepCopyInto(out *InstanceSourceViaImage
 		*out = new(string)
 		**out = **in
 	}
+	if in.ImageSelector != nil {
+		in, out := &in.ImageSelector, &out.ImageSelector
+		*out = new(apiv1beta2.ImageSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KmsKeyId != nil {
 		in, out := &in.KmsKeyId, &out.KmsKeyId
 		*out = new(string)
@@ -603,6 +608,11 @@ func (in *OCIMachinePoolSpec) DeepCopyInto(out *OCIMachinePoolSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LaunchFallbackPolicy != nil {
+		in, out := &in.LaunchFallbackPolicy, &out.LaunchFallbackPolicy
+		*out = new(apiv1beta2.LaunchFallbackPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIMachinePoolSpec.
@@ -635,6 +645,40 @@ func (in *OCIMachinePoolStatus) DeepCopyInto(out *OCIMachinePoolStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PlannedOperations != nil {
+		in, out := &in.PlannedOperations, &out.PlannedOperations
+		*out = make([]apiv1beta2.PlannedOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HibernatedReplicas != nil {
+		in, out := &in.HibernatedReplicas, &out.HibernatedReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(apiv1beta2.SelectedImage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LaunchAttempts != nil {
+		in, out := &in.LaunchAttempts, &out.LaunchAttempts
+		*out = make([]apiv1beta2.LaunchAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(apiv1beta2.InstancePlacement)
+		**out = **in
+	}
+	if in.AppliedTags != nil {
+		in, out := &in.AppliedTags, &out.AppliedTags
+		*out = new(apiv1beta2.AppliedTags)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIMachinePoolStatus.
@@ -800,6 +844,16 @@ func (in *OCIManagedMachinePoolStatus) DeepCopyInto(out *OCIManagedMachinePoolSt
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.HibernatedReplicas != nil {
+		in, out := &in.HibernatedReplicas, &out.HibernatedReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AppliedTags != nil {
+		in, out := &in.AppliedTags, &out.AppliedTags
+		*out = new(apiv1beta2.AppliedTags)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIManagedMachinePoolStatus.
@@ -1038,6 +1092,11 @@ func (in *OCIVirtualMachinePoolStatus) DeepCopyInto(out *OCIVirtualMachinePoolSt
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AppliedTags != nil {
+		in, out := &in.AppliedTags, &out.AppliedTags
+		*out = new(apiv1beta2.AppliedTags)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIVirtualMachinePoolStatus.
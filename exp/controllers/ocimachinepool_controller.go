@@ -24,6 +24,7 @@ import (
 	"github.com/go-logr/logr"
 	infrastructurev1beta2 "github.com/oracle/cluster-api-provider-oci/api/v1beta2"
 	"github.com/oracle/cluster-api-provider-oci/cloud/ociutil"
+	"github.com/oracle/cluster-api-provider-oci/cloud/plan"
 	"github.com/oracle/cluster-api-provider-oci/cloud/scope"
 	cloudutil "github.com/oracle/cluster-api-provider-oci/cloud/util"
 	expV1Beta1 "github.com/oracle/cluster-api-provider-oci/exp/api/v1beta1"
@@ -127,6 +128,7 @@ func (r *OCIMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			logger.V(2).Info("OCICluster is not available yet")
 			return ctrl.Result{}, nil
 		}
+		scope.LoadNetworkInventory(&ociCluster.Spec.NetworkSpec, ociCluster.Status.Network)
 		clusterAccessor = scope.OCISelfManagedCluster{
 			OCICluster: ociCluster,
 		}
@@ -154,11 +156,20 @@ func (r *OCIMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	computeManagementClient := clients.ComputeManagementClient
+	var planRecorder *plan.Recorder
+	if plan.IsPlanMode(ociMachinePool) {
+		planRecorder = plan.NewRecorder()
+		computeManagementClient = planRecorder.NewComputeManagementClient(computeManagementClient)
+	} else {
+		ociMachinePool.Status.PlannedOperations = nil
+	}
 
 	// Create the machine pool scope
 	machinePoolScope, err := scope.NewMachinePoolScope(scope.MachinePoolScopeParams{
 		Client:                  r.Client,
-		ComputeManagementClient: clients.ComputeManagementClient,
+		ComputeManagementClient: computeManagementClient,
+		ComputeClient:           clients.ComputeClient,
 		Logger:                  &logger,
 		Cluster:                 cluster,
 		OCIClusterAccessor:      clusterAccessor,
@@ -176,6 +187,10 @@ func (r *OCIMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}()
 
+	if planRecorder != nil {
+		return r.reconcilePlan(ctx, machinePoolScope, planRecorder)
+	}
+
 	// Handle deleted machines
 	if !ociMachinePool.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, machinePoolScope)
@@ -214,7 +229,10 @@ func (r *OCIMachinePoolReconciler) SetupWithManager(ctx context.Context, mgr ctr
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(clusterToObjectFunc),
 			builder.WithPredicates(
-				predicates.ClusterUnpausedAndInfrastructureReady(ctrl.LoggerFrom(ctx)),
+				predicates.Any(ctrl.LoggerFrom(ctx),
+					predicates.ClusterUnpausedAndInfrastructureReady(ctrl.LoggerFrom(ctx)),
+					scope.ClusterHibernationChanged(ctrl.LoggerFrom(ctx)),
+				),
 			),
 		).
 		WithEventFilter(predicates.ResourceNotPaused(ctrl.LoggerFrom(ctx))).
@@ -311,10 +329,15 @@ func (r *OCIMachinePoolReconciler) reconcileNormal(ctx context.Context, logger l
 
 	// get or create the InstanceConfiguration
 	// https://docs.oracle.com/en-us/iaas/api/#/en/iaas/20160918/InstanceConfiguration/
+	placement := machinePoolScope.OCIMachinePool.Status.Placement
 	if err := machinePoolScope.ReconcileInstanceConfiguration(ctx); err != nil {
 		r.Recorder.Eventf(machinePoolScope.OCIMachinePool, corev1.EventTypeWarning, "FailedLaunchTemplateReconcile", "Failed to reconcile launch template: %v", err)
 		return ctrl.Result{}, err
 	}
+	if newPlacement := machinePoolScope.OCIMachinePool.Status.Placement; newPlacement != nil && newPlacement != placement {
+		r.Recorder.Eventf(machinePoolScope.OCIMachinePool, corev1.EventTypeNormal, "InstanceConfigurationShapeSelected",
+			"Selected %s for the instance configuration", scope.FormatPlacement(*newPlacement))
+	}
 
 	// set the LaunchTemplateReady condition
 	conditions.MarkTrue(machinePoolScope.OCIMachinePool, infrav2exp.LaunchTemplateReadyCondition)
@@ -388,8 +411,16 @@ func (r *OCIMachinePoolReconciler) reconcileNormal(ctx context.Context, logger l
 		if err != nil {
 			return ctrl.Result{}, err
 		}
+		if err := machinePoolScope.ReconcileInstanceTags(ctx); err != nil {
+			r.Recorder.Eventf(machinePoolScope.OCIMachinePool, corev1.EventTypeWarning, "FailedUpdate", "Failed to update the tags of the instances: %v", err)
+			return ctrl.Result{}, err
+		}
 		machinePoolScope.SetReplicaCount(int32(len(providerIDList)))
 		machinePoolScope.SetReady()
+		if machinePoolScope.IsWaitingForControlPlaneResume() {
+			machinePoolScope.Info("Waiting for the control plane to resume before scaling the instance pool")
+			return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+		}
 	default:
 		conditions.MarkFalse(machinePoolScope.OCIMachinePool, infrav2exp.InstancePoolReadyCondition, infrav2exp.InstancePoolProvisionFailedReason, clusterv1.ConditionSeverityError, "")
 		machinePoolScope.SetFailureReason(capierrors.CreateMachineError)
@@ -403,6 +434,72 @@ func (r *OCIMachinePoolReconciler) reconcileNormal(ctx context.Context, logger l
 	return reconcile.Result{RequeueAfter: 300 * time.Second}, nil
 }
 
+// reconcilePlan lists the operations which the reconciliation would perform on the instance pool and its instance
+// configurations in the status of the OCIMachinePool. The machines of the pool are not reconciled in plan mode.
+func (r *OCIMachinePoolReconciler) reconcilePlan(ctx context.Context, machinePoolScope *scope.MachinePoolScope, planRecorder *plan.Recorder) (ctrl.Result, error) {
+	var err error
+	if machinePoolScope.OCIMachinePool.DeletionTimestamp.IsZero() {
+		err = r.planNormal(ctx, machinePoolScope)
+	} else {
+		err = r.planDelete(ctx, machinePoolScope)
+	}
+	if err != nil && !plan.IsPlanned(err) {
+		return ctrl.Result{}, err
+	}
+	previous := machinePoolScope.OCIMachinePool.Status.PlannedOperations
+	machinePoolScope.OCIMachinePool.Status.PlannedOperations = planRecorder.Operations()
+	plan.ReportEvent(r.Recorder, machinePoolScope.OCIMachinePool, previous, machinePoolScope.OCIMachinePool.Status.PlannedOperations)
+	return ctrl.Result{}, nil
+}
+
+func (r *OCIMachinePoolReconciler) planNormal(ctx context.Context, machinePoolScope *scope.MachinePoolScope) error {
+	// nothing is done until the cluster infrastructure and the bootstrap data are ready
+	if !machinePoolScope.Cluster.Status.InfrastructureReady || machinePoolScope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
+		return nil
+	}
+	if err := machinePoolScope.ReconcileInstanceConfiguration(ctx); err != nil {
+		return err
+	}
+	instancePool, err := machinePoolScope.FindInstancePool(ctx)
+	if err != nil {
+		return err
+	}
+	if instancePool == nil {
+		_, err := machinePoolScope.CreateInstancePool(ctx)
+		return err
+	}
+	instancePool, err = machinePoolScope.UpdatePool(ctx, instancePool)
+	if err != nil {
+		return err
+	}
+	return machinePoolScope.CleanupInstanceConfiguration(ctx, instancePool)
+}
+
+func (r *OCIMachinePoolReconciler) planDelete(ctx context.Context, machinePoolScope *scope.MachinePoolScope) error {
+	instancePool, err := machinePoolScope.FindInstancePool(ctx)
+	if err != nil && !ociutil.IsNotFound(err) {
+		return err
+	}
+	if instancePool != nil && instancePool.LifecycleState != core.InstancePoolLifecycleStateTerminating &&
+		instancePool.LifecycleState != core.InstancePoolLifecycleStateTerminated {
+		return machinePoolScope.TerminateInstancePool(ctx, instancePool)
+	}
+	if err := machinePoolScope.CleanupInstanceConfiguration(ctx, nil); err != nil {
+		return err
+	}
+	instanceConfiguration, err := machinePoolScope.GetInstanceConfiguration(ctx)
+	if err != nil && !ociutil.IsNotFound(err) {
+		return err
+	}
+	if instanceConfiguration != nil {
+		_, err := machinePoolScope.ComputeManagementClient.DeleteInstanceConfiguration(ctx, core.DeleteInstanceConfigurationRequest{
+			InstanceConfigurationId: instanceConfiguration.Id,
+		})
+		return err
+	}
+	return nil
+}
+
 func (r *OCIMachinePoolReconciler) reconcileDelete(ctx context.Context, machinePoolScope *scope.MachinePoolScope) (_ ctrl.Result, reterr error) {
 	machinePoolScope.Info("Handling deleted OCIMachinePool")
 
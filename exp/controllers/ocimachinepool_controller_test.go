@@ -319,6 +319,7 @@ func TestReconciliationFunction(t *testing.T) {
 				})
 				ms.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName = common.String("bootstrap")
 				ms.OCIMachinePool.Spec.OCID = common.String("pool-id")
+				ms.OCIMachinePool.Status.AppliedTags = scope.NewAppliedTags(ms.GetFreeFormTags(), ms.OCIClusterAccesor.GetDefinedTags())
 				computeManagementClient.EXPECT().GetInstanceConfiguration(gomock.Any(), gomock.Eq(core.GetInstanceConfigurationRequest{
 					InstanceConfigurationId: common.String("test"),
 				})).
@@ -350,6 +351,7 @@ func TestReconciliationFunction(t *testing.T) {
 							Id:                      common.String("pool-id"),
 							InstanceConfigurationId: common.String("test"),
 							Size:                    common.Int(3),
+							FreeformTags:            tags,
 						},
 					}, nil)
 				computeManagementClient.EXPECT().ListInstancePoolInstances(gomock.Any(), gomock.Any()).
@@ -429,6 +431,7 @@ func TestReconciliationFunction(t *testing.T) {
 				})
 				ms.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName = common.String("bootstrap")
 				ms.OCIMachinePool.Spec.OCID = common.String("pool-id")
+				ms.OCIMachinePool.Status.AppliedTags = scope.NewAppliedTags(ms.GetFreeFormTags(), ms.OCIClusterAccesor.GetDefinedTags())
 				computeManagementClient.EXPECT().GetInstanceConfiguration(gomock.Any(), gomock.Eq(core.GetInstanceConfigurationRequest{
 					InstanceConfigurationId: common.String("test"),
 				})).
@@ -460,6 +463,7 @@ func TestReconciliationFunction(t *testing.T) {
 							Id:                      common.String("pool-id"),
 							InstanceConfigurationId: common.String("test"),
 							Size:                    common.Int(3),
+							FreeformTags:            tags,
 						},
 					}, nil)
 				computeManagementClient.EXPECT().ListInstancePoolInstances(gomock.Any(), gomock.Any()).
@@ -497,6 +501,7 @@ func TestReconciliationFunction(t *testing.T) {
 				}
 				ms.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName = common.String("bootstrap")
 				ms.OCIMachinePool.Spec.OCID = common.String("pool-id")
+				ms.OCIMachinePool.Status.AppliedTags = scope.NewAppliedTags(ms.GetFreeFormTags(), ms.OCIClusterAccesor.GetDefinedTags())
 				computeManagementClient.EXPECT().GetInstanceConfiguration(gomock.Any(), gomock.Eq(core.GetInstanceConfigurationRequest{
 					InstanceConfigurationId: common.String("test"),
 				})).
@@ -643,6 +648,7 @@ func TestDeleteeconciliationFunction(t *testing.T) {
 					InstanceConfigurationId: common.String("test"),
 				}
 				ms.OCIMachinePool.Spec.OCID = common.String("pool-id")
+				ms.OCIMachinePool.Status.AppliedTags = scope.NewAppliedTags(ms.GetFreeFormTags(), ms.OCIClusterAccesor.GetDefinedTags())
 				computeManagementClient.EXPECT().GetInstancePool(gomock.Any(), gomock.Any()).
 					Return(core.GetInstancePoolResponse{
 						InstancePool: core.InstancePool{
@@ -666,6 +672,7 @@ func TestDeleteeconciliationFunction(t *testing.T) {
 					InstanceConfigurationId: common.String("test"),
 				}
 				ms.OCIMachinePool.Spec.OCID = common.String("pool-id")
+				ms.OCIMachinePool.Status.AppliedTags = scope.NewAppliedTags(ms.GetFreeFormTags(), ms.OCIClusterAccesor.GetDefinedTags())
 				computeManagementClient.EXPECT().GetInstancePool(gomock.Any(), gomock.Any()).
 					Return(core.GetInstancePoolResponse{
 						InstancePool: core.InstancePool{
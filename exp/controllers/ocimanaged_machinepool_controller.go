@@ -205,7 +205,10 @@ func (r *OCIManagedMachinePoolReconciler) SetupWithManager(ctx context.Context,
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(clusterToObjectFunc),
 			builder.WithPredicates(
-				predicates.ClusterUnpausedAndInfrastructureReady(ctrl.LoggerFrom(ctx)),
+				predicates.Any(ctrl.LoggerFrom(ctx),
+					predicates.ClusterUnpausedAndInfrastructureReady(ctrl.LoggerFrom(ctx)),
+					scope.ClusterHibernationChanged(ctrl.LoggerFrom(ctx)),
+				),
 			),
 		).
 		WithEventFilter(predicates.ResourceNotPaused(ctrl.LoggerFrom(ctx))).
@@ -19,6 +19,7 @@ package main
 import (
 	"flag"
 	"os"
+	"strings"
 	"time"
 
 	infrastructurev1beta1 "github.com/oracle/cluster-api-provider-oci/api/v1beta1"
@@ -61,6 +62,7 @@ var (
 
 const (
 	AuthConfigDirectory = "AUTH_CONFIG_DIR"
+	PodNamespace        = "POD_NAMESPACE"
 )
 
 func init() {
@@ -89,8 +91,15 @@ func main() {
 	var ociClusterConcurrency int
 	var ociMachineConcurrency int
 	var ociMachinePoolConcurrency int
+	var ociClusterNetworkConcurrency int
 	var initOciClientsOnStartup bool
 	var enableInstanceMetadataServiceLookup bool
+	// Flags for the orphaned resource janitor
+	var enableOrphanedResourceJanitor bool
+	var orphanedResourceJanitorCompartments string
+	var orphanedResourceJanitorInterval time.Duration
+	var orphanedResourceJanitorGracePeriod time.Duration
+	var orphanedResourceJanitorDelete bool
 
 	fs := pflag.CommandLine
 	logs.AddFlags(fs, logs.SkipLoggingConfigurationFlags())
@@ -150,6 +159,12 @@ func main() {
 		5,
 		"Number of OciMachinePools to process simultaneously",
 	)
+	flag.IntVar(
+		&ociClusterNetworkConcurrency,
+		"ocicluster-network-concurrency",
+		4,
+		"Number of network resources of an OciCluster to process simultaneously",
+	)
 	flag.BoolVar(
 		&initOciClientsOnStartup,
 		"init-oci-clients-on-startup",
@@ -168,6 +183,36 @@ func main() {
 		false,
 		"Initialize OCI clients on startup",
 	)
+	flag.BoolVar(
+		&enableOrphanedResourceJanitor,
+		"enable-orphaned-resource-janitor",
+		false,
+		"Periodically scan compartments for OCI resources tagged by CAPOCI which are not owned by any cluster",
+	)
+	flag.StringVar(
+		&orphanedResourceJanitorCompartments,
+		"orphaned-resource-janitor-compartments",
+		"",
+		"Comma separated list of the compartments scanned by the orphaned resource janitor",
+	)
+	flag.DurationVar(
+		&orphanedResourceJanitorInterval,
+		"orphaned-resource-janitor-interval",
+		1*time.Hour,
+		"Interval between two scans of the orphaned resource janitor (duration string)",
+	)
+	flag.DurationVar(
+		&orphanedResourceJanitorGracePeriod,
+		"orphaned-resource-janitor-grace-period",
+		24*time.Hour,
+		"Duration a resource has to be orphaned for before the orphaned resource janitor deletes it (duration string)",
+	)
+	flag.BoolVar(
+		&orphanedResourceJanitorDelete,
+		"orphaned-resource-janitor-delete",
+		false,
+		"Delete the orphaned resources after the grace period, otherwise they are only reported as events and metrics",
+	)
 
 	opts := zap.Options{
 		Development: true,
@@ -266,11 +311,12 @@ func main() {
 		common.EnableInstanceMetadataServiceLookup()
 	}
 	if err = (&controllers.OCIClusterReconciler{
-		Client:         mgr.GetClient(),
-		Scheme:         mgr.GetScheme(),
-		Region:         region,
-		ClientProvider: clientProvider,
-		Recorder:       mgr.GetEventRecorderFor("ocicluster-controller"),
+		Client:                         mgr.GetClient(),
+		Scheme:                         mgr.GetScheme(),
+		Region:                         region,
+		ClientProvider:                 clientProvider,
+		Recorder:                       mgr.GetEventRecorderFor("ocicluster-controller"),
+		MaxConcurrentNetworkReconciles: ociClusterNetworkConcurrency,
 	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: ociClusterConcurrency}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", scope.OCIClusterKind)
 		os.Exit(1)
@@ -359,11 +405,40 @@ func main() {
 		}
 	}
 
+	if enableOrphanedResourceJanitor {
+		setupLog.Info("enabling orphaned resource janitor")
+		var compartments []string
+		for _, compartment := range strings.Split(orphanedResourceJanitorCompartments, ",") {
+			if compartment = strings.TrimSpace(compartment); compartment != "" {
+				compartments = append(compartments, compartment)
+			}
+		}
+		if err = (&controllers.OrphanedResourceJanitor{
+			Client:         mgr.GetClient(),
+			Region:         region,
+			ClientProvider: clientProvider,
+			Recorder:       mgr.GetEventRecorderFor("orphaned-resource-janitor"),
+			Compartments:   compartments,
+			Interval:       orphanedResourceJanitorInterval,
+			GracePeriod:    orphanedResourceJanitorGracePeriod,
+			DeleteOrphans:  orphanedResourceJanitorDelete,
+			Namespace:      os.Getenv(PodNamespace),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create orphaned resource janitor")
+			os.Exit(1)
+		}
+	}
+
 	if err = (&infrastructurev1beta2.OCICluster{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "OCICluster")
 		os.Exit(1)
 	}
 
+	if err = (&infrastructurev1beta2.OCIMachine{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "OCIMachine")
+		os.Exit(1)
+	}
+
 	if err = (&infrastructurev1beta2.OCIMachineTemplate{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "OCIMachineTemplate")
 		os.Exit(1)